@@ -0,0 +1,263 @@
+package archiver
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/go-ssz"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/epoch/precompute"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/state"
+	"github.com/prysmaticlabs/prysm/beacon-chain/db/filters"
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"go.opencensus.io/trace"
+)
+
+var backfillProgress = promauto.NewGauge(prometheus.GaugeOpts{
+	Name: "archiver_backfill_epoch",
+	Help: "The next epoch to be archived by the archive backfill.",
+})
+
+// runBackfill archives the finalized epochs which were not archived as they were processed, such
+// as the epochs before archiving was enabled. The historical states are regenerated by replaying
+// the finalized blocks, and the progress is saved after every epoch so the backfill resumes from
+// there after a restart. It runs until it reaches the first epoch archived by the event loop.
+func (s *Service) runBackfill(ctx context.Context) {
+	if err := s.backfillArchive(ctx); err != nil && ctx.Err() == nil {
+		log.WithError(err).Error("Could not backfill archive")
+	}
+}
+
+func (s *Service) backfillArchive(ctx context.Context) error {
+	next, err := s.beaconDB.ArchiveBackfillEpoch(ctx)
+	if err != nil {
+		return errors.Wrap(err, "could not get archive backfill progress")
+	}
+	backfillProgress.Set(float64(next))
+	log.WithField("epoch", next).Info("Starting archive backfill")
+
+	var epochEndState *pb.BeaconState
+	// The participation of an epoch is computed at the end of the epoch before it.
+	var participation *precompute.Balance
+	for {
+		finalized, err := s.beaconDB.FinalizedCheckpoint(ctx)
+		if err != nil {
+			return errors.Wrap(err, "could not get finalized checkpoint")
+		}
+		// Only the blocks up to the finalized checkpoint are known to be canonical.
+		for ; next < finalized.Epoch; next++ {
+			if epochEndState == nil {
+				epochEndState, participation, err = s.backfillStartState(ctx, next)
+				if err != nil {
+					return errors.Wrap(err, "could not get start state")
+				}
+			}
+			epochEndState, err = s.replayToEpochEnd(ctx, epochEndState, next)
+			if err != nil {
+				return errors.Wrapf(err, "could not replay blocks of epoch %d", next)
+			}
+			if err := s.archiveBackfilledEpoch(ctx, epochEndState, next, participation); err != nil {
+				return errors.Wrapf(err, "could not archive epoch %d", next)
+			}
+			participation, err = epochBalance(ctx, epochEndState)
+			if err != nil {
+				return err
+			}
+			if err := s.beaconDB.SaveArchiveBackfillEpoch(ctx, next+1); err != nil {
+				return errors.Wrap(err, "could not save archive backfill progress")
+			}
+			backfillProgress.Set(float64(next + 1))
+			log.WithField("epoch", next).Debug("Backfilled archive of epoch")
+			select {
+			case <-time.After(s.backfillDelay):
+			case <-ctx.Done():
+				return ctx.Err()
+			}
+		}
+
+		// The epochs from the first one archived by the event loop onwards need no backfill.
+		if first, ok := s.firstArchivedEpoch(); ok && next >= first {
+			log.WithField("epoch", next).Info("Archive backfill done")
+			return nil
+		}
+		epochDuration := time.Duration(params.BeaconConfig().SecondsPerSlot*params.BeaconConfig().SlotsPerEpoch) * time.Second
+		select {
+		case <-time.After(epochDuration):
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+}
+
+// archiveBackfilledEpoch archives the data of the epoch from the state at its last slot, unless
+// the epoch was already archived.
+func (s *Service) archiveBackfilledEpoch(ctx context.Context, epochEndState *pb.BeaconState, epoch uint64, participation *precompute.Balance) error {
+	ctx, span := trace.StartSpan(ctx, "archiver.archiveBackfilledEpoch")
+	defer span.End()
+
+	balances, err := s.beaconDB.ArchivedBalances(ctx, epoch)
+	if err != nil {
+		return err
+	}
+	if balances != nil {
+		return nil
+	}
+	if err := s.archiveCommitteeInfo(ctx, epochEndState, epoch); err != nil {
+		return err
+	}
+	// There are no validator set changes before genesis.
+	if epoch > 0 {
+		if err := s.archiveActiveSetChanges(ctx, epochEndState, epoch); err != nil {
+			return err
+		}
+	}
+	if err := s.archiveParticipation(ctx, epoch, participation); err != nil {
+		return err
+	}
+	if err := s.archiveRewards(ctx, epochEndState, epoch); err != nil {
+		return err
+	}
+	// Balances are archived last, as they mark the epoch as archived.
+	return s.archiveBalances(ctx, epochEndState, epoch)
+}
+
+// backfillStartState returns the state to replay the blocks of the epoch from, with the balances
+// of the validator attestations at the end of the previous epoch. The latest stored state of a
+// finalized block before the epoch is used, or the genesis state if there is none.
+func (s *Service) backfillStartState(ctx context.Context, epoch uint64) (*pb.BeaconState, *precompute.Balance, error) {
+	if epoch == 0 {
+		genesisState, err := s.beaconDB.GenesisState(ctx)
+		if err != nil {
+			return nil, nil, err
+		}
+		if genesisState == nil {
+			return nil, nil, errors.New("no genesis state")
+		}
+		return genesisState, nil, nil
+	}
+
+	prevEndSlot := helpers.StartSlot(epoch) - 1
+	startState, err := s.latestFinalizedState(ctx, prevEndSlot)
+	if err != nil {
+		return nil, nil, err
+	}
+	if startState == nil {
+		startState, err = s.beaconDB.GenesisState(ctx)
+		if err != nil {
+			return nil, nil, err
+		}
+		if startState == nil {
+			return nil, nil, errors.New("no genesis state")
+		}
+	}
+	prevEndState, err := s.replayToEpochEnd(ctx, startState, epoch-1)
+	if err != nil {
+		return nil, nil, err
+	}
+	participation, err := epochBalance(ctx, prevEndState)
+	if err != nil {
+		return nil, nil, err
+	}
+	return prevEndState, participation, nil
+}
+
+// latestFinalizedState is the stored state of the finalized block with the highest slot at or
+// before the slot, or nil if no such state is stored.
+func (s *Service) latestFinalizedState(ctx context.Context, slot uint64) (*pb.BeaconState, error) {
+	for {
+		if ctx.Err() != nil {
+			return nil, ctx.Err()
+		}
+		startSlot := uint64(1)
+		if slot >= params.BeaconConfig().SlotsPerEpoch {
+			startSlot = slot - params.BeaconConfig().SlotsPerEpoch + 1
+		}
+		if slot < startSlot {
+			return nil, nil
+		}
+		blocks, err := s.beaconDB.Blocks(ctx, filters.NewFilter().SetStartSlot(startSlot).SetEndSlot(slot))
+		if err != nil {
+			return nil, err
+		}
+		sort.Slice(blocks, func(i, j int) bool {
+			return blocks[i].Block.Slot > blocks[j].Block.Slot
+		})
+		for _, b := range blocks {
+			root, err := ssz.HashTreeRoot(b.Block)
+			if err != nil {
+				return nil, err
+			}
+			if s.beaconDB.IsFinalizedBlock(ctx, root) && s.stateGen.HasState(ctx, root) {
+				return s.stateGen.StateByRoot(ctx, root)
+			}
+		}
+		if startSlot == 1 {
+			return nil, nil
+		}
+		slot = startSlot - 1
+	}
+}
+
+// replayToEpochEnd applies the finalized blocks after the slot of the state up to the last slot
+// of the epoch, and processes the empty slots up to it. The blocks were verified when they were
+// first processed, so their signatures are not verified again.
+func (s *Service) replayToEpochEnd(ctx context.Context, beaconState *pb.BeaconState, epoch uint64) (*pb.BeaconState, error) {
+	ctx, span := trace.StartSpan(ctx, "archiver.replayToEpochEnd")
+	defer span.End()
+
+	endSlot := helpers.StartSlot(epoch+1) - 1
+	if beaconState.Slot >= endSlot {
+		return beaconState, nil
+	}
+	blocks, err := s.beaconDB.Blocks(ctx, filters.NewFilter().SetStartSlot(beaconState.Slot+1).SetEndSlot(endSlot))
+	if err != nil {
+		return nil, err
+	}
+	sort.Slice(blocks, func(i, j int) bool {
+		return blocks[i].Block.Slot < blocks[j].Block.Slot
+	})
+	for _, b := range blocks {
+		canonical, err := s.isFinalizedBlock(ctx, b)
+		if err != nil {
+			return nil, err
+		}
+		if !canonical {
+			continue
+		}
+		beaconState, err = state.ExecuteStateTransitionNoVerify(ctx, beaconState, b)
+		if err != nil {
+			return nil, errors.Wrapf(err, "could not replay block at slot %d", b.Block.Slot)
+		}
+	}
+	if beaconState.Slot < endSlot {
+		beaconState, err = state.ProcessSlots(ctx, beaconState, endSlot)
+		if err != nil {
+			return nil, errors.Wrap(err, "could not process slots to the end of the epoch")
+		}
+	}
+	return beaconState, nil
+}
+
+func (s *Service) isFinalizedBlock(ctx context.Context, b *ethpb.SignedBeaconBlock) (bool, error) {
+	root, err := ssz.HashTreeRoot(b.Block)
+	if err != nil {
+		return false, err
+	}
+	return s.beaconDB.IsFinalizedBlock(ctx, root), nil
+}
+
+// epochBalance of the validator attestations matched at the end of the epoch of the state.
+func epochBalance(ctx context.Context, epochEndState *pb.BeaconState) (*precompute.Balance, error) {
+	vp, bp := precompute.New(ctx, epochEndState)
+	_, bp, err := precompute.ProcessAttestations(ctx, epochEndState, vp, bp)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not process attestations")
+	}
+	return bp, nil
+}
@@ -0,0 +1,159 @@
+package archiver
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/gogo/protobuf/proto"
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/go-ssz"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/blocks"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/state"
+	"github.com/prysmaticlabs/prysm/beacon-chain/db"
+	dbutil "github.com/prysmaticlabs/prysm/beacon-chain/db/testing"
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/testutil"
+)
+
+// generateFinalizedChain saves a genesis block and state followed by a block at every slot up to
+// the start of the finalized epoch, which is finalized. It returns the post states by slot.
+func generateFinalizedChain(t *testing.T, beaconDB db.Database, finalizedEpoch uint64) []*pb.BeaconState {
+	ctx := context.Background()
+	genesisState, privKeys := testutil.DeterministicGenesisState(t, 32)
+	stateRoot, err := ssz.HashTreeRoot(genesisState)
+	if err != nil {
+		t.Fatal(err)
+	}
+	genesisBlock := blocks.NewGenesisBlock(stateRoot[:])
+	genesisRoot, err := ssz.HashTreeRoot(genesisBlock.Block)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := beaconDB.SaveBlock(ctx, genesisBlock); err != nil {
+		t.Fatal(err)
+	}
+	if err := beaconDB.SaveGenesisBlockRoot(ctx, genesisRoot); err != nil {
+		t.Fatal(err)
+	}
+	if err := beaconDB.SaveState(ctx, genesisState, genesisRoot); err != nil {
+		t.Fatal(err)
+	}
+
+	states := []*pb.BeaconState{proto.Clone(genesisState).(*pb.BeaconState)}
+	beaconState := genesisState
+	var root [32]byte
+	finalizedSlot := finalizedEpoch * params.BeaconConfig().SlotsPerEpoch
+	for slot := uint64(1); slot <= finalizedSlot; slot++ {
+		b, err := testutil.GenerateFullBlock(beaconState, privKeys, nil, slot)
+		if err != nil {
+			t.Fatal(err)
+		}
+		beaconState, err = state.ExecuteStateTransition(ctx, beaconState, b)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err := beaconDB.SaveBlock(ctx, b); err != nil {
+			t.Fatal(err)
+		}
+		root, err = ssz.HashTreeRoot(b.Block)
+		if err != nil {
+			t.Fatal(err)
+		}
+		states = append(states, proto.Clone(beaconState).(*pb.BeaconState))
+	}
+	if err := beaconDB.SaveState(ctx, beaconState, root); err != nil {
+		t.Fatal(err)
+	}
+	if err := beaconDB.SaveFinalizedCheckpoint(ctx, &ethpb.Checkpoint{Epoch: finalizedEpoch, Root: root[:]}); err != nil {
+		t.Fatal(err)
+	}
+	return states
+}
+
+func TestArchiverService_BackfillsFinalizedEpochs(t *testing.T) {
+	svc, beaconDB := setupService(t)
+	defer dbutil.TeardownDB(t, beaconDB)
+	ctx := context.Background()
+	states := generateFinalizedChain(t, beaconDB, 2)
+	// The event loop archived from the finalized epoch onwards.
+	firstArchived := uint64(2)
+	svc.firstArchived = &firstArchived
+
+	if err := svc.backfillArchive(ctx); err != nil {
+		t.Fatal(err)
+	}
+
+	epochEnd := params.BeaconConfig().SlotsPerEpoch - 1
+	for epoch := uint64(0); epoch < 2; epoch++ {
+		endState := states[epoch*params.BeaconConfig().SlotsPerEpoch+epochEnd]
+		balances, err := beaconDB.ArchivedBalances(ctx, epoch)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !reflect.DeepEqual(balances, endState.Balances) {
+			t.Errorf("Wanted balances at the end of epoch %d %v, retrieved %v", epoch, endState.Balances, balances)
+		}
+		info, err := beaconDB.ArchivedCommitteeInfo(ctx, epoch)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if info == nil {
+			t.Errorf("Expected committee info of epoch %d to be archived", epoch)
+		}
+		rewards, err := beaconDB.ArchivedValidatorRewards(ctx, epoch)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if rewards == nil || len(rewards.Rewards) != len(endState.Validators) {
+			t.Errorf("Wanted rewards of %d validators for epoch %d, retrieved %v", len(endState.Validators), epoch, rewards)
+		}
+	}
+	next, err := beaconDB.ArchiveBackfillEpoch(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if next != 2 {
+		t.Errorf("Wanted backfill to continue from epoch 2, received %d", next)
+	}
+}
+
+func TestArchiverService_BackfillResumes(t *testing.T) {
+	svc, beaconDB := setupService(t)
+	defer dbutil.TeardownDB(t, beaconDB)
+	ctx := context.Background()
+	states := generateFinalizedChain(t, beaconDB, 2)
+	firstArchived := uint64(2)
+	svc.firstArchived = &firstArchived
+	if err := beaconDB.SaveArchiveBackfillEpoch(ctx, 1); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := svc.backfillArchive(ctx); err != nil {
+		t.Fatal(err)
+	}
+
+	balances, err := beaconDB.ArchivedBalances(ctx, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if balances != nil {
+		t.Error("Expected the epoch before the backfill progress not to be archived again")
+	}
+	endState := states[2*params.BeaconConfig().SlotsPerEpoch-1]
+	balances, err = beaconDB.ArchivedBalances(ctx, 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(balances, endState.Balances) {
+		t.Errorf("Wanted balances at the end of epoch 1 %v, retrieved %v", endState.Balances, balances)
+	}
+	participation, err := beaconDB.ArchivedValidatorParticipation(ctx, 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if participation == nil || participation.EligibleEther == 0 {
+		t.Errorf("Expected participation of epoch 1 from the end of epoch 0, retrieved %v", participation)
+	}
+}
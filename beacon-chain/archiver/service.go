@@ -3,16 +3,22 @@ package archiver
 import (
 	"context"
 	"fmt"
+	"sync"
+	"time"
 
 	"github.com/pkg/errors"
 	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
 	"github.com/prysmaticlabs/prysm/beacon-chain/blockchain"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/epoch/precompute"
 	"github.com/prysmaticlabs/prysm/beacon-chain/core/feed"
 	statefeed "github.com/prysmaticlabs/prysm/beacon-chain/core/feed/state"
 	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/state"
 	"github.com/prysmaticlabs/prysm/beacon-chain/core/validators"
 	"github.com/prysmaticlabs/prysm/beacon-chain/db"
+	"github.com/prysmaticlabs/prysm/beacon-chain/state/stategen"
 	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
 	"github.com/prysmaticlabs/prysm/shared/params"
 	"github.com/sirupsen/logrus"
 )
@@ -29,6 +35,11 @@ type Service struct {
 	participationFetcher blockchain.ParticipationFetcher
 	stateNotifier        statefeed.Notifier
 	lastArchivedEpoch    uint64
+	firstArchived        *uint64
+	firstArchivedLock    sync.RWMutex
+	backfill             bool
+	backfillDelay        time.Duration
+	stateGen             *stategen.State
 }
 
 // Config options for the archiver service.
@@ -37,6 +48,9 @@ type Config struct {
 	HeadFetcher          blockchain.HeadFetcher
 	ParticipationFetcher blockchain.ParticipationFetcher
 	StateNotifier        statefeed.Notifier
+	Backfill             bool
+	BackfillDelay        time.Duration
+	StateGen             *stategen.State
 }
 
 // NewArchiverService initializes the service from configuration options.
@@ -49,12 +63,18 @@ func NewArchiverService(ctx context.Context, cfg *Config) *Service {
 		headFetcher:          cfg.HeadFetcher,
 		participationFetcher: cfg.ParticipationFetcher,
 		stateNotifier:        cfg.StateNotifier,
+		backfill:             cfg.Backfill,
+		backfillDelay:        cfg.BackfillDelay,
+		stateGen:             cfg.StateGen,
 	}
 }
 
 // Start the archiver service event loop.
 func (s *Service) Start() {
 	go s.run(s.ctx)
+	if s.backfill {
+		go s.runBackfill(s.ctx)
+	}
 }
 
 // Stop the archiver service event loop.
@@ -114,10 +134,9 @@ func (s *Service) archiveActiveSetChanges(ctx context.Context, headState *pb.Bea
 	return nil
 }
 
-// We compute participation metrics by first retrieving the head state and
-// matching validator attestations during the epoch.
-func (s *Service) archiveParticipation(ctx context.Context, epoch uint64) error {
-	p := s.participationFetcher.Participation(epoch)
+// We compute participation metrics from the balances of the validator attestations
+// matched during the latest epoch processing.
+func (s *Service) archiveParticipation(ctx context.Context, epoch uint64, p *precompute.Balance) error {
 	participation := &ethpb.ValidatorParticipation{}
 	if p != nil {
 		participation = &ethpb.ValidatorParticipation{
@@ -138,6 +157,72 @@ func (s *Service) archiveBalances(ctx context.Context, headState *pb.BeaconState
 	return nil
 }
 
+// We archive the breakdown of the rewards and penalties applied to every validator by the
+// processing at the end of the epoch, which is computed from the state at the last slot of the epoch.
+func (s *Service) archiveRewards(ctx context.Context, epochEndState *pb.BeaconState, epoch uint64) error {
+	breakdown, err := precompute.RewardsBreakdown(ctx, epochEndState)
+	if err != nil {
+		return errors.Wrap(err, "could not compute rewards breakdown")
+	}
+	rewards := make([]*pb.ValidatorRewards, len(breakdown))
+	for i, r := range breakdown {
+		rewards[i] = &pb.ValidatorRewards{
+			SourceReward:         r.SourceReward,
+			TargetReward:         r.TargetReward,
+			HeadReward:           r.HeadReward,
+			InclusionDelayReward: r.InclusionDelayReward,
+			ProposerReward:       r.ProposerReward,
+			SourcePenalty:        r.SourcePenalty,
+			TargetPenalty:        r.TargetPenalty,
+			HeadPenalty:          r.HeadPenalty,
+			InactivityPenalty:    r.InactivityPenalty,
+			SlashingPenalty:      r.SlashingPenalty,
+		}
+	}
+	if err := s.beaconDB.SaveArchivedValidatorRewards(ctx, epoch, &pb.ArchivedValidatorRewards{Rewards: rewards}); err != nil {
+		return errors.Wrap(err, "could not archive validator rewards")
+	}
+	return nil
+}
+
+// epochEndState is the state at the last slot of the epoch, before the epoch transition. The head
+// state is past the epoch transition when the last slot of the epoch was skipped, in which case
+// the state is regenerated from the state of the last block of the epoch.
+func (s *Service) epochEndState(ctx context.Context, headState *pb.BeaconState, epoch uint64) (*pb.BeaconState, error) {
+	endSlot := helpers.StartSlot(epoch+1) - 1
+	if headState.Slot == endSlot {
+		return headState, nil
+	}
+	root, err := helpers.BlockRootAtSlot(headState, endSlot)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not get block root of the end of the epoch")
+	}
+	epochEndState, err := s.stateGen.StateByRoot(ctx, bytesutil.ToBytes32(root))
+	if err != nil {
+		return nil, err
+	}
+	if epochEndState == nil {
+		return nil, errors.Errorf("no state for block root %#x", root)
+	}
+	if epochEndState.Slot < endSlot {
+		epochEndState, err = state.ProcessSlots(ctx, epochEndState, endSlot)
+		if err != nil {
+			return nil, errors.Wrap(err, "could not process slots to the end of the epoch")
+		}
+	}
+	return epochEndState, nil
+}
+
+// firstArchivedEpoch by the event loop, if it archived any epoch yet.
+func (s *Service) firstArchivedEpoch() (uint64, bool) {
+	s.firstArchivedLock.RLock()
+	defer s.firstArchivedLock.RUnlock()
+	if s.firstArchived == nil {
+		return 0, false
+	}
+	return *s.firstArchived, true
+}
+
 func (s *Service) run(ctx context.Context) {
 	stateChannel := make(chan *feed.Event, 1)
 	stateSub := s.stateNotifier.StateFeed().Subscribe(stateChannel)
@@ -169,7 +254,7 @@ func (s *Service) run(ctx context.Context) {
 					log.WithError(err).Error("Could not archive active validator set changes")
 					continue
 				}
-				if err := s.archiveParticipation(ctx, epochToArchive); err != nil {
+				if err := s.archiveParticipation(ctx, epochToArchive, s.participationFetcher.Participation(epochToArchive)); err != nil {
 					log.WithError(err).Error("Could not archive validator participation")
 					continue
 				}
@@ -177,11 +262,23 @@ func (s *Service) run(ctx context.Context) {
 					log.WithError(err).Error("Could not archive validator balances and active indices")
 					continue
 				}
+				// Missing rewards of an epoch do not hold back the archival of the other data.
+				epochEndState, err := s.epochEndState(ctx, headState, epochToArchive)
+				if err != nil {
+					log.WithError(err).Error("Could not get state at the end of the epoch")
+				} else if err := s.archiveRewards(ctx, epochEndState, epochToArchive); err != nil {
+					log.WithError(err).Error("Could not archive validator rewards")
+				}
 				log.WithField(
 					"epoch",
 					epochToArchive,
 				).Debug("Successfully archived beacon chain data during epoch")
 				s.lastArchivedEpoch = epochToArchive
+				s.firstArchivedLock.Lock()
+				if s.firstArchived == nil {
+					s.firstArchived = &epochToArchive
+				}
+				s.firstArchivedLock.Unlock()
 			}
 		case <-s.ctx.Done():
 			log.Debug("Context closed, exiting goroutine")
@@ -17,6 +17,7 @@ import (
 	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
 	"github.com/prysmaticlabs/prysm/beacon-chain/db"
 	dbutil "github.com/prysmaticlabs/prysm/beacon-chain/db/testing"
+	"github.com/prysmaticlabs/prysm/beacon-chain/state/stategen"
 	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
 	"github.com/prysmaticlabs/prysm/shared/params"
 	"github.com/prysmaticlabs/prysm/shared/testutil"
@@ -198,6 +199,44 @@ func TestArchiverService_SavesIndicesAndBalances(t *testing.T) {
 	testutil.AssertLogsContain(t, hook, "Successfully archived")
 }
 
+func TestArchiverService_SavesValidatorRewards(t *testing.T) {
+	hook := logTest.NewGlobal()
+	validatorCount := uint64(100)
+	headState := setupState(validatorCount)
+	headState.CurrentEpochAttestations = nil
+	svc, beaconDB := setupService(t)
+	defer dbutil.TeardownDB(t, beaconDB)
+	svc.headFetcher = &mock.ChainService{
+		State: headState,
+	}
+	event := &feed.Event{
+		Type: statefeed.BlockProcessed,
+		Data: &statefeed.BlockProcessedData{
+			BlockRoot: [32]byte{1, 2, 3},
+			Verified:  true,
+		},
+	}
+	triggerStateEvent(t, svc, event)
+
+	retrieved, err := svc.beaconDB.ArchivedValidatorRewards(svc.ctx, helpers.CurrentEpoch(headState))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if retrieved == nil || uint64(len(retrieved.Rewards)) != validatorCount {
+		t.Fatalf("Wanted rewards of %d validators, retrieved %v", validatorCount, retrieved)
+	}
+	// No validator attested during the previous epoch, so all of them are penalized.
+	for i, r := range retrieved.Rewards {
+		if r.SourcePenalty == 0 || r.TargetPenalty == 0 || r.HeadPenalty == 0 {
+			t.Errorf("Wanted attestation penalties for validator %d, received %v", i, r)
+		}
+		if r.SourceReward != 0 || r.ProposerReward != 0 {
+			t.Errorf("Wanted no rewards for validator %d, received %v", i, r)
+		}
+	}
+	testutil.AssertLogsContain(t, hook, "Successfully archived")
+}
+
 func TestArchiverService_SavesCommitteeInfo(t *testing.T) {
 	hook := logTest.NewGlobal()
 	validatorCount := uint64(100)
@@ -388,6 +427,7 @@ func setupService(t *testing.T) (*Service, db.Database) {
 		ctx:           ctx,
 		cancel:        cancel,
 		stateNotifier: mockChainService.StateNotifier(),
+		stateGen:      stategen.New(beaconDB, 0),
 		participationFetcher: &mock.ChainService{
 			Balance: &precompute.Balance{PrevEpoch: totalBalance, PrevEpochTargetAttesters: 1}},
 	}, beaconDB
@@ -0,0 +1,162 @@
+// Package backup defines a service which backs up the beacon chain database at a fixed epoch
+// interval, and removes the backups past the retention.
+package backup
+
+import (
+	"context"
+	"sync/atomic"
+	"time"
+
+	"github.com/prysmaticlabs/prysm/beacon-chain/blockchain"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/feed"
+	statefeed "github.com/prysmaticlabs/prysm/beacon-chain/core/feed/state"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+	"github.com/prysmaticlabs/prysm/beacon-chain/db"
+	"github.com/prysmaticlabs/prysm/beacon-chain/db/kv"
+	"github.com/prysmaticlabs/prysm/beacon-chain/sync"
+	"github.com/sirupsen/logrus"
+)
+
+var log = logrus.WithField("prefix", "backup")
+
+// Service defining the scheduled backups of the beacon chain database.
+type Service struct {
+	ctx             context.Context
+	cancel          context.CancelFunc
+	beaconDB        db.Database
+	headFetcher     blockchain.HeadFetcher
+	stateNotifier   statefeed.Notifier
+	syncChecker     sync.Checker
+	epochs          uint64
+	retention       int
+	lastBackupEpoch uint64 // Accessed atomically, set once a backup succeeded.
+	retryEpoch      uint64 // Accessed atomically, the epoch from which a failed backup is retried.
+	backupRequests  chan uint64
+}
+
+// Config options for the backup service.
+type Config struct {
+	BeaconDB      db.Database
+	HeadFetcher   blockchain.HeadFetcher
+	StateNotifier statefeed.Notifier
+	SyncChecker   sync.Checker
+	// Epochs between two backups.
+	Epochs uint64
+	// Retention is the number of most recent backups to keep, or 0 to keep all backups.
+	Retention int
+}
+
+// NewBackupService initializes the service from configuration options.
+func NewBackupService(ctx context.Context, cfg *Config) *Service {
+	ctx, cancel := context.WithCancel(ctx)
+	return &Service{
+		ctx:            ctx,
+		cancel:         cancel,
+		beaconDB:       cfg.BeaconDB,
+		headFetcher:    cfg.HeadFetcher,
+		stateNotifier:  cfg.StateNotifier,
+		syncChecker:    cfg.SyncChecker,
+		epochs:         cfg.Epochs,
+		retention:      cfg.Retention,
+		backupRequests: make(chan uint64, 1),
+	}
+}
+
+// Start the backup service event loop.
+func (s *Service) Start() {
+	go s.backupLoop()
+	go s.run()
+}
+
+// Stop the backup service event loop.
+func (s *Service) Stop() error {
+	defer s.cancel()
+	return nil
+}
+
+// Status reports the healthy status of the backup service. Returning nil means service
+// is correctly running without error.
+func (s *Service) Status() error {
+	return nil
+}
+
+func (s *Service) run() {
+	stateChannel := make(chan *feed.Event, 1)
+	stateSub := s.stateNotifier.StateFeed().Subscribe(stateChannel)
+	defer stateSub.Unsubscribe()
+	for {
+		select {
+		case event := <-stateChannel:
+			if event.Type == statefeed.BlockProcessed {
+				s.requestBackup()
+			}
+		case <-s.ctx.Done():
+			log.Debug("Context closed, exiting goroutine")
+			return
+		case err := <-stateSub.Err():
+			log.WithError(err).Error("Subscription to state feed notifier failed")
+			return
+		}
+	}
+}
+
+// requestBackup once the head is at least the configured number of epochs past the last backup.
+// Backups are not taken while the node syncs, as the database changes quickly.
+func (s *Service) requestBackup() {
+	if s.syncChecker != nil && s.syncChecker.Syncing() {
+		return
+	}
+	epoch := helpers.SlotToEpoch(s.headFetcher.HeadSlot())
+	if !s.backupDue(epoch) {
+		return
+	}
+	// The backup runs apart from the event loop, so it does not hold back the state feed.
+	select {
+	case s.backupRequests <- epoch:
+	default:
+	}
+}
+
+// backupDue returns true if the epoch is the configured number of epochs past the last successful
+// backup, and past the epoch of the last failed backup.
+func (s *Service) backupDue(epoch uint64) bool {
+	return epoch >= atomic.LoadUint64(&s.lastBackupEpoch)+s.epochs && epoch >= atomic.LoadUint64(&s.retryEpoch)
+}
+
+// backupLoop backs up the database for every request.
+func (s *Service) backupLoop() {
+	for {
+		select {
+		case epoch := <-s.backupRequests:
+			s.backup(epoch)
+		case <-s.ctx.Done():
+			return
+		}
+	}
+}
+
+// backup the database at the epoch unless a request queued meanwhile was served, and prune the
+// older backups. The epoch is only recorded as backed up once the backup succeeded, a failed backup
+// is retried from the next epoch.
+func (s *Service) backup(epoch uint64) {
+	if !s.backupDue(epoch) {
+		return
+	}
+	start := time.Now()
+	if err := s.beaconDB.Backup(s.ctx); err != nil {
+		atomic.StoreUint64(&s.retryEpoch, epoch+1)
+		log.WithError(err).WithField("epoch", epoch).Error("Could not back up database")
+		return
+	}
+	atomic.StoreUint64(&s.lastBackupEpoch, epoch)
+	log.WithFields(logrus.Fields{
+		"epoch":    epoch,
+		"duration": time.Since(start),
+	}).Info("Backed up database")
+	if s.retention <= 0 {
+		return
+	}
+	if err := kv.PruneBackups(s.beaconDB.DatabasePath(), s.retention); err != nil {
+		log.WithError(err).Error("Could not remove old database backups")
+	}
+}
@@ -0,0 +1,108 @@
+package backup
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	mock "github.com/prysmaticlabs/prysm/beacon-chain/blockchain/testing"
+	"github.com/prysmaticlabs/prysm/beacon-chain/db"
+	mockSync "github.com/prysmaticlabs/prysm/beacon-chain/sync/initial-sync/testing"
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+	"github.com/prysmaticlabs/prysm/shared/params"
+)
+
+// backupDB records the backups of the database, which fail with the given error.
+type backupDB struct {
+	db.Database
+	err     error
+	backups int
+}
+
+func (d *backupDB) Backup(_ context.Context) error {
+	d.backups++
+	return d.err
+}
+
+// requestedEpoch returns the epoch of the backup requested by the service, if any.
+func requestedEpoch(svc *Service) (uint64, bool) {
+	svc.requestBackup()
+	select {
+	case epoch := <-svc.backupRequests:
+		return epoch, true
+	default:
+		return 0, false
+	}
+}
+
+func TestBackupService_RequestsBackupEveryEpochs(t *testing.T) {
+	chainService := &mock.ChainService{State: &pb.BeaconState{}}
+	syncChecker := &mockSync.Sync{}
+	svc := NewBackupService(context.Background(), &Config{
+		BeaconDB:      &backupDB{},
+		HeadFetcher:   chainService,
+		StateNotifier: chainService.StateNotifier(),
+		SyncChecker:   syncChecker,
+		Epochs:        4,
+	})
+
+	slotsPerEpoch := params.BeaconConfig().SlotsPerEpoch
+	chainService.State.Slot = 3 * slotsPerEpoch
+	if _, ok := requestedEpoch(svc); ok {
+		t.Error("Requested a backup before the first interval")
+	}
+	chainService.State.Slot = 4 * slotsPerEpoch
+	if epoch, ok := requestedEpoch(svc); !ok || epoch != 4 {
+		t.Errorf("Wanted a backup at epoch 4, received %d (requested: %v)", epoch, ok)
+	}
+	svc.backup(4)
+	chainService.State.Slot = 7 * slotsPerEpoch
+	if _, ok := requestedEpoch(svc); ok {
+		t.Error("Requested a backup before the interval since the last backup")
+	}
+	syncChecker.IsSyncing = true
+	chainService.State.Slot = 8 * slotsPerEpoch
+	if _, ok := requestedEpoch(svc); ok {
+		t.Error("Requested a backup while syncing")
+	}
+	syncChecker.IsSyncing = false
+	if epoch, ok := requestedEpoch(svc); !ok || epoch != 8 {
+		t.Errorf("Wanted a backup at epoch 8, received %d (requested: %v)", epoch, ok)
+	}
+}
+
+func TestBackupService_RetriesFailedBackup(t *testing.T) {
+	chainService := &mock.ChainService{State: &pb.BeaconState{}}
+	beaconDB := &backupDB{err: errors.New("disk full")}
+	svc := NewBackupService(context.Background(), &Config{
+		BeaconDB:      beaconDB,
+		HeadFetcher:   chainService,
+		StateNotifier: chainService.StateNotifier(),
+		Epochs:        4,
+	})
+
+	slotsPerEpoch := params.BeaconConfig().SlotsPerEpoch
+	chainService.State.Slot = 4 * slotsPerEpoch
+	if epoch, ok := requestedEpoch(svc); !ok || epoch != 4 {
+		t.Fatalf("Wanted a backup at epoch 4, received %d (requested: %v)", epoch, ok)
+	}
+	svc.backup(4)
+	if _, ok := requestedEpoch(svc); ok {
+		t.Error("Requested a backup again in the epoch of the failed backup")
+	}
+
+	// The failed backup is retried from the next epoch, not after a full interval.
+	beaconDB.err = nil
+	chainService.State.Slot = 5 * slotsPerEpoch
+	if epoch, ok := requestedEpoch(svc); !ok || epoch != 5 {
+		t.Fatalf("Wanted a backup at epoch 5, received %d (requested: %v)", epoch, ok)
+	}
+	svc.backup(5)
+	if beaconDB.backups != 2 {
+		t.Errorf("Wanted 2 backups, received %d", beaconDB.backups)
+	}
+	chainService.State.Slot = 8 * slotsPerEpoch
+	if _, ok := requestedEpoch(svc); ok {
+		t.Error("Requested a backup before the interval since the last successful backup")
+	}
+}
@@ -6,6 +6,7 @@ import (
 
 	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
 	testDB "github.com/prysmaticlabs/prysm/beacon-chain/db/testing"
+	"github.com/prysmaticlabs/prysm/beacon-chain/state/stategen"
 )
 
 func TestHeadSlot_DataRace(t *testing.T) {
@@ -14,6 +15,7 @@ func TestHeadSlot_DataRace(t *testing.T) {
 	s := &Service{
 		beaconDB:       db,
 		canonicalRoots: make(map[uint64][]byte),
+		stateGen:       stategen.New(db, 0),
 	}
 	go func() {
 		s.saveHead(
@@ -31,6 +33,7 @@ func TestHeadRoot_DataRace(t *testing.T) {
 	s := &Service{
 		beaconDB:       db,
 		canonicalRoots: make(map[uint64][]byte),
+		stateGen:       stategen.New(db, 0),
 	}
 	go func() {
 		s.saveHead(
@@ -48,6 +51,7 @@ func TestHeadBlock_DataRace(t *testing.T) {
 	s := &Service{
 		beaconDB:       db,
 		canonicalRoots: make(map[uint64][]byte),
+		stateGen:       stategen.New(db, 0),
 	}
 	go func() {
 		s.saveHead(
@@ -65,6 +69,7 @@ func TestHeadState_DataRace(t *testing.T) {
 	s := &Service{
 		beaconDB:       db,
 		canonicalRoots: make(map[uint64][]byte),
+		stateGen:       stategen.New(db, 0),
 	}
 	go func() {
 		s.saveHead(
@@ -14,6 +14,7 @@ import (
 	testDB "github.com/prysmaticlabs/prysm/beacon-chain/db/testing"
 	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
 	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/featureconfig"
 	"github.com/prysmaticlabs/prysm/shared/params"
 	"gopkg.in/yaml.v2"
 )
@@ -30,6 +31,18 @@ type Config struct {
 }
 
 func TestGetHeadFromYaml(t *testing.T) {
+	testGetHeadFromYaml(t)
+}
+
+func TestGetHeadFromYaml_ProtoArray(t *testing.T) {
+	featureconfig.Init(&featureconfig.Flags{ProtoArrayForkChoice: true})
+	defer featureconfig.Init(nil)
+	testGetHeadFromYaml(t)
+}
+
+// testGetHeadFromYaml runs the scenarios of the yaml file against the fork choice selected by the
+// feature flags.
+func testGetHeadFromYaml(t *testing.T) {
 	ctx := context.Background()
 	filename, _ := filepath.Abs("./lmd_ghost_test.yaml")
 	yamlFile, err := ioutil.ReadFile(filename)
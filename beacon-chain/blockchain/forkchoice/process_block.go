@@ -13,7 +13,6 @@ import (
 	"github.com/prysmaticlabs/go-ssz"
 	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
 	"github.com/prysmaticlabs/prysm/beacon-chain/core/state"
-	"github.com/prysmaticlabs/prysm/beacon-chain/db/filters"
 	"github.com/prysmaticlabs/prysm/beacon-chain/flags"
 	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
 	"github.com/prysmaticlabs/prysm/shared/bytesutil"
@@ -89,9 +88,12 @@ func (s *Store) OnBlock(ctx context.Context, signed *ethpb.SignedBeaconBlock) er
 	if err := s.db.SaveBlock(ctx, signed); err != nil {
 		return errors.Wrapf(err, "could not save block from slot %d", b.Slot)
 	}
-	if err := s.db.SaveState(ctx, postState, root); err != nil {
+	if err := s.stateGen.SaveState(ctx, root, postState); err != nil {
 		return errors.Wrap(err, "could not save state")
 	}
+	if err := s.insertProtoArrayBlock(ctx, b, root, postState); err != nil {
+		return err
+	}
 
 	// Update justified check point.
 	if postState.CurrentJustifiedCheckpoint.Epoch > s.justifiedCheckpt.Epoch {
@@ -107,13 +109,11 @@ func (s *Store) OnBlock(ctx context.Context, signed *ethpb.SignedBeaconBlock) er
 			return errors.Wrap(err, "could not save finalized checkpoint")
 		}
 
-		startSlot := helpers.StartSlot(s.prevFinalizedCheckpt.Epoch)
-		endSlot := helpers.StartSlot(s.finalizedCheckpt.Epoch)
-		if endSlot > startSlot {
-			if err := s.rmStatesOlderThanLastFinalized(ctx, startSlot, endSlot); err != nil {
-				return errors.Wrapf(err, "could not delete states prior to finalized check point, range: %d, %d",
-					startSlot, endSlot)
-			}
+		if err := s.stateGen.MigrateToCold(ctx, bytesutil.ToBytes32(postState.FinalizedCheckpoint.Root)); err != nil {
+			return errors.Wrap(err, "could not migrate finalized states to cold storage")
+		}
+		if err := s.pruneProtoArray(ctx, bytesutil.ToBytes32(postState.FinalizedCheckpoint.Root)); err != nil {
+			return err
 		}
 
 		s.prevFinalizedCheckpt = s.finalizedCheckpt
@@ -189,10 +189,13 @@ func (s *Store) OnBlockInitialSyncStateTransition(ctx context.Context, signed *e
 	if featureconfig.Get().InitSyncCacheState {
 		s.initSyncState[root] = postState
 	} else {
-		if err := s.db.SaveState(ctx, postState, root); err != nil {
+		if err := s.stateGen.SaveState(ctx, root, postState); err != nil {
 			return errors.Wrap(err, "could not save state")
 		}
 	}
+	if err := s.insertProtoArrayBlock(ctx, b, root, postState); err != nil {
+		return err
+	}
 
 	// Update justified check point.
 	if postState.CurrentJustifiedCheckpoint.Epoch > s.justifiedCheckpt.Epoch {
@@ -204,15 +207,6 @@ func (s *Store) OnBlockInitialSyncStateTransition(ctx context.Context, signed *e
 	// Update finalized check point.
 	// Prune the block cache and helper caches on every new finalized epoch.
 	if postState.FinalizedCheckpoint.Epoch > s.finalizedCheckpt.Epoch {
-		startSlot := helpers.StartSlot(s.prevFinalizedCheckpt.Epoch)
-		endSlot := helpers.StartSlot(s.finalizedCheckpt.Epoch)
-		if endSlot > startSlot {
-			if err := s.rmStatesOlderThanLastFinalized(ctx, startSlot, endSlot); err != nil {
-				return errors.Wrapf(err, "could not delete states prior to finalized check point, range: %d, %d",
-					startSlot, endSlot)
-			}
-		}
-
 		if err := s.saveInitState(ctx, postState); err != nil {
 			return errors.Wrap(err, "could not save init sync finalized state")
 		}
@@ -221,6 +215,13 @@ func (s *Store) OnBlockInitialSyncStateTransition(ctx context.Context, signed *e
 			return errors.Wrap(err, "could not save finalized checkpoint")
 		}
 
+		if err := s.stateGen.MigrateToCold(ctx, bytesutil.ToBytes32(postState.FinalizedCheckpoint.Root)); err != nil {
+			return errors.Wrap(err, "could not migrate finalized states to cold storage")
+		}
+		if err := s.pruneProtoArray(ctx, bytesutil.ToBytes32(postState.FinalizedCheckpoint.Root)); err != nil {
+			return err
+		}
+
 		s.prevFinalizedCheckpt = s.finalizedCheckpt
 		s.finalizedCheckpt = postState.FinalizedCheckpoint
 	}
@@ -280,7 +281,7 @@ func (s *Store) getBlockPreState(ctx context.Context, b *ethpb.BeaconBlock) (*pb
 
 // verifyBlkPreState validates input block has a valid pre-state.
 func (s *Store) verifyBlkPreState(ctx context.Context, b *ethpb.BeaconBlock) (*pb.BeaconState, error) {
-	preState, err := s.db.State(ctx, bytesutil.ToBytes32(b.ParentRoot))
+	preState, err := s.stateGen.StateByRoot(ctx, bytesutil.ToBytes32(b.ParentRoot))
 	if err != nil {
 		return nil, errors.Wrapf(err, "could not get pre state for slot %d", b.Slot)
 	}
@@ -361,64 +362,6 @@ func (s *Store) saveNewBlockAttestations(ctx context.Context, atts []*ethpb.Atte
 	return nil
 }
 
-// rmStatesOlderThanLastFinalized deletes the states in db since last finalized check point.
-func (s *Store) rmStatesOlderThanLastFinalized(ctx context.Context, startSlot uint64, endSlot uint64) error {
-	ctx, span := trace.StartSpan(ctx, "forkchoice.rmStatesBySlots")
-	defer span.End()
-
-	// Make sure start slot is not a skipped slot
-	for i := startSlot; i > 0; i-- {
-		filter := filters.NewFilter().SetStartSlot(i).SetEndSlot(i)
-		b, err := s.db.Blocks(ctx, filter)
-		if err != nil {
-			return err
-		}
-		if len(b) > 0 {
-			startSlot = i
-			break
-		}
-	}
-
-	// Make sure finalized slot is not a skipped slot.
-	for i := endSlot; i > 0; i-- {
-		filter := filters.NewFilter().SetStartSlot(i).SetEndSlot(i)
-		b, err := s.db.Blocks(ctx, filter)
-		if err != nil {
-			return err
-		}
-		if len(b) > 0 {
-			endSlot = i - 1
-			break
-		}
-	}
-
-	// Do not remove genesis state
-	if startSlot == 0 {
-		startSlot++
-	}
-	// If end slot comes less than start slot
-	if endSlot < startSlot {
-		endSlot = startSlot
-	}
-
-	filter := filters.NewFilter().SetStartSlot(startSlot).SetEndSlot(endSlot)
-	roots, err := s.db.BlockRoots(ctx, filter)
-	if err != nil {
-		return err
-	}
-
-	roots, err = s.filterBlockRoots(ctx, roots)
-	if err != nil {
-		return err
-	}
-
-	if err := s.db.DeleteStates(ctx, roots); err != nil {
-		return err
-	}
-
-	return nil
-}
-
 // shouldUpdateCurrentJustified prevents bouncing attack, by only update conflicting justified
 // checkpoints in the fork choice if in the early slots of the epoch.
 // Otherwise, delay incorporation of new justified checkpoint until next epoch boundary.
@@ -501,7 +444,7 @@ func (s *Store) cachedPreState(ctx context.Context, b *ethpb.BeaconBlock) (*pb.B
 		preState := s.initSyncState[bytesutil.ToBytes32(b.ParentRoot)]
 		var err error
 		if preState == nil {
-			preState, err = s.db.State(ctx, bytesutil.ToBytes32(b.ParentRoot))
+			preState, err = s.stateGen.StateByRoot(ctx, bytesutil.ToBytes32(b.ParentRoot))
 			if err != nil {
 				return nil, errors.Wrapf(err, "could not get pre state for slot %d", b.Slot)
 			}
@@ -512,7 +455,7 @@ func (s *Store) cachedPreState(ctx context.Context, b *ethpb.BeaconBlock) (*pb.B
 		return proto.Clone(preState).(*pb.BeaconState), nil
 	}
 
-	preState, err := s.db.State(ctx, bytesutil.ToBytes32(b.ParentRoot))
+	preState, err := s.stateGen.StateByRoot(ctx, bytesutil.ToBytes32(b.ParentRoot))
 	if err != nil {
 		return nil, errors.Wrapf(err, "could not get pre state for slot %d", b.Slot)
 	}
@@ -542,31 +485,3 @@ func (s *Store) saveInitState(ctx context.Context, state *pb.BeaconState) error
 	}
 	return nil
 }
-
-// This filters block roots that are not known as head root and finalized root in DB.
-// It serves as the last line of defence before we prune states.
-func (s *Store) filterBlockRoots(ctx context.Context, roots [][32]byte) ([][32]byte, error) {
-	f, err := s.db.FinalizedCheckpoint(ctx)
-	if err != nil {
-		return nil, err
-	}
-	fRoot := f.Root
-	h, err := s.db.HeadBlock(ctx)
-	if err != nil {
-		return nil, err
-	}
-	hRoot, err := ssz.SigningRoot(h)
-	if err != nil {
-		return nil, err
-	}
-
-	filtered := make([][32]byte, 0, len(roots))
-	for _, root := range roots {
-		if bytes.Equal(root[:], fRoot[:]) || bytes.Equal(root[:], hRoot[:]) {
-			continue
-		}
-		filtered = append(filtered, root)
-	}
-
-	return filtered, nil
-}
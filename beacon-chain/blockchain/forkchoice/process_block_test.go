@@ -12,7 +12,6 @@ import (
 	"github.com/prysmaticlabs/go-bitfield"
 	"github.com/prysmaticlabs/go-ssz"
 	"github.com/prysmaticlabs/prysm/beacon-chain/core/blocks"
-	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
 	testDB "github.com/prysmaticlabs/prysm/beacon-chain/db/testing"
 	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
 	"github.com/prysmaticlabs/prysm/shared/bytesutil"
@@ -194,79 +193,7 @@ func TestStore_SavesNewBlockAttestations(t *testing.T) {
 	}
 }
 
-func TestRemoveStateSinceLastFinalized(t *testing.T) {
-	ctx := context.Background()
-	db := testDB.SetupDB(t)
-	defer testDB.TeardownDB(t, db)
-	params.UseMinimalConfig()
-	defer params.UseMainnetConfig()
-
-	store := NewForkChoiceService(ctx, db)
-
-	// Save 100 blocks in DB, each has a state.
-	numBlocks := 100
-	totalBlocks := make([]*ethpb.SignedBeaconBlock, numBlocks)
-	blockRoots := make([][32]byte, 0)
-	for i := 0; i < len(totalBlocks); i++ {
-		totalBlocks[i] = &ethpb.SignedBeaconBlock{
-			Block: &ethpb.BeaconBlock{
-				Slot: uint64(i),
-			},
-		}
-		r, err := ssz.HashTreeRoot(totalBlocks[i].Block)
-		if err != nil {
-			t.Fatal(err)
-		}
-		if err := store.db.SaveState(ctx, &pb.BeaconState{Slot: uint64(i)}, r); err != nil {
-			t.Fatal(err)
-		}
-		if err := store.db.SaveBlock(ctx, totalBlocks[i]); err != nil {
-			t.Fatal(err)
-		}
-		blockRoots = append(blockRoots, r)
-		if err := store.db.SaveHeadBlockRoot(ctx, r); err != nil {
-			t.Fatal(err)
-		}
-	}
-
-	// New finalized epoch: 1
-	finalizedEpoch := uint64(1)
-	finalizedSlot := finalizedEpoch * params.BeaconConfig().SlotsPerEpoch
-	endSlot := helpers.StartSlot(finalizedEpoch+1) - 1 // Inclusive
-	if err := store.rmStatesOlderThanLastFinalized(ctx, 0, endSlot); err != nil {
-		t.Fatal(err)
-	}
-	for _, r := range blockRoots {
-		s, err := store.db.State(ctx, r)
-		if err != nil {
-			t.Fatal(err)
-		}
-		// Also verifies genesis state didnt get deleted
-		if s != nil && s.Slot != finalizedSlot && s.Slot != 0 && s.Slot < endSlot {
-			t.Errorf("State with slot %d should not be in DB", s.Slot)
-		}
-	}
-
-	// New finalized epoch: 5
-	newFinalizedEpoch := uint64(5)
-	newFinalizedSlot := newFinalizedEpoch * params.BeaconConfig().SlotsPerEpoch
-	endSlot = helpers.StartSlot(newFinalizedEpoch+1) - 1 // Inclusive
-	if err := store.rmStatesOlderThanLastFinalized(ctx, helpers.StartSlot(finalizedEpoch+1)-1, endSlot); err != nil {
-		t.Fatal(err)
-	}
-	for _, r := range blockRoots {
-		s, err := store.db.State(ctx, r)
-		if err != nil {
-			t.Fatal(err)
-		}
-		// Also verifies genesis state didnt get deleted
-		if s != nil && s.Slot != newFinalizedSlot && s.Slot != finalizedSlot && s.Slot != 0 && s.Slot < endSlot {
-			t.Errorf("State with slot %d should not be in DB", s.Slot)
-		}
-	}
-}
-
-func TestRemoveStateSinceLastFinalized_EmptyStartSlot(t *testing.T) {
+func TestShouldUpdateJustified_ReturnTrue(t *testing.T) {
 	ctx := context.Background()
 	db := testDB.SetupDB(t)
 	defer testDB.TeardownDB(t, db)
@@ -375,45 +302,6 @@ func TestUpdateJustifiedCheckpoint_NoUpdate(t *testing.T) {
 
 	if bytes.Equal(store.justifiedCheckpt.Root, []byte{'B'}) {
 		t.Error("Justified check point root was not suppose to update")
-
-		store := NewForkChoiceService(ctx, db)
-
-		// Save 5 blocks in DB, each has a state.
-		numBlocks := 5
-		totalBlocks := make([]*ethpb.SignedBeaconBlock, numBlocks)
-		blockRoots := make([][32]byte, 0)
-		for i := 0; i < len(totalBlocks); i++ {
-			totalBlocks[i] = &ethpb.SignedBeaconBlock{
-				Block: &ethpb.BeaconBlock{
-					Slot: uint64(i),
-				},
-			}
-			r, err := ssz.HashTreeRoot(totalBlocks[i].Block)
-			if err != nil {
-				t.Fatal(err)
-			}
-			if err := store.db.SaveState(ctx, &pb.BeaconState{Slot: uint64(i)}, r); err != nil {
-				t.Fatal(err)
-			}
-			if err := store.db.SaveBlock(ctx, totalBlocks[i]); err != nil {
-				t.Fatal(err)
-			}
-			blockRoots = append(blockRoots, r)
-		}
-		if err := store.db.SaveHeadBlockRoot(ctx, blockRoots[0]); err != nil {
-			t.Fatal(err)
-		}
-		if err := store.rmStatesOlderThanLastFinalized(ctx, 10, 11); err != nil {
-			t.Fatal(err)
-		}
-		// Since 5-10 are skip slots, block with slot 4 should be deleted
-		s, err := store.db.State(ctx, blockRoots[4])
-		if err != nil {
-			t.Fatal(err)
-		}
-		if s != nil {
-			t.Error("Did not delete state for start slot")
-		}
 	}
 }
 
@@ -568,45 +456,3 @@ func TestUpdateJustified_CouldUpdateBest(t *testing.T) {
 		t.Error("Incorrect justified epoch in store")
 	}
 }
-
-func TestFilterBlockRoots_CanFilter(t *testing.T) {
-	ctx := context.Background()
-	db := testDB.SetupDB(t)
-	defer testDB.TeardownDB(t, db)
-
-	store := NewForkChoiceService(ctx, db)
-	fBlock := &ethpb.BeaconBlock{}
-	fRoot, _ := ssz.HashTreeRoot(fBlock)
-	hBlock := &ethpb.BeaconBlock{Slot: 1}
-	headRoot, _ := ssz.HashTreeRoot(hBlock)
-	if err := store.db.SaveBlock(ctx, &ethpb.SignedBeaconBlock{Block: fBlock}); err != nil {
-		t.Fatal(err)
-	}
-	if err := store.db.SaveState(ctx, &pb.BeaconState{}, fRoot); err != nil {
-		t.Fatal(err)
-	}
-	if err := store.db.SaveFinalizedCheckpoint(ctx, &ethpb.Checkpoint{Root: fRoot[:]}); err != nil {
-		t.Fatal(err)
-	}
-	if err := store.db.SaveBlock(ctx, &ethpb.SignedBeaconBlock{Block: hBlock}); err != nil {
-		t.Fatal(err)
-	}
-	if err := store.db.SaveState(ctx, &pb.BeaconState{}, headRoot); err != nil {
-		t.Fatal(err)
-	}
-	if err := store.db.SaveHeadBlockRoot(ctx, headRoot); err != nil {
-		t.Fatal(err)
-	}
-
-	roots := [][32]byte{{'C'}, {'D'}, headRoot, {'E'}, fRoot, {'F'}}
-	wanted := [][32]byte{{'C'}, {'D'}, {'E'}, {'F'}}
-
-	received, err := store.filterBlockRoots(ctx, roots)
-	if err != nil {
-		t.Fatal(err)
-	}
-
-	if !reflect.DeepEqual(wanted, received) {
-		t.Error("Did not filter correctly")
-	}
-}
@@ -0,0 +1,145 @@
+package forkchoice
+
+import (
+	"context"
+	"sort"
+
+	"github.com/pkg/errors"
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/go-ssz"
+	"github.com/prysmaticlabs/prysm/beacon-chain/blockchain/forkchoice/protoarray"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+	"github.com/prysmaticlabs/prysm/beacon-chain/db/filters"
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"go.opencensus.io/trace"
+)
+
+// initProtoArray builds the proto-array fork choice from the finalized block and the blocks after
+// it in the DB, so the head can be computed right away after a restart.
+func (s *Store) initProtoArray(ctx context.Context) error {
+	ctx, span := trace.StartSpan(ctx, "forkchoice.initProtoArray")
+	defer span.End()
+
+	finalizedRoot := bytesutil.ToBytes32(s.finalizedCheckpt.Root)
+	finalizedBlock, err := s.db.Block(ctx, finalizedRoot)
+	if err != nil {
+		return errors.Wrap(err, "could not get finalized block")
+	}
+	if finalizedBlock == nil || finalizedBlock.Block == nil {
+		return errors.Errorf("finalized block %#x does not exist", finalizedRoot)
+	}
+
+	s.protoArray = protoarray.New(s.justifiedCheckpt.Epoch, s.finalizedCheckpt.Epoch, finalizedRoot)
+	if err := s.protoArray.ProcessBlock(
+		ctx,
+		finalizedBlock.Block.Slot,
+		finalizedRoot,
+		bytesutil.ToBytes32(finalizedBlock.Block.ParentRoot),
+		s.justifiedCheckpt.Epoch,
+		s.finalizedCheckpt.Epoch,
+	); err != nil {
+		return errors.Wrap(err, "could not insert finalized block to proto-array")
+	}
+
+	blks, err := s.db.Blocks(ctx, filters.NewFilter().SetStartSlot(finalizedBlock.Block.Slot+1))
+	if err != nil {
+		return errors.Wrap(err, "could not get non-finalized blocks")
+	}
+	// Parents come before their children once sorted by slot.
+	sort.Slice(blks, func(i, j int) bool {
+		return blks[i].Block.Slot < blks[j].Block.Slot
+	})
+	for _, b := range blks {
+		if b == nil || b.Block == nil {
+			continue
+		}
+		// Blocks of a branch which does not descend from the finalized block can never be the head.
+		if !s.protoArray.HasNode(bytesutil.ToBytes32(b.Block.ParentRoot)) {
+			continue
+		}
+		root, err := ssz.HashTreeRoot(b.Block)
+		if err != nil {
+			return errors.Wrapf(err, "could not get signing root of block %d", b.Block.Slot)
+		}
+		postState, err := s.stateGen.StateByRoot(ctx, root)
+		if err != nil {
+			return errors.Wrapf(err, "could not get post state of block %d", b.Block.Slot)
+		}
+		if postState == nil {
+			continue
+		}
+		if err := s.insertProtoArrayBlock(ctx, b.Block, root, postState); err != nil {
+			return err
+		}
+	}
+	log.WithField("blocks", s.protoArray.NodeCount()).Info("Initialized proto-array fork choice")
+	return nil
+}
+
+// insertProtoArrayBlock inserts the block to the proto-array fork choice, if it is enabled.
+func (s *Store) insertProtoArrayBlock(ctx context.Context, b *ethpb.BeaconBlock, root [32]byte, postState *pb.BeaconState) error {
+	if s.protoArray == nil {
+		return nil
+	}
+	if err := s.protoArray.ProcessBlock(
+		ctx,
+		b.Slot,
+		root,
+		bytesutil.ToBytes32(b.ParentRoot),
+		postState.GetCurrentJustifiedCheckpoint().GetEpoch(),
+		postState.GetFinalizedCheckpoint().GetEpoch(),
+	); err != nil {
+		return errors.Wrap(err, "could not insert block to proto-array")
+	}
+	return nil
+}
+
+// pruneProtoArray prunes the proto-array fork choice up to the finalized block, if it is enabled.
+func (s *Store) pruneProtoArray(ctx context.Context, finalizedRoot [32]byte) error {
+	if s.protoArray == nil {
+		return nil
+	}
+	if err := s.protoArray.Prune(ctx, finalizedRoot); err != nil {
+		return errors.Wrap(err, "could not prune proto-array")
+	}
+	return nil
+}
+
+// protoArrayHead returns the head computed by the proto-array fork choice, weighting the latest
+// votes with the effective balances of the active validators of the justified checkpoint state.
+func (s *Store) protoArrayHead(ctx context.Context) ([]byte, error) {
+	ctx, span := trace.StartSpan(ctx, "forkchoice.protoArrayHead")
+	defer span.End()
+
+	justifiedCheckpt := s.JustifiedCheckpt()
+	justifiedState, err := s.checkpointState.StateByCheckpoint(justifiedCheckpt)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not retrieve cached state via last justified check point")
+	}
+	if justifiedState == nil {
+		return nil, errors.Errorf("could not get justified state at epoch %d", justifiedCheckpt.Epoch)
+	}
+	epoch := helpers.CurrentEpoch(justifiedState)
+	balances := make([]uint64, len(justifiedState.Validators))
+	for i, v := range justifiedState.Validators {
+		if helpers.IsActiveValidator(v, epoch) {
+			balances[i] = v.EffectiveBalance
+		}
+	}
+
+	s.voteLock.RLock()
+	defer s.voteLock.RUnlock()
+	head, err := s.protoArray.Head(
+		ctx,
+		justifiedCheckpt.Epoch,
+		bytesutil.ToBytes32(justifiedCheckpt.Root),
+		s.finalizedCheckpt.Epoch,
+		balances,
+		s.latestVoteMap,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return head[:], nil
+}
@@ -0,0 +1,7 @@
+/*
+Package protoarray implements the proto-array fork choice. The non-finalized blocks are kept in
+an array in which every block comes after its parent, so the weights of the votes and the best
+descendant of every block are updated in a single backwards pass over the array, from the
+changes of the latest votes since the last head computation.
+*/
+package protoarray
@@ -0,0 +1,136 @@
+package protoarray
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"go.opencensus.io/trace"
+)
+
+// New initializes an empty proto-array fork choice. The first block processed must be the
+// finalized block, which is the root of the tree.
+func New(justifiedEpoch uint64, finalizedEpoch uint64, finalizedRoot [32]byte) *ForkChoice {
+	s := &Store{
+		pruneThreshold: defaultPruneThreshold,
+		justifiedEpoch: justifiedEpoch,
+		finalizedEpoch: finalizedEpoch,
+		finalizedRoot:  finalizedRoot,
+		nodes:          make([]*Node, 0),
+		nodeIndices:    make(map[[32]byte]uint64),
+	}
+	return &ForkChoice{
+		store: s,
+		votes: make(map[uint64]appliedVote),
+	}
+}
+
+// ProcessBlock inserts the block to the tree with the justified and finalized epochs of its post
+// state. The parent of the block must be in the tree, unless the block is the finalized root.
+func (f *ForkChoice) ProcessBlock(ctx context.Context, slot uint64, root [32]byte, parentRoot [32]byte, justifiedEpoch uint64, finalizedEpoch uint64) error {
+	ctx, span := trace.StartSpan(ctx, "protoArrayForkChoice.ProcessBlock")
+	defer span.End()
+
+	f.lock.Lock()
+	defer f.lock.Unlock()
+	_, parentKnown := f.store.nodeIndices[parentRoot]
+	if !parentKnown && root != f.store.finalizedRoot {
+		return errors.Errorf("unknown parent %#x of block %#x", parentRoot, root)
+	}
+	if err := f.store.insert(ctx, slot, root, parentRoot, justifiedEpoch, finalizedEpoch); err != nil {
+		return err
+	}
+	nodeCount.Set(float64(len(f.store.nodes)))
+	return nil
+}
+
+// Head applies the changes of the latest votes and the justified balances since the last call
+// to the weights of the tree, and returns the root of the best descendant of the justified block.
+// The balances are indexed by validator index.
+func (f *ForkChoice) Head(
+	ctx context.Context,
+	justifiedEpoch uint64,
+	justifiedRoot [32]byte,
+	finalizedEpoch uint64,
+	balances []uint64,
+	latestVotes map[uint64]*pb.ValidatorLatestVote,
+) ([32]byte, error) {
+	ctx, span := trace.StartSpan(ctx, "protoArrayForkChoice.Head")
+	defer span.End()
+
+	f.lock.Lock()
+	defer f.lock.Unlock()
+
+	deltas := computeDeltas(f.store.nodeIndices, f.votes, latestVotes, balances)
+	if err := f.store.applyWeightChanges(ctx, justifiedEpoch, finalizedEpoch, deltas); err != nil {
+		return [32]byte{}, errors.Wrap(err, "could not apply weight changes")
+	}
+	return f.store.head(ctx, justifiedRoot)
+}
+
+// Prune sets the finalized block as the root of the tree, and removes its ancestors from the
+// array once there are enough of them.
+func (f *ForkChoice) Prune(ctx context.Context, finalizedRoot [32]byte) error {
+	ctx, span := trace.StartSpan(ctx, "protoArrayForkChoice.Prune")
+	defer span.End()
+
+	f.lock.Lock()
+	defer f.lock.Unlock()
+	if err := f.store.prune(ctx, finalizedRoot); err != nil {
+		return err
+	}
+	nodeCount.Set(float64(len(f.store.nodes)))
+	return nil
+}
+
+// HasNode returns true if the block is in the tree.
+func (f *ForkChoice) HasNode(root [32]byte) bool {
+	f.lock.RLock()
+	defer f.lock.RUnlock()
+	_, ok := f.store.nodeIndices[root]
+	return ok
+}
+
+// NodeCount returns the number of blocks in the tree.
+func (f *ForkChoice) NodeCount() int {
+	f.lock.RLock()
+	defer f.lock.RUnlock()
+	return len(f.store.nodes)
+}
+
+// computeDeltas returns the weight change of every node since the votes were last applied, and
+// records the latest votes and balances as applied. A vote for a block which is not in the tree
+// yet is left unapplied until the block is inserted.
+func computeDeltas(
+	nodeIndices map[[32]byte]uint64,
+	applied map[uint64]appliedVote,
+	latestVotes map[uint64]*pb.ValidatorLatestVote,
+	balances []uint64,
+) []int64 {
+	deltas := make([]int64, len(nodeIndices))
+	for validatorIndex, vote := range latestVotes {
+		if vote == nil {
+			continue
+		}
+		newRoot := bytesutil.ToBytes32(vote.Root)
+		newBalance := uint64(0)
+		if validatorIndex < uint64(len(balances)) {
+			newBalance = balances[validatorIndex]
+		}
+		old := applied[validatorIndex]
+		if old.root == newRoot && old.balance == newBalance {
+			continue
+		}
+		newIndex, ok := nodeIndices[newRoot]
+		if !ok {
+			continue
+		}
+		if oldIndex, ok := nodeIndices[old.root]; ok {
+			deltas[oldIndex] -= int64(old.balance)
+		}
+		deltas[newIndex] += int64(newBalance)
+		applied[validatorIndex] = appliedVote{root: newRoot, balance: newBalance}
+	}
+	return deltas
+}
@@ -0,0 +1,167 @@
+package protoarray
+
+import (
+	"context"
+	"testing"
+
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+)
+
+func root(b byte) [32]byte {
+	return [32]byte{b}
+}
+
+func vote(r [32]byte) *pb.ValidatorLatestVote {
+	return &pb.ValidatorLatestVote{Root: r[:]}
+}
+
+// setupTree builds a tree in which blocks 1 and 2 are children of block 0, and block 3 is a
+// child of block 1.
+func setupTree(t *testing.T) *ForkChoice {
+	ctx := context.Background()
+	f := New(0, 0, root(0))
+	if err := f.ProcessBlock(ctx, 0, root(0), [32]byte{}, 0, 0); err != nil {
+		t.Fatal(err)
+	}
+	if err := f.ProcessBlock(ctx, 1, root(1), root(0), 0, 0); err != nil {
+		t.Fatal(err)
+	}
+	if err := f.ProcessBlock(ctx, 1, root(2), root(0), 0, 0); err != nil {
+		t.Fatal(err)
+	}
+	if err := f.ProcessBlock(ctx, 2, root(3), root(1), 0, 0); err != nil {
+		t.Fatal(err)
+	}
+	return f
+}
+
+func TestProcessBlock_UnknownParent(t *testing.T) {
+	f := setupTree(t)
+	if err := f.ProcessBlock(context.Background(), 3, root(4), root(9), 0, 0); err == nil {
+		t.Error("Expected an error for a block with an unknown parent")
+	}
+	if f.HasNode(root(4)) {
+		t.Error("Block with an unknown parent was inserted")
+	}
+}
+
+func TestHead_NoVotesFavorsHigherRoot(t *testing.T) {
+	f := setupTree(t)
+	head, err := f.Head(context.Background(), 0, root(0), 0, []uint64{}, map[uint64]*pb.ValidatorLatestVote{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if head != root(2) {
+		t.Errorf("Wanted head %#x, received %#x", root(2), head)
+	}
+}
+
+func TestHead_FollowsVoteChanges(t *testing.T) {
+	ctx := context.Background()
+	f := setupTree(t)
+	balances := []uint64{10, 10, 10}
+	votes := map[uint64]*pb.ValidatorLatestVote{
+		0: vote(root(3)),
+		1: vote(root(3)),
+		2: vote(root(2)),
+	}
+	head, err := f.Head(ctx, 0, root(0), 0, balances, votes)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if head != root(3) {
+		t.Errorf("Wanted head %#x, received %#x", root(3), head)
+	}
+
+	// Two validators move to the other branch.
+	votes[0] = vote(root(2))
+	votes[1] = vote(root(2))
+	head, err = f.Head(ctx, 0, root(0), 0, balances, votes)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if head != root(2) {
+		t.Errorf("Wanted head %#x, received %#x", root(2), head)
+	}
+	if w := f.store.nodes[f.store.nodeIndices[root(0)]].weight; w != 30 {
+		t.Errorf("Wanted root weight 30, received %d", w)
+	}
+	if w := f.store.nodes[f.store.nodeIndices[root(1)]].weight; w != 0 {
+		t.Errorf("Wanted weight 0 for the abandoned branch, received %d", w)
+	}
+
+	// A balance change of the remaining validators is applied as a delta.
+	balances = []uint64{1, 1, 1}
+	votes[0] = vote(root(3))
+	head, err = f.Head(ctx, 0, root(0), 0, balances, votes)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if head != root(2) {
+		t.Errorf("Wanted head %#x, received %#x", root(2), head)
+	}
+	if w := f.store.nodes[f.store.nodeIndices[root(0)]].weight; w != 3 {
+		t.Errorf("Wanted root weight 3, received %d", w)
+	}
+}
+
+func TestHead_FiltersNonViableBranches(t *testing.T) {
+	ctx := context.Background()
+	f := New(1, 0, root(0))
+	if err := f.ProcessBlock(ctx, 0, root(0), [32]byte{}, 1, 0); err != nil {
+		t.Fatal(err)
+	}
+	if err := f.ProcessBlock(ctx, 1, root(1), root(0), 1, 0); err != nil {
+		t.Fatal(err)
+	}
+	// The heavier branch did not see the justified checkpoint.
+	if err := f.ProcessBlock(ctx, 1, root(2), root(0), 0, 0); err != nil {
+		t.Fatal(err)
+	}
+	votes := map[uint64]*pb.ValidatorLatestVote{0: vote(root(2))}
+	head, err := f.Head(ctx, 1, root(0), 0, []uint64{10}, votes)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if head != root(1) {
+		t.Errorf("Wanted head %#x, received %#x", root(1), head)
+	}
+}
+
+func TestPrune_ReindexesNodes(t *testing.T) {
+	ctx := context.Background()
+	f := setupTree(t)
+	f.store.pruneThreshold = 0
+	if err := f.Prune(ctx, root(1)); err != nil {
+		t.Fatal(err)
+	}
+	if f.NodeCount() != 3 {
+		t.Fatalf("Wanted 3 nodes after pruning, received %d", f.NodeCount())
+	}
+	if f.HasNode(root(0)) {
+		t.Error("Expected the node before the finalized node to be pruned")
+	}
+	if f.store.nodes[0].parent != nonExistentNode {
+		t.Error("Expected the finalized node to have no parent")
+	}
+	if f.store.nodes[f.store.nodeIndices[root(3)]].parent != 0 {
+		t.Error("Expected the parent index to be shifted")
+	}
+	head, err := f.Head(ctx, 0, root(1), 0, []uint64{}, map[uint64]*pb.ValidatorLatestVote{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if head != root(3) {
+		t.Errorf("Wanted head %#x, received %#x", root(3), head)
+	}
+}
+
+func TestPrune_BelowThreshold(t *testing.T) {
+	f := setupTree(t)
+	if err := f.Prune(context.Background(), root(1)); err != nil {
+		t.Fatal(err)
+	}
+	if f.NodeCount() != 4 {
+		t.Errorf("Wanted no pruning below the threshold, received %d nodes", f.NodeCount())
+	}
+}
@@ -0,0 +1,13 @@
+package protoarray
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+var (
+	nodeCount = promauto.NewGauge(prometheus.GaugeOpts{
+		Name: "proto_array_node_count",
+		Help: "The number of blocks in the proto-array fork choice tree",
+	})
+)
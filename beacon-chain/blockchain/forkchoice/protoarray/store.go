@@ -0,0 +1,204 @@
+package protoarray
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+
+	"github.com/pkg/errors"
+	"go.opencensus.io/trace"
+)
+
+// insert a node of the block to the array, after its parent. Inserting a known block is a no-op.
+func (s *Store) insert(ctx context.Context, slot uint64, root [32]byte, parent [32]byte, justifiedEpoch uint64, finalizedEpoch uint64) error {
+	ctx, span := trace.StartSpan(ctx, "protoArrayForkChoice.insert")
+	defer span.End()
+
+	if _, ok := s.nodeIndices[root]; ok {
+		return nil
+	}
+
+	index := uint64(len(s.nodes))
+	parentIndex, ok := s.nodeIndices[parent]
+	if !ok {
+		parentIndex = nonExistentNode
+	}
+	s.nodeIndices[root] = index
+	s.nodes = append(s.nodes, &Node{
+		slot:           slot,
+		root:           root,
+		parent:         parentIndex,
+		justifiedEpoch: justifiedEpoch,
+		finalizedEpoch: finalizedEpoch,
+		bestChild:      nonExistentNode,
+		bestDescendant: nonExistentNode,
+	})
+
+	if parentIndex != nonExistentNode {
+		return s.updateBestChildAndDescendant(parentIndex, index)
+	}
+	return nil
+}
+
+// applyWeightChanges adds the vote deltas to the weights of the nodes and their ancestors, and
+// updates the best child and descendant of every node along the way. The deltas are indexed like
+// the nodes.
+func (s *Store) applyWeightChanges(ctx context.Context, justifiedEpoch uint64, finalizedEpoch uint64, deltas []int64) error {
+	ctx, span := trace.StartSpan(ctx, "protoArrayForkChoice.applyWeightChanges")
+	defer span.End()
+
+	if len(deltas) != len(s.nodes) {
+		return fmt.Errorf("received %d deltas for %d nodes", len(deltas), len(s.nodes))
+	}
+	s.justifiedEpoch = justifiedEpoch
+	s.finalizedEpoch = finalizedEpoch
+
+	// Children always come after their parent, so walking the array backwards applies the
+	// weights of a subtree before its root's.
+	for i := len(s.nodes) - 1; i >= 0; i-- {
+		node := s.nodes[i]
+		delta := deltas[i]
+		if delta < 0 {
+			if uint64(-delta) > node.weight {
+				return fmt.Errorf("delta %d underflows the weight %d of node %#x", delta, node.weight, node.root)
+			}
+			node.weight -= uint64(-delta)
+		} else {
+			node.weight += uint64(delta)
+		}
+
+		if node.parent == nonExistentNode {
+			continue
+		}
+		deltas[node.parent] += delta
+		if err := s.updateBestChildAndDescendant(node.parent, uint64(i)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// updateBestChildAndDescendant updates the best child and descendant of the parent after the
+// child changed. A child leading to a viable head beats one which does not, then the heavier
+// child wins, and ties are broken in favor of the higher root.
+func (s *Store) updateBestChildAndDescendant(parentIndex uint64, childIndex uint64) error {
+	if parentIndex >= uint64(len(s.nodes)) || childIndex >= uint64(len(s.nodes)) {
+		return errors.New("node index out of range")
+	}
+	parent := s.nodes[parentIndex]
+	child := s.nodes[childIndex]
+
+	childLeadsToViableHead := s.leadsToViableHead(child)
+	bestDescendant := child.bestDescendant
+	if bestDescendant == nonExistentNode {
+		bestDescendant = childIndex
+	}
+	changeToChild := func() {
+		parent.bestChild = childIndex
+		parent.bestDescendant = bestDescendant
+	}
+	changeToNone := func() {
+		parent.bestChild = nonExistentNode
+		parent.bestDescendant = nonExistentNode
+	}
+
+	switch {
+	case parent.bestChild == childIndex:
+		if childLeadsToViableHead {
+			changeToChild()
+		} else {
+			changeToNone()
+		}
+	case parent.bestChild != nonExistentNode:
+		bestChild := s.nodes[parent.bestChild]
+		bestChildLeadsToViableHead := s.leadsToViableHead(bestChild)
+		switch {
+		case childLeadsToViableHead && !bestChildLeadsToViableHead:
+			changeToChild()
+		case !childLeadsToViableHead && bestChildLeadsToViableHead:
+		case child.weight == bestChild.weight:
+			if bytes.Compare(child.root[:], bestChild.root[:]) >= 0 {
+				changeToChild()
+			}
+		case child.weight > bestChild.weight:
+			changeToChild()
+		}
+	case childLeadsToViableHead:
+		changeToChild()
+	}
+	return nil
+}
+
+// leadsToViableHead returns true if the node or its best descendant can be the head.
+func (s *Store) leadsToViableHead(node *Node) bool {
+	if node.bestDescendant != nonExistentNode {
+		return s.viableForHead(s.nodes[node.bestDescendant])
+	}
+	return s.viableForHead(node)
+}
+
+// viableForHead returns true if the justified and finalized epochs of the node agree with the
+// store's, as the filtered block tree of the spec does for its leaves.
+func (s *Store) viableForHead(node *Node) bool {
+	justified := s.justifiedEpoch == 0 || node.justifiedEpoch == s.justifiedEpoch
+	finalized := s.finalizedEpoch == 0 || node.finalizedEpoch == s.finalizedEpoch
+	return justified && finalized
+}
+
+// head returns the root of the best descendant of the justified node.
+func (s *Store) head(ctx context.Context, justifiedRoot [32]byte) ([32]byte, error) {
+	ctx, span := trace.StartSpan(ctx, "protoArrayForkChoice.head")
+	defer span.End()
+
+	justifiedIndex, ok := s.nodeIndices[justifiedRoot]
+	if !ok {
+		return [32]byte{}, fmt.Errorf("unknown justified root %#x", justifiedRoot)
+	}
+	bestDescendantIndex := s.nodes[justifiedIndex].bestDescendant
+	if bestDescendantIndex == nonExistentNode {
+		bestDescendantIndex = justifiedIndex
+	}
+	bestNode := s.nodes[bestDescendantIndex]
+	if !s.viableForHead(bestNode) {
+		return [32]byte{}, fmt.Errorf(
+			"head at slot %d is not viable, justified epoch %d != %d or finalized epoch %d != %d",
+			bestNode.slot, bestNode.justifiedEpoch, s.justifiedEpoch, bestNode.finalizedEpoch, s.finalizedEpoch,
+		)
+	}
+	return bestNode.root, nil
+}
+
+// prune removes the nodes before the finalized node once there are more of them than the prune
+// threshold, and shifts the indices of the remaining nodes accordingly.
+func (s *Store) prune(ctx context.Context, finalizedRoot [32]byte) error {
+	ctx, span := trace.StartSpan(ctx, "protoArrayForkChoice.prune")
+	defer span.End()
+
+	finalizedIndex, ok := s.nodeIndices[finalizedRoot]
+	if !ok {
+		return fmt.Errorf("unknown finalized root %#x", finalizedRoot)
+	}
+	s.finalizedRoot = finalizedRoot
+	if finalizedIndex < s.pruneThreshold {
+		return nil
+	}
+
+	for _, node := range s.nodes[:finalizedIndex] {
+		delete(s.nodeIndices, node.root)
+	}
+	s.nodes = s.nodes[finalizedIndex:]
+	shift := func(index uint64) uint64 {
+		if index == nonExistentNode || index < finalizedIndex {
+			return nonExistentNode
+		}
+		return index - finalizedIndex
+	}
+	for i, node := range s.nodes {
+		node.parent = shift(node.parent)
+		node.bestChild = shift(node.bestChild)
+		node.bestDescendant = shift(node.bestDescendant)
+		s.nodeIndices[node.root] = uint64(i)
+	}
+	span.AddAttributes(trace.Int64Attribute("prunedNodes", int64(finalizedIndex)))
+	return nil
+}
@@ -0,0 +1,53 @@
+package protoarray
+
+import (
+	"sync"
+)
+
+// nonExistentNode is the index of a node which is not in the array, such as the parent of
+// the finalized node.
+const nonExistentNode = ^uint64(0)
+
+// defaultPruneThreshold is the number of nodes before the finalized node from which the array is
+// pruned. Pruning shifts every index in the array, so it is not done on every finalization.
+const defaultPruneThreshold = 256
+
+// ForkChoice computes the head of the chain from the latest votes of the validators with the
+// proto-array fork choice.
+type ForkChoice struct {
+	store *Store
+	votes map[uint64]appliedVote
+	lock  sync.RWMutex
+}
+
+// Store keeps the non-finalized block tree as an array of nodes, in which every node comes after
+// its parent. The weights and best descendants of the nodes are updated in a single pass from the
+// last node down to the first.
+type Store struct {
+	pruneThreshold uint64
+	justifiedEpoch uint64
+	finalizedEpoch uint64
+	finalizedRoot  [32]byte
+	nodes          []*Node
+	nodeIndices    map[[32]byte]uint64
+}
+
+// Node of the block tree, with the justified and finalized epochs of its post state, the weight
+// of the votes of its subtree, and its best child and descendant to follow to the head.
+type Node struct {
+	slot           uint64
+	root           [32]byte
+	parent         uint64
+	justifiedEpoch uint64
+	finalizedEpoch uint64
+	weight         uint64
+	bestChild      uint64
+	bestDescendant uint64
+}
+
+// appliedVote is the block root and the balance a validator's vote is counted with in the
+// weights of the nodes.
+type appliedVote struct {
+	root    [32]byte
+	balance uint64
+}
@@ -11,11 +11,14 @@ import (
 	"github.com/pkg/errors"
 	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
 	"github.com/prysmaticlabs/go-ssz"
+	"github.com/prysmaticlabs/prysm/beacon-chain/blockchain/forkchoice/protoarray"
 	"github.com/prysmaticlabs/prysm/beacon-chain/cache"
 	"github.com/prysmaticlabs/prysm/beacon-chain/core/blocks"
 	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
 	"github.com/prysmaticlabs/prysm/beacon-chain/db"
 	"github.com/prysmaticlabs/prysm/beacon-chain/db/filters"
+	"github.com/prysmaticlabs/prysm/beacon-chain/flags"
+	"github.com/prysmaticlabs/prysm/beacon-chain/state/stategen"
 	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
 	"github.com/prysmaticlabs/prysm/shared/bytesutil"
 	"github.com/prysmaticlabs/prysm/shared/featureconfig"
@@ -52,6 +55,8 @@ type Store struct {
 	initSyncState         map[[32]byte]*pb.BeaconState
 	initSyncStateLock     sync.RWMutex
 	nextEpochBoundarySlot uint64
+	stateGen              *stategen.State
+	protoArray            *protoarray.ForkChoice
 }
 
 // NewForkChoiceService instantiates a new service instance that will
@@ -65,9 +70,15 @@ func NewForkChoiceService(ctx context.Context, db db.Database) *Store {
 		checkpointState: cache.NewCheckpointStateCache(),
 		latestVoteMap:   make(map[uint64]*pb.ValidatorLatestVote),
 		initSyncState:   make(map[[32]byte]*pb.BeaconState),
+		stateGen:        stategen.New(db, flags.Get().SlotsPerArchivedPoint),
 	}
 }
 
+// StateGen returns the state generator the fork choice store saves and retrieves states with.
+func (s *Store) StateGen() *stategen.State {
+	return s.stateGen
+}
+
 // GenesisStore initializes the store struct before beacon chain
 // starts to advance.
 //
@@ -95,7 +106,10 @@ func (s *Store) GenesisStore(
 	s.finalizedCheckpt = proto.Clone(finalizedCheckpoint).(*ethpb.Checkpoint)
 	s.prevFinalizedCheckpt = proto.Clone(finalizedCheckpoint).(*ethpb.Checkpoint)
 
-	justifiedState, err := s.db.State(ctx, bytesutil.ToBytes32(s.justifiedCheckpt.Root))
+	if err := s.stateGen.Resume(ctx, bytesutil.ToBytes32(s.finalizedCheckpt.Root)); err != nil {
+		return errors.Wrap(err, "could not resume state generator")
+	}
+	justifiedState, err := s.stateGen.StateByRoot(ctx, bytesutil.ToBytes32(s.justifiedCheckpt.Root))
 	if err != nil {
 		return errors.Wrap(err, "could not retrieve last justified state")
 	}
@@ -112,6 +126,12 @@ func (s *Store) GenesisStore(
 		return errors.Wrap(err, "could not cache initial sync state")
 	}
 
+	if featureconfig.Get().ProtoArrayForkChoice {
+		if err := s.initProtoArray(ctx); err != nil {
+			return errors.Wrap(err, "could not initialize proto-array fork choice")
+		}
+	}
+
 	return nil
 }
 
@@ -125,6 +145,10 @@ func (s *Store) cacheGenesisState(ctx context.Context) error {
 	if err != nil {
 		return err
 	}
+	// A node started from a checkpoint has no genesis state.
+	if genesisState == nil {
+		return nil
+	}
 	stateRoot, err := stateutil.HashTreeRootState(genesisState)
 	if err != nil {
 		return errors.Wrap(err, "could not tree hash genesis state")
@@ -261,6 +285,10 @@ func (s *Store) Head(ctx context.Context) ([]byte, error) {
 	ctx, span := trace.StartSpan(ctx, "forkchoice.head")
 	defer span.End()
 
+	if s.protoArray != nil {
+		return s.protoArrayHead(ctx)
+	}
+
 	head := s.JustifiedCheckpt().Root
 	filteredBlocks, err := s.getFilterBlockTree(ctx)
 	if err != nil {
@@ -402,7 +430,7 @@ func (s *Store) filterBlockTree(ctx context.Context, blockRoot [32]byte, filtere
 	}
 
 
-	headState, err := s.db.State(ctx, blockRoot)
+	headState, err := s.stateGen.StateByRoot(ctx, blockRoot)
 	if err != nil {
 		return false, err
 	}
@@ -55,6 +55,15 @@ var (
 		Name: "head_finalized_root",
 		Help: "Last finalized root of the head state",
 	})
+	reorgCount = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "beacon_reorg_total",
+		Help: "The # of times the new head of the chain was not a descendant of the previous head",
+	})
+	reorgDepth = promauto.NewHistogram(prometheus.HistogramOpts{
+		Name:    "beacon_reorg_depth_slots",
+		Help:    "The # of slots from the common ancestor to the previous head of a chain reorganization",
+		Buckets: []float64{1, 2, 3, 4, 8, 16, 32, 64},
+	})
 )
 
 func (s *Service) reportSlotMetrics(currentSlot uint64) {
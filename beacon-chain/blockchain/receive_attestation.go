@@ -71,25 +71,31 @@ func (s *Service) processAttestation() {
 		case <-s.ctx.Done():
 			return
 		case <-st.C():
-			ctx := context.Background()
-			atts := s.attPool.ForkchoiceAttestations()
-			for _, a := range atts {
-				hasState := s.beaconDB.HasState(ctx, bytesutil.ToBytes32(a.Data.BeaconBlockRoot))
-				hasBlock := s.beaconDB.HasBlock(ctx, bytesutil.ToBytes32(a.Data.BeaconBlockRoot))
-				if !(hasState && hasBlock) {
-					continue
-				}
+			s.processForkchoiceAttestations(context.Background())
+		}
+	}
+}
 
-				if err := s.attPool.DeleteForkchoiceAttestation(a); err != nil {
-					log.WithError(err).Error("Could not delete fork choice attestation in pool")
-				}
+// processForkchoiceAttestations applies the pooled fork choice attestations whose voted block
+// and its state are known, leaving the others in the pool for a later slot.
+func (s *Service) processForkchoiceAttestations(ctx context.Context) {
+	atts := s.attPool.ForkchoiceAttestations()
+	for _, a := range atts {
+		root := bytesutil.ToBytes32(a.Data.BeaconBlockRoot)
+		hasState := s.stateGen.HasState(ctx, root)
+		hasBlock := s.beaconDB.HasBlock(ctx, root)
+		if !(hasState && hasBlock) {
+			continue
+		}
+
+		if err := s.attPool.DeleteForkchoiceAttestation(a); err != nil {
+			log.WithError(err).Error("Could not delete fork choice attestation in pool")
+		}
 
-				if err := s.ReceiveAttestationNoPubsub(ctx, a); err != nil {
-					log.WithFields(logrus.Fields{
-						"targetRoot": fmt.Sprintf("%#x", a.Data.Target.Root),
-					}).WithError(err).Error("Could not receive attestation in chain service")
-				}
-			}
+		if err := s.ReceiveAttestationNoPubsub(ctx, a); err != nil {
+			log.WithFields(logrus.Fields{
+				"targetRoot": fmt.Sprintf("%#x", a.Data.Target.Root),
+			}).WithError(err).Error("Could not receive attestation in chain service")
 		}
 	}
 }
@@ -7,6 +7,7 @@ import (
 	"github.com/prysmaticlabs/go-ssz"
 	testDB "github.com/prysmaticlabs/prysm/beacon-chain/db/testing"
 	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+	"github.com/prysmaticlabs/prysm/shared/params"
 	"github.com/prysmaticlabs/prysm/shared/testutil"
 	logTest "github.com/sirupsen/logrus/hooks/test"
 	"golang.org/x/net/context"
@@ -44,3 +45,43 @@ func TestReceiveAttestationNoPubsub_ProcessCorrectly(t *testing.T) {
 	testutil.AssertLogsContain(t, hook, "Saved new head info")
 	testutil.AssertLogsDoNotContain(t, hook, "Broadcasting attestation")
 }
+
+func TestProcessForkchoiceAttestations_AppliesVoteForBlockWithCachedState(t *testing.T) {
+	db := testDB.SetupDB(t)
+	defer testDB.TeardownDB(t, db)
+	ctx := context.Background()
+
+	chainService := setupBeaconChain(t, db)
+	chainService.forkChoiceStore = &store{headRoot: params.BeaconConfig().ZeroHash[:]}
+
+	// A block in the middle of an epoch that is not the head only has its state in the hot
+	// state cache.
+	b := &ethpb.SignedBeaconBlock{Block: &ethpb.BeaconBlock{Slot: 3}}
+	if err := chainService.beaconDB.SaveBlock(ctx, b); err != nil {
+		t.Fatal(err)
+	}
+	root, err := ssz.HashTreeRoot(b.Block)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := chainService.stateGen.SaveState(ctx, root, &pb.BeaconState{Slot: 3}); err != nil {
+		t.Fatal(err)
+	}
+	if chainService.beaconDB.HasState(ctx, root) {
+		t.Fatal("Expected the mid epoch state not to be saved in the DB")
+	}
+
+	a := &ethpb.Attestation{Data: &ethpb.AttestationData{
+		BeaconBlockRoot: root[:],
+		Target:          &ethpb.Checkpoint{Root: root[:]},
+	}}
+	if err := chainService.attPool.SaveForkchoiceAttestation(a); err != nil {
+		t.Fatal(err)
+	}
+
+	chainService.processForkchoiceAttestations(ctx)
+
+	if len(chainService.attPool.ForkchoiceAttestations()) != 0 {
+		t.Error("Expected the attestation to be applied and removed from the fork choice pool")
+	}
+}
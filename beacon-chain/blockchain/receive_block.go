@@ -104,6 +104,7 @@ func (s *Service) ReceiveBlockNoPubsub(ctx context.Context, block *ethpb.SignedB
 	s.stateNotifier.StateFeed().Send(&feed.Event{
 		Type: statefeed.BlockProcessed,
 		Data: &statefeed.BlockProcessedData{
+			Slot:      blockCopy.Block.Slot,
 			BlockRoot: root,
 			Verified:  true,
 		},
@@ -112,7 +113,6 @@ func (s *Service) ReceiveBlockNoPubsub(ctx context.Context, block *ethpb.SignedB
 	// Add attestations from the block to the pool for fork choice.
 	if err := s.attPool.SaveBlockAttestations(blockCopy.Block.Body.Attestations); err != nil {
 		log.Errorf("Could not save attestation for fork choice: %v", err)
-		return nil
 	}
 
 	// Reports on block and fork choice metrics.
@@ -163,6 +163,7 @@ func (s *Service) ReceiveBlockNoPubsubForkchoice(ctx context.Context, block *eth
 	s.stateNotifier.StateFeed().Send(&feed.Event{
 		Type: statefeed.BlockProcessed,
 		Data: &statefeed.BlockProcessedData{
+			Slot:      blockCopy.Block.Slot,
 			BlockRoot: root,
 			Verified:  true,
 		},
@@ -221,6 +222,7 @@ func (s *Service) ReceiveBlockNoVerify(ctx context.Context, block *ethpb.SignedB
 	s.stateNotifier.StateFeed().Send(&feed.Event{
 		Type: statefeed.BlockProcessed,
 		Data: &statefeed.BlockProcessedData{
+			Slot:      blockCopy.Block.Slot,
 			BlockRoot: root,
 			Verified:  false,
 		},
@@ -243,6 +245,106 @@ func (s *Service) ReceiveBlockNoVerify(ctx context.Context, block *ethpb.SignedB
 	return nil
 }
 
+// This updates the operation pools once the head changed. The voluntary exits and slashings of
+// the blocks which became canonical are removed, so proposers don't include them again, and on a
+// reorg those of the orphaned blocks are returned to the pools to be included in the new chain.
+func (s *Service) updateOperationPools(
+	ctx context.Context,
+	oldHead *ethpb.SignedBeaconBlock,
+	newHead *ethpb.SignedBeaconBlock,
+	reorg *statefeed.ReorgData,
+) {
+	if (s.exitPool == nil && s.slashingPool == nil) || newHead.Block.Slot == 0 {
+		return
+	}
+	// The blocks of the new head above this slot became canonical.
+	canonicalSlot := newHead.Block.Slot - 1
+	if reorg != nil {
+		canonicalSlot = reorg.CommonAncestorSlot
+		orphaned, err := s.blocksAbove(ctx, oldHead.Block, reorg.CommonAncestorSlot)
+		if err != nil {
+			log.WithError(err).Error("Could not get the orphaned blocks to restore their operations")
+		}
+		s.restoreOperations(ctx, orphaned)
+	} else if oldHead != nil && oldHead.Block != nil && oldHead.Block.Slot < newHead.Block.Slot {
+		canonicalSlot = oldHead.Block.Slot
+	}
+	canonical, err := s.blocksAbove(ctx, newHead.Block, canonicalSlot)
+	if err != nil {
+		log.WithError(err).Error("Could not get the canonical blocks to mark their operations included")
+	}
+	for _, blk := range canonical {
+		s.markIncludedOperations(blk)
+	}
+}
+
+// This returns the block and its ancestors above the given slot.
+func (s *Service) blocksAbove(ctx context.Context, blk *ethpb.BeaconBlock, slot uint64) ([]*ethpb.BeaconBlock, error) {
+	var blks []*ethpb.BeaconBlock
+	for blk.Slot > slot {
+		blks = append(blks, blk)
+		if blk.Slot == slot+1 {
+			break
+		}
+		var err error
+		_, blk, err = s.parentBlock(ctx, blk)
+		if err != nil {
+			return blks, err
+		}
+	}
+	return blks, nil
+}
+
+// This returns the voluntary exits and slashings of orphaned blocks to the operation pools. They
+// are verified against the new head state, so those already included in the new chain are not.
+func (s *Service) restoreOperations(ctx context.Context, blks []*ethpb.BeaconBlock) {
+	if len(blks) == 0 {
+		return
+	}
+	headState, err := s.HeadState(ctx)
+	if err != nil || headState == nil {
+		log.WithError(err).Error("Could not get head state to restore the operations of orphaned blocks")
+		return
+	}
+	for _, blk := range blks {
+		if s.exitPool != nil {
+			for _, exit := range blk.Body.VoluntaryExits {
+				s.exitPool.InsertVoluntaryExit(ctx, headState, exit)
+			}
+		}
+		if s.slashingPool != nil {
+			for _, slashing := range blk.Body.ProposerSlashings {
+				if err := s.slashingPool.InsertProposerSlashing(ctx, headState, slashing); err != nil {
+					log.WithError(err).Debug("Could not restore proposer slashing of orphaned block")
+				}
+			}
+			for _, slashing := range blk.Body.AttesterSlashings {
+				if err := s.slashingPool.InsertAttesterSlashing(ctx, headState, slashing); err != nil {
+					log.WithError(err).Debug("Could not restore attester slashing of orphaned block")
+				}
+			}
+		}
+	}
+}
+
+// This removes the voluntary exits and slashings included in the block from the
+// operation pools, so proposers don't include them again in a future block.
+func (s *Service) markIncludedOperations(blk *ethpb.BeaconBlock) {
+	if s.exitPool != nil {
+		for _, exit := range blk.Body.VoluntaryExits {
+			s.exitPool.MarkIncluded(exit)
+		}
+	}
+	if s.slashingPool != nil {
+		for _, slashing := range blk.Body.ProposerSlashings {
+			s.slashingPool.MarkIncludedProposerSlashing(slashing)
+		}
+		for _, slashing := range blk.Body.AttesterSlashings {
+			s.slashingPool.MarkIncludedAttesterSlashing(slashing)
+		}
+	}
+}
+
 // This checks if the block is from a competing chain, emits warning and updates metrics.
 func isCompetingBlock(root []byte, slot uint64, headRoot []byte, headSlot uint64) {
 	if !bytes.Equal(root[:], headRoot) {
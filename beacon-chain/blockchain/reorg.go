@@ -0,0 +1,105 @@
+package blockchain
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+
+	"github.com/pkg/errors"
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/feed"
+	statefeed "github.com/prysmaticlabs/prysm/beacon-chain/core/feed/state"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/sirupsen/logrus"
+	"go.opencensus.io/trace"
+)
+
+// detectReorg returns the reorg of the chain if the new head is not a descendant of the current
+// head, or nil if it is. The caller must hold the head lock.
+func (s *Service) detectReorg(ctx context.Context, newHead *ethpb.SignedBeaconBlock, newRoot [32]byte) (*statefeed.ReorgData, error) {
+	ctx, span := trace.StartSpan(ctx, "blockchain.detectReorg")
+	defer span.End()
+
+	oldHead := s.headBlock
+	oldRoot := s.canonicalRoots[s.headSlot]
+	if oldHead == nil || oldHead.Block == nil || len(oldRoot) == 0 {
+		return nil, nil
+	}
+	// The new head is usually a child of the current head, which needs no lookup.
+	if bytes.Equal(newRoot[:], oldRoot) || bytes.Equal(newHead.Block.ParentRoot, oldRoot) {
+		return nil, nil
+	}
+
+	ancestorRoot, ancestorSlot, err := s.commonAncestor(ctx, oldRoot, oldHead.Block, newRoot[:], newHead.Block)
+	if err != nil {
+		return nil, err
+	}
+	if bytes.Equal(ancestorRoot, oldRoot) {
+		return nil, nil
+	}
+	return &statefeed.ReorgData{
+		OldHeadRoot:        bytesutil.ToBytes32(oldRoot),
+		OldHeadSlot:        oldHead.Block.Slot,
+		NewHeadRoot:        newRoot,
+		NewHeadSlot:        newHead.Block.Slot,
+		CommonAncestorRoot: bytesutil.ToBytes32(ancestorRoot),
+		CommonAncestorSlot: ancestorSlot,
+		Depth:              oldHead.Block.Slot - ancestorSlot,
+	}, nil
+}
+
+// commonAncestor of two blocks, found by walking back the parents of the block at the higher slot
+// until both chains reach the same block.
+func (s *Service) commonAncestor(
+	ctx context.Context,
+	rootA []byte,
+	blkA *ethpb.BeaconBlock,
+	rootB []byte,
+	blkB *ethpb.BeaconBlock,
+) ([]byte, uint64, error) {
+	for !bytes.Equal(rootA, rootB) {
+		if ctx.Err() != nil {
+			return nil, 0, ctx.Err()
+		}
+		var err error
+		if blkA.Slot >= blkB.Slot {
+			rootA, blkA, err = s.parentBlock(ctx, blkA)
+		} else {
+			rootB, blkB, err = s.parentBlock(ctx, blkB)
+		}
+		if err != nil {
+			return nil, 0, err
+		}
+	}
+	return rootA, blkA.Slot, nil
+}
+
+// parentBlock of the block, with its root.
+func (s *Service) parentBlock(ctx context.Context, blk *ethpb.BeaconBlock) ([]byte, *ethpb.BeaconBlock, error) {
+	parent, err := s.beaconDB.Block(ctx, bytesutil.ToBytes32(blk.ParentRoot))
+	if err != nil {
+		return nil, nil, err
+	}
+	if parent == nil || parent.Block == nil {
+		return nil, nil, errors.Errorf("missing parent block %#x of block at slot %d", blk.ParentRoot, blk.Slot)
+	}
+	return blk.ParentRoot, parent.Block, nil
+}
+
+// notifyReorg logs the reorg, records it in the metrics and sends it to the state feed.
+func (s *Service) notifyReorg(reorg *statefeed.ReorgData) {
+	log.WithFields(logrus.Fields{
+		"oldHeadSlot":        reorg.OldHeadSlot,
+		"oldHeadRoot":        fmt.Sprintf("%#x", reorg.OldHeadRoot),
+		"newHeadSlot":        reorg.NewHeadSlot,
+		"newHeadRoot":        fmt.Sprintf("%#x", reorg.NewHeadRoot),
+		"commonAncestorSlot": reorg.CommonAncestorSlot,
+		"depth":              reorg.Depth,
+	}).Warn("Chain reorganization")
+	reorgCount.Inc()
+	reorgDepth.Observe(float64(reorg.Depth))
+	s.stateNotifier.StateFeed().Send(&feed.Event{
+		Type: statefeed.Reorg,
+		Data: reorg,
+	})
+}
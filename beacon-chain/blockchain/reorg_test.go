@@ -0,0 +1,233 @@
+package blockchain
+
+import (
+	"context"
+	"reflect"
+	"sync"
+	"testing"
+
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/go-ssz"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/feed"
+	statefeed "github.com/prysmaticlabs/prysm/beacon-chain/core/feed/state"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+	testDB "github.com/prysmaticlabs/prysm/beacon-chain/db/testing"
+	"github.com/prysmaticlabs/prysm/beacon-chain/operations/voluntaryexits"
+	"github.com/prysmaticlabs/prysm/beacon-chain/state/stategen"
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/testutil"
+)
+
+func TestDetectReorg(t *testing.T) {
+	db := testDB.SetupDB(t)
+	defer testDB.TeardownDB(t, db)
+	ctx := context.Background()
+	s := &Service{
+		beaconDB:       db,
+		canonicalRoots: make(map[uint64][]byte),
+	}
+
+	// saveBlock at the slot with the given parent, and return the block with its root.
+	saveBlock := func(slot uint64, parentRoot [32]byte) (*ethpb.SignedBeaconBlock, [32]byte) {
+		blk := &ethpb.SignedBeaconBlock{Block: &ethpb.BeaconBlock{Slot: slot, ParentRoot: parentRoot[:]}}
+		if err := db.SaveBlock(ctx, blk); err != nil {
+			t.Fatal(err)
+		}
+		root, err := ssz.HashTreeRoot(blk.Block)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return blk, root
+	}
+	//   genesis - a1 - a2 - a3 - a4
+	//              \
+	//               b2 - b3
+	_, genesisRoot := saveBlock(0, [32]byte{})
+	_, a1Root := saveBlock(1, genesisRoot)
+	a2, a2Root := saveBlock(2, a1Root)
+	a3, a3Root := saveBlock(3, a2Root)
+	a4, a4Root := saveBlock(4, a3Root)
+	_, b2Root := saveBlock(2, a1Root)
+	b3, b3Root := saveBlock(3, b2Root)
+
+	s.headBlock = a2
+	s.headSlot = 2
+	s.canonicalRoots[2] = a2Root[:]
+
+	for _, descendant := range []struct {
+		blk  *ethpb.SignedBeaconBlock
+		root [32]byte
+	}{{a3, a3Root}, {a4, a4Root}} {
+		reorg, err := s.detectReorg(ctx, descendant.blk, descendant.root)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if reorg != nil {
+			t.Errorf("Wanted no reorg for a descendant at slot %d, received %+v", descendant.blk.Block.Slot, reorg)
+		}
+	}
+
+	reorg, err := s.detectReorg(ctx, b3, b3Root)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if reorg == nil {
+		t.Fatal("Wanted a reorg for a block on another chain")
+	}
+	if reorg.OldHeadRoot != a2Root || reorg.NewHeadRoot != b3Root || reorg.OldHeadSlot != 2 || reorg.NewHeadSlot != 3 {
+		t.Errorf("Unexpected heads of reorg %+v", reorg)
+	}
+	if reorg.CommonAncestorRoot != a1Root || reorg.CommonAncestorSlot != 1 || reorg.Depth != 1 {
+		t.Errorf("Wanted common ancestor at slot 1 with depth 1, received %+v", reorg)
+	}
+}
+
+func TestSaveHead_ConcurrentSiblingsReportOneReorg(t *testing.T) {
+	db := testDB.SetupDB(t)
+	defer testDB.TeardownDB(t, db)
+	ctx := context.Background()
+	s := &Service{
+		beaconDB:       db,
+		canonicalRoots: make(map[uint64][]byte),
+		stateGen:       stategen.New(db, 0),
+		stateNotifier:  &mockBeaconNode{},
+	}
+
+	// saveBlock at the slot with the given parent and its state, and return the block with its root.
+	saveBlock := func(slot uint64, parentRoot [32]byte, graffiti byte) (*ethpb.SignedBeaconBlock, [32]byte) {
+		blk := &ethpb.SignedBeaconBlock{Block: &ethpb.BeaconBlock{
+			Slot:       slot,
+			ParentRoot: parentRoot[:],
+			Body:       &ethpb.BeaconBlockBody{Graffiti: []byte{graffiti}},
+		}}
+		if err := db.SaveBlock(ctx, blk); err != nil {
+			t.Fatal(err)
+		}
+		root, err := ssz.HashTreeRoot(blk.Block)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err := db.SaveState(ctx, &pb.BeaconState{Slot: slot}, root); err != nil {
+			t.Fatal(err)
+		}
+		return blk, root
+	}
+	//   genesis - a1 - a2
+	//              \
+	//               b2
+	_, genesisRoot := saveBlock(0, [32]byte{}, 0)
+	a1, a1Root := saveBlock(1, genesisRoot, 0)
+	a2, a2Root := saveBlock(2, a1Root, 'a')
+	b2, b2Root := saveBlock(2, a1Root, 'b')
+
+	s.headBlock = a1
+	s.headSlot = 1
+	s.canonicalRoots[1] = a1Root[:]
+
+	events := make(chan *feed.Event, 2)
+	sub := s.stateNotifier.StateFeed().Subscribe(events)
+	defer sub.Unsubscribe()
+
+	// Whichever sibling is saved last reorgs the other one out of the chain.
+	var wg sync.WaitGroup
+	for _, head := range []struct {
+		blk  *ethpb.SignedBeaconBlock
+		root [32]byte
+	}{{a2, a2Root}, {b2, b2Root}} {
+		wg.Add(1)
+		go func(blk *ethpb.SignedBeaconBlock, root [32]byte) {
+			defer wg.Done()
+			if err := s.saveHead(ctx, blk, root); err != nil {
+				t.Error(err)
+			}
+		}(head.blk, head.root)
+	}
+	wg.Wait()
+
+	if len(events) != 1 {
+		t.Fatalf("Wanted 1 reorg event, received %d", len(events))
+	}
+	event := <-events
+	reorg, ok := event.Data.(*statefeed.ReorgData)
+	if event.Type != statefeed.Reorg || !ok {
+		t.Fatalf("Wanted a reorg event, received %+v", event)
+	}
+	if headRoot := bytesutil.ToBytes32(s.HeadRoot()); reorg.NewHeadRoot != headRoot {
+		t.Errorf("Wanted the reorg to the final head %#x, received %#x", headRoot, reorg.NewHeadRoot)
+	}
+	if reorg.CommonAncestorRoot != a1Root || reorg.Depth != 1 {
+		t.Errorf("Wanted common ancestor a1 with depth 1, received %+v", reorg)
+	}
+}
+
+func TestUpdateOperationPools_CanonicalBlocksOnly(t *testing.T) {
+	db := testDB.SetupDB(t)
+	defer testDB.TeardownDB(t, db)
+	ctx := context.Background()
+
+	headState, privKeys := testutil.DeterministicGenesisState(t, 4)
+	// Moving the state forward due to PERSISTENT_COMMITTEE_PERIOD.
+	headState.Slot = params.BeaconConfig().PersistentCommitteePeriod * params.BeaconConfig().SlotsPerEpoch
+	domain := helpers.Domain(headState.Fork, 0, params.BeaconConfig().DomainVoluntaryExit)
+	exits := make([]*ethpb.SignedVoluntaryExit, len(privKeys))
+	pool := voluntaryexits.NewPool()
+	for i := range exits {
+		exit := &ethpb.VoluntaryExit{ValidatorIndex: uint64(i)}
+		root, err := ssz.HashTreeRoot(exit)
+		if err != nil {
+			t.Fatal(err)
+		}
+		exits[i] = &ethpb.SignedVoluntaryExit{Exit: exit, Signature: privKeys[i].Sign(root[:], domain).Marshal()}
+		pool.InsertVoluntaryExit(ctx, headState, exits[i])
+	}
+	s := &Service{
+		beaconDB:  db,
+		exitPool:  pool,
+		headState: headState,
+	}
+
+	// saveBlock at the slot with the given parent and exits, and return the block with its root.
+	saveBlock := func(slot uint64, parentRoot [32]byte, exits ...*ethpb.SignedVoluntaryExit) (*ethpb.SignedBeaconBlock, [32]byte) {
+		blk := &ethpb.SignedBeaconBlock{Block: &ethpb.BeaconBlock{
+			Slot:       slot,
+			ParentRoot: parentRoot[:],
+			Body:       &ethpb.BeaconBlockBody{VoluntaryExits: exits},
+		}}
+		if err := db.SaveBlock(ctx, blk); err != nil {
+			t.Fatal(err)
+		}
+		root, err := ssz.HashTreeRoot(blk.Block)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return blk, root
+	}
+	pendingIndices := func() []uint64 {
+		var indices []uint64
+		for _, exit := range pool.PendingExits(ctx, headState) {
+			indices = append(indices, exit.Exit.ValidatorIndex)
+		}
+		return indices
+	}
+	//   genesis - a1 - a2
+	//              \
+	//               b2
+	_, genesisRoot := saveBlock(0, [32]byte{})
+	a1, a1Root := saveBlock(1, genesisRoot)
+	a2, _ := saveBlock(2, a1Root, exits[2])
+	b2, _ := saveBlock(2, a1Root, exits[1])
+
+	// The exit of the head is included, the exit of the competing block is not.
+	s.updateOperationPools(ctx, a1, a2, nil)
+	if indices := pendingIndices(); !reflect.DeepEqual(indices, []uint64{0, 1, 3}) {
+		t.Errorf("Wanted pending exits of validators [0 1 3], received %v", indices)
+	}
+
+	// Once the competing block becomes the head, the exit of the orphaned block is pending again.
+	s.updateOperationPools(ctx, a2, b2, &statefeed.ReorgData{CommonAncestorRoot: a1Root, CommonAncestorSlot: 1})
+	if indices := pendingIndices(); !reflect.DeepEqual(indices, []uint64{0, 2, 3}) {
+		t.Errorf("Wanted pending exits of validators [0 2 3], received %v", indices)
+	}
+}
@@ -23,8 +23,12 @@ import (
 	"github.com/prysmaticlabs/prysm/beacon-chain/core/state"
 	"github.com/prysmaticlabs/prysm/beacon-chain/db"
 	"github.com/prysmaticlabs/prysm/beacon-chain/operations/attestations"
+	"github.com/prysmaticlabs/prysm/beacon-chain/operations/slashings"
+	"github.com/prysmaticlabs/prysm/beacon-chain/operations/voluntaryexits"
 	"github.com/prysmaticlabs/prysm/beacon-chain/p2p"
 	"github.com/prysmaticlabs/prysm/beacon-chain/powchain"
+	"github.com/prysmaticlabs/prysm/beacon-chain/state/stategen"
+	"github.com/prysmaticlabs/prysm/beacon-chain/sync/checkpoint"
 	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
 	"github.com/prysmaticlabs/prysm/shared/bytesutil"
 	"github.com/prysmaticlabs/prysm/shared/featureconfig"
@@ -41,6 +45,8 @@ type Service struct {
 	depositCache           *depositcache.DepositCache
 	chainStartFetcher      powchain.ChainStartFetcher
 	attPool                attestations.Pool
+	exitPool               *voluntaryexits.Pool
+	slashingPool           *slashings.Pool
 	forkChoiceStore        forkchoice.ForkChoicer
 	genesisTime            time.Time
 	p2p                    p2p.Broadcaster
@@ -54,6 +60,8 @@ type Service struct {
 	genesisRoot            [32]byte
 	epochParticipation     map[uint64]*precompute.Balance
 	epochParticipationLock sync.RWMutex
+	checkpoint             *checkpoint.Checkpoint
+	stateGen               *stategen.State
 }
 
 // Config options for the service.
@@ -63,9 +71,12 @@ type Config struct {
 	BeaconDB          db.Database
 	DepositCache      *depositcache.DepositCache
 	AttPool           attestations.Pool
+	ExitPool          *voluntaryexits.Pool
+	SlashingPool      *slashings.Pool
 	P2p               p2p.Broadcaster
 	MaxRoutines       int64
 	StateNotifier     statefeed.Notifier
+	Checkpoint        *checkpoint.Checkpoint
 }
 
 // NewService instantiates a new block service instance that will
@@ -80,12 +91,16 @@ func NewService(ctx context.Context, cfg *Config) (*Service, error) {
 		depositCache:       cfg.DepositCache,
 		chainStartFetcher:  cfg.ChainStartFetcher,
 		attPool:            cfg.AttPool,
+		exitPool:           cfg.ExitPool,
+		slashingPool:       cfg.SlashingPool,
 		forkChoiceStore:    store,
 		p2p:                cfg.P2p,
 		canonicalRoots:     make(map[uint64][]byte),
 		maxRoutines:        cfg.MaxRoutines,
 		stateNotifier:      cfg.StateNotifier,
 		epochParticipation: make(map[uint64]*precompute.Balance),
+		checkpoint:         cfg.Checkpoint,
+		stateGen:           store.StateGen(),
 	}, nil
 }
 
@@ -106,7 +121,7 @@ func (s *Service) Start() {
 			log.Fatalf("Could not fetch finalized cp: %v", err)
 		}
 		if beaconState == nil {
-			beaconState, err = s.beaconDB.State(ctx, bytesutil.ToBytes32(cp.Root))
+			beaconState, err = s.stateGen.StateByRoot(ctx, bytesutil.ToBytes32(cp.Root))
 			if err != nil {
 				log.Fatalf("Could not fetch beacon state: %v", err)
 			}
@@ -137,6 +152,18 @@ func (s *Service) Start() {
 				StartTime: s.genesisTime,
 			},
 		})
+	} else if s.checkpoint != nil {
+		log.WithField("slot", s.checkpoint.Block.Block.Slot).Info("Initializing beacon chain from checkpoint state...")
+		s.genesisTime = time.Unix(int64(s.checkpoint.State.GenesisTime), 0)
+		if err := s.saveCheckpointData(ctx, s.checkpoint); err != nil {
+			log.Fatalf("Could not initialize beacon chain from checkpoint: %v", err)
+		}
+		s.stateNotifier.StateFeed().Send(&feed.Event{
+			Type: statefeed.Initialized,
+			Data: &statefeed.InitializedData{
+				StartTime: s.genesisTime,
+			},
+		})
 	} else {
 		log.Info("Waiting to reach the validator deposit threshold to start the beacon chain...")
 		if s.chainStartFetcher == nil {
@@ -234,13 +261,40 @@ func (s *Service) Status() error {
 	return nil
 }
 
-// This gets called to update canonical root mapping.
+// StateGen returns the state generator the chain service saves and retrieves states with.
+func (s *Service) StateGen() *stategen.State {
+	return s.stateGen
+}
+
+// This gets called to update canonical root mapping. A reorg is reported once the head is saved,
+// if the new head is not a descendant of the previous head.
 func (s *Service) saveHead(ctx context.Context, signed *ethpb.SignedBeaconBlock, r [32]byte) error {
+	if signed == nil || signed.Block == nil {
+		return errors.New("cannot save nil head block")
+	}
+	reorg, oldHead, err := s.swapHead(ctx, signed, r)
+	if err != nil {
+		return err
+	}
+	// The reorg is sent once the head lock is released, as subscribers may read the head.
+	if reorg != nil {
+		s.notifyReorg(reorg)
+	}
+	s.updateOperationPools(ctx, oldHead, signed, reorg)
+	return nil
+}
+
+// swapHead saves the head block, its root and its state, and returns the reorg caused by the new
+// head if any, with the previous head block. The previous head is compared and replaced under the
+// same lock, so that concurrent calls cannot both compare against the same previous head.
+func (s *Service) swapHead(ctx context.Context, signed *ethpb.SignedBeaconBlock, r [32]byte) (*statefeed.ReorgData, *ethpb.SignedBeaconBlock, error) {
 	s.headLock.Lock()
 	defer s.headLock.Unlock()
 
-	if signed == nil || signed.Block == nil {
-		return errors.New("cannot save nil head block")
+	oldHead := s.headBlock
+	reorg, err := s.detectReorg(ctx, signed, r)
+	if err != nil {
+		log.WithError(err).Error("Could not check for chain reorganization")
 	}
 
 	s.headSlot = signed.Block.Slot
@@ -248,13 +302,20 @@ func (s *Service) saveHead(ctx context.Context, signed *ethpb.SignedBeaconBlock,
 	s.canonicalRoots[signed.Block.Slot] = r[:]
 
 	if err := s.beaconDB.SaveHeadBlockRoot(ctx, r); err != nil {
-		return errors.Wrap(err, "could not save head root in DB")
+		return nil, nil, errors.Wrap(err, "could not save head root in DB")
 	}
 	s.headBlock = signed
 
-	headState, err := s.beaconDB.State(ctx, r)
+	headState, err := s.stateGen.StateByRoot(ctx, r)
 	if err != nil {
-		return errors.Wrap(err, "could not retrieve head state in DB")
+		return nil, nil, errors.Wrap(err, "could not retrieve head state")
+	}
+	// Hot states are only persisted at epoch boundaries, the head state is persisted for the
+	// readers of the head state in DB.
+	if headState != nil && !s.beaconDB.HasState(ctx, r) {
+		if err := s.beaconDB.SaveState(ctx, headState, r); err != nil {
+			return nil, nil, errors.Wrap(err, "could not save head state in DB")
+		}
 	}
 	s.headState = headState
 
@@ -262,7 +323,7 @@ func (s *Service) saveHead(ctx context.Context, signed *ethpb.SignedBeaconBlock,
 		"slot":     signed.Block.Slot,
 		"headRoot": fmt.Sprintf("%#x", r),
 	}).Debug("Saved new head info")
-	return nil
+	return reorg, oldHead, nil
 }
 
 // This gets called to update canonical root mapping. It does not save head block
@@ -270,6 +331,9 @@ func (s *Service) saveHead(ctx context.Context, signed *ethpb.SignedBeaconBlock,
 // check point as anchors to resume sync therefore head is no longer needed to be saved on per slot basis.
 func (s *Service) saveHeadNoDB(ctx context.Context, b *ethpb.SignedBeaconBlock, r [32]byte) error {
 	s.headLock.Lock()
+	oldHead := s.headBlock
+	// The operation pools are updated once the head lock is released.
+	defer s.updateOperationPools(ctx, oldHead, b, nil)
 	defer s.headLock.Unlock()
 
 	s.headSlot = b.Block.Slot
@@ -278,9 +342,9 @@ func (s *Service) saveHeadNoDB(ctx context.Context, b *ethpb.SignedBeaconBlock,
 
 	s.headBlock = b
 
-	headState, err := s.beaconDB.State(ctx, r)
+	headState, err := s.stateGen.StateByRoot(ctx, r)
 	if err != nil {
-		return errors.Wrap(err, "could not retrieve head state in DB")
+		return errors.Wrap(err, "could not retrieve head state")
 	}
 	s.headState = headState
 
@@ -345,6 +409,64 @@ func (s *Service) saveGenesisData(ctx context.Context, genesisState *pb.BeaconSt
 	return nil
 }
 
+// This gets called when beacon chain is first initialized from a trusted finalized checkpoint
+// instead of genesis, to save the checkpoint data (state, block, and more) in db. The chain is
+// then synced forward from the checkpoint block.
+func (s *Service) saveCheckpointData(ctx context.Context, cp *checkpoint.Checkpoint) error {
+	s.headLock.Lock()
+	defer s.headLock.Unlock()
+
+	if err := cp.Verify(); err != nil {
+		return errors.Wrap(err, "could not verify checkpoint")
+	}
+	root, err := cp.Root()
+	if err != nil {
+		return errors.Wrap(err, "could not get checkpoint block root")
+	}
+
+	if err := s.beaconDB.SaveBlock(ctx, cp.Block); err != nil {
+		return errors.Wrap(err, "could not save checkpoint block")
+	}
+	if err := s.beaconDB.SaveState(ctx, cp.State, root); err != nil {
+		return errors.Wrap(err, "could not save checkpoint state")
+	}
+	if err := s.beaconDB.SaveOriginBlockRoot(ctx, root); err != nil {
+		return errors.Wrap(err, "could not save origin block root")
+	}
+	if err := s.beaconDB.SaveHeadBlockRoot(ctx, root); err != nil {
+		return errors.Wrap(err, "could not save head block root")
+	}
+	if err := s.saveGenesisValidators(ctx, cp.State); err != nil {
+		return errors.Wrap(err, "could not save checkpoint validators")
+	}
+
+	checkpointCp := &ethpb.Checkpoint{Epoch: cp.Epoch, Root: root[:]}
+	if err := s.beaconDB.SaveJustifiedCheckpoint(ctx, checkpointCp); err != nil {
+		return errors.Wrap(err, "could not save justified checkpoint")
+	}
+	if err := s.beaconDB.SaveFinalizedCheckpoint(ctx, checkpointCp); err != nil {
+		return errors.Wrap(err, "could not save finalized checkpoint")
+	}
+	if err := s.forkChoiceStore.GenesisStore(ctx, checkpointCp, checkpointCp); err != nil {
+		return errors.Wrap(err, "could not start fork choice service")
+	}
+
+	// The checkpoint block stands in for the genesis block until it is backfilled.
+	s.genesisRoot = root
+	s.headBlock = cp.Block
+	s.headState = cp.State
+	s.headSlot = cp.Block.Block.Slot
+	s.canonicalRoots[s.headSlot] = root[:]
+
+	if featureconfig.Get().EnableNewCache {
+		if err := helpers.UpdateCommitteeCache(cp.State, helpers.CurrentEpoch(cp.State)); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
 // This gets called to initialize chain info variables using the finalized checkpoint stored in DB
 func (s *Service) initializeChainInfo(ctx context.Context) error {
 	s.headLock.Lock()
@@ -354,14 +476,23 @@ func (s *Service) initializeChainInfo(ctx context.Context) error {
 	if err != nil {
 		return errors.Wrap(err, "could not get genesis block from db")
 	}
-	if genesisBlock == nil {
-		return errors.New("no genesis block in db")
-	}
-	genesisBlkRoot, err := ssz.HashTreeRoot(genesisBlock.Block)
-	if err != nil {
-		return errors.Wrap(err, "could not get signing root of genesis block")
+	if genesisBlock != nil {
+		genesisBlkRoot, err := ssz.HashTreeRoot(genesisBlock.Block)
+		if err != nil {
+			return errors.Wrap(err, "could not get signing root of genesis block")
+		}
+		s.genesisRoot = genesisBlkRoot
+	} else {
+		// A node started from a checkpoint has no genesis block until it is backfilled.
+		originRoot, err := s.beaconDB.OriginBlockRoot(ctx)
+		if err != nil {
+			return errors.Wrap(err, "could not get origin block root from db")
+		}
+		if originRoot == [32]byte{} {
+			return errors.New("no genesis block in db")
+		}
+		s.genesisRoot = originRoot
 	}
-	s.genesisRoot = genesisBlkRoot
 
 	finalized, err := s.beaconDB.FinalizedCheckpoint(ctx)
 	if err != nil {
@@ -372,9 +503,9 @@ func (s *Service) initializeChainInfo(ctx context.Context) error {
 		// would be the genesis state and block.
 		return errors.New("no finalized epoch in the database")
 	}
-	s.headState, err = s.beaconDB.State(ctx, bytesutil.ToBytes32(finalized.Root))
+	s.headState, err = s.stateGen.StateByRoot(ctx, bytesutil.ToBytes32(finalized.Root))
 	if err != nil {
-		return errors.Wrap(err, "could not get finalized state from db")
+		return errors.Wrap(err, "could not get finalized state")
 	}
 	s.headBlock, err = s.beaconDB.Block(ctx, bytesutil.ToBytes32(finalized.Root))
 	if err != nil {
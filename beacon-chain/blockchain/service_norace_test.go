@@ -7,6 +7,7 @@ import (
 
 	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
 	testDB "github.com/prysmaticlabs/prysm/beacon-chain/db/testing"
+	"github.com/prysmaticlabs/prysm/beacon-chain/state/stategen"
 	"github.com/sirupsen/logrus"
 )
 
@@ -21,6 +22,7 @@ func TestChainService_SaveHead_DataRace(t *testing.T) {
 	s := &Service{
 		beaconDB:       db,
 		canonicalRoots: make(map[uint64][]byte),
+		stateGen:       stategen.New(db, 0),
 	}
 	go func() {
 		s.saveHead(
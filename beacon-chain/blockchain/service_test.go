@@ -24,6 +24,8 @@ import (
 	"github.com/prysmaticlabs/prysm/beacon-chain/operations/attestations"
 	"github.com/prysmaticlabs/prysm/beacon-chain/p2p"
 	"github.com/prysmaticlabs/prysm/beacon-chain/powchain"
+	"github.com/prysmaticlabs/prysm/beacon-chain/state/stategen"
+	"github.com/prysmaticlabs/prysm/beacon-chain/sync/checkpoint"
 	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
 	"github.com/prysmaticlabs/prysm/shared/bytesutil"
 	"github.com/prysmaticlabs/prysm/shared/event"
@@ -311,7 +313,7 @@ func TestChainService_InitializeChainInfo(t *testing.T) {
 	if err := db.SaveBlock(ctx, headBlock); err != nil {
 		t.Fatal(err)
 	}
-	c := &Service{beaconDB: db, canonicalRoots: make(map[uint64][]byte)}
+	c := &Service{beaconDB: db, stateGen: stategen.New(db, 0), canonicalRoots: make(map[uint64][]byte)}
 	if err := c.initializeChainInfo(ctx); err != nil {
 		t.Fatal(err)
 	}
@@ -336,12 +338,61 @@ func TestChainService_InitializeChainInfo(t *testing.T) {
 	}
 }
 
+func TestChainService_SaveCheckpointData(t *testing.T) {
+	db := testDB.SetupDB(t)
+	defer testDB.TeardownDB(t, db)
+	ctx := context.Background()
+
+	cpState, _ := testutil.DeterministicGenesisState(t, 8)
+	cpState.Slot = 64
+	stateRoot, err := ssz.HashTreeRoot(cpState)
+	if err != nil {
+		t.Fatal(err)
+	}
+	cpBlock := &ethpb.SignedBeaconBlock{Block: &ethpb.BeaconBlock{Slot: 64, StateRoot: stateRoot[:]}}
+	cpRoot, err := ssz.HashTreeRoot(cpBlock.Block)
+	if err != nil {
+		t.Fatal(err)
+	}
+	cp := &checkpoint.Checkpoint{State: cpState, Block: cpBlock, Epoch: 2}
+
+	c := &Service{beaconDB: db, forkChoiceStore: &store{}, canonicalRoots: make(map[uint64][]byte)}
+	if err := c.saveCheckpointData(ctx, cp); err != nil {
+		t.Fatal(err)
+	}
+
+	originRoot, err := db.OriginBlockRoot(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if originRoot != cpRoot {
+		t.Errorf("Wanted origin root %#x, received %#x", cpRoot, originRoot)
+	}
+	finalized, err := db.FinalizedCheckpoint(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if finalized.Epoch != 2 || !bytes.Equal(finalized.Root, cpRoot[:]) {
+		t.Errorf("Finalized checkpoint incorrect, received %v", finalized)
+	}
+	if !reflect.DeepEqual(c.HeadBlock(), cpBlock) {
+		t.Error("head block incorrect")
+	}
+	if c.HeadSlot() != 64 {
+		t.Errorf("Wanted head slot 64, received %d", c.HeadSlot())
+	}
+	if c.genesisRoot != cpRoot {
+		t.Error("checkpoint root should stand in for the genesis root")
+	}
+}
+
 func TestChainService_SaveHeadNoDB(t *testing.T) {
 	db := testDB.SetupDB(t)
 	defer testDB.TeardownDB(t, db)
 	ctx := context.Background()
 	s := &Service{
 		beaconDB:       db,
+		stateGen:       stategen.New(db, 0),
 		canonicalRoots: make(map[uint64][]byte),
 	}
 	b := &ethpb.SignedBeaconBlock{Block: &ethpb.BeaconBlock{Slot: 1}}
@@ -0,0 +1,114 @@
+package cache
+
+import (
+	"sort"
+	"sync"
+
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+)
+
+// SubnetIDs is the cache of the attestation subnets needed by the validators attached to the
+// beacon node. It is filled by the validator server from the duties of the validators, and
+// read by the sync service to decide which attestation subnets to subscribe to.
+var SubnetIDs = newSubnetIDs()
+
+type persistentSubnets struct {
+	subnets []uint64
+	expiry  uint64
+}
+
+type subnetIDs struct {
+	attester   map[uint64][]uint64
+	persistent map[[48]byte]*persistentSubnets
+	lock       sync.RWMutex
+}
+
+func newSubnetIDs() *subnetIDs {
+	return &subnetIDs{
+		attester:   make(map[uint64][]uint64),
+		persistent: make(map[[48]byte]*persistentSubnets),
+	}
+}
+
+// AddAttesterSubnetID records that an attached validator attests on the subnet at the slot.
+// Slots older than the cache padding are pruned.
+func (c *subnetIDs) AddAttesterSubnetID(slot uint64, subnet uint64) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	for _, s := range c.attester[slot] {
+		if s == subnet {
+			return
+		}
+	}
+	c.attester[slot] = append(c.attester[slot], subnet)
+
+	if slot > uint64(maxCacheSize) {
+		for s := range c.attester {
+			if s < slot-uint64(maxCacheSize) {
+				delete(c.attester, s)
+			}
+		}
+	}
+}
+
+// AttesterSubnetIDs returns the subnets attached validators attest on at the slot.
+func (c *subnetIDs) AttesterSubnetIDs(slot uint64) []uint64 {
+	c.lock.RLock()
+	defer c.lock.RUnlock()
+
+	subnets := make([]uint64, len(c.attester[slot]))
+	copy(subnets, c.attester[slot])
+	return subnets
+}
+
+// AddPersistentSubnetIDs records the long-lived subnets of the validator, which are kept
+// until the expiry epoch.
+func (c *subnetIDs) AddPersistentSubnetIDs(pubKey []byte, subnets []uint64, expiry uint64) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	c.persistent[bytesutil.ToBytes48(pubKey)] = &persistentSubnets{
+		subnets: subnets,
+		expiry:  expiry,
+	}
+}
+
+// PersistentSubnetIDs returns the long-lived subnets of the validator, if they have not
+// expired at the epoch.
+func (c *subnetIDs) PersistentSubnetIDs(pubKey []byte, epoch uint64) ([]uint64, bool) {
+	c.lock.RLock()
+	defer c.lock.RUnlock()
+
+	p, ok := c.persistent[bytesutil.ToBytes48(pubKey)]
+	if !ok || p.expiry <= epoch {
+		return nil, false
+	}
+	return p.subnets, true
+}
+
+// AllPersistentSubnetIDs returns the sorted long-lived subnets of all the attached validators
+// which have not expired at the epoch. Expired subnets are pruned.
+func (c *subnetIDs) AllPersistentSubnetIDs(epoch uint64) []uint64 {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	seen := make(map[uint64]bool)
+	var subnets []uint64
+	for k, p := range c.persistent {
+		if p.expiry <= epoch {
+			delete(c.persistent, k)
+			continue
+		}
+		for _, s := range p.subnets {
+			if !seen[s] {
+				seen[s] = true
+				subnets = append(subnets, s)
+			}
+		}
+	}
+	sort.Slice(subnets, func(i, j int) bool {
+		return subnets[i] < subnets[j]
+	})
+	return subnets
+}
@@ -0,0 +1,51 @@
+package cache
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestSubnetIDs_AttesterSubnetIDs(t *testing.T) {
+	c := newSubnetIDs()
+
+	c.AddAttesterSubnetID(1, 4)
+	c.AddAttesterSubnetID(1, 4)
+	c.AddAttesterSubnetID(1, 9)
+	if subnets := c.AttesterSubnetIDs(1); !reflect.DeepEqual(subnets, []uint64{4, 9}) {
+		t.Errorf("Wanted subnets %v, received %v", []uint64{4, 9}, subnets)
+	}
+	if subnets := c.AttesterSubnetIDs(2); len(subnets) != 0 {
+		t.Errorf("Wanted no subnets, received %v", subnets)
+	}
+
+	// Adding a subnet far in the future prunes the old slots.
+	c.AddAttesterSubnetID(uint64(maxCacheSize)+2, 1)
+	if subnets := c.AttesterSubnetIDs(1); len(subnets) != 0 {
+		t.Errorf("Wanted pruned subnets, received %v", subnets)
+	}
+}
+
+func TestSubnetIDs_PersistentSubnetIDs(t *testing.T) {
+	c := newSubnetIDs()
+	pubKey1 := []byte{'A'}
+	pubKey2 := []byte{'B'}
+
+	c.AddPersistentSubnetIDs(pubKey1, []uint64{3}, 10)
+	c.AddPersistentSubnetIDs(pubKey2, []uint64{7, 3}, 20)
+
+	if subnets, ok := c.PersistentSubnetIDs(pubKey1, 9); !ok || !reflect.DeepEqual(subnets, []uint64{3}) {
+		t.Errorf("Wanted subnets %v, received %v", []uint64{3}, subnets)
+	}
+	if _, ok := c.PersistentSubnetIDs(pubKey1, 10); ok {
+		t.Error("Expected subnets to be expired")
+	}
+	if subnets := c.AllPersistentSubnetIDs(5); !reflect.DeepEqual(subnets, []uint64{3, 7}) {
+		t.Errorf("Wanted subnets %v, received %v", []uint64{3, 7}, subnets)
+	}
+	if subnets := c.AllPersistentSubnetIDs(15); !reflect.DeepEqual(subnets, []uint64{3, 7}) {
+		t.Errorf("Wanted subnets %v, received %v", []uint64{3, 7}, subnets)
+	}
+	if subnets := c.AllPersistentSubnetIDs(20); len(subnets) != 0 {
+		t.Errorf("Wanted no subnets, received %v", subnets)
+	}
+}
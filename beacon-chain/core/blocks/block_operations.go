@@ -3,9 +3,7 @@ package blocks
 import (
 	"bytes"
 	"context"
-	"encoding/binary"
 	"fmt"
-	"reflect"
 	"sort"
 
 	"github.com/gogo/protobuf/proto"
@@ -37,25 +35,6 @@ var eth1DataCache = cache.NewEth1DataVoteCache()
 // failed to verify.
 var ErrSigFailedToVerify = errors.New("signature did not verify")
 
-func verifySigningRoot(obj interface{}, pub []byte, signature []byte, domain uint64) error {
-	publicKey, err := bls.PublicKeyFromBytes(pub)
-	if err != nil {
-		return errors.Wrap(err, "could not convert bytes to public key")
-	}
-	sig, err := bls.SignatureFromBytes(signature)
-	if err != nil {
-		return errors.Wrap(err, "could not convert bytes to signature")
-	}
-	root, err := ssz.HashTreeRoot(obj)
-	if err != nil {
-		return errors.Wrap(err, "could not get signing root")
-	}
-	if !sig.Verify(root[:], publicKey, domain) {
-		return ErrSigFailedToVerify
-	}
-	return nil
-}
-
 // Deprecated: This method uses deprecated ssz.SigningRoot.
 func verifyDepositDataSigningRoot(obj *ethpb.Deposit_Data, pub []byte, signature []byte, domain uint64) error {
 	publicKey, err := bls.PublicKeyFromBytes(pub)
@@ -76,21 +55,6 @@ func verifyDepositDataSigningRoot(obj *ethpb.Deposit_Data, pub []byte, signature
 	return nil
 }
 
-func verifySignature(signedData []byte, pub []byte, signature []byte, domain uint64) error {
-	publicKey, err := bls.PublicKeyFromBytes(pub)
-	if err != nil {
-		return errors.Wrap(err, "could not convert bytes to public key")
-	}
-	sig, err := bls.SignatureFromBytes(signature)
-	if err != nil {
-		return errors.Wrap(err, "could not convert bytes to signature")
-	}
-	if !sig.Verify(signedData, publicKey, domain) {
-		return ErrSigFailedToVerify
-	}
-	return nil
-}
-
 // ProcessEth1DataInBlock is an operation performed on each
 // beacon block to ensure the ETH1 data votes are processed
 // into the beacon state.
@@ -189,16 +153,12 @@ func ProcessBlockHeader(
 		return nil, err
 	}
 
-	idx, err := helpers.BeaconProposerIndex(beaconState)
+	// Verify proposer signature.
+	set, err := BlockSignatureSet(beaconState, block)
 	if err != nil {
 		return nil, err
 	}
-	proposer := beaconState.Validators[idx]
-
-	// Verify proposer signature.
-	currentEpoch := helpers.CurrentEpoch(beaconState)
-	domain := helpers.Domain(beaconState.Fork, currentEpoch, params.BeaconConfig().DomainBeaconProposer)
-	if err := verifySigningRoot(block.Block, proposer.PublicKey, block.Signature, domain); err != nil {
+	if err := set.Verify(); err != nil {
 		return nil, ErrSigFailedToVerify
 	}
 
@@ -294,20 +254,13 @@ func ProcessRandao(
 	beaconState *pb.BeaconState,
 	body *ethpb.BeaconBlockBody,
 ) (*pb.BeaconState, error) {
-	proposerIdx, err := helpers.BeaconProposerIndex(beaconState)
+	set, err := RandaoSignatureSet(beaconState, body)
 	if err != nil {
-		return nil, errors.Wrap(err, "could not get beacon proposer index")
-	}
-	proposerPub := beaconState.Validators[proposerIdx].PublicKey
-
-	currentEpoch := helpers.CurrentEpoch(beaconState)
-	buf := make([]byte, 32)
-	binary.LittleEndian.PutUint64(buf, currentEpoch)
-
-	domain := helpers.Domain(beaconState.Fork, currentEpoch, params.BeaconConfig().DomainRandao)
-	if err := verifySignature(buf, proposerPub, body.RandaoReveal, domain); err != nil {
 		return nil, errors.Wrap(err, "could not verify block randao")
 	}
+	if err := set.Verify(); err != nil {
+		return nil, errors.Wrap(ErrSigFailedToVerify, "could not verify block randao")
+	}
 
 	beaconState, err = ProcessRandaoNoVerify(beaconState, body)
 	if err != nil {
@@ -383,11 +336,58 @@ func ProcessProposerSlashings(ctx context.Context, beaconState *pb.BeaconState,
 	return beaconState, nil
 }
 
+// ProcessProposerSlashingsNoVerifySig processes the proposer slashings of the block like
+// ProcessProposerSlashings, but collects the signatures of the slashed block headers in a
+// signature set instead of verifying them.
+func ProcessProposerSlashingsNoVerifySig(
+	ctx context.Context,
+	beaconState *pb.BeaconState,
+	body *ethpb.BeaconBlockBody,
+) (*pb.BeaconState, *bls.SignatureSet, error) {
+	set := bls.NewSet()
+	for idx, slashing := range body.ProposerSlashings {
+		if int(slashing.ProposerIndex) >= len(beaconState.Validators) {
+			return nil, nil, fmt.Errorf("invalid proposer index given in slashing %d", slashing.ProposerIndex)
+		}
+		if err := verifyProposerSlashingConditions(beaconState, slashing); err != nil {
+			return nil, nil, errors.Wrapf(err, "could not verify proposer slashing %d", idx)
+		}
+		slashingSet, err := ProposerSlashingSignatureSet(beaconState, slashing)
+		if err != nil {
+			return nil, nil, errors.Wrapf(err, "could not verify proposer slashing %d", idx)
+		}
+		set.Join(slashingSet)
+		beaconState, err = v.SlashValidator(
+			beaconState, slashing.ProposerIndex, 0, /* proposer is whistleblower */
+		)
+		if err != nil {
+			return nil, nil, errors.Wrapf(err, "could not slash proposer index %d", slashing.ProposerIndex)
+		}
+	}
+	return beaconState, set, nil
+}
+
 // VerifyProposerSlashing verifies that the data provided fro slashing is valid.
 func VerifyProposerSlashing(
 	beaconState *pb.BeaconState,
 	slashing *ethpb.ProposerSlashing,
 ) error {
+	if err := verifyProposerSlashingConditions(beaconState, slashing); err != nil {
+		return err
+	}
+	set, err := ProposerSlashingSignatureSet(beaconState, slashing)
+	if err != nil {
+		return err
+	}
+	if err := set.Verify(); err != nil {
+		return errors.Wrap(ErrSigFailedToVerify, "could not verify beacon block header")
+	}
+	return nil
+}
+
+// verifyProposerSlashingConditions verifies the proposer slashing, except for the signatures
+// of its block headers.
+func verifyProposerSlashingConditions(beaconState *pb.BeaconState, slashing *ethpb.ProposerSlashing) error {
 	proposer := beaconState.Validators[slashing.ProposerIndex]
 
 	if slashing.Header_1.Header.Slot != slashing.Header_2.Header.Slot {
@@ -399,14 +399,6 @@ func VerifyProposerSlashing(
 	if !helpers.IsSlashableValidator(proposer, helpers.CurrentEpoch(beaconState)) {
 		return fmt.Errorf("validator with key %#x is not slashable", proposer.PublicKey)
 	}
-	// Using headerEpoch1 here because both of the headers should have the same epoch.
-	domain := helpers.Domain(beaconState.Fork, helpers.StartSlot(slashing.Header_1.Header.Slot), params.BeaconConfig().DomainBeaconProposer)
-	headers := []*ethpb.SignedBeaconBlockHeader{slashing.Header_1, slashing.Header_2}
-	for _, header := range headers {
-		if err := verifySigningRoot(header.Header, proposer.PublicKey, header.Signature, domain); err != nil {
-			return errors.Wrap(err, "could not verify beacon block header")
-		}
-	}
 	return nil
 }
 
@@ -434,27 +426,67 @@ func ProcessAttesterSlashings(ctx context.Context, beaconState *pb.BeaconState,
 		if err := VerifyAttesterSlashing(ctx, beaconState, slashing); err != nil {
 			return nil, errors.Wrapf(err, "could not verify attester slashing %d", idx)
 		}
-		slashableIndices := slashableAttesterIndices(slashing)
-		sort.SliceStable(slashableIndices, func(i, j int) bool {
-			return slashableIndices[i] < slashableIndices[j]
-		})
-		currentEpoch := helpers.CurrentEpoch(beaconState)
 		var err error
-		var slashedAny bool
-		for _, validatorIndex := range slashableIndices {
-			if helpers.IsSlashableValidator(beaconState.Validators[validatorIndex], currentEpoch) {
-				beaconState, err = v.SlashValidator(beaconState, validatorIndex, 0)
-				if err != nil {
-					return nil, errors.Wrapf(err, "could not slash validator index %d",
-						validatorIndex)
-				}
-				slashedAny = true
+		beaconState, err = slashAttesters(beaconState, slashing)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return beaconState, nil
+}
+
+// ProcessAttesterSlashingsNoVerifySig processes the attester slashings of the block like
+// ProcessAttesterSlashings, but collects the aggregate signatures of both attestations of each
+// slashing in a signature set instead of verifying them.
+func ProcessAttesterSlashingsNoVerifySig(
+	ctx context.Context,
+	beaconState *pb.BeaconState,
+	body *ethpb.BeaconBlockBody,
+) (*pb.BeaconState, *bls.SignatureSet, error) {
+	set := bls.NewSet()
+	for idx, slashing := range body.AttesterSlashings {
+		if !IsSlashableAttestationData(slashing.Attestation_1.Data, slashing.Attestation_2.Data) {
+			return nil, nil, fmt.Errorf("could not verify attester slashing %d: attestations are not slashable", idx)
+		}
+		for _, att := range []*ethpb.IndexedAttestation{slashing.Attestation_1, slashing.Attestation_2} {
+			attSet, err := IndexedAttestationSignatureSet(beaconState, att)
+			if err != nil {
+				return nil, nil, errors.Wrapf(err, "could not verify attester slashing %d", idx)
 			}
+			set.Join(attSet)
 		}
-		if !slashedAny {
-			return nil, errors.New("unable to slash any validator despite confirmed attester slashing")
+		var err error
+		beaconState, err = slashAttesters(beaconState, slashing)
+		if err != nil {
+			return nil, nil, err
 		}
 	}
+	return beaconState, set, nil
+}
+
+// slashAttesters slashes the slashable validators attesting to both attestations of the
+// verified attester slashing.
+func slashAttesters(beaconState *pb.BeaconState, slashing *ethpb.AttesterSlashing) (*pb.BeaconState, error) {
+	slashableIndices := slashableAttesterIndices(slashing)
+	sort.SliceStable(slashableIndices, func(i, j int) bool {
+		return slashableIndices[i] < slashableIndices[j]
+	})
+	currentEpoch := helpers.CurrentEpoch(beaconState)
+	var err error
+	var slashedAny bool
+	for _, validatorIndex := range slashableIndices {
+		if helpers.IsSlashableValidator(beaconState.Validators[validatorIndex], currentEpoch) {
+			beaconState, err = v.SlashValidator(beaconState, validatorIndex, 0)
+			if err != nil {
+				return nil, errors.Wrapf(err, "could not slash validator index %d",
+					validatorIndex)
+			}
+			slashedAny = true
+		}
+	}
+	if !slashedAny {
+		return nil, errors.New("unable to slash any validator despite confirmed attester slashing")
+	}
 	return beaconState, nil
 }
 
@@ -528,6 +560,30 @@ func ProcessAttestationsNoVerify(ctx context.Context, beaconState *pb.BeaconStat
 	return beaconState, nil
 }
 
+// ProcessAttestationsNoVerifySig processes the attestations of the block like
+// ProcessAttestations, but collects their aggregate signatures in a signature set instead of
+// verifying them.
+func ProcessAttestationsNoVerifySig(
+	ctx context.Context,
+	beaconState *pb.BeaconState,
+	body *ethpb.BeaconBlockBody,
+) (*pb.BeaconState, *bls.SignatureSet, error) {
+	set := bls.NewSet()
+	var err error
+	for idx, attestation := range body.Attestations {
+		beaconState, err = ProcessAttestationNoVerify(ctx, beaconState, attestation)
+		if err != nil {
+			return nil, nil, errors.Wrapf(err, "could not verify attestation at index %d in block", idx)
+		}
+		attSet, err := AttestationSignatureSet(ctx, beaconState, attestation)
+		if err != nil {
+			return nil, nil, errors.Wrapf(err, "could not verify attestation at index %d in block", idx)
+		}
+		set.Join(attSet)
+	}
+	return beaconState, set, nil
+}
+
 // ProcessAttestation verifies an input attestation can pass through processing using the given beacon state.
 //
 // Spec pseudocode definition:
@@ -715,57 +771,11 @@ func VerifyIndexedAttestation(ctx context.Context, beaconState *pb.BeaconState,
 	ctx, span := trace.StartSpan(ctx, "core.VerifyIndexedAttestation")
 	defer span.End()
 
-	indices := indexedAtt.AttestingIndices
-
-	if uint64(len(indices)) > params.BeaconConfig().MaxValidatorsPerCommittee {
-		return fmt.Errorf("validator indices count exceeds MAX_VALIDATORS_PER_COMMITTEE, %d > %d", len(indices), params.BeaconConfig().MaxValidatorsPerCommittee)
-	}
-
-	set := make(map[uint64]bool)
-	setIndices := make([]uint64, 0, len(indices))
-	for _, i := range indices {
-		if ok := set[i]; ok {
-			continue
-		}
-		setIndices = append(setIndices, i)
-		set[i] = true
-	}
-	sort.SliceStable(setIndices, func(i, j int) bool {
-		return setIndices[i] < setIndices[j]
-	})
-	if !reflect.DeepEqual(setIndices, indices) {
-		return errors.New("attesting indices is not uniquely sorted")
-	}
-
-	domain := helpers.Domain(beaconState.Fork, indexedAtt.Data.Target.Epoch, params.BeaconConfig().DomainBeaconAttester)
-	var pubkey *bls.PublicKey
-	var err error
-	if len(indices) > 0 {
-		pubkey, err = bls.PublicKeyFromBytes(beaconState.Validators[indices[0]].PublicKey)
-		if err != nil {
-			return errors.Wrap(err, "could not deserialize validator public key")
-		}
-		for _, i := range indices[1:] {
-			pk, err := bls.PublicKeyFromBytes(beaconState.Validators[i].PublicKey)
-			if err != nil {
-				return errors.Wrap(err, "could not deserialize validator public key")
-			}
-			pubkey.Aggregate(pk)
-		}
-	}
-
-	messageHash, err := ssz.HashTreeRoot(indexedAtt.Data)
+	set, err := IndexedAttestationSignatureSet(beaconState, indexedAtt)
 	if err != nil {
-		return errors.Wrap(err, "could not tree hash att data")
-	}
-
-	sig, err := bls.SignatureFromBytes(indexedAtt.Signature)
-	if err != nil {
-		return errors.Wrap(err, "could not convert bytes to signature")
+		return err
 	}
-
-	voted := len(indices) > 0
-	if voted && !sig.Verify(messageHash[:], pubkey, domain) {
+	if err := set.Verify(); err != nil {
 		return ErrSigFailedToVerify
 	}
 	return nil
@@ -989,6 +999,32 @@ func ProcessVoluntaryExitsNoVerify(
 	return beaconState, nil
 }
 
+// ProcessVoluntaryExitsNoVerifySig processes the voluntary exits of the block like
+// ProcessVoluntaryExits, but collects their signatures in a signature set instead of
+// verifying them.
+func ProcessVoluntaryExitsNoVerifySig(
+	ctx context.Context,
+	beaconState *pb.BeaconState,
+	body *ethpb.BeaconBlockBody,
+) (*pb.BeaconState, *bls.SignatureSet, error) {
+	set := bls.NewSet()
+	for idx, exit := range body.VoluntaryExits {
+		if err := verifyExitConditions(beaconState, exit); err != nil {
+			return nil, nil, errors.Wrapf(err, "could not verify exit %d", idx)
+		}
+		exitSet, err := ExitSignatureSet(beaconState, exit)
+		if err != nil {
+			return nil, nil, errors.Wrapf(err, "could not verify exit %d", idx)
+		}
+		set.Join(exitSet)
+		beaconState, err = v.InitiateValidatorExit(beaconState, exit.Exit.ValidatorIndex)
+		if err != nil {
+			return nil, nil, err
+		}
+	}
+	return beaconState, set, nil
+}
+
 // VerifyExit implements the spec defined validation for voluntary exits.
 //
 // Spec pseudocode definition:
@@ -1009,6 +1045,21 @@ func ProcessVoluntaryExitsNoVerify(
 //    domain = get_domain(state, DOMAIN_VOLUNTARY_EXIT, exit.epoch)
 //    assert bls_verify(validator.pubkey, signing_root(exit), exit.signature, domain)
 func VerifyExit(beaconState *pb.BeaconState, signed *ethpb.SignedVoluntaryExit) error {
+	if err := verifyExitConditions(beaconState, signed); err != nil {
+		return err
+	}
+	set, err := ExitSignatureSet(beaconState, signed)
+	if err != nil {
+		return ErrSigFailedToVerify
+	}
+	if err := set.Verify(); err != nil {
+		return ErrSigFailedToVerify
+	}
+	return nil
+}
+
+// verifyExitConditions verifies the voluntary exit, except for its signature.
+func verifyExitConditions(beaconState *pb.BeaconState, signed *ethpb.SignedVoluntaryExit) error {
 	if signed == nil || signed.Exit == nil {
 		return errors.New("nil exit")
 	}
@@ -1040,10 +1091,6 @@ func VerifyExit(beaconState *pb.BeaconState, signed *ethpb.SignedVoluntaryExit)
 			validator.ActivationEpoch+params.BeaconConfig().PersistentCommitteePeriod,
 		)
 	}
-	domain := helpers.Domain(beaconState.Fork, exit.Epoch, params.BeaconConfig().DomainVoluntaryExit)
-	if err := verifySigningRoot(exit, validator.PublicKey, signed.Signature, domain); err != nil {
-		return ErrSigFailedToVerify
-	}
 	return nil
 }
 
@@ -0,0 +1,171 @@
+package blocks
+
+import (
+	"context"
+	"encoding/binary"
+	"fmt"
+	"reflect"
+	"sort"
+
+	"github.com/pkg/errors"
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/go-ssz"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+	"github.com/prysmaticlabs/prysm/shared/bls"
+	"github.com/prysmaticlabs/prysm/shared/params"
+)
+
+// signatureSet returns the signature set of a single signature of the message.
+func signatureSet(msg [32]byte, pub []byte, signature []byte, domain uint64, description string) (*bls.SignatureSet, error) {
+	publicKey, err := bls.PublicKeyFromBytes(pub)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not convert bytes to public key")
+	}
+	sig, err := bls.SignatureFromBytes(signature)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not convert bytes to signature")
+	}
+	set := bls.NewSet()
+	set.Add(sig, publicKey, msg, domain, description)
+	return set, nil
+}
+
+// signingRootSignatureSet returns the signature set of a single signature of the signing root
+// of the object.
+func signingRootSignatureSet(obj interface{}, pub []byte, signature []byte, domain uint64, description string) (*bls.SignatureSet, error) {
+	root, err := ssz.HashTreeRoot(obj)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not get signing root")
+	}
+	return signatureSet(root, pub, signature, domain, description)
+}
+
+// BlockSignatureSet retrieves the signature set of the proposer signature of the block, to be
+// verified against the state the block header is processed on.
+func BlockSignatureSet(beaconState *pb.BeaconState, block *ethpb.SignedBeaconBlock) (*bls.SignatureSet, error) {
+	idx, err := helpers.BeaconProposerIndex(beaconState)
+	if err != nil {
+		return nil, err
+	}
+	proposer := beaconState.Validators[idx]
+	currentEpoch := helpers.CurrentEpoch(beaconState)
+	domain := helpers.Domain(beaconState.Fork, currentEpoch, params.BeaconConfig().DomainBeaconProposer)
+	return signingRootSignatureSet(block.Block, proposer.PublicKey, block.Signature, domain, "block proposal")
+}
+
+// RandaoSignatureSet retrieves the signature set of the randao reveal of the block body.
+func RandaoSignatureSet(beaconState *pb.BeaconState, body *ethpb.BeaconBlockBody) (*bls.SignatureSet, error) {
+	proposerIdx, err := helpers.BeaconProposerIndex(beaconState)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not get beacon proposer index")
+	}
+	proposerPub := beaconState.Validators[proposerIdx].PublicKey
+
+	currentEpoch := helpers.CurrentEpoch(beaconState)
+	var buf [32]byte
+	binary.LittleEndian.PutUint64(buf[:], currentEpoch)
+
+	domain := helpers.Domain(beaconState.Fork, currentEpoch, params.BeaconConfig().DomainRandao)
+	return signatureSet(buf, proposerPub, body.RandaoReveal, domain, "randao reveal")
+}
+
+// ProposerSlashingSignatureSet retrieves the signature set of both block headers of the
+// proposer slashing.
+func ProposerSlashingSignatureSet(beaconState *pb.BeaconState, slashing *ethpb.ProposerSlashing) (*bls.SignatureSet, error) {
+	proposer := beaconState.Validators[slashing.ProposerIndex]
+	// Using headerEpoch1 here because both of the headers should have the same epoch.
+	domain := helpers.Domain(beaconState.Fork, helpers.StartSlot(slashing.Header_1.Header.Slot), params.BeaconConfig().DomainBeaconProposer)
+	set := bls.NewSet()
+	for i, header := range []*ethpb.SignedBeaconBlockHeader{slashing.Header_1, slashing.Header_2} {
+		description := fmt.Sprintf("header %d of proposer slashing of validator %d", i+1, slashing.ProposerIndex)
+		headerSet, err := signingRootSignatureSet(header.Header, proposer.PublicKey, header.Signature, domain, description)
+		if err != nil {
+			return nil, errors.Wrap(err, "could not verify beacon block header")
+		}
+		set.Join(headerSet)
+	}
+	return set, nil
+}
+
+// IndexedAttestationSignatureSet validates the attesting indices of the indexed attestation
+// and retrieves the signature set of its aggregate signature. The set is empty if there is
+// no attesting index.
+func IndexedAttestationSignatureSet(beaconState *pb.BeaconState, indexedAtt *ethpb.IndexedAttestation) (*bls.SignatureSet, error) {
+	indices := indexedAtt.AttestingIndices
+
+	if uint64(len(indices)) > params.BeaconConfig().MaxValidatorsPerCommittee {
+		return nil, fmt.Errorf("validator indices count exceeds MAX_VALIDATORS_PER_COMMITTEE, %d > %d", len(indices), params.BeaconConfig().MaxValidatorsPerCommittee)
+	}
+
+	set := make(map[uint64]bool)
+	setIndices := make([]uint64, 0, len(indices))
+	for _, i := range indices {
+		if ok := set[i]; ok {
+			continue
+		}
+		setIndices = append(setIndices, i)
+		set[i] = true
+	}
+	sort.SliceStable(setIndices, func(i, j int) bool {
+		return setIndices[i] < setIndices[j]
+	})
+	if !reflect.DeepEqual(setIndices, indices) {
+		return nil, errors.New("attesting indices is not uniquely sorted")
+	}
+
+	messageHash, err := ssz.HashTreeRoot(indexedAtt.Data)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not tree hash att data")
+	}
+
+	sig, err := bls.SignatureFromBytes(indexedAtt.Signature)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not convert bytes to signature")
+	}
+
+	if len(indices) == 0 {
+		return bls.NewSet(), nil
+	}
+
+	domain := helpers.Domain(beaconState.Fork, indexedAtt.Data.Target.Epoch, params.BeaconConfig().DomainBeaconAttester)
+	pubkey, err := bls.PublicKeyFromBytes(beaconState.Validators[indices[0]].PublicKey)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not deserialize validator public key")
+	}
+	for _, i := range indices[1:] {
+		pk, err := bls.PublicKeyFromBytes(beaconState.Validators[i].PublicKey)
+		if err != nil {
+			return nil, errors.Wrap(err, "could not deserialize validator public key")
+		}
+		pubkey.Aggregate(pk)
+	}
+
+	sigSet := bls.NewSet()
+	description := fmt.Sprintf("attestation of committee %d at slot %d", indexedAtt.Data.CommitteeIndex, indexedAtt.Data.Slot)
+	sigSet.Add(sig, pubkey, messageHash, domain, description)
+	return sigSet, nil
+}
+
+// AttestationSignatureSet converts the attestation into an indexed attestation and retrieves
+// the signature set of its aggregate signature.
+func AttestationSignatureSet(ctx context.Context, beaconState *pb.BeaconState, att *ethpb.Attestation) (*bls.SignatureSet, error) {
+	committee, err := helpers.BeaconCommitteeFromState(beaconState, att.Data.Slot, att.Data.CommitteeIndex)
+	if err != nil {
+		return nil, err
+	}
+	indexedAtt, err := ConvertToIndexed(ctx, att, committee)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not convert to indexed attestation")
+	}
+	return IndexedAttestationSignatureSet(beaconState, indexedAtt)
+}
+
+// ExitSignatureSet retrieves the signature set of the voluntary exit.
+func ExitSignatureSet(beaconState *pb.BeaconState, signed *ethpb.SignedVoluntaryExit) (*bls.SignatureSet, error) {
+	exit := signed.Exit
+	validator := beaconState.Validators[exit.ValidatorIndex]
+	domain := helpers.Domain(beaconState.Fork, exit.Epoch, params.BeaconConfig().DomainVoluntaryExit)
+	description := fmt.Sprintf("voluntary exit of validator %d", exit.ValidatorIndex)
+	return signingRootSignatureSet(exit, validator.PublicKey, signed.Signature, domain, description)
+}
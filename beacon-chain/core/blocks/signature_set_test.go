@@ -0,0 +1,68 @@
+package blocks_test
+
+import (
+	"strings"
+	"testing"
+
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/go-ssz"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/blocks"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/testutil"
+)
+
+func TestBlockSignatureSet_VerifiesWithRandao(t *testing.T) {
+	beaconState, privKeys := testutil.DeterministicGenesisState(t, 100)
+	randaoReveal, err := testutil.RandaoReveal(beaconState, 0, privKeys)
+	if err != nil {
+		t.Fatal(err)
+	}
+	block := &ethpb.SignedBeaconBlock{
+		Block: &ethpb.BeaconBlock{
+			Body: &ethpb.BeaconBlockBody{RandaoReveal: randaoReveal},
+		},
+	}
+	proposerIdx, err := helpers.BeaconProposerIndex(beaconState)
+	if err != nil {
+		t.Fatal(err)
+	}
+	signingRoot, err := ssz.HashTreeRoot(block.Block)
+	if err != nil {
+		t.Fatal(err)
+	}
+	domain := helpers.Domain(beaconState.Fork, 0, params.BeaconConfig().DomainBeaconProposer)
+	block.Signature = privKeys[proposerIdx].Sign(signingRoot[:], domain).Marshal()
+
+	set, err := blocks.BlockSignatureSet(beaconState, block)
+	if err != nil {
+		t.Fatal(err)
+	}
+	randaoSet, err := blocks.RandaoSignatureSet(beaconState, block.Block.Body)
+	if err != nil {
+		t.Fatal(err)
+	}
+	set.Join(randaoSet)
+	if len(set.Signatures) != 2 {
+		t.Fatalf("Expected 2 signatures in the set, received %d", len(set.Signatures))
+	}
+	if err := set.Verify(); err != nil {
+		t.Errorf("Expected block signatures to verify, received %v", err)
+	}
+}
+
+func TestRandaoSignatureSet_PinpointsWrongEpoch(t *testing.T) {
+	beaconState, privKeys := testutil.DeterministicGenesisState(t, 100)
+	randaoReveal, err := testutil.RandaoReveal(beaconState, 1, privKeys)
+	if err != nil {
+		t.Fatal(err)
+	}
+	set, err := blocks.RandaoSignatureSet(beaconState, &ethpb.BeaconBlockBody{RandaoReveal: randaoReveal})
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := "signature of randao reveal did not verify"
+	if err := set.Verify(); err == nil || !strings.Contains(err.Error(), want) {
+		t.Errorf("Expected %s, received %v", want, err)
+	}
+}
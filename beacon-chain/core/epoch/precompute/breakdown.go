@@ -0,0 +1,87 @@
+package precompute
+
+import (
+	"context"
+
+	"github.com/gogo/protobuf/proto"
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+	"go.opencensus.io/trace"
+)
+
+// Rewards is the breakdown of the balance changes of an individual validator during the processing
+// of the rewards, penalties and slashings of an epoch.
+type Rewards struct {
+	// SourceReward is the reward for attesting to the correct source during prev epoch.
+	SourceReward uint64
+	// TargetReward is the reward for attesting to the correct target during prev epoch.
+	TargetReward uint64
+	// HeadReward is the reward for attesting to the correct head during prev epoch.
+	HeadReward uint64
+	// InclusionDelayReward is the reward for the attestation of prev epoch being included early.
+	InclusionDelayReward uint64
+	// ProposerReward is the reward for including the attestations of other validators as proposer.
+	ProposerReward uint64
+	// SourcePenalty is the penalty for not attesting to the correct source during prev epoch.
+	SourcePenalty uint64
+	// TargetPenalty is the penalty for not attesting to the correct target during prev epoch.
+	TargetPenalty uint64
+	// HeadPenalty is the penalty for not attesting to the correct head during prev epoch.
+	HeadPenalty uint64
+	// InactivityPenalty is the penalty applied while the chain is not finalizing.
+	InactivityPenalty uint64
+	// SlashingPenalty is the penalty applied to a slashed validator halfway through its withdrawal delay.
+	SlashingPenalty uint64
+}
+
+// RewardsBreakdown computes the rewards and penalties of every validator which the processing of
+// the current epoch of the state applies, without modifying the state. The state has to be at the
+// last slot of the epoch, before the epoch transition.
+func RewardsBreakdown(ctx context.Context, state *pb.BeaconState) ([]*Rewards, error) {
+	ctx, span := trace.StartSpan(ctx, "precomputeEpoch.RewardsBreakdown")
+	defer span.End()
+
+	// Justification and finalization are processed on a copy, as the inactivity penalty depends on
+	// the finalized checkpoint they update.
+	state = proto.Clone(state).(*pb.BeaconState)
+	vp, bp := New(ctx, state)
+	vp, bp, err := ProcessAttestations(ctx, state, vp, bp)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not process attestations")
+	}
+	state, err = ProcessJustificationAndFinalizationPreCompute(state, bp)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not process justification")
+	}
+
+	rewards := make([]*Rewards, len(state.Validators))
+	for i := range rewards {
+		rewards[i] = &Rewards{}
+	}
+	// Can't process rewards and penalties in genesis epoch.
+	currentEpoch := helpers.CurrentEpoch(state)
+	if currentEpoch > 0 {
+		if len(vp) != len(state.Validators) {
+			return nil, errors.New("precomputed registries not the same length as state registries")
+		}
+		for i, v := range vp {
+			d := attestationDeltaBreakdown(state, bp, v)
+			rewards[i] = &d
+		}
+		for _, v := range vp {
+			if v.IsPrevEpochAttester {
+				rewards[v.ProposerIndex].ProposerReward += proposerReward(bp.CurrentEpoch, v)
+			}
+		}
+	}
+
+	totalSlashing := uint64(0)
+	for _, slashing := range state.Slashings {
+		totalSlashing += slashing
+	}
+	for i, validator := range state.Validators {
+		rewards[i].SlashingPenalty = slashingPenalty(validator, currentEpoch, totalSlashing, bp)
+	}
+	return rewards, nil
+}
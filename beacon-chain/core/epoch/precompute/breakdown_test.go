@@ -0,0 +1,75 @@
+package precompute
+
+import (
+	"context"
+	"testing"
+
+	"github.com/gogo/protobuf/proto"
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/go-bitfield"
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+	"github.com/prysmaticlabs/prysm/shared/params"
+)
+
+func TestRewardsBreakdown_MatchesBalanceChanges(t *testing.T) {
+	e := params.BeaconConfig().SlotsPerEpoch
+	validatorCount := uint64(2048)
+	state := buildState(2*e-1, validatorCount)
+	atts := make([]*pb.PendingAttestation, 3)
+	for i := 0; i < len(atts); i++ {
+		atts[i] = &pb.PendingAttestation{
+			Data: &ethpb.AttestationData{
+				Target: &ethpb.Checkpoint{},
+				Source: &ethpb.Checkpoint{},
+			},
+			AggregationBits: bitfield.Bitlist{0xC0, 0xC0, 0xC0, 0xC0, 0x01},
+			InclusionDelay:  1,
+			ProposerIndex:   7,
+		}
+	}
+	state.PreviousEpochAttestations = atts
+	state.Validators[100].Slashed = true
+	state.Validators[100].WithdrawableEpoch = 1 + params.BeaconConfig().EpochsPerSlashingsVector/2
+	state.Slashings[0] = 1e9
+	before := proto.Clone(state).(*pb.BeaconState)
+
+	rewards, err := RewardsBreakdown(context.Background(), state)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !proto.Equal(state, before) {
+		t.Fatal("Expected the state not to be modified")
+	}
+
+	vp, bp := New(context.Background(), state)
+	vp, bp, err = ProcessAttestations(context.Background(), state, vp, bp)
+	if err != nil {
+		t.Fatal(err)
+	}
+	state, err = ProcessJustificationAndFinalizationPreCompute(state, bp)
+	if err != nil {
+		t.Fatal(err)
+	}
+	state, err = ProcessRewardsAndPenaltiesPrecompute(state, bp, vp)
+	if err != nil {
+		t.Fatal(err)
+	}
+	state = ProcessSlashingsPrecompute(state, bp)
+
+	for i, r := range rewards {
+		gained := r.SourceReward + r.TargetReward + r.HeadReward + r.InclusionDelayReward + r.ProposerReward
+		lost := r.SourcePenalty + r.TargetPenalty + r.HeadPenalty + r.InactivityPenalty + r.SlashingPenalty
+		if wanted := before.Balances[i] + gained - lost; state.Balances[i] != wanted {
+			t.Errorf("Validator %d: wanted balance %d from the breakdown, got %d", i, wanted, state.Balances[i])
+		}
+	}
+	if rewards[7].ProposerReward == 0 {
+		t.Error("Expected a proposer reward for including the attestations")
+	}
+	if rewards[100].SlashingPenalty == 0 {
+		t.Error("Expected a slashing penalty for the slashed validator")
+	}
+	if rewards[0].SourcePenalty == 0 || rewards[0].SourceReward != 0 {
+		t.Errorf("Expected only a source penalty for a validator which did not attest, got %+v", rewards[0])
+	}
+}
@@ -49,49 +49,57 @@ func attestationDeltas(state *pb.BeaconState, bp *Balance, vp []*Validator) ([]u
 }
 
 func attestationDelta(state *pb.BeaconState, bp *Balance, v *Validator) (uint64, uint64) {
+	d := attestationDeltaBreakdown(state, bp, v)
+	r := d.SourceReward + d.InclusionDelayReward + d.TargetReward + d.HeadReward
+	p := d.SourcePenalty + d.TargetPenalty + d.HeadPenalty + d.InactivityPenalty
+	return r, p
+}
+
+// This computes the components of the attestation rewards and penalties of an individual validator.
+func attestationDeltaBreakdown(state *pb.BeaconState, bp *Balance, v *Validator) Rewards {
+	d := Rewards{}
 	eligible := v.IsActivePrevEpoch || (v.IsSlashed && !v.IsWithdrawableCurrentEpoch)
 	if !eligible {
-		return 0, 0
+		return d
 	}
 
 	e := helpers.PrevEpoch(state)
 	vb := v.CurrentEpochEffectiveBalance
 	br := vb * params.BeaconConfig().BaseRewardFactor / mathutil.IntegerSquareRoot(bp.CurrentEpoch) / params.BeaconConfig().BaseRewardsPerEpoch
-	r, p := uint64(0), uint64(0)
 
 	// Process source reward / penalty
 	if v.IsPrevEpochAttester && !v.IsSlashed {
-		r += br * bp.PrevEpochAttesters / bp.CurrentEpoch
+		d.SourceReward = br * bp.PrevEpochAttesters / bp.CurrentEpoch
 		proposerReward := br / params.BeaconConfig().ProposerRewardQuotient
 		maxAtteserReward := br - proposerReward
-		r += maxAtteserReward / v.InclusionDistance
+		d.InclusionDelayReward = maxAtteserReward / v.InclusionDistance
 	} else {
-		p += br
+		d.SourcePenalty = br
 	}
 
 	// Process target reward / penalty
 	if v.IsPrevEpochTargetAttester && !v.IsSlashed {
-		r += br * bp.PrevEpochTargetAttesters / bp.CurrentEpoch
+		d.TargetReward = br * bp.PrevEpochTargetAttesters / bp.CurrentEpoch
 	} else {
-		p += br
+		d.TargetPenalty = br
 	}
 
 	// Process head reward / penalty
 	if v.IsPrevEpochHeadAttester && !v.IsSlashed {
-		r += br * bp.PrevEpochHeadAttesters / bp.CurrentEpoch
+		d.HeadReward = br * bp.PrevEpochHeadAttesters / bp.CurrentEpoch
 	} else {
-		p += br
+		d.HeadPenalty = br
 	}
 
 	// Process finality delay penalty
 	finalityDelay := e - state.FinalizedCheckpoint.Epoch
 	if finalityDelay > params.BeaconConfig().MinEpochsToInactivityPenalty {
-		p += params.BeaconConfig().BaseRewardsPerEpoch * br
+		d.InactivityPenalty = params.BeaconConfig().BaseRewardsPerEpoch * br
 		if !v.IsPrevEpochTargetAttester {
-			p += vb * finalityDelay / params.BeaconConfig().InactivityPenaltyQuotient
+			d.InactivityPenalty += vb * finalityDelay / params.BeaconConfig().InactivityPenaltyQuotient
 		}
 	}
-	return r, p
+	return d
 }
 
 // This computes the rewards and penalties differences for individual validators based on the
@@ -103,11 +111,15 @@ func proposerDeltaPrecompute(state *pb.BeaconState, bp *Balance, vp []*Validator
 
 	for _, v := range vp {
 		if v.IsPrevEpochAttester {
-			vBalance := v.CurrentEpochEffectiveBalance
-			baseReward := vBalance * params.BeaconConfig().BaseRewardFactor / mathutil.IntegerSquareRoot(totalBalance) / params.BeaconConfig().BaseRewardsPerEpoch
-			proposerReward := baseReward / params.BeaconConfig().ProposerRewardQuotient
-			rewards[v.ProposerIndex] += proposerReward
+			rewards[v.ProposerIndex] += proposerReward(totalBalance, v)
 		}
 	}
 	return rewards, nil
 }
+
+// This computes the reward of the proposer which included the attestation of the validator.
+func proposerReward(totalBalance uint64, v *Validator) uint64 {
+	vBalance := v.CurrentEpochEffectiveBalance
+	baseReward := vBalance * params.BeaconConfig().BaseRewardFactor / mathutil.IntegerSquareRoot(totalBalance) / params.BeaconConfig().BaseRewardsPerEpoch
+	return baseReward / params.BeaconConfig().ProposerRewardQuotient
+}
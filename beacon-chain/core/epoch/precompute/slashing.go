@@ -1,6 +1,7 @@
 package precompute
 
 import (
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
 	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
 	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
 	"github.com/prysmaticlabs/prysm/shared/mathutil"
@@ -11,7 +12,6 @@ import (
 // This is an optimized version by passing in precomputed total epoch balances.
 func ProcessSlashingsPrecompute(state *pb.BeaconState, p *Balance) *pb.BeaconState {
 	currentEpoch := helpers.CurrentEpoch(state)
-	exitLength := params.BeaconConfig().EpochsPerSlashingsVector
 
 	// Compute the sum of state slashings
 	totalSlashing := uint64(0)
@@ -21,14 +21,22 @@ func ProcessSlashingsPrecompute(state *pb.BeaconState, p *Balance) *pb.BeaconSta
 
 	// Compute slashing for each validator.
 	for index, validator := range state.Validators {
-		correctEpoch := (currentEpoch + exitLength/2) == validator.WithdrawableEpoch
-		if validator.Slashed && correctEpoch {
-			minSlashing := mathutil.Min(totalSlashing*3, p.CurrentEpoch)
-			increment := params.BeaconConfig().EffectiveBalanceIncrement
-			penaltyNumerator := validator.EffectiveBalance / increment * minSlashing
-			penalty := penaltyNumerator / p.CurrentEpoch * increment
+		if penalty := slashingPenalty(validator, currentEpoch, totalSlashing, p); penalty > 0 {
 			state = helpers.DecreaseBalance(state, uint64(index), penalty)
 		}
 	}
 	return state
 }
+
+// This computes the penalty of a slashed validator, which is applied halfway through its withdrawal delay.
+func slashingPenalty(validator *ethpb.Validator, currentEpoch uint64, totalSlashing uint64, p *Balance) uint64 {
+	exitLength := params.BeaconConfig().EpochsPerSlashingsVector
+	correctEpoch := (currentEpoch + exitLength/2) == validator.WithdrawableEpoch
+	if !validator.Slashed || !correctEpoch {
+		return 0
+	}
+	minSlashing := mathutil.Min(totalSlashing*3, p.CurrentEpoch)
+	increment := params.BeaconConfig().EffectiveBalanceIncrement
+	penaltyNumerator := validator.EffectiveBalance / increment * minSlashing
+	return penaltyNumerator / p.CurrentEpoch * increment
+}
@@ -9,10 +9,14 @@ const (
 	ChainStarted
 	// Initialized is sent when the internal beacon node's state is ready to be accessed.
 	Initialized
+	// Reorg is sent when the new head of the chain is not a descendant of the previous head.
+	Reorg
 )
 
 // BlockProcessedData is the data sent with BlockProcessed events.
 type BlockProcessedData struct {
+	// Slot is the slot of the processed block.
+	Slot uint64
 	// BlockHash is the hash of the processed block.
 	BlockRoot [32]byte
 	// Verified is true if the block's BLS contents have been verified.
@@ -30,3 +34,21 @@ type InitializedData struct {
 	// StartTime is the time at which the chain started.
 	StartTime time.Time
 }
+
+// ReorgData is the data sent with Reorg events.
+type ReorgData struct {
+	// OldHeadRoot is the root of the head block before the reorg.
+	OldHeadRoot [32]byte
+	// OldHeadSlot is the slot of the head block before the reorg.
+	OldHeadSlot uint64
+	// NewHeadRoot is the root of the head block after the reorg.
+	NewHeadRoot [32]byte
+	// NewHeadSlot is the slot of the head block after the reorg.
+	NewHeadSlot uint64
+	// CommonAncestorRoot is the root of the latest block shared by the old and the new chain.
+	CommonAncestorRoot [32]byte
+	// CommonAncestorSlot is the slot of the latest block shared by the old and the new chain.
+	CommonAncestorSlot uint64
+	// Depth is the number of slots from the common ancestor to the old head.
+	Depth uint64
+}
@@ -18,6 +18,7 @@ import (
 	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
 	"github.com/prysmaticlabs/prysm/beacon-chain/core/state/interop"
 	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+	"github.com/prysmaticlabs/prysm/shared/bls"
 	"github.com/prysmaticlabs/prysm/shared/featureconfig"
 	"github.com/prysmaticlabs/prysm/shared/mathutil"
 	"github.com/prysmaticlabs/prysm/shared/params"
@@ -323,6 +324,9 @@ func ProcessSlots(ctx context.Context, state *pb.BeaconState, slot uint64) (*pb.
 //    process_randao(state, block.body)
 //    process_eth1_data(state, block.body)
 //    process_operations(state, block.body)
+//
+// The signatures of the block are collected while it is processed, and verified at the end
+// in a single batch.
 func ProcessBlock(
 	ctx context.Context,
 	state *pb.BeaconState,
@@ -331,13 +335,25 @@ func ProcessBlock(
 	ctx, span := trace.StartSpan(ctx, "beacon-chain.ChainService.state.ProcessBlock")
 	defer span.End()
 
-	state, err := b.ProcessBlockHeader(state, signed)
+	// The proposer signature is verified against the state the block header is processed on.
+	set, err := b.BlockSignatureSet(state, signed)
+	if err != nil {
+		traceutil.AnnotateError(span, err)
+		return nil, errors.Wrap(err, "could not process block header")
+	}
+	state, err = b.ProcessBlockHeaderNoVerify(state, signed.Block)
 	if err != nil {
 		traceutil.AnnotateError(span, err)
 		return nil, errors.Wrap(err, "could not process block header")
 	}
 
-	state, err = b.ProcessRandao(state, signed.Block.Body)
+	randaoSet, err := b.RandaoSignatureSet(state, signed.Block.Body)
+	if err != nil {
+		traceutil.AnnotateError(span, err)
+		return nil, errors.Wrap(err, "could not verify and process randao")
+	}
+	set.Join(randaoSet)
+	state, err = b.ProcessRandaoNoVerify(state, signed.Block.Body)
 	if err != nil {
 		traceutil.AnnotateError(span, err)
 		return nil, errors.Wrap(err, "could not verify and process randao")
@@ -349,11 +365,18 @@ func ProcessBlock(
 		return nil, errors.Wrap(err, "could not process eth1 data")
 	}
 
-	state, err = ProcessOperations(ctx, state, signed.Block.Body)
+	state, operationsSet, err := processOperationsNoVerifySig(ctx, state, signed.Block.Body)
 	if err != nil {
 		traceutil.AnnotateError(span, err)
 		return nil, errors.Wrap(err, "could not process block operation")
 	}
+	set.Join(operationsSet)
+
+	span.AddAttributes(trace.Int64Attribute("signatures", int64(len(set.Signatures))))
+	if err := set.Verify(); err != nil {
+		traceutil.AnnotateError(span, err)
+		return nil, errors.Wrap(err, "could not verify block signatures")
+	}
 
 	return state, nil
 }
@@ -525,6 +548,50 @@ func processOperationsNoVerify(
 	return state, nil
 }
 
+// processOperationsNoVerifySig processes the operations in the beacon block like
+// ProcessOperations, but collects the signatures of the operations in a signature set instead
+// of verifying them. Deposit signatures are still verified one by one, as a deposit with an
+// invalid signature is skipped rather than invalidating the block.
+func processOperationsNoVerifySig(
+	ctx context.Context,
+	state *pb.BeaconState,
+	body *ethpb.BeaconBlockBody) (*pb.BeaconState, *bls.SignatureSet, error) {
+	ctx, span := trace.StartSpan(ctx, "beacon-chain.ChainService.state.ProcessOperations")
+	defer span.End()
+
+	if err := verifyOperationLengths(state, body); err != nil {
+		return nil, nil, errors.Wrap(err, "could not verify operation lengths")
+	}
+
+	set := bls.NewSet()
+	state, opSet, err := b.ProcessProposerSlashingsNoVerifySig(ctx, state, body)
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "could not process block proposer slashings")
+	}
+	set.Join(opSet)
+	state, opSet, err = b.ProcessAttesterSlashingsNoVerifySig(ctx, state, body)
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "could not process block attester slashings")
+	}
+	set.Join(opSet)
+	state, opSet, err = b.ProcessAttestationsNoVerifySig(ctx, state, body)
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "could not process block attestations")
+	}
+	set.Join(opSet)
+	state, err = b.ProcessDeposits(ctx, state, body)
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "could not process block validator deposits")
+	}
+	state, opSet, err = b.ProcessVoluntaryExitsNoVerifySig(ctx, state, body)
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "could not process validator exits")
+	}
+	set.Join(opSet)
+
+	return state, set, nil
+}
+
 func verifyOperationLengths(state *pb.BeaconState, body *ethpb.BeaconBlockBody) error {
 	if uint64(len(body.ProposerSlashings)) > params.BeaconConfig().MaxProposerSlashings {
 		return fmt.Errorf(
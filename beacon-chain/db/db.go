@@ -1,8 +1,19 @@
 package db
 
-import "github.com/prysmaticlabs/prysm/beacon-chain/db/kv"
+import (
+	"context"
 
-// NewDB initializes a new DB.
-func NewDB(dirPath string) (Database, error) {
-	return kv.NewKVStore(dirPath)
+	"github.com/prysmaticlabs/prysm/beacon-chain/db/export"
+	"github.com/prysmaticlabs/prysm/beacon-chain/db/kv"
+)
+
+// NewDB initializes a new DB, which exports the objects it saves to the given sinks and to the
+// export sinks of the feature config.
+func NewDB(ctx context.Context, dirPath string, sinks ...export.Sink) (Database, error) {
+	db, err := kv.NewKVStore(ctx, dirPath)
+	if err != nil {
+		return nil, err
+	}
+
+	return export.Wrap(db, sinks...)
 }
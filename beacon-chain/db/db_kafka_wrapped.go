@@ -1,16 +1,28 @@
 package db
 
 import (
+	"context"
+
+	"github.com/prysmaticlabs/prysm/beacon-chain/db/export"
 	"github.com/prysmaticlabs/prysm/beacon-chain/db/kafka"
 	"github.com/prysmaticlabs/prysm/beacon-chain/db/kv"
+	"github.com/prysmaticlabs/prysm/shared/featureconfig"
 )
 
-// NewDB initializes a new DB with kafka wrapper.
-func NewDB(dirPath string) (Database, error) {
-	db, err := kv.NewKVStore(dirPath)
+// NewDB initializes a new DB, which exports the objects it saves to the given sinks, to the
+// export sinks of the feature config and to kafka if kafka servers are configured.
+func NewDB(ctx context.Context, dirPath string, sinks ...export.Sink) (Database, error) {
+	db, err := kv.NewKVStore(ctx, dirPath)
 	if err != nil {
 		return nil, err
 	}
 
-	return kafka.Wrap(db)
+	if servers := featureconfig.Get().KafkaBootstrapServers; servers != "" {
+		kafkaSink, err := kafka.NewSink(servers)
+		if err != nil {
+			return nil, err
+		}
+		sinks = append(sinks, kafkaSink)
+	}
+	return export.Wrap(db, sinks...)
 }
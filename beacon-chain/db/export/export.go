@@ -0,0 +1,69 @@
+// Package export defines a wrapper of the beacon chain database which exports the objects it
+// saves, such as blocks and attestations, to sinks like kafka, rotated files or gRPC streams.
+package export
+
+import (
+	"context"
+	"sort"
+	"strings"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/pkg/errors"
+)
+
+// Topics of the exported objects.
+const (
+	BlockTopic               = "beacon_block"
+	AttestationTopic         = "beacon_attestation"
+	FinalizedCheckpointTopic = "beacon_finalized_checkpoint"
+	ProposerSlashingTopic    = "beacon_proposer_slashing"
+	AttesterSlashingTopic    = "beacon_attester_slashing"
+	VoluntaryExitTopic       = "beacon_voluntary_exit"
+	ArchivedBalancesTopic    = "beacon_archived_balances"
+)
+
+var topics = map[string]bool{
+	BlockTopic:               true,
+	AttestationTopic:         true,
+	FinalizedCheckpointTopic: true,
+	ProposerSlashingTopic:    true,
+	AttesterSlashingTopic:    true,
+	VoluntaryExitTopic:       true,
+	ArchivedBalancesTopic:    true,
+}
+
+// Sink receives the objects exported from the database, one at a time and in the order in which
+// they were saved.
+type Sink interface {
+	Export(ctx context.Context, topic string, msg proto.Message) error
+	Close() error
+}
+
+// Filter of the topics to export. An empty filter allows every topic.
+type Filter map[string]bool
+
+// NewFilter allowing the given topics, or every topic if none is given.
+func NewFilter(allowed []string) (Filter, error) {
+	f := make(Filter)
+	for _, topic := range allowed {
+		topic = strings.TrimSpace(topic)
+		if topic == "" {
+			continue
+		}
+		if !topics[topic] {
+			valid := make([]string, 0, len(topics))
+			for t := range topics {
+				valid = append(valid, t)
+			}
+			sort.Strings(valid)
+			return nil, errors.Errorf("unknown export topic %q, valid topics are %s", topic, strings.Join(valid, ", "))
+		}
+		f[topic] = true
+	}
+	return f, nil
+}
+
+// Allows returns true if the objects of the topic pass the filter.
+func (f Filter) Allows(topic string) bool {
+	return len(f) == 0 || f[topic]
+}
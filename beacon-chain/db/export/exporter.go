@@ -0,0 +1,258 @@
+package export
+
+import (
+	"context"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	eth "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/beacon-chain/db/iface"
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+	"github.com/prysmaticlabs/prysm/shared/featureconfig"
+	"github.com/sirupsen/logrus"
+)
+
+var _ = iface.Database(&Exporter{})
+var log = logrus.WithField("prefix", "exporter")
+
+// queueSize is the number of exported objects which may wait for the sinks. Once the queue is
+// full, saving to the database blocks until the sinks catch up, so no object is lost.
+const queueSize = 1024
+
+var (
+	exportedObjects = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "db_exported_objects_total",
+		Help: "The number of objects exported from the database, by topic.",
+	}, []string{"topic"})
+	droppedObjects = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "db_export_dropped_objects_total",
+		Help: "The number of objects dropped because the save was canceled while the export queue was full, by topic.",
+	}, []string{"topic"})
+)
+
+// object saved to the database, waiting to be exported.
+type object struct {
+	topic string
+	msg   proto.Message
+}
+
+// Exporter wraps a database interface and exports the objects of the allowed topics to sinks once
+// they are saved.
+type Exporter struct {
+	db     iface.Database
+	sinks  []Sink
+	filter Filter
+	queue  chan *object
+	cancel context.CancelFunc
+	done   chan struct{}
+}
+
+// Wrap the db with an exporter to the given sinks, and to a file sink if an export directory is
+// set in the feature config. Only the topics of the feature config are exported, or all topics if
+// none is set. If there is no sink, the database is not wrapped but returned itself.
+func Wrap(db iface.Database, sinks ...Sink) (iface.Database, error) {
+	cfg := featureconfig.Get()
+	filter, err := NewFilter(cfg.ExportTopics)
+	if err != nil {
+		closeSinks(sinks)
+		return nil, err
+	}
+	if cfg.ExportFileDir != "" {
+		fileSink, err := NewFileSink(cfg.ExportFileDir, cfg.ExportFileFormat, int64(cfg.ExportFileMaxSize))
+		if err != nil {
+			closeSinks(sinks)
+			return nil, err
+		}
+		sinks = append(sinks, fileSink)
+	}
+	if len(sinks) == 0 {
+		return db, nil
+	}
+	return NewExporter(db, filter, sinks...), nil
+}
+
+// NewExporter of the objects of the topics allowed by the filter to the sinks, which starts
+// exporting in the background until it is closed.
+func NewExporter(db iface.Database, filter Filter, sinks ...Sink) *Exporter {
+	ctx, cancel := context.WithCancel(context.Background())
+	e := &Exporter{
+		db:     db,
+		sinks:  sinks,
+		filter: filter,
+		queue:  make(chan *object, queueSize),
+		cancel: cancel,
+		done:   make(chan struct{}),
+	}
+	go e.run(ctx)
+	return e
+}
+
+// run exports the queued objects until the context is canceled, and then the objects which were
+// still queued.
+func (e Exporter) run(ctx context.Context) {
+	defer close(e.done)
+	for {
+		select {
+		case obj := <-e.queue:
+			e.exportToSinks(obj)
+		case <-ctx.Done():
+			for {
+				select {
+				case obj := <-e.queue:
+					e.exportToSinks(obj)
+				default:
+					return
+				}
+			}
+		}
+	}
+}
+
+func (e Exporter) exportToSinks(obj *object) {
+	for _, s := range e.sinks {
+		if err := s.Export(context.Background(), obj.topic, obj.msg); err != nil {
+			log.WithError(err).WithField("topic", obj.topic).Error("Failed to export object")
+		}
+	}
+	exportedObjects.WithLabelValues(obj.topic).Inc()
+}
+
+// export queues a copy of the object for the sinks if its topic passes the filter. It blocks
+// while the queue is full, and only drops the object once the context of the caller is done or
+// the exporter is closed. The object is copied as the caller may keep mutating it once it is saved.
+func (e Exporter) export(ctx context.Context, topic string, msg proto.Message) {
+	if !e.filter.Allows(topic) {
+		return
+	}
+	select {
+	case e.queue <- &object{topic: topic, msg: proto.Clone(msg)}:
+	case <-ctx.Done():
+		droppedObjects.WithLabelValues(topic).Inc()
+		log.WithError(ctx.Err()).WithField("topic", topic).Warn("Dropped object waiting for the export queue")
+	case <-e.done:
+		droppedObjects.WithLabelValues(topic).Inc()
+		log.WithField("topic", topic).Warn("Dropped object saved after the exporter was closed")
+	}
+}
+
+// exportBlock exports the block, and the slashings and exits included in it.
+func (e Exporter) exportBlock(ctx context.Context, block *eth.SignedBeaconBlock) {
+	e.export(ctx, BlockTopic, block)
+	if block.Block == nil || block.Block.Body == nil {
+		return
+	}
+	for _, slashing := range block.Block.Body.ProposerSlashings {
+		e.export(ctx, ProposerSlashingTopic, slashing)
+	}
+	for _, slashing := range block.Block.Body.AttesterSlashings {
+		e.export(ctx, AttesterSlashingTopic, slashing)
+	}
+	for _, exit := range block.Block.Body.VoluntaryExits {
+		if exit.Exit != nil {
+			e.export(ctx, VoluntaryExitTopic, exit.Exit)
+		}
+	}
+}
+
+// Close stops the exporter once the queued objects are exported, and closes the sinks and the
+// underlying db.
+func (e Exporter) Close() error {
+	e.cancel()
+	<-e.done
+	closeSinks(e.sinks)
+	return e.db.Close()
+}
+
+func closeSinks(sinks []Sink) {
+	for _, s := range sinks {
+		if err := s.Close(); err != nil {
+			log.WithError(err).Error("Failed to close export sink")
+		}
+	}
+}
+
+// SaveAttestation exports the attestation once it is saved.
+func (e Exporter) SaveAttestation(ctx context.Context, att *eth.Attestation) error {
+	if err := e.db.SaveAttestation(ctx, att); err != nil {
+		return err
+	}
+	e.export(ctx, AttestationTopic, att)
+	return nil
+}
+
+// SaveAttestations exports the attestations once they are saved.
+func (e Exporter) SaveAttestations(ctx context.Context, atts []*eth.Attestation) error {
+	if err := e.db.SaveAttestations(ctx, atts); err != nil {
+		return err
+	}
+	for _, att := range atts {
+		e.export(ctx, AttestationTopic, att)
+	}
+	return nil
+}
+
+// SaveBlock exports the block, and the operations included in it, once it is saved.
+func (e Exporter) SaveBlock(ctx context.Context, block *eth.SignedBeaconBlock) error {
+	if err := e.db.SaveBlock(ctx, block); err != nil {
+		return err
+	}
+	e.exportBlock(ctx, block)
+	return nil
+}
+
+// SaveBlocks exports the blocks, and the operations included in them, once they are saved.
+func (e Exporter) SaveBlocks(ctx context.Context, blocks []*eth.SignedBeaconBlock) error {
+	if err := e.db.SaveBlocks(ctx, blocks); err != nil {
+		return err
+	}
+	for _, block := range blocks {
+		e.exportBlock(ctx, block)
+	}
+	return nil
+}
+
+// SaveFinalizedCheckpoint exports the checkpoint once it is saved.
+func (e Exporter) SaveFinalizedCheckpoint(ctx context.Context, checkpoint *eth.Checkpoint) error {
+	if err := e.db.SaveFinalizedCheckpoint(ctx, checkpoint); err != nil {
+		return err
+	}
+	e.export(ctx, FinalizedCheckpointTopic, checkpoint)
+	return nil
+}
+
+// SaveProposerSlashing exports the slashing once it is saved.
+func (e Exporter) SaveProposerSlashing(ctx context.Context, slashing *eth.ProposerSlashing) error {
+	if err := e.db.SaveProposerSlashing(ctx, slashing); err != nil {
+		return err
+	}
+	e.export(ctx, ProposerSlashingTopic, slashing)
+	return nil
+}
+
+// SaveAttesterSlashing exports the slashing once it is saved.
+func (e Exporter) SaveAttesterSlashing(ctx context.Context, slashing *eth.AttesterSlashing) error {
+	if err := e.db.SaveAttesterSlashing(ctx, slashing); err != nil {
+		return err
+	}
+	e.export(ctx, AttesterSlashingTopic, slashing)
+	return nil
+}
+
+// SaveVoluntaryExit exports the exit once it is saved.
+func (e Exporter) SaveVoluntaryExit(ctx context.Context, exit *eth.VoluntaryExit) error {
+	if err := e.db.SaveVoluntaryExit(ctx, exit); err != nil {
+		return err
+	}
+	e.export(ctx, VoluntaryExitTopic, exit)
+	return nil
+}
+
+// SaveArchivedBalances exports the balances of the epoch once they are saved.
+func (e Exporter) SaveArchivedBalances(ctx context.Context, epoch uint64, balances []uint64) error {
+	if err := e.db.SaveArchivedBalances(ctx, epoch, balances); err != nil {
+		return err
+	}
+	e.export(ctx, ArchivedBalancesTopic, &pb.ArchivedBalances{Epoch: epoch, Balances: balances})
+	return nil
+}
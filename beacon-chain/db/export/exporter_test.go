@@ -0,0 +1,237 @@
+package export
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path"
+	"testing"
+
+	"github.com/golang/protobuf/proto"
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/go-bitfield"
+	"github.com/prysmaticlabs/prysm/beacon-chain/db/kv"
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+	"github.com/prysmaticlabs/prysm/shared/testutil"
+)
+
+// recordingSink records the exported objects.
+type recordingSink struct {
+	objects []*Object
+	closed  bool
+}
+
+func (s *recordingSink) Export(_ context.Context, topic string, msg proto.Message) error {
+	s.objects = append(s.objects, &Object{Topic: topic, Message: msg})
+	return nil
+}
+
+func (s *recordingSink) Close() error {
+	s.closed = true
+	return nil
+}
+
+func setupKVStore(t *testing.T, name string) *kv.Store {
+	p := path.Join(testutil.TempDir(), fmt.Sprintf("export_%s", name))
+	if err := os.RemoveAll(p); err != nil {
+		t.Fatal(err)
+	}
+	s, err := kv.NewKVStore(context.Background(), p)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return s
+}
+
+func TestExporter_ExportsSavedObjects(t *testing.T) {
+	ctx := context.Background()
+	store := setupKVStore(t, "saved")
+	defer os.RemoveAll(store.DatabasePath())
+	sink := &recordingSink{}
+	e := NewExporter(store, nil, sink)
+
+	block := &ethpb.SignedBeaconBlock{Block: &ethpb.BeaconBlock{Slot: 3}}
+	if err := e.SaveBlock(ctx, block); err != nil {
+		t.Fatal(err)
+	}
+	att := &ethpb.Attestation{
+		Data:            &ethpb.AttestationData{Slot: 2},
+		AggregationBits: bitfield.Bitlist{0b00000001, 0b1},
+	}
+	if err := e.SaveAttestations(ctx, []*ethpb.Attestation{att}); err != nil {
+		t.Fatal(err)
+	}
+	exit := &ethpb.VoluntaryExit{Epoch: 4, ValidatorIndex: 7}
+	if err := e.SaveVoluntaryExit(ctx, exit); err != nil {
+		t.Fatal(err)
+	}
+	if err := e.SaveArchivedBalances(ctx, 6, []uint64{32, 31}); err != nil {
+		t.Fatal(err)
+	}
+	// The state of the checkpoint is missing, so the checkpoint is not saved nor exported.
+	if err := e.SaveFinalizedCheckpoint(ctx, &ethpb.Checkpoint{Epoch: 1, Root: []byte{'a'}}); err == nil {
+		t.Fatal("Expected an error saving a checkpoint without state")
+	}
+	if err := e.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	wantTopics := []string{BlockTopic, AttestationTopic, VoluntaryExitTopic, ArchivedBalancesTopic}
+	if len(sink.objects) != len(wantTopics) {
+		t.Fatalf("Wanted %d exported objects, received %d", len(wantTopics), len(sink.objects))
+	}
+	for i, topic := range wantTopics {
+		if sink.objects[i].Topic != topic {
+			t.Errorf("Wanted topic %s for object %d, received %s", topic, i, sink.objects[i].Topic)
+		}
+	}
+	if !proto.Equal(sink.objects[0].Message, block) {
+		t.Errorf("Wanted block %v, received %v", block, sink.objects[0].Message)
+	}
+	balances := &pb.ArchivedBalances{Epoch: 6, Balances: []uint64{32, 31}}
+	if !proto.Equal(sink.objects[3].Message, balances) {
+		t.Errorf("Wanted balances %v, received %v", balances, sink.objects[3].Message)
+	}
+	if !sink.closed {
+		t.Error("Expected the sink to be closed with the exporter")
+	}
+}
+
+func TestExporter_ExportsCopyOfSavedObjects(t *testing.T) {
+	ctx := context.Background()
+	store := setupKVStore(t, "copied")
+	defer os.RemoveAll(store.DatabasePath())
+	sink := &recordingSink{}
+	e := NewExporter(store, nil, sink)
+
+	// The balances of the state keep changing once they are archived.
+	balances := []uint64{32, 31}
+	if err := e.SaveArchivedBalances(ctx, 6, balances); err != nil {
+		t.Fatal(err)
+	}
+	balances[0] = 0
+	if err := e.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	want := &pb.ArchivedBalances{Epoch: 6, Balances: []uint64{32, 31}}
+	if len(sink.objects) != 1 || !proto.Equal(sink.objects[0].Message, want) {
+		t.Errorf("Wanted balances %v, received %v", want, sink.objects)
+	}
+}
+
+func TestExporter_ExportsOperationsOfSavedBlocks(t *testing.T) {
+	ctx := context.Background()
+	store := setupKVStore(t, "operations")
+	defer os.RemoveAll(store.DatabasePath())
+	sink := &recordingSink{}
+	e := NewExporter(store, nil, sink)
+
+	proposerSlashing := &ethpb.ProposerSlashing{ProposerIndex: 2}
+	attesterSlashing := &ethpb.AttesterSlashing{
+		Attestation_1: &ethpb.IndexedAttestation{AttestingIndices: []uint64{3}},
+		Attestation_2: &ethpb.IndexedAttestation{AttestingIndices: []uint64{3}},
+	}
+	exit := &ethpb.VoluntaryExit{Epoch: 4, ValidatorIndex: 7}
+	block := &ethpb.SignedBeaconBlock{Block: &ethpb.BeaconBlock{
+		Slot: 3,
+		Body: &ethpb.BeaconBlockBody{
+			ProposerSlashings: []*ethpb.ProposerSlashing{proposerSlashing},
+			AttesterSlashings: []*ethpb.AttesterSlashing{attesterSlashing},
+			VoluntaryExits:    []*ethpb.SignedVoluntaryExit{{Exit: exit}},
+		},
+	}}
+	if err := e.SaveBlocks(ctx, []*ethpb.SignedBeaconBlock{block}); err != nil {
+		t.Fatal(err)
+	}
+	if err := e.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	want := []*Object{
+		{Topic: BlockTopic, Message: block},
+		{Topic: ProposerSlashingTopic, Message: proposerSlashing},
+		{Topic: AttesterSlashingTopic, Message: attesterSlashing},
+		{Topic: VoluntaryExitTopic, Message: exit},
+	}
+	if len(sink.objects) != len(want) {
+		t.Fatalf("Wanted %d exported objects, received %d", len(want), len(sink.objects))
+	}
+	for i, obj := range want {
+		if sink.objects[i].Topic != obj.Topic || !proto.Equal(sink.objects[i].Message, obj.Message) {
+			t.Errorf("Wanted object %d to be %v, received %v", i, obj, sink.objects[i])
+		}
+	}
+}
+
+func TestExporter_ExportDropsObjectOnceCanceled(t *testing.T) {
+	// Nothing reads the queue, as if the sinks could not keep up.
+	e := Exporter{queue: make(chan *object), done: make(chan struct{})}
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	// The export returns, dropping the object, instead of blocking the canceled save.
+	e.export(ctx, BlockTopic, &ethpb.SignedBeaconBlock{Block: &ethpb.BeaconBlock{Slot: 3}})
+}
+
+func TestExporter_FiltersTopics(t *testing.T) {
+	ctx := context.Background()
+	store := setupKVStore(t, "filtered")
+	defer os.RemoveAll(store.DatabasePath())
+	filter, err := NewFilter([]string{VoluntaryExitTopic})
+	if err != nil {
+		t.Fatal(err)
+	}
+	sink := &recordingSink{}
+	e := NewExporter(store, filter, sink)
+
+	if err := e.SaveBlock(ctx, &ethpb.SignedBeaconBlock{Block: &ethpb.BeaconBlock{Slot: 3}}); err != nil {
+		t.Fatal(err)
+	}
+	if err := e.SaveVoluntaryExit(ctx, &ethpb.VoluntaryExit{Epoch: 4, ValidatorIndex: 7}); err != nil {
+		t.Fatal(err)
+	}
+	if err := e.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	if len(sink.objects) != 1 || sink.objects[0].Topic != VoluntaryExitTopic {
+		t.Errorf("Wanted only the exit to be exported, received %v", sink.objects)
+	}
+}
+
+func TestNewFilter(t *testing.T) {
+	f, err := NewFilter(nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !f.Allows(BlockTopic) || !f.Allows(ArchivedBalancesTopic) {
+		t.Error("Expected an empty filter to allow every topic")
+	}
+
+	f, err = NewFilter([]string{BlockTopic, " beacon_attestation "})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !f.Allows(BlockTopic) || !f.Allows(AttestationTopic) || f.Allows(VoluntaryExitTopic) {
+		t.Errorf("Unexpected filter %v", f)
+	}
+
+	if _, err := NewFilter([]string{"beacon_state"}); err == nil {
+		t.Error("Expected an error for an unknown topic")
+	}
+}
+
+func TestWrap_NoSinks(t *testing.T) {
+	store := setupKVStore(t, "unwrapped")
+	defer os.RemoveAll(store.DatabasePath())
+	defer store.Close()
+
+	db, err := Wrap(store)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if db != store {
+		t.Error("Expected the database not to be wrapped without sinks")
+	}
+}
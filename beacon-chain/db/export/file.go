@@ -0,0 +1,223 @@
+package export
+
+import (
+	"bytes"
+	"context"
+	"encoding/binary"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/golang/protobuf/jsonpb"
+	"github.com/golang/protobuf/proto"
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/go-ssz"
+	"go.opencensus.io/trace"
+)
+
+// Formats of the files written by the file sink.
+const (
+	JSONFormat = "json"
+	SSZFormat  = "ssz"
+)
+
+const (
+	exportFilePrefix = "export_"
+	// DefaultMaxFileSize of the files written by the file sink, in bytes.
+	DefaultMaxFileSize = 256 << 20
+)
+
+var fileExtensions = map[string]string{
+	JSONFormat: ".jsonl",
+	SSZFormat:  ".ssz",
+}
+
+var marshaler = &jsonpb.Marshaler{}
+
+// jsonRecord is a line of the files in the json format.
+type jsonRecord struct {
+	Topic  string          `json:"topic"`
+	Key    string          `json:"key"`
+	Object json.RawMessage `json:"object"`
+}
+
+// FileSink appends the exported objects to numbered files in a directory, and starts a new file
+// once the current one would grow past the maximum size. Every run of the node starts a new file.
+//
+// In the json format, every object is a line with its topic, the hex encoded hash tree root of the
+// object as key and the JSON encoding of the object. In the ssz format, every object is a record
+// of its topic and its SSZ encoding, each prefixed by its length as a little endian uint32.
+type FileSink struct {
+	dir     string
+	format  string
+	maxSize int64
+	lock    sync.Mutex
+	file    *os.File
+	size    int64
+	seq     uint64
+	closed  bool
+}
+
+// NewFileSink writing files of the format to the directory. The json format is used if no format
+// is given, and the default size if the maximum size is not positive.
+func NewFileSink(dir string, format string, maxSize int64) (*FileSink, error) {
+	if format == "" {
+		format = JSONFormat
+	}
+	if _, ok := fileExtensions[format]; !ok {
+		return nil, errors.Errorf("unknown export file format %q, valid formats are %s and %s", format, JSONFormat, SSZFormat)
+	}
+	if maxSize <= 0 {
+		maxSize = DefaultMaxFileSize
+	}
+	if err := os.MkdirAll(dir, os.ModePerm); err != nil {
+		return nil, err
+	}
+	seq, err := lastFileSeq(dir)
+	if err != nil {
+		return nil, err
+	}
+	return &FileSink{
+		dir:     dir,
+		format:  format,
+		maxSize: maxSize,
+		seq:     seq,
+	}, nil
+}
+
+// lastFileSeq is the highest sequence number of the export files in the directory, or 0 if there
+// are none.
+func lastFileSeq(dir string) (uint64, error) {
+	files, err := ioutil.ReadDir(dir)
+	if err != nil {
+		return 0, err
+	}
+	var last uint64
+	for _, f := range files {
+		name := f.Name()
+		if f.IsDir() || !strings.HasPrefix(name, exportFilePrefix) {
+			continue
+		}
+		name = strings.TrimPrefix(name, exportFilePrefix)
+		if i := strings.Index(name, "."); i >= 0 {
+			name = name[:i]
+		}
+		seq, err := strconv.ParseUint(name, 10, 64)
+		if err != nil {
+			continue
+		}
+		if seq > last {
+			last = seq
+		}
+	}
+	return last, nil
+}
+
+// Export appends the object to the current file.
+func (f *FileSink) Export(ctx context.Context, topic string, msg proto.Message) error {
+	_, span := trace.StartSpan(ctx, "export.FileSink.Export")
+	defer span.End()
+
+	record, err := f.encode(topic, msg)
+	if err != nil {
+		return err
+	}
+
+	f.lock.Lock()
+	defer f.lock.Unlock()
+	if f.closed {
+		return errors.New("file sink is closed")
+	}
+	if f.file != nil && f.size > 0 && f.size+int64(len(record)) > f.maxSize {
+		if err := f.file.Close(); err != nil {
+			return err
+		}
+		f.file = nil
+	}
+	if f.file == nil {
+		if err := f.openNext(); err != nil {
+			return err
+		}
+	}
+	n, err := f.file.Write(record)
+	f.size += int64(n)
+	return err
+}
+
+// openNext opens the file following the current one.
+func (f *FileSink) openNext() error {
+	f.seq++
+	name := path.Join(f.dir, fmt.Sprintf("%s%010d%s", exportFilePrefix, f.seq, fileExtensions[f.format]))
+	file, err := os.OpenFile(name, os.O_CREATE|os.O_APPEND|os.O_WRONLY, 0600)
+	if err != nil {
+		return err
+	}
+	info, err := file.Stat()
+	if err != nil {
+		file.Close()
+		return err
+	}
+	log.WithField("file", name).Debug("Writing export file")
+	f.file = file
+	f.size = info.Size()
+	return nil
+}
+
+func (f *FileSink) encode(topic string, msg proto.Message) ([]byte, error) {
+	if f.format == SSZFormat {
+		enc, err := ssz.Marshal(msg)
+		if err != nil {
+			return nil, err
+		}
+		buf := bytes.NewBuffer(make([]byte, 0, 8+len(topic)+len(enc)))
+		if err := binary.Write(buf, binary.LittleEndian, uint32(len(topic))); err != nil {
+			return nil, err
+		}
+		buf.WriteString(topic)
+		if err := binary.Write(buf, binary.LittleEndian, uint32(len(enc))); err != nil {
+			return nil, err
+		}
+		buf.Write(enc)
+		return buf.Bytes(), nil
+	}
+
+	key, err := ssz.HashTreeRoot(msg)
+	if err != nil {
+		return nil, err
+	}
+	obj := bytes.NewBuffer(nil)
+	if err := marshaler.Marshal(obj, msg); err != nil {
+		return nil, err
+	}
+	line, err := json.Marshal(&jsonRecord{
+		Topic:  topic,
+		Key:    fmt.Sprintf("%#x", key),
+		Object: obj.Bytes(),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return append(line, '\n'), nil
+}
+
+// Close syncs and closes the current file.
+func (f *FileSink) Close() error {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+	f.closed = true
+	if f.file == nil {
+		return nil
+	}
+	file := f.file
+	f.file = nil
+	if err := file.Sync(); err != nil {
+		file.Close()
+		return err
+	}
+	return file.Close()
+}
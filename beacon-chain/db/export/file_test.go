@@ -0,0 +1,171 @@
+package export
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/binary"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path"
+	"testing"
+
+	"github.com/golang/protobuf/jsonpb"
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/go-ssz"
+	"github.com/prysmaticlabs/prysm/shared/testutil"
+)
+
+func setupExportDir(t *testing.T, name string) string {
+	dir := path.Join(testutil.TempDir(), "export_files_"+name)
+	if err := os.RemoveAll(dir); err != nil {
+		t.Fatal(err)
+	}
+	return dir
+}
+
+func TestFileSink_JSONLines(t *testing.T) {
+	ctx := context.Background()
+	dir := setupExportDir(t, "json")
+	defer os.RemoveAll(dir)
+	sink, err := NewFileSink(dir, JSONFormat, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	checkpoint := &ethpb.Checkpoint{Epoch: 3, Root: make([]byte, 32)}
+	exit := &ethpb.VoluntaryExit{Epoch: 4, ValidatorIndex: 7}
+	if err := sink.Export(ctx, FinalizedCheckpointTopic, checkpoint); err != nil {
+		t.Fatal(err)
+	}
+	if err := sink.Export(ctx, VoluntaryExitTopic, exit); err != nil {
+		t.Fatal(err)
+	}
+	if err := sink.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	f, err := os.Open(path.Join(dir, "export_0000000001.jsonl"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer f.Close()
+	var records []*jsonRecord
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		record := &jsonRecord{}
+		if err := json.Unmarshal(scanner.Bytes(), record); err != nil {
+			t.Fatal(err)
+		}
+		records = append(records, record)
+	}
+	if len(records) != 2 {
+		t.Fatalf("Wanted 2 records, received %d", len(records))
+	}
+	if records[0].Topic != FinalizedCheckpointTopic || records[1].Topic != VoluntaryExitTopic {
+		t.Errorf("Unexpected topics %s and %s", records[0].Topic, records[1].Topic)
+	}
+	root, err := ssz.HashTreeRoot(checkpoint)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if records[0].Key != fmt.Sprintf("%#x", root) {
+		t.Errorf("Wanted key %#x, received %s", root, records[0].Key)
+	}
+	obj := &ethpb.VoluntaryExit{}
+	if err := jsonpb.Unmarshal(bytes.NewReader(records[1].Object), obj); err != nil {
+		t.Fatal(err)
+	}
+	if obj.Epoch != 4 || obj.ValidatorIndex != 7 {
+		t.Errorf("Unexpected exit %v", obj)
+	}
+}
+
+func TestFileSink_SSZRecords(t *testing.T) {
+	ctx := context.Background()
+	dir := setupExportDir(t, "ssz")
+	defer os.RemoveAll(dir)
+	sink, err := NewFileSink(dir, SSZFormat, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	checkpoint := &ethpb.Checkpoint{Epoch: 3, Root: make([]byte, 32)}
+	if err := sink.Export(ctx, FinalizedCheckpointTopic, checkpoint); err != nil {
+		t.Fatal(err)
+	}
+	if err := sink.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	enc, err := ioutil.ReadFile(path.Join(dir, "export_0000000001.ssz"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	topicLen := binary.LittleEndian.Uint32(enc[:4])
+	topic := string(enc[4 : 4+topicLen])
+	if topic != FinalizedCheckpointTopic {
+		t.Errorf("Wanted topic %s, received %s", FinalizedCheckpointTopic, topic)
+	}
+	rest := enc[4+topicLen:]
+	objLen := binary.LittleEndian.Uint32(rest[:4])
+	if int(objLen) != len(rest)-4 {
+		t.Fatalf("Wanted object length %d, received %d", len(rest)-4, objLen)
+	}
+	received := &ethpb.Checkpoint{}
+	if err := ssz.Unmarshal(rest[4:], received); err != nil {
+		t.Fatal(err)
+	}
+	if received.Epoch != 3 {
+		t.Errorf("Wanted checkpoint epoch 3, received %d", received.Epoch)
+	}
+}
+
+func TestFileSink_RotatesFiles(t *testing.T) {
+	ctx := context.Background()
+	dir := setupExportDir(t, "rotated")
+	defer os.RemoveAll(dir)
+	// Every record is larger than half the maximum size, so each one starts a new file.
+	sink, err := NewFileSink(dir, SSZFormat, 80)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for i := uint64(0); i < 3; i++ {
+		if err := sink.Export(ctx, FinalizedCheckpointTopic, &ethpb.Checkpoint{Epoch: i, Root: make([]byte, 32)}); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := sink.Close(); err != nil {
+		t.Fatal(err)
+	}
+	files, err := ioutil.ReadDir(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(files) != 3 {
+		t.Fatalf("Wanted 3 files, received %d", len(files))
+	}
+
+	// A new sink continues the numbering of the existing files.
+	sink, err = NewFileSink(dir, SSZFormat, 80)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := sink.Export(ctx, FinalizedCheckpointTopic, &ethpb.Checkpoint{Epoch: 3, Root: make([]byte, 32)}); err != nil {
+		t.Fatal(err)
+	}
+	if err := sink.Close(); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := os.Stat(path.Join(dir, "export_0000000004.ssz")); err != nil {
+		t.Errorf("Expected a fourth export file: %v", err)
+	}
+}
+
+func TestNewFileSink_UnknownFormat(t *testing.T) {
+	dir := setupExportDir(t, "unknown")
+	defer os.RemoveAll(dir)
+	if _, err := NewFileSink(dir, "xml", 0); err == nil {
+		t.Error("Expected an error for an unknown format")
+	}
+}
@@ -1,4 +1,4 @@
-package kafka
+package export
 
 import (
 	"context"
@@ -191,6 +191,16 @@ func (e Exporter) ArchivedValidatorParticipation(ctx context.Context, epoch uint
 	return e.db.ArchivedValidatorParticipation(ctx, epoch)
 }
 
+// ArchiveBackfillEpoch -- passthrough.
+func (e Exporter) ArchiveBackfillEpoch(ctx context.Context) (uint64, error) {
+	return e.db.ArchiveBackfillEpoch(ctx)
+}
+
+// ArchivedValidatorRewards -- passthrough.
+func (e Exporter) ArchivedValidatorRewards(ctx context.Context, epoch uint64) (*ethereum_beacon_p2p_v1.ArchivedValidatorRewards, error) {
+	return e.db.ArchivedValidatorRewards(ctx, epoch)
+}
+
 // DepositContractAddress -- passthrough.
 func (e Exporter) DepositContractAddress(ctx context.Context) ([]byte, error) {
 	return e.db.DepositContractAddress(ctx)
@@ -211,29 +221,34 @@ func (e Exporter) SaveGenesisBlockRoot(ctx context.Context, blockRoot [32]byte)
 	return e.db.SaveGenesisBlockRoot(ctx, blockRoot)
 }
 
-// SaveValidatorIndex -- passthrough.
-func (e Exporter) SaveValidatorIndex(ctx context.Context, publicKey []byte, validatorIdx uint64) error {
-	return e.db.SaveValidatorIndex(ctx, publicKey, validatorIdx)
+// OriginBlockRoot -- passthrough.
+func (e Exporter) OriginBlockRoot(ctx context.Context) ([32]byte, error) {
+	return e.db.OriginBlockRoot(ctx)
 }
 
-// SaveState -- passthrough.
-func (e Exporter) SaveState(ctx context.Context, state *ethereum_beacon_p2p_v1.BeaconState, blockRoot [32]byte) error {
-	return e.db.SaveState(ctx, state, blockRoot)
+// SaveOriginBlockRoot -- passthrough.
+func (e Exporter) SaveOriginBlockRoot(ctx context.Context, blockRoot [32]byte) error {
+	return e.db.SaveOriginBlockRoot(ctx, blockRoot)
 }
 
-// SaveProposerSlashing -- passthrough.
-func (e Exporter) SaveProposerSlashing(ctx context.Context, slashing *eth.ProposerSlashing) error {
-	return e.db.SaveProposerSlashing(ctx, slashing)
+// BackfillBlockRoot -- passthrough.
+func (e Exporter) BackfillBlockRoot(ctx context.Context) ([32]byte, error) {
+	return e.db.BackfillBlockRoot(ctx)
 }
 
-// SaveAttesterSlashing -- passthrough.
-func (e Exporter) SaveAttesterSlashing(ctx context.Context, slashing *eth.AttesterSlashing) error {
-	return e.db.SaveAttesterSlashing(ctx, slashing)
+// SaveBackfillBlockRoot -- passthrough.
+func (e Exporter) SaveBackfillBlockRoot(ctx context.Context, blockRoot [32]byte) error {
+	return e.db.SaveBackfillBlockRoot(ctx, blockRoot)
+}
+
+// SaveValidatorIndex -- passthrough.
+func (e Exporter) SaveValidatorIndex(ctx context.Context, publicKey []byte, validatorIdx uint64) error {
+	return e.db.SaveValidatorIndex(ctx, publicKey, validatorIdx)
 }
 
-// SaveVoluntaryExit -- passthrough.
-func (e Exporter) SaveVoluntaryExit(ctx context.Context, exit *eth.VoluntaryExit) error {
-	return e.db.SaveVoluntaryExit(ctx, exit)
+// SaveState -- passthrough.
+func (e Exporter) SaveState(ctx context.Context, state *ethereum_beacon_p2p_v1.BeaconState, blockRoot [32]byte) error {
+	return e.db.SaveState(ctx, state, blockRoot)
 }
 
 // SaveJustifiedCheckpoint -- passthrough.
@@ -241,11 +256,6 @@ func (e Exporter) SaveJustifiedCheckpoint(ctx context.Context, checkpoint *eth.C
 	return e.db.SaveJustifiedCheckpoint(ctx, checkpoint)
 }
 
-// SaveFinalizedCheckpoint -- passthrough.
-func (e Exporter) SaveFinalizedCheckpoint(ctx context.Context, checkpoint *eth.Checkpoint) error {
-	return e.db.SaveFinalizedCheckpoint(ctx, checkpoint)
-}
-
 // SaveArchivedActiveValidatorChanges -- passthrough.
 func (e Exporter) SaveArchivedActiveValidatorChanges(ctx context.Context, epoch uint64, changes *ethereum_beacon_p2p_v1.ArchivedActiveSetChanges) error {
 	return e.db.SaveArchivedActiveValidatorChanges(ctx, epoch, changes)
@@ -256,16 +266,21 @@ func (e Exporter) SaveArchivedCommitteeInfo(ctx context.Context, epoch uint64, i
 	return e.db.SaveArchivedCommitteeInfo(ctx, epoch, info)
 }
 
-// SaveArchivedBalances -- passthrough.
-func (e Exporter) SaveArchivedBalances(ctx context.Context, epoch uint64, balances []uint64) error {
-	return e.db.SaveArchivedBalances(ctx, epoch, balances)
-}
-
 // SaveArchivedValidatorParticipation -- passthrough.
 func (e Exporter) SaveArchivedValidatorParticipation(ctx context.Context, epoch uint64, part *eth.ValidatorParticipation) error {
 	return e.db.SaveArchivedValidatorParticipation(ctx, epoch, part)
 }
 
+// SaveArchiveBackfillEpoch -- passthrough.
+func (e Exporter) SaveArchiveBackfillEpoch(ctx context.Context, epoch uint64) error {
+	return e.db.SaveArchiveBackfillEpoch(ctx, epoch)
+}
+
+// SaveArchivedValidatorRewards -- passthrough.
+func (e Exporter) SaveArchivedValidatorRewards(ctx context.Context, epoch uint64, rewards *ethereum_beacon_p2p_v1.ArchivedValidatorRewards) error {
+	return e.db.SaveArchivedValidatorRewards(ctx, epoch, rewards)
+}
+
 // SaveDepositContractAddress -- passthrough.
 func (e Exporter) SaveDepositContractAddress(ctx context.Context, addr common.Address) error {
 	return e.db.SaveDepositContractAddress(ctx, addr)
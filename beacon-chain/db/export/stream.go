@@ -0,0 +1,90 @@
+package export
+
+import (
+	"context"
+	"sync"
+
+	"github.com/golang/protobuf/proto"
+)
+
+// Object exported to the subscribers of a stream sink.
+type Object struct {
+	Topic   string
+	Message proto.Message
+}
+
+type subscription struct {
+	filter  Filter
+	objects chan *Object
+}
+
+// StreamSink sends the exported objects to its in-process subscribers, such as the clients of the
+// export gRPC service. Objects are dropped for a subscriber which falls behind, so it cannot hold
+// back the other sinks.
+type StreamSink struct {
+	lock   sync.RWMutex
+	subs   map[*subscription]bool
+	closed bool
+}
+
+// NewStreamSink without subscribers.
+func NewStreamSink() *StreamSink {
+	return &StreamSink{
+		subs: make(map[*subscription]bool),
+	}
+}
+
+// Subscribe to the exported objects of the topics allowed by the filter, with a buffer of the
+// given size. The returned function ends the subscription, and the channel is closed once the
+// subscription ends or the sink is closed.
+func (s *StreamSink) Subscribe(filter Filter, buffer int) (<-chan *Object, func()) {
+	sub := &subscription{
+		filter:  filter,
+		objects: make(chan *Object, buffer),
+	}
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	if s.closed {
+		close(sub.objects)
+		return sub.objects, func() {}
+	}
+	s.subs[sub] = true
+	return sub.objects, func() {
+		s.lock.Lock()
+		defer s.lock.Unlock()
+		if s.subs[sub] {
+			delete(s.subs, sub)
+			close(sub.objects)
+		}
+	}
+}
+
+// Export the object to the subscribers of its topic.
+func (s *StreamSink) Export(_ context.Context, topic string, msg proto.Message) error {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+	obj := &Object{Topic: topic, Message: msg}
+	for sub := range s.subs {
+		if !sub.filter.Allows(topic) {
+			continue
+		}
+		select {
+		case sub.objects <- obj:
+		default:
+			log.WithField("topic", topic).Debug("Export stream subscriber is too slow, dropping object")
+		}
+	}
+	return nil
+}
+
+// Close ends all subscriptions.
+func (s *StreamSink) Close() error {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	for sub := range s.subs {
+		close(sub.objects)
+	}
+	s.subs = make(map[*subscription]bool)
+	s.closed = true
+	return nil
+}
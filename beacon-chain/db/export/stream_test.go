@@ -0,0 +1,62 @@
+package export
+
+import (
+	"context"
+	"testing"
+
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+)
+
+func TestStreamSink_SendsToSubscribers(t *testing.T) {
+	ctx := context.Background()
+	sink := NewStreamSink()
+	all, unsubscribeAll := sink.Subscribe(nil, 2)
+	defer unsubscribeAll()
+	exits, unsubscribeExits := sink.Subscribe(Filter{VoluntaryExitTopic: true}, 2)
+
+	if err := sink.Export(ctx, BlockTopic, &ethpb.SignedBeaconBlock{}); err != nil {
+		t.Fatal(err)
+	}
+	if err := sink.Export(ctx, VoluntaryExitTopic, &ethpb.VoluntaryExit{Epoch: 4}); err != nil {
+		t.Fatal(err)
+	}
+	if len(all) != 2 {
+		t.Errorf("Wanted 2 objects for the subscriber of all topics, received %d", len(all))
+	}
+	if len(exits) != 1 {
+		t.Fatalf("Wanted 1 object for the subscriber of exits, received %d", len(exits))
+	}
+	if obj := <-exits; obj.Topic != VoluntaryExitTopic {
+		t.Errorf("Wanted topic %s, received %s", VoluntaryExitTopic, obj.Topic)
+	}
+
+	unsubscribeExits()
+	if _, ok := <-exits; ok {
+		t.Error("Expected the channel to be closed once unsubscribed")
+	}
+	// The full buffer of a subscriber drops the object instead of blocking.
+	if err := sink.Export(ctx, VoluntaryExitTopic, &ethpb.VoluntaryExit{Epoch: 5}); err != nil {
+		t.Fatal(err)
+	}
+	if len(all) != 2 {
+		t.Errorf("Wanted the object to be dropped for a full subscriber, received %d objects", len(all))
+	}
+}
+
+func TestStreamSink_CloseEndsSubscriptions(t *testing.T) {
+	sink := NewStreamSink()
+	objects, unsubscribe := sink.Subscribe(nil, 1)
+	if err := sink.Close(); err != nil {
+		t.Fatal(err)
+	}
+	if _, ok := <-objects; ok {
+		t.Error("Expected the channel to be closed with the sink")
+	}
+	// Unsubscribing after the sink is closed does nothing.
+	unsubscribe()
+
+	objects, _ = sink.Subscribe(nil, 1)
+	if _, ok := <-objects; ok {
+		t.Error("Expected the channel of a closed sink to be closed")
+	}
+}
@@ -42,6 +42,10 @@ type Database interface {
 	SaveHeadBlockRoot(ctx context.Context, blockRoot [32]byte) error
 	GenesisBlock(ctx context.Context) (*ethpb.SignedBeaconBlock, error)
 	SaveGenesisBlockRoot(ctx context.Context, blockRoot [32]byte) error
+	OriginBlockRoot(ctx context.Context) ([32]byte, error)
+	SaveOriginBlockRoot(ctx context.Context, blockRoot [32]byte) error
+	BackfillBlockRoot(ctx context.Context) ([32]byte, error)
+	SaveBackfillBlockRoot(ctx context.Context, blockRoot [32]byte) error
 	IsFinalizedBlock(ctx context.Context, blockRoot [32]byte) bool
 	// Validator related methods.
 	ValidatorIndex(ctx context.Context, publicKey []byte) (uint64, bool, error)
@@ -84,6 +88,10 @@ type Database interface {
 	SaveArchivedBalances(ctx context.Context, epoch uint64, balances []uint64) error
 	ArchivedValidatorParticipation(ctx context.Context, epoch uint64) (*eth.ValidatorParticipation, error)
 	SaveArchivedValidatorParticipation(ctx context.Context, epoch uint64, part *eth.ValidatorParticipation) error
+	ArchivedValidatorRewards(ctx context.Context, epoch uint64) (*ethereum_beacon_p2p_v1.ArchivedValidatorRewards, error)
+	SaveArchivedValidatorRewards(ctx context.Context, epoch uint64, rewards *ethereum_beacon_p2p_v1.ArchivedValidatorRewards) error
+	ArchiveBackfillEpoch(ctx context.Context) (uint64, error)
+	SaveArchiveBackfillEpoch(ctx context.Context, epoch uint64) error
 	// Deposit contract related handlers.
 	DepositContractAddress(ctx context.Context) ([]byte, error)
 	SaveDepositContractAddress(ctx context.Context, addr common.Address) error
@@ -0,0 +1,67 @@
+package kafka
+
+import (
+	"bytes"
+	"context"
+
+	"github.com/golang/protobuf/jsonpb"
+	"github.com/golang/protobuf/proto"
+	"github.com/prysmaticlabs/go-ssz"
+	"github.com/prysmaticlabs/prysm/beacon-chain/db/export"
+	"github.com/prysmaticlabs/prysm/shared/traceutil"
+	"go.opencensus.io/trace"
+	"gopkg.in/confluentinc/confluent-kafka-go.v1/kafka"
+)
+
+var _ = export.Sink(&Sink{})
+var marshaler = &jsonpb.Marshaler{}
+
+// Sink publishes the exported objects to the kafka topics named after their export topics.
+type Sink struct {
+	p *kafka.Producer
+}
+
+// NewSink producing to the kafka cluster of the bootstrap servers.
+func NewSink(bootstrapServers string) (*Sink, error) {
+	p, err := kafka.NewProducer(&kafka.ConfigMap{"bootstrap.servers": bootstrapServers})
+	if err != nil {
+		return nil, err
+	}
+	return &Sink{p: p}, nil
+}
+
+// Export publishes the JSON encoding of the object to the topic, keyed by its hash tree root.
+func (s *Sink) Export(ctx context.Context, topic string, msg proto.Message) error {
+	_, span := trace.StartSpan(ctx, "kafka.publish")
+	defer span.End()
+
+	buf := bytes.NewBuffer(nil)
+	if err := marshaler.Marshal(buf, msg); err != nil {
+		traceutil.AnnotateError(span, err)
+		return err
+	}
+
+	key, err := ssz.HashTreeRoot(msg)
+	if err != nil {
+		traceutil.AnnotateError(span, err)
+		return err
+	}
+
+	if err := s.p.Produce(&kafka.Message{
+		TopicPartition: kafka.TopicPartition{
+			Topic: &topic,
+		},
+		Value: buf.Bytes(),
+		Key:   key[:],
+	}, nil); err != nil {
+		traceutil.AnnotateError(span, err)
+		return err
+	}
+	return nil
+}
+
+// Close closes kafka producer.
+func (s *Sink) Close() error {
+	s.p.Close()
+	return nil
+}
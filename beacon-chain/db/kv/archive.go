@@ -2,6 +2,7 @@ package kv
 
 import (
 	"context"
+	"encoding/binary"
 
 	"github.com/boltdb/bolt"
 	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
@@ -145,3 +146,64 @@ func (k *Store) SaveArchivedValidatorParticipation(ctx context.Context, epoch ui
 		return bucket.Put(buf, enc)
 	})
 }
+
+// ArchivedValidatorRewards retrieval by epoch.
+func (k *Store) ArchivedValidatorRewards(ctx context.Context, epoch uint64) (*pb.ArchivedValidatorRewards, error) {
+	ctx, span := trace.StartSpan(ctx, "BeaconDB.ArchivedValidatorRewards")
+	defer span.End()
+
+	buf := uint64ToBytes(epoch)
+	var target *pb.ArchivedValidatorRewards
+	err := k.db.View(func(tx *bolt.Tx) error {
+		bkt := tx.Bucket(archivedValidatorRewardsBucket)
+		enc := bkt.Get(buf)
+		if enc == nil {
+			return nil
+		}
+		target = &pb.ArchivedValidatorRewards{}
+		return decode(enc, target)
+	})
+	return target, err
+}
+
+// SaveArchivedValidatorRewards by epoch.
+func (k *Store) SaveArchivedValidatorRewards(ctx context.Context, epoch uint64, rewards *pb.ArchivedValidatorRewards) error {
+	ctx, span := trace.StartSpan(ctx, "BeaconDB.SaveArchivedValidatorRewards")
+	defer span.End()
+	buf := uint64ToBytes(epoch)
+	enc, err := encode(rewards)
+	if err != nil {
+		return err
+	}
+	return k.db.Update(func(tx *bolt.Tx) error {
+		bucket := tx.Bucket(archivedValidatorRewardsBucket)
+		return bucket.Put(buf, enc)
+	})
+}
+
+// ArchiveBackfillEpoch retrieves the next epoch to be archived by the archive backfill, which is 0
+// if the backfill never ran.
+func (k *Store) ArchiveBackfillEpoch(ctx context.Context) (uint64, error) {
+	ctx, span := trace.StartSpan(ctx, "BeaconDB.ArchiveBackfillEpoch")
+	defer span.End()
+	var epoch uint64
+	err := k.db.View(func(tx *bolt.Tx) error {
+		enc := tx.Bucket(chainMetadataBucket).Get(archiveBackfillEpochKey)
+		if enc == nil {
+			return nil
+		}
+		epoch = binary.LittleEndian.Uint64(enc)
+		return nil
+	})
+	return epoch, err
+}
+
+// SaveArchiveBackfillEpoch as the next epoch to be archived by the archive backfill.
+func (k *Store) SaveArchiveBackfillEpoch(ctx context.Context, epoch uint64) error {
+	ctx, span := trace.StartSpan(ctx, "BeaconDB.SaveArchiveBackfillEpoch")
+	defer span.End()
+	return k.db.Update(func(tx *bolt.Tx) error {
+		bucket := tx.Bucket(chainMetadataBucket)
+		return bucket.Put(archiveBackfillEpochKey, uint64ToBytes(epoch))
+	})
+}
@@ -164,3 +164,56 @@ func TestStore_ArchivedValidatorParticipation(t *testing.T) {
 		t.Errorf("Wanted %v, received %v", part, retrieved)
 	}
 }
+
+func TestStore_ArchivedValidatorRewards(t *testing.T) {
+	db := setupDB(t)
+	defer teardownDB(t, db)
+	ctx := context.Background()
+	epoch := uint64(10)
+	rewards := &pbp2p.ArchivedValidatorRewards{
+		Rewards: []*pbp2p.ValidatorRewards{
+			{SourceReward: 10, TargetReward: 20, HeadReward: 30, InclusionDelayReward: 40, ProposerReward: 50},
+			{SourcePenalty: 10, TargetPenalty: 20, HeadPenalty: 30, InactivityPenalty: 40, SlashingPenalty: 50},
+		},
+	}
+	if err := db.SaveArchivedValidatorRewards(ctx, epoch, rewards); err != nil {
+		t.Fatal(err)
+	}
+	retrieved, err := db.ArchivedValidatorRewards(ctx, epoch)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !proto.Equal(rewards, retrieved) {
+		t.Errorf("Wanted %v, received %v", rewards, retrieved)
+	}
+	retrieved, err = db.ArchivedValidatorRewards(ctx, epoch+1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if retrieved != nil {
+		t.Errorf("Expected no rewards for an epoch which was not archived, received %v", retrieved)
+	}
+}
+
+func TestStore_ArchiveBackfillEpoch(t *testing.T) {
+	db := setupDB(t)
+	defer teardownDB(t, db)
+	ctx := context.Background()
+	epoch, err := db.ArchiveBackfillEpoch(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if epoch != 0 {
+		t.Errorf("Wanted epoch 0 before the backfill ran, received %d", epoch)
+	}
+	if err := db.SaveArchiveBackfillEpoch(ctx, 42); err != nil {
+		t.Fatal(err)
+	}
+	epoch, err = db.ArchiveBackfillEpoch(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if epoch != 42 {
+		t.Errorf("Wanted epoch 42, received %d", epoch)
+	}
+}
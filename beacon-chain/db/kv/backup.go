@@ -3,18 +3,36 @@ package kv
 import (
 	"context"
 	"fmt"
+	"io"
+	"io/ioutil"
 	"os"
 	"path"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
 
 	"github.com/boltdb/bolt"
 	"github.com/pkg/errors"
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
 	"github.com/sirupsen/logrus"
 	"go.opencensus.io/trace"
 )
 
-const backupsDirectoryName = "backups"
+const (
+	backupsDirectoryName = "backups"
+	backupFilePrefix     = "prysm_beacondb_at_slot_"
+	backupFileSuffix     = ".backup"
+)
+
+// BackupFile of the database in the backups directory.
+type BackupFile struct {
+	Path string
+	Slot uint64
+}
 
-// Backup the database to the datadir backup directory.
+// Backup the database to the datadir backup directory. The backup is verified before it is
+// moved into place, so the backups directory only ever holds complete backups.
 // Example for backup at slot 345: $DATADIR/backups/prysm_beacondb_at_slot_0000345.backup
 func (k *Store) Backup(ctx context.Context) error {
 	ctx, span := trace.StartSpan(ctx, "BeaconDB.Backup")
@@ -32,9 +50,210 @@ func (k *Store) Backup(ctx context.Context) error {
 	if err := os.MkdirAll(backupsDir, os.ModePerm); err != nil {
 		return err
 	}
-	backupPath := path.Join(backupsDir, fmt.Sprintf("prysm_beacondb_at_slot_%07d.backup", head.Block.Slot))
+	backupPath := path.Join(backupsDir, fmt.Sprintf("%s%07d%s", backupFilePrefix, head.Block.Slot, backupFileSuffix))
+	tmpPath := backupPath + ".tmp"
 	logrus.WithField("prefix", "db").WithField("backup", backupPath).Info("Writing backup database.")
-	return k.db.View(func(tx *bolt.Tx) error {
-		return tx.CopyFile(backupPath, 0666)
+	if err := k.db.View(func(tx *bolt.Tx) error {
+		return tx.CopyFile(tmpPath, 0666)
+	}); err != nil {
+		removeTmpFile(tmpPath)
+		return err
+	}
+	if err := VerifyBackup(tmpPath); err != nil {
+		removeTmpFile(tmpPath)
+		return errors.Wrap(err, "could not verify backup")
+	}
+	return os.Rename(tmpPath, backupPath)
+}
+
+// removeTmpFile of a failed backup or restore, if it was created.
+func removeTmpFile(tmpPath string) {
+	if err := os.Remove(tmpPath); err != nil && !os.IsNotExist(err) {
+		logrus.WithField("prefix", "db").WithError(err).WithField("path", tmpPath).Error("Could not remove temporary file")
+	}
+}
+
+// Backups in the backups directory of the database in the directory, sorted by slot.
+func Backups(dirPath string) ([]*BackupFile, error) {
+	backupsDir := path.Join(dirPath, backupsDirectoryName)
+	files, err := ioutil.ReadDir(backupsDir)
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	backups := make([]*BackupFile, 0, len(files))
+	for _, f := range files {
+		name := f.Name()
+		// The suffix check skips the temporary files of backups in progress.
+		if f.IsDir() || !strings.HasPrefix(name, backupFilePrefix) || !strings.HasSuffix(name, backupFileSuffix) {
+			continue
+		}
+		slot, err := strconv.ParseUint(strings.TrimSuffix(strings.TrimPrefix(name, backupFilePrefix), backupFileSuffix), 10, 64)
+		if err != nil {
+			continue
+		}
+		backups = append(backups, &BackupFile{Path: path.Join(backupsDir, name), Slot: slot})
+	}
+	sort.Slice(backups, func(i, j int) bool {
+		return backups[i].Slot < backups[j].Slot
+	})
+	return backups, nil
+}
+
+// PruneBackups removes all but the retain most recent backups of the database in the directory.
+func PruneBackups(dirPath string, retain int) error {
+	backups, err := Backups(dirPath)
+	if err != nil {
+		return err
+	}
+	for i := 0; i < len(backups)-retain; i++ {
+		logrus.WithField("prefix", "db").WithField("backup", backups[i].Path).Info("Removing old backup database.")
+		if err := os.Remove(backups[i].Path); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// VerifyBackup checks the consistency of the pages of the backup database, and that its head
+// block and the blocks of its justified and finalized checkpoints can be read.
+func VerifyBackup(backupPath string) error {
+	if _, err := os.Stat(backupPath); err != nil {
+		return err
+	}
+	boltDB, err := bolt.Open(backupPath, 0600, &bolt.Options{Timeout: 1 * time.Second, ReadOnly: true})
+	if err != nil {
+		return err
+	}
+	defer boltDB.Close()
+
+	return boltDB.View(func(tx *bolt.Tx) error {
+		// The check has to be drained, as it keeps reading the pages of the transaction.
+		var checkErr error
+		for err := range tx.Check() {
+			if checkErr == nil {
+				checkErr = err
+			}
+		}
+		if checkErr != nil {
+			return errors.Wrap(checkErr, "inconsistent database pages")
+		}
+		blocks := tx.Bucket(blocksBucket)
+		checkpoints := tx.Bucket(checkpointBucket)
+		if blocks == nil || checkpoints == nil {
+			return errors.New("missing blocks or checkpoints bucket")
+		}
+		headRoot := blocks.Get(headBlockRootKey)
+		if headRoot == nil {
+			return errors.New("no head block root")
+		}
+		if err := verifyBlock(blocks, headRoot); err != nil {
+			return errors.Wrap(err, "invalid head block")
+		}
+		for name, key := range map[string][]byte{
+			"justified": justifiedCheckpointKey,
+			"finalized": finalizedCheckpointKey,
+		} {
+			enc := checkpoints.Get(key)
+			if enc == nil {
+				// The node starts from the genesis block until a checkpoint is saved.
+				continue
+			}
+			checkpoint := &ethpb.Checkpoint{}
+			if err := decode(enc, checkpoint); err != nil {
+				return errors.Wrapf(err, "invalid %s checkpoint", name)
+			}
+			if checkpoint.Epoch == 0 {
+				continue
+			}
+			if err := verifyBlock(blocks, checkpoint.Root); err != nil {
+				return errors.Wrapf(err, "invalid %s checkpoint block", name)
+			}
+		}
+		return nil
 	})
 }
+
+// verifyBlock checks that the block with the given root is in the bucket and can be decoded.
+func verifyBlock(blocks *bolt.Bucket, root []byte) error {
+	enc := blocks.Get(root)
+	if enc == nil {
+		return errors.Errorf("no block with root %#x", root)
+	}
+	return decode(enc, &ethpb.SignedBeaconBlock{})
+}
+
+// RestoreBackup replaces the database in the directory with the given backup, once the backup is
+// verified. The replaced database is kept next to the restored one, and the database must not be
+// in use by a running node.
+func RestoreBackup(dirPath string, backupPath string) error {
+	if err := VerifyBackup(backupPath); err != nil {
+		return errors.Wrap(err, "could not verify backup")
+	}
+	if err := os.MkdirAll(dirPath, os.ModePerm); err != nil {
+		return err
+	}
+	datafile := path.Join(dirPath, databaseFileName)
+	_, err := os.Stat(datafile)
+	exists := err == nil
+	if err != nil && !os.IsNotExist(err) {
+		return err
+	}
+	if exists {
+		// Taking the lock of the database ensures no node uses it while it is replaced.
+		boltDB, err := bolt.Open(datafile, 0600, &bolt.Options{Timeout: 1 * time.Second})
+		if err != nil {
+			if err == bolt.ErrTimeout {
+				return errors.New("cannot obtain database lock, database may be in use by another process")
+			}
+			return err
+		}
+		if err := boltDB.Close(); err != nil {
+			return err
+		}
+	}
+
+	// Copy the backup next to the database first, so the database is replaced by a rename.
+	tmpPath := datafile + ".restore"
+	if err := copyFile(backupPath, tmpPath); err != nil {
+		removeTmpFile(tmpPath)
+		return errors.Wrap(err, "could not copy backup")
+	}
+	log := logrus.WithField("prefix", "db")
+	if exists {
+		replacedPath := fmt.Sprintf("%s.replaced_%d", datafile, time.Now().Unix())
+		if err := os.Rename(datafile, replacedPath); err != nil {
+			return err
+		}
+		log.WithField("path", replacedPath).Info("Moved replaced database")
+	}
+	if err := os.Rename(tmpPath, datafile); err != nil {
+		return err
+	}
+	log.WithField("backup", backupPath).Info("Restored database from backup")
+	return nil
+}
+
+// copyFile to the destination, and syncs it to disk.
+func copyFile(src string, dst string) error {
+	in, err := os.Open(src)
+	if err != nil {
+		return err
+	}
+	defer in.Close()
+	out, err := os.OpenFile(dst, os.O_CREATE|os.O_TRUNC|os.O_WRONLY, 0600)
+	if err != nil {
+		return err
+	}
+	if _, err := io.Copy(out, in); err != nil {
+		out.Close()
+		return err
+	}
+	if err := out.Sync(); err != nil {
+		out.Close()
+		return err
+	}
+	return out.Close()
+}
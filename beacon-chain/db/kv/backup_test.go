@@ -3,7 +3,9 @@ package kv
 import (
 	"context"
 	"io/ioutil"
+	"os"
 	"path"
+	"strings"
 	"testing"
 
 	eth "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
@@ -11,13 +13,10 @@ import (
 	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
 )
 
-func TestStore_Backup(t *testing.T) {
-	db := setupDB(t)
-	defer teardownDB(t, db)
+// saveHeadBlock at the slot, and returns its root.
+func saveHeadBlock(t *testing.T, db *Store, slot uint64) [32]byte {
 	ctx := context.Background()
-
-	head := &eth.SignedBeaconBlock{Block: &eth.BeaconBlock{Slot: 5000}}
-
+	head := &eth.SignedBeaconBlock{Block: &eth.BeaconBlock{Slot: slot}}
 	if err := db.SaveBlock(ctx, head); err != nil {
 		t.Fatal(err)
 	}
@@ -25,12 +24,21 @@ func TestStore_Backup(t *testing.T) {
 	if err != nil {
 		t.Fatal(err)
 	}
-	if err := db.SaveState(ctx, &pb.BeaconState{}, root); err != nil {
+	if err := db.SaveState(ctx, &pb.BeaconState{Slot: slot}, root); err != nil {
 		t.Fatal(err)
 	}
 	if err := db.SaveHeadBlockRoot(ctx, root); err != nil {
 		t.Fatal(err)
 	}
+	return root
+}
+
+func TestStore_Backup(t *testing.T) {
+	db := setupDB(t)
+	defer teardownDB(t, db)
+	ctx := context.Background()
+
+	saveHeadBlock(t, db, 5000)
 
 	if err := db.Backup(ctx); err != nil {
 		t.Fatal(err)
@@ -44,3 +52,121 @@ func TestStore_Backup(t *testing.T) {
 		t.Fatal("No backups created.")
 	}
 }
+
+func TestStore_PruneBackups(t *testing.T) {
+	db := setupDB(t)
+	defer teardownDB(t, db)
+	ctx := context.Background()
+
+	for _, slot := range []uint64{100, 10000000, 300, 200} {
+		saveHeadBlock(t, db, slot)
+		if err := db.Backup(ctx); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := PruneBackups(db.databasePath, 2); err != nil {
+		t.Fatal(err)
+	}
+
+	backups, err := Backups(db.databasePath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(backups) != 2 {
+		t.Fatalf("Wanted 2 backups, received %d", len(backups))
+	}
+	if backups[0].Slot != 300 || backups[1].Slot != 10000000 {
+		t.Errorf("Wanted the backups at slots 300 and 10000000, received %d and %d", backups[0].Slot, backups[1].Slot)
+	}
+}
+
+func TestVerifyBackup_MissingFinalizedBlock(t *testing.T) {
+	db := setupDB(t)
+	defer teardownDB(t, db)
+	ctx := context.Background()
+
+	saveHeadBlock(t, db, 100)
+	if err := db.Backup(ctx); err != nil {
+		t.Fatal(err)
+	}
+	backups, err := Backups(db.databasePath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := VerifyBackup(backups[0].Path); err != nil {
+		t.Fatalf("Valid backup failed verification: %v", err)
+	}
+
+	// A finalized checkpoint of a block which is not in the database fails the verification.
+	if err := db.SaveFinalizedCheckpoint(ctx, &eth.Checkpoint{Epoch: 2, Root: []byte("unknown")}); err != nil {
+		t.Fatal(err)
+	}
+	if err := db.Backup(ctx); err == nil {
+		t.Error("Expected the backup to fail verification")
+	}
+	backups, err = Backups(db.databasePath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(backups) != 1 {
+		t.Errorf("Wanted only the valid backup to be kept, received %d backups", len(backups))
+	}
+}
+
+func TestRestoreBackup(t *testing.T) {
+	db := setupDB(t)
+	ctx := context.Background()
+
+	root := saveHeadBlock(t, db, 100)
+	if err := db.Backup(ctx); err != nil {
+		t.Fatal(err)
+	}
+	saveHeadBlock(t, db, 200)
+	backups, err := Backups(db.databasePath)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err := RestoreBackup(db.databasePath, backups[0].Path); err == nil {
+		t.Fatal("Expected restoring a database in use to fail")
+	}
+	if err := db.Close(); err != nil {
+		t.Fatal(err)
+	}
+	if err := RestoreBackup(db.databasePath, backups[0].Path); err != nil {
+		t.Fatal(err)
+	}
+
+	db, err = NewKVStore(context.Background(), db.databasePath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer teardownDB(t, db)
+	head, err := db.HeadBlock(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	headRoot, err := ssz.HashTreeRoot(head.Block)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if headRoot != root {
+		t.Errorf("Wanted head block root %#x after restore, received %#x", root, headRoot)
+	}
+	files, err := ioutil.ReadDir(db.databasePath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	replaced := false
+	for _, f := range files {
+		if strings.HasPrefix(f.Name(), databaseFileName+".replaced_") {
+			replaced = true
+		}
+	}
+	if !replaced {
+		t.Error("Expected the replaced database to be kept")
+	}
+	if _, err := os.Stat(path.Join(db.databasePath, databaseFileName+".restore")); !os.IsNotExist(err) {
+		t.Error("Expected the temporary restore file to be removed")
+	}
+}
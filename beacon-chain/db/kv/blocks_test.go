@@ -178,6 +178,41 @@ func TestStore_GenesisBlock(t *testing.T) {
 	}
 }
 
+func TestStore_OriginAndBackfillBlockRoots(t *testing.T) {
+	db := setupDB(t)
+	defer teardownDB(t, db)
+	ctx := context.Background()
+
+	root, err := db.OriginBlockRoot(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if root != [32]byte{} {
+		t.Errorf("Expected no origin block root, received %#x", root)
+	}
+
+	origin := [32]byte{'o'}
+	backfill := [32]byte{'b'}
+	if err := db.SaveOriginBlockRoot(ctx, origin); err != nil {
+		t.Fatal(err)
+	}
+	if err := db.SaveBackfillBlockRoot(ctx, backfill); err != nil {
+		t.Fatal(err)
+	}
+	if root, err = db.OriginBlockRoot(ctx); err != nil {
+		t.Fatal(err)
+	}
+	if root != origin {
+		t.Errorf("Wanted origin block root %#x, received %#x", origin, root)
+	}
+	if root, err = db.BackfillBlockRoot(ctx); err != nil {
+		t.Fatal(err)
+	}
+	if root != backfill {
+		t.Errorf("Wanted backfill block root %#x, received %#x", backfill, root)
+	}
+}
+
 func TestStore_BlocksCRUD_NoCache(t *testing.T) {
 	db := setupDB(t)
 	defer teardownDB(t, db)
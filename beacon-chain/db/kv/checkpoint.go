@@ -38,20 +38,28 @@ func (k *Store) FinalizedCheckpoint(ctx context.Context) (*ethpb.Checkpoint, err
 	defer span.End()
 	var checkpoint *ethpb.Checkpoint
 	err := k.db.View(func(tx *bolt.Tx) error {
-		bkt := tx.Bucket(checkpointBucket)
-		enc := bkt.Get(finalizedCheckpointKey)
-		if enc == nil {
-			blockBucket := tx.Bucket(blocksBucket)
-			genesisRoot := blockBucket.Get(genesisBlockRootKey)
-			checkpoint = &ethpb.Checkpoint{Root: genesisRoot}
-			return nil
-		}
-		checkpoint = &ethpb.Checkpoint{}
-		return decode(enc, checkpoint)
+		var err error
+		checkpoint, err = finalizedCheckpoint(tx)
+		return err
 	})
 	return checkpoint, err
 }
 
+// finalizedCheckpoint in the transaction, which is the genesis block until a checkpoint is
+// finalized.
+func finalizedCheckpoint(tx *bolt.Tx) (*ethpb.Checkpoint, error) {
+	enc := tx.Bucket(checkpointBucket).Get(finalizedCheckpointKey)
+	if enc == nil {
+		genesisRoot := tx.Bucket(blocksBucket).Get(genesisBlockRootKey)
+		return &ethpb.Checkpoint{Root: genesisRoot}, nil
+	}
+	checkpoint := &ethpb.Checkpoint{}
+	if err := decode(enc, checkpoint); err != nil {
+		return nil, err
+	}
+	return checkpoint, nil
+}
+
 // SaveJustifiedCheckpoint saves justified checkpoint in beacon chain.
 func (k *Store) SaveJustifiedCheckpoint(ctx context.Context, checkpoint *ethpb.Checkpoint) error {
 	ctx, span := trace.StartSpan(ctx, "BeaconDB.SaveJustifiedCheckpoint")
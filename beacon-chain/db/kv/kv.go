@@ -12,6 +12,7 @@ import (
 	"github.com/pkg/errors"
 	"github.com/prometheus/client_golang/prometheus"
 	"github.com/prysmaticlabs/prysm/beacon-chain/db/iface"
+	"github.com/sirupsen/logrus"
 )
 
 var _ = iface.Database(&Store{})
@@ -40,7 +41,7 @@ type Store struct {
 // NewKVStore initializes a new boltDB key-value store at the directory
 // path specified, creates the kv-buckets based on the schema, and stores
 // an open connection db object as a property of the Store struct.
-func NewKVStore(dirPath string) (*Store, error) {
+func NewKVStore(ctx context.Context, dirPath string) (*Store, error) {
 	if err := os.MkdirAll(dirPath, 0700); err != nil {
 		return nil, err
 	}
@@ -93,6 +94,7 @@ func NewKVStore(dirPath string) (*Store, error) {
 			archivedCommitteeInfoBucket,
 			archivedBalancesBucket,
 			archivedValidatorParticipationBucket,
+			archivedValidatorRewardsBucket,
 			powchainBucket,
 			// Indices buckets.
 			attestationHeadBlockRootBucket,
@@ -110,7 +112,10 @@ func NewKVStore(dirPath string) (*Store, error) {
 		return nil, err
 	}
 
-	if err := kv.pruneStates(context.TODO()); err != nil {
+	if err := kv.runMigrations(ctx); err != nil {
+		if closeErr := kv.db.Close(); closeErr != nil {
+			logrus.WithField("prefix", "kv").WithError(closeErr).Error("Could not close database")
+		}
 		return nil, err
 	}
 
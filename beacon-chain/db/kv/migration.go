@@ -0,0 +1,146 @@
+package kv
+
+import (
+	"context"
+	"os"
+	"path"
+	"time"
+
+	"github.com/boltdb/bolt"
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/sirupsen/logrus"
+)
+
+// migration of the database schema. The schema version of a database is the number of
+// migrations applied to it, so migrations must only ever be appended to the registry. A migration
+// runs in the transaction which records it as applied, so it is either applied and recorded or
+// not applied at all.
+type migration struct {
+	name    string
+	migrate func(context.Context, *bolt.Tx) error
+}
+
+// migrations is the ordered registry of the schema migrations.
+var migrations = []migration{
+	{name: "prune-states", migrate: pruneStates},
+}
+
+// MigrationStatus of a schema migration of the database.
+type MigrationStatus struct {
+	Version   uint64
+	Name      string
+	Applied   bool
+	AppliedAt time.Time
+}
+
+// LatestSchemaVersion is the schema version of a database once all known migrations are applied.
+func LatestSchemaVersion() uint64 {
+	return uint64(len(migrations))
+}
+
+// schemaVersion stored in the migration bucket, which is 0 for a database written before
+// schema versions were introduced.
+func schemaVersion(tx *bolt.Tx) uint64 {
+	bkt := tx.Bucket(migrationBucket)
+	if bkt == nil {
+		return 0
+	}
+	enc := bkt.Get(schemaVersionKey)
+	if enc == nil {
+		return 0
+	}
+	return bytesutil.FromBytes8(enc)
+}
+
+// migrationAppliedKey of the time the migration of the given version was applied at.
+func migrationAppliedKey(version uint64) []byte {
+	key := append([]byte{}, migrationAppliedPrefix...)
+	return append(key, bytesutil.Bytes8(version)...)
+}
+
+// runMigrations applies the migrations which are newer than the schema version of the database,
+// in order. It refuses to run on a database written by a newer schema, as its data may not be
+// understood by this version of the node.
+func (k *Store) runMigrations(ctx context.Context) error {
+	var version uint64
+	if err := k.db.View(func(tx *bolt.Tx) error {
+		version = schemaVersion(tx)
+		return nil
+	}); err != nil {
+		return err
+	}
+	if version > LatestSchemaVersion() {
+		return errors.Errorf(
+			"database schema version %d is newer than the latest version %d known by this node, please upgrade the node",
+			version,
+			LatestSchemaVersion(),
+		)
+	}
+
+	log := logrus.WithField("prefix", "kv")
+	for ; version < LatestSchemaVersion(); version++ {
+		m := migrations[version]
+		log := log.WithFields(logrus.Fields{
+			"migration": m.name,
+			"version":   version + 1,
+			"latest":    LatestSchemaVersion(),
+		})
+		log.Info("Running database migration")
+		start := time.Now()
+		applied := version + 1
+		if err := k.db.Update(func(tx *bolt.Tx) error {
+			if err := m.migrate(ctx, tx); err != nil {
+				return err
+			}
+			bkt := tx.Bucket(migrationBucket)
+			if err := bkt.Put(migrationAppliedKey(applied), bytesutil.Bytes8(uint64(time.Now().Unix()))); err != nil {
+				return err
+			}
+			return bkt.Put(schemaVersionKey, bytesutil.Bytes8(applied))
+		}); err != nil {
+			return errors.Wrapf(err, "could not run database migration %s", m.name)
+		}
+		log.WithField("duration", time.Since(start)).Info("Database migration done")
+	}
+	return nil
+}
+
+// Migrations returns the status of every known migration of the database in the directory,
+// without applying any. The database is opened read-only and must not be in use by a node.
+func Migrations(dirPath string) ([]*MigrationStatus, uint64, error) {
+	datafile := path.Join(dirPath, databaseFileName)
+	if _, err := os.Stat(datafile); err != nil {
+		return nil, 0, err
+	}
+	boltDB, err := bolt.Open(datafile, 0600, &bolt.Options{Timeout: 1 * time.Second, ReadOnly: true})
+	if err != nil {
+		if err == bolt.ErrTimeout {
+			return nil, 0, errors.New("cannot obtain database lock, database may be in use by another process")
+		}
+		return nil, 0, err
+	}
+	defer boltDB.Close()
+
+	var version uint64
+	statuses := make([]*MigrationStatus, len(migrations))
+	err = boltDB.View(func(tx *bolt.Tx) error {
+		version = schemaVersion(tx)
+		bkt := tx.Bucket(migrationBucket)
+		for i, m := range migrations {
+			status := &MigrationStatus{
+				Version: uint64(i + 1),
+				Name:    m.name,
+				Applied: uint64(i+1) <= version,
+			}
+			if bkt != nil {
+				if enc := bkt.Get(migrationAppliedKey(status.Version)); enc != nil {
+					status.AppliedAt = time.Unix(int64(bytesutil.FromBytes8(enc)), 0)
+				}
+			}
+			statuses[i] = status
+		}
+		return nil
+	})
+	return statuses, version, err
+}
@@ -0,0 +1,130 @@
+package kv
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path"
+	"testing"
+
+	"github.com/boltdb/bolt"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+)
+
+func TestStore_RunMigrations_AppliesNewMigrations(t *testing.T) {
+	db := setupDB(t)
+	dirPath := db.DatabasePath()
+	defer os.RemoveAll(dirPath)
+
+	ran := 0
+	defer func(registry []migration) {
+		migrations = registry
+	}(migrations)
+	migrations = append(migrations, migration{
+		name: "test-migration",
+		migrate: func(context.Context, *bolt.Tx) error {
+			ran++
+			return nil
+		},
+	})
+
+	// Reopening the database applies the new migration once.
+	for i := 0; i < 2; i++ {
+		if err := db.Close(); err != nil {
+			t.Fatal(err)
+		}
+		var err error
+		db, err = NewKVStore(context.Background(), dirPath)
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+	if ran != 1 {
+		t.Errorf("Wanted the migration to run once, ran %d times", ran)
+	}
+	if err := db.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	statuses, version, err := Migrations(dirPath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if version != LatestSchemaVersion() {
+		t.Errorf("Wanted schema version %d, received %d", LatestSchemaVersion(), version)
+	}
+	if len(statuses) != len(migrations) {
+		t.Fatalf("Wanted %d migrations, received %d", len(migrations), len(statuses))
+	}
+	for _, status := range statuses {
+		if !status.Applied || status.AppliedAt.IsZero() {
+			t.Errorf("Migration %s was not recorded as applied", status.Name)
+		}
+	}
+}
+
+func TestStore_RunMigrations_RefusesNewerSchema(t *testing.T) {
+	db := setupDB(t)
+	dirPath := db.DatabasePath()
+	defer os.RemoveAll(dirPath)
+
+	if err := db.db.Update(func(tx *bolt.Tx) error {
+		return tx.Bucket(migrationBucket).Put(schemaVersionKey, bytesutil.Bytes8(LatestSchemaVersion()+1))
+	}); err != nil {
+		t.Fatal(err)
+	}
+	if err := db.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := NewKVStore(context.Background(), dirPath); err == nil {
+		t.Error("Expected an error opening a database written by a newer schema")
+	}
+}
+
+func TestStore_RunMigrations_FailedMigrationNotRecorded(t *testing.T) {
+	db := setupDB(t)
+	dirPath := db.DatabasePath()
+	defer os.RemoveAll(dirPath)
+
+	defer func(registry []migration) {
+		migrations = registry
+	}(migrations)
+	migrations = append(migrations, migration{
+		name: "failing-migration",
+		migrate: func(_ context.Context, tx *bolt.Tx) error {
+			if err := tx.Bucket(migrationBucket).Put([]byte("partial"), []byte{0x01}); err != nil {
+				return err
+			}
+			return errors.New("migration failed")
+		},
+	})
+
+	if err := db.Close(); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := NewKVStore(context.Background(), dirPath); err == nil {
+		t.Fatal("Expected an error opening a database whose migration fails")
+	}
+
+	_, version, err := Migrations(dirPath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if version != LatestSchemaVersion()-1 {
+		t.Errorf("Wanted schema version %d, received %d", LatestSchemaVersion()-1, version)
+	}
+	boltDB, err := bolt.Open(path.Join(dirPath, databaseFileName), 0600, &bolt.Options{ReadOnly: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer boltDB.Close()
+	if err := boltDB.View(func(tx *bolt.Tx) error {
+		if tx.Bucket(migrationBucket).Get([]byte("partial")) != nil {
+			t.Error("The writes of the failed migration should be rolled back")
+		}
+		return nil
+	}); err != nil {
+		t.Fatal(err)
+	}
+}
@@ -6,57 +6,53 @@ import (
 
 	"github.com/boltdb/bolt"
 	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
-	"github.com/prysmaticlabs/prysm/beacon-chain/db/filters"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
 	"github.com/sirupsen/logrus"
+	"go.opencensus.io/trace"
 )
 
 var pruneStatesKey = []byte("prune-states")
 
-func (k *Store) pruneStates(ctx context.Context) error {
-	var pruned bool
+// pruneStates deletes the states before the last finalized checkpoint. It was run on every start
+// before schema versions were introduced, so it still skips databases it already pruned.
+func pruneStates(ctx context.Context, tx *bolt.Tx) error {
+	ctx, span := trace.StartSpan(ctx, "BeaconDB.pruneStates")
+	defer span.End()
 
-	k.db.View(func(tx *bolt.Tx) error {
-		bkt := tx.Bucket(migrationBucket)
-		v := bkt.Get(pruneStatesKey)
-		pruned = len(v) == 1 && v[0] == 0x01
-		return nil
-	})
-
-	if pruned {
+	bkt := tx.Bucket(migrationBucket)
+	v := bkt.Get(pruneStatesKey)
+	if len(v) == 1 && v[0] == 0x01 {
 		return nil
 	}
 
 	log := logrus.WithField("prefix", "kv")
 	log.Info("Pruning states before last finalized check point. This might take a while...")
 
-	roots, err := k.rootsToPrune(ctx)
+	roots, err := rootsToPrune(tx)
 	if err != nil {
 		return err
 	}
 
-	if err := k.DeleteStates(ctx, roots); err != nil {
+	if err := deleteStates(tx, roots); err != nil {
 		return err
 	}
 
-	return k.db.Update(func(tx *bolt.Tx) error {
-		bkt := tx.Bucket(migrationBucket)
-		return bkt.Put(pruneStatesKey, []byte{0x01})
-	})
+	return bkt.Put(pruneStatesKey, []byte{0x01})
 }
 
 // This retrieves the key roots needed to prune states
 // * Get last finalized check point
 // * Rewind end slot until it's not finalized root
 // * return roots between slot 1 and end slot
-func (k *Store) rootsToPrune(ctx context.Context) ([][32]byte, error) {
-	cp, err := k.FinalizedCheckpoint(ctx)
+func rootsToPrune(tx *bolt.Tx) ([][32]byte, error) {
+	cp, err := finalizedCheckpoint(tx)
 	if err != nil {
 		return nil, err
 	}
-	f := filters.NewFilter().SetStartSlot(1).SetEndSlot(helpers.StartSlot(cp.Epoch))
-	roots, err := k.BlockRoots(ctx, f)
-	if err != nil {
-		return nil, err
+	keys := fetchBlockRootsBySlotRange(tx.Bucket(blockSlotIndicesBucket), uint64(1), helpers.StartSlot(cp.Epoch), nil, nil)
+	roots := make([][32]byte, len(keys))
+	for i, key := range keys {
+		roots[i] = bytesutil.ToBytes32(key)
 	}
 	// Ensure we don't delete finalized root
 	i := 0
@@ -20,6 +20,7 @@ var (
 	archivedCommitteeInfoBucket          = []byte("archived-committee-info")
 	archivedBalancesBucket               = []byte("archived-balances")
 	archivedValidatorParticipationBucket = []byte("archived-validator-participation")
+	archivedValidatorRewardsBucket       = []byte("archived-validator-rewards")
 	powchainBucket                       = []byte("powchain")
 
 	// Key indices buckets.
@@ -35,11 +36,16 @@ var (
 	// Specific item keys.
 	headBlockRootKey          = []byte("head-root")
 	genesisBlockRootKey       = []byte("genesis-root")
+	originBlockRootKey        = []byte("origin-root")
+	backfillBlockRootKey      = []byte("backfill-root")
 	depositContractAddressKey = []byte("deposit-contract")
 	justifiedCheckpointKey    = []byte("justified-checkpoint")
 	finalizedCheckpointKey    = []byte("finalized-checkpoint")
 	powchainDataKey           = []byte("powchain-data")
+	archiveBackfillEpochKey   = []byte("archive-backfill-epoch")
 
 	// Migration bucket.
-	migrationBucket = []byte("migrations")
+	migrationBucket        = []byte("migrations")
+	schemaVersionKey       = []byte("schema-version")
+	migrationAppliedPrefix = []byte("applied-")
 )
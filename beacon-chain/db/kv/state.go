@@ -149,34 +149,36 @@ func (k *Store) DeleteStates(ctx context.Context, blockRoots [][32]byte) error {
 	defer span.End()
 
 	return k.db.Batch(func(tx *bolt.Tx) error {
-		bkt := tx.Bucket(blocksBucket)
-		genesisBlockRoot := bkt.Get(genesisBlockRootKey)
+		return deleteStates(tx, blockRoots)
+	})
+}
 
-		bkt = tx.Bucket(checkpointBucket)
-		enc := bkt.Get(finalizedCheckpointKey)
-		checkpoint := &ethpb.Checkpoint{}
-		if enc == nil {
-			checkpoint = &ethpb.Checkpoint{Root: genesisBlockRoot}
-		} else if err := decode(enc, checkpoint); err != nil {
-			return err
-		}
+// deleteStates by block roots in the transaction, refusing to delete the genesis, finalized or
+// head state.
+func deleteStates(tx *bolt.Tx, blockRoots [][32]byte) error {
+	bkt := tx.Bucket(blocksBucket)
+	genesisBlockRoot := bkt.Get(genesisBlockRootKey)
 
-		bkt = tx.Bucket(blocksBucket)
-		headBlkRoot := bkt.Get(headBlockRootKey)
+	checkpoint, err := finalizedCheckpoint(tx)
+	if err != nil {
+		return err
+	}
 
-		for _, blockRoot := range blockRoots {
-			// Safe guard against deleting genesis, finalized, or head state.
-			if bytes.Equal(blockRoot[:], checkpoint.Root) || bytes.Equal(blockRoot[:], genesisBlockRoot) || bytes.Equal(blockRoot[:], headBlkRoot) {
-				return errors.New("could not delete genesis, finalized, or head state")
-			}
+	bkt = tx.Bucket(blocksBucket)
+	headBlkRoot := bkt.Get(headBlockRootKey)
 
-			bkt = tx.Bucket(stateBucket)
-			if err := bkt.Delete(blockRoot[:]); err != nil {
-				return err
-			}
+	for _, blockRoot := range blockRoots {
+		// Safe guard against deleting genesis, finalized, or head state.
+		if bytes.Equal(blockRoot[:], checkpoint.Root) || bytes.Equal(blockRoot[:], genesisBlockRoot) || bytes.Equal(blockRoot[:], headBlkRoot) {
+			return errors.New("could not delete genesis, finalized, or head state")
 		}
-		return nil
-	})
+
+		bkt = tx.Bucket(stateBucket)
+		if err := bkt.Delete(blockRoot[:]); err != nil {
+			return err
+		}
+	}
+	return nil
 }
 
 // creates state from marshaled proto state bytes.
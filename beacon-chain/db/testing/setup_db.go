@@ -1,6 +1,7 @@
 package testing
 
 import (
+	"context"
 	"crypto/rand"
 	"fmt"
 	"math/big"
@@ -23,7 +24,7 @@ func SetupDB(t testing.TB) db.Database {
 	if err := os.RemoveAll(p); err != nil {
 		t.Fatalf("failed to remove directory: %v", err)
 	}
-	s, err := kv.NewKVStore(p)
+	s, err := kv.NewKVStore(context.Background(), p)
 	if err != nil {
 		t.Fatal(err)
 	}
@@ -0,0 +1,40 @@
+package main
+
+import (
+	"fmt"
+	"os"
+	"path"
+	"text/tabwriter"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/beacon-chain/db/kv"
+	"github.com/prysmaticlabs/prysm/beacon-chain/node"
+	"github.com/prysmaticlabs/prysm/shared/cmd"
+	"github.com/urfave/cli"
+)
+
+// listMigrations prints the schema migrations of the beacon chain database in the data directory,
+// and when they were applied.
+func listMigrations(ctx *cli.Context) error {
+	dbPath := path.Join(ctx.String(cmd.DataDirFlag.Name), node.BeaconChainDBName)
+	statuses, version, err := kv.Migrations(dbPath)
+	if err != nil {
+		return errors.Wrapf(err, "could not read migrations of database at %s", dbPath)
+	}
+
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintf(w, "Schema version %d, latest known version %d\n", version, kv.LatestSchemaVersion())
+	fmt.Fprintln(w, "VERSION\tNAME\tAPPLIED")
+	for _, status := range statuses {
+		applied := "no"
+		if status.Applied {
+			applied = "yes"
+			if !status.AppliedAt.IsZero() {
+				applied = status.AppliedAt.Format(time.RFC3339)
+			}
+		}
+		fmt.Fprintf(w, "%d\t%s\t%s\n", status.Version, status.Name, applied)
+	}
+	return w.Flush()
+}
@@ -0,0 +1,25 @@
+package main
+
+import (
+	"path"
+
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/beacon-chain/db/kv"
+	"github.com/prysmaticlabs/prysm/beacon-chain/flags"
+	"github.com/prysmaticlabs/prysm/beacon-chain/node"
+	"github.com/prysmaticlabs/prysm/shared/cmd"
+	"github.com/urfave/cli"
+)
+
+// restoreBackup replaces the beacon chain database in the data directory with the chosen backup.
+func restoreBackup(ctx *cli.Context) error {
+	backupPath := ctx.String(flags.BackupFileFlag.Name)
+	if backupPath == "" {
+		return errors.Errorf("--%s is required", flags.BackupFileFlag.Name)
+	}
+	dbPath := path.Join(ctx.String(cmd.DataDirFlag.Name), node.BeaconChainDBName)
+	if err := kv.RestoreBackup(dbPath, backupPath); err != nil {
+		return errors.Wrapf(err, "could not restore backup %s to database at %s", backupPath, dbPath)
+	}
+	return nil
+}
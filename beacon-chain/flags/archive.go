@@ -1,6 +1,8 @@
 package flags
 
 import (
+	"time"
+
 	"github.com/urfave/cli"
 )
 
@@ -29,4 +31,16 @@ var (
 		Name:  "archive-attestations",
 		Usage: "Whether or not beacon chain should archive historical blocks",
 	}
+	// ArchiveBackfillFlag defines whether or not the beacon chain should archive the epochs
+	// which were finalized before archiving was enabled.
+	ArchiveBackfillFlag = cli.BoolFlag{
+		Name:  "archive-backfill",
+		Usage: "Whether or not beacon chain should archive the finalized epochs from before archiving was enabled, by replaying the stored blocks in the background",
+	}
+	// ArchiveBackfillDelayFlag throttles the archive backfill.
+	ArchiveBackfillDelayFlag = cli.DurationFlag{
+		Name:  "archive-backfill-delay",
+		Usage: "The time to wait between two epochs archived by the archive backfill, to limit its load on the node",
+		Value: 100 * time.Millisecond,
+	}
 )
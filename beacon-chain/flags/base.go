@@ -56,6 +56,28 @@ var (
 		Usage: "The required number of valid peers to connect with before syncing.",
 		Value: 3,
 	}
+	// SlotsPerArchivedPoint specifies the slot interval at which finalized states are stored in full.
+	SlotsPerArchivedPoint = cli.IntFlag{
+		Name:  "slots-per-archive-point",
+		Usage: "The slot interval at which finalized states are stored in full. States in between are regenerated by replaying blocks.",
+		Value: 2048,
+	}
+	// BackupEpochsFlag specifies the epoch interval at which the database is backed up.
+	BackupEpochsFlag = cli.Uint64Flag{
+		Name:  "db-backup-epochs",
+		Usage: "The epoch interval at which the database is backed up to the backups directory of the data directory. 0 disables scheduled backups.",
+	}
+	// BackupRetentionFlag specifies the number of database backups to keep.
+	BackupRetentionFlag = cli.IntFlag{
+		Name:  "db-backup-retention",
+		Usage: "The number of most recent database backups to keep, older backups are removed after every scheduled backup. 0 keeps all backups.",
+		Value: 5,
+	}
+	// BackupFileFlag specifies the database backup to restore.
+	BackupFileFlag = cli.StringFlag{
+		Name:  "backup-file",
+		Usage: "Path of the database backup to restore.",
+	}
 	// SlasherCertFlag defines a flag for the slasher TLS certificate.
 	SlasherCertFlag = cli.StringFlag{
 		Name:  "slasher-tls-cert",
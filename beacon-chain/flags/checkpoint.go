@@ -0,0 +1,32 @@
+package flags
+
+import (
+	"github.com/urfave/cli"
+)
+
+var (
+	// CheckpointStateFlag defines a flag for the beacon node to start from a trusted finalized
+	// state loaded from a file, instead of replaying the chain from genesis.
+	CheckpointStateFlag = cli.StringFlag{
+		Name:  "checkpoint-state",
+		Usage: "The SSZ encoded finalized beacon state file (.SSZ) to start syncing from. Requires --checkpoint-block",
+	}
+	// CheckpointBlockFlag defines a flag for the SSZ encoded signed block of the trusted
+	// finalized state.
+	CheckpointBlockFlag = cli.StringFlag{
+		Name:  "checkpoint-block",
+		Usage: "The SSZ encoded signed beacon block file (.SSZ) of the checkpoint state",
+	}
+	// CheckpointNodeFlag defines a flag for the gRPC endpoint of a trusted beacon node to fetch
+	// its finalized state and block from, instead of replaying the chain from genesis.
+	CheckpointNodeFlag = cli.StringFlag{
+		Name:  "checkpoint-node",
+		Usage: "The gRPC endpoint of a trusted beacon node to fetch the finalized checkpoint state from, e.g. 127.0.0.1:4000",
+	}
+	// CheckpointBackfillFlag defines whether the beacon node should fetch the blocks older than
+	// its checkpoint from peers in the background.
+	CheckpointBackfillFlag = cli.BoolFlag{
+		Name:  "checkpoint-backfill",
+		Usage: "Whether or not the beacon node should backfill the blocks older than its checkpoint state from peers",
+	}
+)
@@ -1,6 +1,8 @@
 package flags
 
 import (
+	"time"
+
 	"github.com/prysmaticlabs/prysm/shared/cmd"
 	log "github.com/sirupsen/logrus"
 	"github.com/urfave/cli"
@@ -13,7 +15,12 @@ type GlobalFlags struct {
 	EnableArchivedValidatorSetChanges bool
 	EnableArchivedBlocks              bool
 	EnableArchivedAttestations        bool
+	EnableArchiveBackfill             bool
+	ArchiveBackfillDelay              time.Duration
 	MinimumSyncPeers                  int
+	SlotsPerArchivedPoint             uint64
+	BackupEpochs                      uint64
+	BackupRetention                   int
 }
 
 var globalConfig *GlobalFlags
@@ -47,6 +54,13 @@ func ConfigureGlobalFlags(ctx *cli.Context) {
 	if ctx.GlobalBool(ArchiveAttestationsFlag.Name) {
 		cfg.EnableArchivedAttestations = true
 	}
+	if ctx.GlobalBool(ArchiveBackfillFlag.Name) {
+		cfg.EnableArchiveBackfill = true
+	}
+	cfg.ArchiveBackfillDelay = ctx.GlobalDuration(ArchiveBackfillDelayFlag.Name)
+	cfg.SlotsPerArchivedPoint = uint64(ctx.GlobalInt(SlotsPerArchivedPoint.Name))
+	cfg.BackupEpochs = ctx.GlobalUint64(BackupEpochsFlag.Name)
+	cfg.BackupRetention = ctx.GlobalInt(BackupRetentionFlag.Name)
 	configureMinimumPeers(ctx, cfg)
 
 	Init(cfg)
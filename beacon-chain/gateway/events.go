@@ -0,0 +1,258 @@
+package gateway
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"net/http"
+	"sort"
+	"strings"
+
+	gwruntime "github.com/grpc-ecosystem/grpc-gateway/runtime"
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/beacon-chain/blockchain"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/feed"
+	opfeed "github.com/prysmaticlabs/prysm/beacon-chain/core/feed/operation"
+	statefeed "github.com/prysmaticlabs/prysm/beacon-chain/core/feed/state"
+)
+
+// Topics of the events which may be subscribed to.
+const (
+	headTopic                = "head"
+	blockTopic               = "block"
+	attestationTopic         = "attestation"
+	exitTopic                = "exit"
+	finalizedCheckpointTopic = "finalized_checkpoint"
+	chainReorgTopic          = "chain_reorg"
+)
+
+var eventTopics = map[string]bool{
+	headTopic:                true,
+	blockTopic:               true,
+	attestationTopic:         true,
+	exitTopic:                true,
+	finalizedCheckpointTopic: true,
+	chainReorgTopic:          true,
+}
+
+// eventsBufferSize is the number of events which may wait to be written to a subscriber. Events
+// are dropped for a subscriber which falls further behind, so it cannot hold back the feeds.
+const eventsBufferSize = 256
+
+// EventsConfig of the sources of the chain events served by the gateway.
+type EventsConfig struct {
+	StateNotifier       statefeed.Notifier
+	OperationNotifier   opfeed.Notifier
+	HeadFetcher         blockchain.HeadFetcher
+	FinalizationFetcher blockchain.FinalizationFetcher
+}
+
+// headEvent is sent when the head of the chain changes.
+type headEvent struct {
+	Slot  uint64 `json:"slot,string"`
+	Block []byte `json:"block"`
+	State []byte `json:"state"`
+}
+
+// blockEvent is sent for every processed block.
+type blockEvent struct {
+	Slot     uint64 `json:"slot,string"`
+	Block    []byte `json:"block"`
+	Verified bool   `json:"verified"`
+}
+
+// chainReorgEvent is sent when the new head of the chain is not a descendant of the previous head.
+type chainReorgEvent struct {
+	OldHeadBlock       []byte `json:"oldHeadBlock"`
+	OldHeadSlot        uint64 `json:"oldHeadSlot,string"`
+	NewHeadBlock       []byte `json:"newHeadBlock"`
+	NewHeadSlot        uint64 `json:"newHeadSlot,string"`
+	CommonAncestorSlot uint64 `json:"commonAncestorSlot,string"`
+	Depth              uint64 `json:"depth,string"`
+}
+
+// chainEvent to be written to a subscriber.
+type chainEvent struct {
+	topic string
+	data  interface{}
+}
+
+// eventsHandler streams the chain events of the requested topics as server-sent events.
+type eventsHandler struct {
+	ctx       context.Context
+	cfg       *EventsConfig
+	marshaler *gwruntime.JSONPb
+}
+
+func newEventsHandler(ctx context.Context, cfg *EventsConfig) *eventsHandler {
+	return &eventsHandler{
+		ctx:       ctx,
+		cfg:       cfg,
+		marshaler: &gwruntime.JSONPb{OrigName: false, EmitDefaults: true},
+	}
+}
+
+// parseTopics from the topics query parameters, which may be repeated or comma separated. All
+// topics are subscribed to when none is requested.
+func parseTopics(params []string) (map[string]bool, error) {
+	topics := make(map[string]bool)
+	for _, param := range params {
+		for _, topic := range strings.Split(param, ",") {
+			topic = strings.TrimSpace(topic)
+			if topic == "" {
+				continue
+			}
+			if !eventTopics[topic] {
+				valid := make([]string, 0, len(eventTopics))
+				for t := range eventTopics {
+					valid = append(valid, t)
+				}
+				sort.Strings(valid)
+				return nil, errors.Errorf("unknown topic %q, valid topics are %s", topic, strings.Join(valid, ", "))
+			}
+			topics[topic] = true
+		}
+	}
+	if len(topics) == 0 {
+		return eventTopics, nil
+	}
+	return topics, nil
+}
+
+// ServeHTTP streams the events until the subscriber disconnects or the gateway stops.
+func (h *eventsHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	topics, err := parseTopics(r.URL.Query()["topics"])
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	flusher, ok := w.(http.Flusher)
+	if !ok {
+		http.Error(w, "streaming is not supported", http.StatusInternalServerError)
+		return
+	}
+	ctx := r.Context()
+
+	stateChannel := make(chan *feed.Event, 1)
+	stateSub := h.cfg.StateNotifier.StateFeed().Subscribe(stateChannel)
+	defer stateSub.Unsubscribe()
+	opChannel := make(chan *feed.Event, 1)
+	opSub := h.cfg.OperationNotifier.OperationFeed().Subscribe(opChannel)
+	defer opSub.Unsubscribe()
+	events := make(chan *chainEvent, eventsBufferSize)
+	var finalizedEpoch uint64
+	if cp := h.cfg.FinalizationFetcher.FinalizedCheckpt(); cp != nil {
+		finalizedEpoch = cp.Epoch
+	}
+	go h.collectEvents(ctx, topics, h.cfg.HeadFetcher.HeadRoot(), finalizedEpoch, stateChannel, opChannel, events)
+
+	w.Header().Set("Content-Type", "text/event-stream")
+	w.Header().Set("Cache-Control", "no-cache")
+	w.Header().Set("Connection", "keep-alive")
+	w.WriteHeader(http.StatusOK)
+	flusher.Flush()
+
+	for {
+		select {
+		case event := <-events:
+			data, err := h.marshaler.Marshal(event.data)
+			if err != nil {
+				log.WithError(err).Error("Could not marshal event")
+				continue
+			}
+			if _, err := fmt.Fprintf(w, "event: %s\ndata: %s\n\n", event.topic, data); err != nil {
+				log.WithError(err).Debug("Could not write event, closing stream")
+				return
+			}
+			flusher.Flush()
+		case err := <-stateSub.Err():
+			log.WithError(err).Error("Subscription to state feed notifier failed")
+			return
+		case err := <-opSub.Err():
+			log.WithError(err).Error("Subscription to operation feed notifier failed")
+			return
+		case <-ctx.Done():
+			return
+		case <-h.ctx.Done():
+			return
+		}
+	}
+}
+
+// collectEvents turns the feed events into the chain events of the subscribed topics, starting
+// from the given head root and finalized epoch. The feeds are always drained, and events are
+// dropped once the buffer of the subscriber is full.
+func (h *eventsHandler) collectEvents(
+	ctx context.Context,
+	topics map[string]bool,
+	headRoot []byte,
+	finalizedEpoch uint64,
+	stateChannel <-chan *feed.Event,
+	opChannel <-chan *feed.Event,
+	events chan<- *chainEvent,
+) {
+	send := func(topic string, data interface{}) {
+		if !topics[topic] {
+			return
+		}
+		select {
+		case events <- &chainEvent{topic: topic, data: data}:
+		default:
+			log.WithField("topic", topic).Debug("Subscriber is too slow, dropping event")
+		}
+	}
+
+	for {
+		select {
+		case event := <-stateChannel:
+			if event.Type == statefeed.Reorg {
+				data := event.Data.(*statefeed.ReorgData)
+				send(chainReorgTopic, &chainReorgEvent{
+					OldHeadBlock:       data.OldHeadRoot[:],
+					OldHeadSlot:        data.OldHeadSlot,
+					NewHeadBlock:       data.NewHeadRoot[:],
+					NewHeadSlot:        data.NewHeadSlot,
+					CommonAncestorSlot: data.CommonAncestorSlot,
+					Depth:              data.Depth,
+				})
+				continue
+			}
+			if event.Type != statefeed.BlockProcessed {
+				continue
+			}
+			data := event.Data.(*statefeed.BlockProcessedData)
+			send(blockTopic, &blockEvent{
+				Slot:     data.Slot,
+				Block:    data.BlockRoot[:],
+				Verified: data.Verified,
+			})
+			if root := h.cfg.HeadFetcher.HeadRoot(); !bytes.Equal(root, headRoot) {
+				headRoot = root
+				if head := h.cfg.HeadFetcher.HeadBlock(); head != nil && head.Block != nil {
+					send(headTopic, &headEvent{
+						Slot:  head.Block.Slot,
+						Block: root,
+						State: head.Block.StateRoot,
+					})
+				}
+			}
+			if cp := h.cfg.FinalizationFetcher.FinalizedCheckpt(); cp != nil && cp.Epoch > finalizedEpoch {
+				finalizedEpoch = cp.Epoch
+				send(finalizedCheckpointTopic, cp)
+			}
+		case event := <-opChannel:
+			switch data := event.Data.(type) {
+			case *opfeed.UnAggregatedAttReceivedData:
+				send(attestationTopic, data.Attestation)
+			case *opfeed.AggregatedAttReceivedData:
+				if data.Attestation != nil {
+					send(attestationTopic, data.Attestation.Aggregate)
+				}
+			case *opfeed.ExitReceivedData:
+				send(exitTopic, data.Exit)
+			}
+		case <-ctx.Done():
+			return
+		}
+	}
+}
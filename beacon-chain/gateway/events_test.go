@@ -0,0 +1,113 @@
+package gateway
+
+import (
+	"bufio"
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	mock "github.com/prysmaticlabs/prysm/beacon-chain/blockchain/testing"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/feed"
+	opfeed "github.com/prysmaticlabs/prysm/beacon-chain/core/feed/operation"
+	statefeed "github.com/prysmaticlabs/prysm/beacon-chain/core/feed/state"
+)
+
+func TestParseTopics(t *testing.T) {
+	topics, err := parseTopics([]string{"head,block", " exit"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(topics) != 3 || !topics[headTopic] || !topics[blockTopic] || !topics[exitTopic] {
+		t.Errorf("Unexpected topics %v", topics)
+	}
+	topics, err = parseTopics(nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(topics) != len(eventTopics) {
+		t.Errorf("Wanted all %d topics by default, received %d", len(eventTopics), len(topics))
+	}
+	if _, err := parseTopics([]string{"head,foo"}); err == nil {
+		t.Error("Expected an error for an unknown topic")
+	}
+}
+
+func TestEventsHandler_StreamsSubscribedTopics(t *testing.T) {
+	chainService := &mock.ChainService{
+		Root:                []byte("old head"),
+		FinalizedCheckPoint: &ethpb.Checkpoint{Epoch: 1},
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	server := httptest.NewServer(newEventsHandler(ctx, &EventsConfig{
+		StateNotifier:       chainService.StateNotifier(),
+		OperationNotifier:   chainService.OperationNotifier(),
+		HeadFetcher:         chainService,
+		FinalizationFetcher: chainService,
+	}))
+	defer server.Close()
+
+	client := &http.Client{Timeout: 5 * time.Second}
+	resp, err := client.Get(server.URL + "?topics=head,exit")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		t.Fatalf("Wanted status %d, received %d", http.StatusOK, resp.StatusCode)
+	}
+	if ct := resp.Header.Get("Content-Type"); ct != "text/event-stream" {
+		t.Errorf("Wanted event stream content type, received %s", ct)
+	}
+
+	// The block event is not subscribed to, but the block changed the head.
+	chainService.Root = []byte("new head")
+	chainService.Block = &ethpb.SignedBeaconBlock{Block: &ethpb.BeaconBlock{Slot: 5}}
+	chainService.StateNotifier().StateFeed().Send(&feed.Event{
+		Type: statefeed.BlockProcessed,
+		Data: &statefeed.BlockProcessedData{Slot: 5, BlockRoot: [32]byte{'a'}, Verified: true},
+	})
+	chainService.OperationNotifier().OperationFeed().Send(&feed.Event{
+		Type: opfeed.ExitReceived,
+		Data: &opfeed.ExitReceivedData{
+			Exit: &ethpb.SignedVoluntaryExit{Exit: &ethpb.VoluntaryExit{ValidatorIndex: 7}},
+		},
+	})
+
+	reader := bufio.NewReader(resp.Body)
+	var lines []string
+	for len(lines) < 4 {
+		line, err := reader.ReadString('\n')
+		if err != nil {
+			t.Fatal(err)
+		}
+		if line = strings.TrimSpace(line); line != "" {
+			lines = append(lines, line)
+		}
+	}
+	if lines[0] != "event: head" || !strings.Contains(lines[1], `"slot":"5"`) {
+		t.Errorf("Unexpected head event %v", lines[:2])
+	}
+	if lines[2] != "event: exit" || !strings.Contains(lines[3], `"validatorIndex":"7"`) {
+		t.Errorf("Unexpected exit event %v", lines[2:])
+	}
+}
+
+func TestEventsHandler_UnknownTopic(t *testing.T) {
+	chainService := &mock.ChainService{}
+	handler := newEventsHandler(context.Background(), &EventsConfig{
+		StateNotifier:       chainService.StateNotifier(),
+		OperationNotifier:   chainService.OperationNotifier(),
+		HeadFetcher:         chainService,
+		FinalizationFetcher: chainService,
+	})
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/events?topics=foo", nil))
+	if rec.Code != http.StatusBadRequest {
+		t.Errorf("Wanted status %d, received %d", http.StatusBadRequest, rec.Code)
+	}
+}
@@ -26,6 +26,7 @@ type Gateway struct {
 	remoteAddr  string
 	server      *http.Server
 	mux         *http.ServeMux
+	events      *EventsConfig
 
 	startFailure error
 }
@@ -61,6 +62,9 @@ func (g *Gateway) Start() {
 	}
 
 	g.mux.Handle("/", gwmux)
+	if g.events != nil {
+		g.mux.Handle("/events", newEventsHandler(ctx, g.events))
+	}
 
 	g.server = &http.Server{
 		Addr:    g.gatewayAddr,
@@ -92,17 +96,24 @@ func (g *Gateway) Status() error {
 
 // Stop the gateway with a graceful shutdown.
 func (g *Gateway) Stop() error {
-	if err := g.server.Shutdown(g.ctx); err != nil {
-		log.WithError(err).Error("Failed to shut down server")
-	}
-
+	// Canceling first ends the event streams, which would otherwise keep the shutdown waiting.
 	if g.cancel != nil {
 		g.cancel()
 	}
 
+	if err := g.server.Shutdown(g.ctx); err != nil {
+		log.WithError(err).Error("Failed to shut down server")
+	}
+
 	return nil
 }
 
+// EnableEvents serves the chain events from the given sources as server-sent events at /events.
+// It must be called before the gateway is started.
+func (g *Gateway) EnableEvents(cfg *EventsConfig) {
+	g.events = cfg
+}
+
 // New returns a new gateway server which translates HTTP into gRPC.
 // Accepts a context and optional http.ServeMux.
 func New(ctx context.Context, remoteAddress, gatewayAddress string, mux *http.ServeMux) *Gateway {
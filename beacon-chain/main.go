@@ -33,6 +33,9 @@ var appFlags = []cli.Flag{
 	flags.KeyFlag,
 	flags.GRPCGatewayPort,
 	flags.MinSyncPeers,
+	flags.SlotsPerArchivedPoint,
+	flags.BackupEpochsFlag,
+	flags.BackupRetentionFlag,
 	flags.InteropMockEth1DataVotesFlag,
 	flags.InteropGenesisStateFlag,
 	flags.InteropNumValidatorsFlag,
@@ -41,6 +44,12 @@ var appFlags = []cli.Flag{
 	flags.ArchiveValidatorSetChangesFlag,
 	flags.ArchiveBlocksFlag,
 	flags.ArchiveAttestationsFlag,
+	flags.ArchiveBackfillFlag,
+	flags.ArchiveBackfillDelayFlag,
+	flags.CheckpointStateFlag,
+	flags.CheckpointBlockFlag,
+	flags.CheckpointNodeFlag,
+	flags.CheckpointBackfillFlag,
 	cmd.BootstrapNode,
 	cmd.NoDiscovery,
 	cmd.StaticPeers,
@@ -51,6 +60,9 @@ var appFlags = []cli.Flag{
 	cmd.P2PMaxPeers,
 	cmd.P2PPrivKey,
 	cmd.P2PWhitelist,
+	cmd.P2PPeerDisconnectScore,
+	cmd.P2PPeerBanScore,
+	cmd.P2PPeerBanDuration,
 	cmd.P2PEncoding,
 	cmd.DataDirFlag,
 	cmd.VerbosityFlag,
@@ -85,6 +97,42 @@ func main() {
 	app.Usage = "this is a beacon chain implementation for Ethereum 2.0"
 	app.Action = startNode
 	app.Version = version.GetVersion()
+	app.Commands = []cli.Command{
+		{
+			Name:     "db",
+			Category: "db",
+			Usage:    "defines useful functions for inspecting and restoring the beacon chain database",
+			Subcommands: cli.Commands{
+				cli.Command{
+					Name: "migrations",
+					Description: `lists the schema migrations known by this node and whether they were applied to the
+beacon chain database in the data directory, which must not be in use by a running node`,
+					Flags: []cli.Flag{
+						cmd.DataDirFlag,
+					},
+					Action: func(ctx *cli.Context) {
+						if err := listMigrations(ctx); err != nil {
+							log.WithError(err).Fatal("Could not list database migrations")
+						}
+					},
+				},
+				cli.Command{
+					Name: "restore",
+					Description: `verifies the chosen backup and replaces the beacon chain database in the data directory
+with it, keeping the replaced database next to it. The database must not be in use by a running node`,
+					Flags: []cli.Flag{
+						cmd.DataDirFlag,
+						flags.BackupFileFlag,
+					},
+					Action: func(ctx *cli.Context) {
+						if err := restoreBackup(ctx); err != nil {
+							log.WithError(err).Fatal("Could not restore database backup")
+						}
+					},
+				},
+			},
+		},
+	}
 
 	app.Flags = appFlags
 
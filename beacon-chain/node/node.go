@@ -17,17 +17,23 @@ import (
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/pkg/errors"
 	"github.com/prysmaticlabs/prysm/beacon-chain/archiver"
+	"github.com/prysmaticlabs/prysm/beacon-chain/backup"
 	"github.com/prysmaticlabs/prysm/beacon-chain/blockchain"
 	"github.com/prysmaticlabs/prysm/beacon-chain/cache/depositcache"
 	"github.com/prysmaticlabs/prysm/beacon-chain/db"
+	"github.com/prysmaticlabs/prysm/beacon-chain/db/export"
 	"github.com/prysmaticlabs/prysm/beacon-chain/flags"
 	"github.com/prysmaticlabs/prysm/beacon-chain/gateway"
 	interopcoldstart "github.com/prysmaticlabs/prysm/beacon-chain/interop-cold-start"
 	"github.com/prysmaticlabs/prysm/beacon-chain/operations/attestations"
+	"github.com/prysmaticlabs/prysm/beacon-chain/operations/slashings"
+	"github.com/prysmaticlabs/prysm/beacon-chain/operations/voluntaryexits"
 	"github.com/prysmaticlabs/prysm/beacon-chain/p2p"
+	"github.com/prysmaticlabs/prysm/beacon-chain/p2p/peers"
 	"github.com/prysmaticlabs/prysm/beacon-chain/powchain"
 	"github.com/prysmaticlabs/prysm/beacon-chain/rpc"
 	prysmsync "github.com/prysmaticlabs/prysm/beacon-chain/sync"
+	"github.com/prysmaticlabs/prysm/beacon-chain/sync/checkpoint"
 	initialsync "github.com/prysmaticlabs/prysm/beacon-chain/sync/initial-sync"
 	"github.com/prysmaticlabs/prysm/shared"
 	"github.com/prysmaticlabs/prysm/shared/cmd"
@@ -45,7 +51,9 @@ import (
 
 var log = logrus.WithField("prefix", "node")
 
-const beaconChainDBName = "beaconchaindata"
+// BeaconChainDBName is the directory of the beacon chain database within the data directory.
+const BeaconChainDBName = "beaconchaindata"
+
 const testSkipPowFlag = "test-skip-pow"
 
 // BeaconNode defines a struct that handles the services running a random beacon chain
@@ -58,9 +66,12 @@ type BeaconNode struct {
 	stop            chan struct{} // Channel to wait for termination notifications.
 	db              db.Database
 	attestationPool attestations.Pool
+	exitPool        *voluntaryexits.Pool
+	slashingsPool   *slashings.Pool
 	depositCache    *depositcache.DepositCache
 	stateFeed       *event.Feed
 	opFeed          *event.Feed
+	exportStream    *export.StreamSink
 }
 
 // NewBeaconNode creates a new node instance, sets up configuration options, and registers
@@ -101,6 +112,8 @@ func NewBeaconNode(ctx *cli.Context) (*BeaconNode, error) {
 		stateFeed:       new(event.Feed),
 		opFeed:          new(event.Feed),
 		attestationPool: attestations.NewPool(),
+		exitPool:        voluntaryexits.NewPool(),
+		slashingsPool:   slashings.NewPool(),
 	}
 
 	if err := beacon.startDB(ctx); err != nil {
@@ -147,6 +160,10 @@ func NewBeaconNode(ctx *cli.Context) (*BeaconNode, error) {
 		return nil, err
 	}
 
+	if err := beacon.registerBackupService(ctx); err != nil {
+		return nil, err
+	}
+
 	if !ctx.GlobalBool(cmd.DisableMonitoringFlag.Name) {
 		if err := beacon.registerPrometheusService(ctx); err != nil {
 			return nil, err
@@ -215,11 +232,11 @@ func (b *BeaconNode) Close() {
 
 func (b *BeaconNode) startDB(ctx *cli.Context) error {
 	baseDir := ctx.GlobalString(cmd.DataDirFlag.Name)
-	dbPath := path.Join(baseDir, beaconChainDBName)
+	dbPath := path.Join(baseDir, BeaconChainDBName)
 	clearDB := ctx.GlobalBool(cmd.ClearDB.Name)
 	forceClearDB := ctx.GlobalBool(cmd.ForceClearDB.Name)
 
-	d, err := db.NewDB(dbPath)
+	d, err := b.openDB(dbPath)
 	if err != nil {
 		return err
 	}
@@ -238,7 +255,12 @@ func (b *BeaconNode) startDB(ctx *cli.Context) error {
 		if err := d.ClearDB(); err != nil {
 			return err
 		}
-		d, err = db.NewDB(dbPath)
+		// Closing the cleared db also stops its exporter and closes its export sinks, which are
+		// created again with the new db.
+		if err := d.Close(); err != nil {
+			return err
+		}
+		d, err = b.openDB(dbPath)
 		if err != nil {
 			return err
 		}
@@ -249,6 +271,17 @@ func (b *BeaconNode) startDB(ctx *cli.Context) error {
 	return nil
 }
 
+// openDB at the path, exporting the saved objects to a new stream sink if the export stream is
+// enabled.
+func (b *BeaconNode) openDB(dbPath string) (db.Database, error) {
+	var sinks []export.Sink
+	if featureconfig.Get().EnableExportStream {
+		b.exportStream = export.NewStreamSink()
+		sinks = append(sinks, b.exportStream)
+	}
+	return db.NewDB(context.Background(), dbPath, sinks...)
+}
+
 func (b *BeaconNode) registerP2P(ctx *cli.Context) error {
 	// Bootnode ENR may be a filepath to an ENR file.
 	bootnodeAddrs := strings.Split(ctx.GlobalString(cmd.BootstrapNode.Name), ",")
@@ -262,6 +295,19 @@ func (b *BeaconNode) registerP2P(ctx *cli.Context) error {
 		}
 	}
 
+	// Only the flags which are set override the default scoring, so any value, zero included,
+	// may be configured.
+	peerScoring := peers.DefaultScoringConfig()
+	if ctx.GlobalIsSet(cmd.P2PPeerDisconnectScore.Name) {
+		peerScoring.DisconnectThreshold = ctx.GlobalInt(cmd.P2PPeerDisconnectScore.Name)
+	}
+	if ctx.GlobalIsSet(cmd.P2PPeerBanScore.Name) {
+		peerScoring.BanThreshold = ctx.GlobalInt(cmd.P2PPeerBanScore.Name)
+	}
+	if ctx.GlobalIsSet(cmd.P2PPeerBanDuration.Name) {
+		peerScoring.BanDuration = ctx.GlobalDuration(cmd.P2PPeerBanDuration.Name)
+	}
+
 	svc, err := p2p.NewService(&p2p.Config{
 		NoDiscovery:       ctx.GlobalBool(cmd.NoDiscovery.Name),
 		StaticPeers:       sliceutil.SplitCommaSeparated(ctx.GlobalStringSlice(cmd.StaticPeers.Name)),
@@ -276,6 +322,7 @@ func (b *BeaconNode) registerP2P(ctx *cli.Context) error {
 		WhitelistCIDR:     ctx.GlobalString(cmd.P2PWhitelist.Name),
 		EnableUPnP:        ctx.GlobalBool(cmd.EnableUPnPFlag.Name),
 		Encoding:          ctx.GlobalString(cmd.P2PEncoding.Name),
+		PeerScoring:       peerScoring,
 	})
 	if err != nil {
 		return err
@@ -297,15 +344,23 @@ func (b *BeaconNode) registerBlockchainService(ctx *cli.Context) error {
 		return err
 	}
 
+	cp, err := b.loadCheckpoint(ctx)
+	if err != nil {
+		return errors.Wrap(err, "could not load checkpoint")
+	}
+
 	maxRoutines := ctx.GlobalInt64(cmd.MaxGoroutines.Name)
 	blockchainService, err := blockchain.NewService(context.Background(), &blockchain.Config{
 		BeaconDB:          b.db,
 		DepositCache:      b.depositCache,
 		ChainStartFetcher: web3Service,
 		AttPool:           b.attestationPool,
+		ExitPool:          b.exitPool,
+		SlashingPool:      b.slashingsPool,
 		P2p:               b.fetchP2P(ctx),
 		MaxRoutines:       maxRoutines,
 		StateNotifier:     b,
+		Checkpoint:        cp,
 	})
 	if err != nil {
 		return errors.Wrap(err, "could not register blockchain service")
@@ -313,6 +368,35 @@ func (b *BeaconNode) registerBlockchainService(ctx *cli.Context) error {
 	return b.services.RegisterService(blockchainService)
 }
 
+// loadCheckpoint loads the trusted finalized checkpoint to start syncing from, if one is
+// configured and the chain was not initialized yet.
+func (b *BeaconNode) loadCheckpoint(ctx *cli.Context) (*checkpoint.Checkpoint, error) {
+	statePath := ctx.GlobalString(flags.CheckpointStateFlag.Name)
+	blockPath := ctx.GlobalString(flags.CheckpointBlockFlag.Name)
+	endpoint := ctx.GlobalString(flags.CheckpointNodeFlag.Name)
+	if statePath == "" && blockPath == "" && endpoint == "" {
+		return nil, nil
+	}
+	if endpoint != "" && (statePath != "" || blockPath != "") {
+		return nil, errors.New("cannot load a checkpoint from both files and a trusted node")
+	}
+
+	headState, err := b.db.HeadState(context.Background())
+	if err != nil {
+		return nil, err
+	}
+	if headState != nil {
+		log.Warn("Blockchain data already exists in DB, ignoring checkpoint")
+		return nil, nil
+	}
+
+	if endpoint != "" {
+		log.WithField("endpoint", endpoint).Info("Fetching finalized checkpoint from trusted node")
+		return checkpoint.FromNode(context.Background(), endpoint)
+	}
+	return checkpoint.FromFiles(statePath, blockPath)
+}
+
 func (b *BeaconNode) registerAttestationPool(ctx *cli.Context) error {
 	attPoolService, err := attestations.NewService(context.Background(), &attestations.Config{
 		Pool: b.attestationPool,
@@ -385,12 +469,15 @@ func (b *BeaconNode) registerSyncService(ctx *cli.Context) error {
 	}
 
 	rs := prysmsync.NewRegularSync(&prysmsync.Config{
-		DB:            b.db,
-		P2P:           b.fetchP2P(ctx),
-		Chain:         chainService,
-		InitialSync:   initSync,
-		StateNotifier: b,
-		AttPool:       b.attestationPool,
+		DB:                b.db,
+		P2P:               b.fetchP2P(ctx),
+		Chain:             chainService,
+		InitialSync:       initSync,
+		StateNotifier:     b,
+		OperationNotifier: b,
+		AttPool:           b.attestationPool,
+		ExitPool:          b.exitPool,
+		SlashingPool:      b.slashingsPool,
 	})
 
 	return b.services.RegisterService(rs)
@@ -408,6 +495,7 @@ func (b *BeaconNode) registerInitialSyncService(ctx *cli.Context) error {
 		Chain:         chainService,
 		P2P:           b.fetchP2P(ctx),
 		StateNotifier: b,
+		Backfill:      ctx.GlobalBool(flags.CheckpointBackfillFlag.Name),
 	})
 
 	return b.services.RegisterService(is)
@@ -468,6 +556,8 @@ func (b *BeaconNode) registerRPCService(ctx *cli.Context) error {
 		AttestationReceiver:   chainService,
 		GenesisTimeFetcher:    chainService,
 		AttestationsPool:      b.attestationPool,
+		ExitPool:              b.exitPool,
+		SlashingsPool:         b.slashingsPool,
 		POWChainService:       web3Service,
 		ChainStartFetcher:     chainStartFetcher,
 		MockEth1Votes:         mockEth1DataVotes,
@@ -478,6 +568,8 @@ func (b *BeaconNode) registerRPCService(ctx *cli.Context) error {
 		OperationNotifier:     b,
 		SlasherCert:           slasherCert,
 		SlasherProvider:       slasherProvider,
+		ExportStream:          b.exportStream,
+		StateGen:              chainService.StateGen(),
 	})
 
 	return b.services.RegisterService(rpcService)
@@ -516,7 +608,18 @@ func (b *BeaconNode) registerGRPCGateway(ctx *cli.Context) error {
 	if gatewayPort > 0 {
 		selfAddress := fmt.Sprintf("127.0.0.1:%d", ctx.GlobalInt(flags.RPCPort.Name))
 		gatewayAddress := fmt.Sprintf("0.0.0.0:%d", gatewayPort)
-		return b.services.RegisterService(gateway.New(context.Background(), selfAddress, gatewayAddress, nil /*optional mux*/))
+		var chainService *blockchain.Service
+		if err := b.services.FetchService(&chainService); err != nil {
+			return err
+		}
+		gw := gateway.New(context.Background(), selfAddress, gatewayAddress, nil /*optional mux*/)
+		gw.EnableEvents(&gateway.EventsConfig{
+			StateNotifier:       b,
+			OperationNotifier:   b,
+			HeadFetcher:         chainService,
+			FinalizationFetcher: chainService,
+		})
+		return b.services.RegisterService(gw)
 	}
 	return nil
 }
@@ -553,6 +656,32 @@ func (b *BeaconNode) registerArchiverService(ctx *cli.Context) error {
 		HeadFetcher:          chainService,
 		ParticipationFetcher: chainService,
 		StateNotifier:        b,
+		Backfill:             flags.Get().EnableArchiveBackfill,
+		BackfillDelay:        flags.Get().ArchiveBackfillDelay,
+		StateGen:             chainService.StateGen(),
+	})
+	return b.services.RegisterService(svc)
+}
+
+func (b *BeaconNode) registerBackupService(ctx *cli.Context) error {
+	if flags.Get().BackupEpochs == 0 {
+		return nil
+	}
+	var chainService *blockchain.Service
+	if err := b.services.FetchService(&chainService); err != nil {
+		return err
+	}
+	var syncService *initialsync.Service
+	if err := b.services.FetchService(&syncService); err != nil {
+		return err
+	}
+	svc := backup.NewBackupService(context.Background(), &backup.Config{
+		BeaconDB:      b.db,
+		HeadFetcher:   chainService,
+		StateNotifier: b,
+		SyncChecker:   syncService,
+		Epochs:        flags.Get().BackupEpochs,
+		Retention:     flags.Get().BackupRetention,
 	})
 	return b.services.RegisterService(svc)
 }
@@ -0,0 +1,7 @@
+package slashings
+
+import (
+	"github.com/sirupsen/logrus"
+)
+
+var log = logrus.WithField("prefix", "pool/slashings")
@@ -0,0 +1,21 @@
+package slashings
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+var (
+	numPendingProposerSlashings = promauto.NewGauge(
+		prometheus.GaugeOpts{
+			Name: "num_pending_proposer_slashings",
+			Help: "Number of pending proposer slashings in the pool.",
+		},
+	)
+	numPendingAttesterSlashings = promauto.NewGauge(
+		prometheus.GaugeOpts{
+			Name: "num_pending_attester_slashings",
+			Help: "Number of pending attester slashings in the pool.",
+		},
+	)
+)
@@ -0,0 +1,245 @@
+package slashings
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"sync"
+
+	"github.com/gogo/protobuf/proto"
+	"github.com/pkg/errors"
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/blocks"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/sliceutil"
+	"go.opencensus.io/trace"
+)
+
+// Pool is a concurrency safe pool of proposer and attester slashings which are
+// waiting to be included in a beacon block. Pending proposer slashings are kept
+// sorted by proposer index and there is at most one per proposer.
+type Pool struct {
+	lock                     sync.RWMutex
+	pendingProposerSlashings []*ethpb.ProposerSlashing
+	pendingAttesterSlashings []*ethpb.AttesterSlashing
+}
+
+// NewPool returns an initialized slashings pool.
+func NewPool() *Pool {
+	return &Pool{
+		pendingProposerSlashings: make([]*ethpb.ProposerSlashing, 0),
+		pendingAttesterSlashings: make([]*ethpb.AttesterSlashing, 0),
+	}
+}
+
+// PendingProposerSlashings returns the proposer slashings which are valid for inclusion
+// in a block built on top of the given state, up to MAX_PROPOSER_SLASHINGS. Slashings
+// which are no longer valid against the state are removed from the pool.
+func (p *Pool) PendingProposerSlashings(ctx context.Context, state *pb.BeaconState) []*ethpb.ProposerSlashing {
+	ctx, span := trace.StartSpan(ctx, "slashingPool.PendingProposerSlashings")
+	defer span.End()
+
+	p.lock.Lock()
+	defer p.lock.Unlock()
+
+	maxSlashings := params.BeaconConfig().MaxProposerSlashings
+	pending := make([]*ethpb.ProposerSlashing, 0, maxSlashings)
+	for i := 0; i < len(p.pendingProposerSlashings); i++ {
+		if uint64(len(pending)) == maxSlashings {
+			break
+		}
+		slashing := p.pendingProposerSlashings[i]
+		if err := verifyProposerSlashing(state, slashing); err != nil {
+			log.WithError(err).WithField("proposerIndex", slashing.ProposerIndex).Debug(
+				"Removing invalid proposer slashing from pool")
+			p.pendingProposerSlashings = append(p.pendingProposerSlashings[:i], p.pendingProposerSlashings[i+1:]...)
+			i--
+			continue
+		}
+		pending = append(pending, slashing)
+	}
+	numPendingProposerSlashings.Set(float64(len(p.pendingProposerSlashings)))
+	span.AddAttributes(trace.Int64Attribute("numSlashings", int64(len(pending))))
+	return pending
+}
+
+// PendingAttesterSlashings returns the attester slashings which are valid for inclusion
+// in a block built on top of the given state, up to MAX_ATTESTER_SLASHINGS. Slashings
+// which no longer slash any validator in the state are removed from the pool, and
+// slashings whose validators are all covered by an already selected slashing are
+// skipped so the returned list always passes block processing.
+func (p *Pool) PendingAttesterSlashings(ctx context.Context, state *pb.BeaconState) []*ethpb.AttesterSlashing {
+	ctx, span := trace.StartSpan(ctx, "slashingPool.PendingAttesterSlashings")
+	defer span.End()
+
+	p.lock.Lock()
+	defer p.lock.Unlock()
+
+	maxSlashings := params.BeaconConfig().MaxAttesterSlashings
+	pending := make([]*ethpb.AttesterSlashing, 0, maxSlashings)
+	covered := make(map[uint64]bool)
+	for i := 0; i < len(p.pendingAttesterSlashings); i++ {
+		if uint64(len(pending)) == maxSlashings {
+			break
+		}
+		slashing := p.pendingAttesterSlashings[i]
+		indices, err := slashableIndices(ctx, state, slashing)
+		if err != nil {
+			log.WithError(err).Debug("Removing invalid attester slashing from pool")
+			p.pendingAttesterSlashings = append(p.pendingAttesterSlashings[:i], p.pendingAttesterSlashings[i+1:]...)
+			i--
+			continue
+		}
+		slashesNew := false
+		for _, idx := range indices {
+			if !covered[idx] {
+				slashesNew = true
+				break
+			}
+		}
+		if !slashesNew {
+			continue
+		}
+		for _, idx := range indices {
+			covered[idx] = true
+		}
+		pending = append(pending, slashing)
+	}
+	numPendingAttesterSlashings.Set(float64(len(p.pendingAttesterSlashings)))
+	span.AddAttributes(trace.Int64Attribute("numSlashings", int64(len(pending))))
+	return pending
+}
+
+// InsertProposerSlashing into the pool. This method returns an error if the slashing is
+// not valid against the given state, and is a no-op if a slashing for the same proposer
+// is already pending.
+func (p *Pool) InsertProposerSlashing(ctx context.Context, state *pb.BeaconState, slashing *ethpb.ProposerSlashing) error {
+	ctx, span := trace.StartSpan(ctx, "slashingPool.InsertProposerSlashing")
+	defer span.End()
+
+	if err := verifyProposerSlashing(state, slashing); err != nil {
+		return errors.Wrap(err, "could not verify proposer slashing")
+	}
+
+	p.lock.Lock()
+	defer p.lock.Unlock()
+
+	i := sort.Search(len(p.pendingProposerSlashings), func(j int) bool {
+		return p.pendingProposerSlashings[j].ProposerIndex >= slashing.ProposerIndex
+	})
+	if i < len(p.pendingProposerSlashings) && p.pendingProposerSlashings[i].ProposerIndex == slashing.ProposerIndex {
+		return nil
+	}
+	p.pendingProposerSlashings = append(p.pendingProposerSlashings, nil)
+	copy(p.pendingProposerSlashings[i+1:], p.pendingProposerSlashings[i:])
+	p.pendingProposerSlashings[i] = slashing
+	numPendingProposerSlashings.Set(float64(len(p.pendingProposerSlashings)))
+	return nil
+}
+
+// InsertAttesterSlashing into the pool. This method returns an error if the slashing is
+// not valid against the given state, and is a no-op if the same slashing is already pending.
+func (p *Pool) InsertAttesterSlashing(ctx context.Context, state *pb.BeaconState, slashing *ethpb.AttesterSlashing) error {
+	ctx, span := trace.StartSpan(ctx, "slashingPool.InsertAttesterSlashing")
+	defer span.End()
+
+	if _, err := slashableIndices(ctx, state, slashing); err != nil {
+		return errors.Wrap(err, "could not verify attester slashing")
+	}
+
+	p.lock.Lock()
+	defer p.lock.Unlock()
+
+	for _, s := range p.pendingAttesterSlashings {
+		if proto.Equal(s, slashing) {
+			return nil
+		}
+	}
+	p.pendingAttesterSlashings = append(p.pendingAttesterSlashings, slashing)
+	numPendingAttesterSlashings.Set(float64(len(p.pendingAttesterSlashings)))
+	return nil
+}
+
+// MarkIncludedProposerSlashing is used when a proposer slashing has been included in a beacon block.
+// Every block seen by this node should call this method to remove the slashing from the pending pool.
+func (p *Pool) MarkIncludedProposerSlashing(slashing *ethpb.ProposerSlashing) {
+	if slashing == nil {
+		return
+	}
+
+	p.lock.Lock()
+	defer p.lock.Unlock()
+
+	i := sort.Search(len(p.pendingProposerSlashings), func(j int) bool {
+		return p.pendingProposerSlashings[j].ProposerIndex >= slashing.ProposerIndex
+	})
+	if i < len(p.pendingProposerSlashings) && p.pendingProposerSlashings[i].ProposerIndex == slashing.ProposerIndex {
+		p.pendingProposerSlashings = append(p.pendingProposerSlashings[:i], p.pendingProposerSlashings[i+1:]...)
+	}
+	numPendingProposerSlashings.Set(float64(len(p.pendingProposerSlashings)))
+}
+
+// MarkIncludedAttesterSlashing is used when an attester slashing has been included in a beacon block.
+// Every block seen by this node should call this method to remove the slashing from the pending pool.
+func (p *Pool) MarkIncludedAttesterSlashing(slashing *ethpb.AttesterSlashing) {
+	if slashing == nil {
+		return
+	}
+
+	p.lock.Lock()
+	defer p.lock.Unlock()
+
+	for i, s := range p.pendingAttesterSlashings {
+		if proto.Equal(s, slashing) {
+			p.pendingAttesterSlashings = append(p.pendingAttesterSlashings[:i], p.pendingAttesterSlashings[i+1:]...)
+			break
+		}
+	}
+	numPendingAttesterSlashings.Set(float64(len(p.pendingAttesterSlashings)))
+}
+
+// verifyProposerSlashing checks the proposer slashing against the state, guarding
+// against out of range proposer indices before running the core verification.
+func verifyProposerSlashing(state *pb.BeaconState, slashing *ethpb.ProposerSlashing) error {
+	if slashing == nil || slashing.Header_1 == nil || slashing.Header_2 == nil ||
+		slashing.Header_1.Header == nil || slashing.Header_2.Header == nil {
+		return errors.New("nil proposer slashing")
+	}
+	if slashing.ProposerIndex >= uint64(len(state.Validators)) {
+		return fmt.Errorf("invalid proposer index %d", slashing.ProposerIndex)
+	}
+	return blocks.VerifyProposerSlashing(state, slashing)
+}
+
+// slashableIndices verifies the attester slashing against the state and returns the sorted
+// indices of the validators it would slash. An error is returned if the slashing is invalid
+// or if none of its validators are slashable anymore.
+func slashableIndices(ctx context.Context, state *pb.BeaconState, slashing *ethpb.AttesterSlashing) ([]uint64, error) {
+	if slashing == nil || slashing.Attestation_1 == nil || slashing.Attestation_2 == nil ||
+		slashing.Attestation_1.Data == nil || slashing.Attestation_2.Data == nil {
+		return nil, errors.New("nil attester slashing")
+	}
+	currentEpoch := helpers.CurrentEpoch(state)
+	intersection := sliceutil.IntersectionUint64(slashing.Attestation_1.AttestingIndices, slashing.Attestation_2.AttestingIndices)
+	indices := make([]uint64, 0, len(intersection))
+	for _, idx := range intersection {
+		if idx >= uint64(len(state.Validators)) {
+			return nil, fmt.Errorf("invalid validator index %d", idx)
+		}
+		if helpers.IsSlashableValidator(state.Validators[idx], currentEpoch) {
+			indices = append(indices, idx)
+		}
+	}
+	if len(indices) == 0 {
+		return nil, errors.New("no slashable validators in attester slashing")
+	}
+	if err := blocks.VerifyAttesterSlashing(ctx, state, slashing); err != nil {
+		return nil, err
+	}
+	sort.Slice(indices, func(i, j int) bool {
+		return indices[i] < indices[j]
+	})
+	return indices, nil
+}
@@ -0,0 +1,132 @@
+package slashings
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/gogo/protobuf/proto"
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/shared/testutil"
+)
+
+func TestPool_ProposerSlashings(t *testing.T) {
+	beaconState, privKeys := testutil.DeterministicGenesisState(t, 64)
+	blk, err := testutil.GenerateFullBlock(beaconState, privKeys, &testutil.BlockGenConfig{NumProposerSlashings: 1}, beaconState.Slot)
+	if err != nil {
+		t.Fatal(err)
+	}
+	slashing := blk.Block.Body.ProposerSlashings[0]
+
+	ctx := context.Background()
+	p := NewPool()
+	if err := p.InsertProposerSlashing(ctx, beaconState, slashing); err != nil {
+		t.Fatal(err)
+	}
+	// Inserting the same slashing twice is a no-op.
+	if err := p.InsertProposerSlashing(ctx, beaconState, slashing); err != nil {
+		t.Fatal(err)
+	}
+	pending := p.PendingProposerSlashings(ctx, beaconState)
+	if !reflect.DeepEqual(pending, []*ethpb.ProposerSlashing{slashing}) {
+		t.Errorf("Unexpected pending proposer slashings %v", pending)
+	}
+
+	p.MarkIncludedProposerSlashing(slashing)
+	if len(p.PendingProposerSlashings(ctx, beaconState)) != 0 {
+		t.Error("Expected included proposer slashing to be removed from pool")
+	}
+}
+
+func TestPool_InsertProposerSlashing_Invalid(t *testing.T) {
+	beaconState, privKeys := testutil.DeterministicGenesisState(t, 64)
+	blk, err := testutil.GenerateFullBlock(beaconState, privKeys, &testutil.BlockGenConfig{NumProposerSlashings: 1}, beaconState.Slot)
+	if err != nil {
+		t.Fatal(err)
+	}
+	slashing := proto.Clone(blk.Block.Body.ProposerSlashings[0]).(*ethpb.ProposerSlashing)
+	slashing.Header_2.Signature = make([]byte, 96)
+
+	p := NewPool()
+	if err := p.InsertProposerSlashing(context.Background(), beaconState, slashing); err == nil {
+		t.Error("Expected error inserting proposer slashing with bad signature")
+	}
+	slashing.ProposerIndex = uint64(len(beaconState.Validators))
+	if err := p.InsertProposerSlashing(context.Background(), beaconState, slashing); err == nil {
+		t.Error("Expected error inserting proposer slashing with out of range index")
+	}
+}
+
+func TestPool_PendingProposerSlashings_DropsSlashedProposer(t *testing.T) {
+	beaconState, privKeys := testutil.DeterministicGenesisState(t, 64)
+	blk, err := testutil.GenerateFullBlock(beaconState, privKeys, &testutil.BlockGenConfig{NumProposerSlashings: 1}, beaconState.Slot)
+	if err != nil {
+		t.Fatal(err)
+	}
+	slashing := blk.Block.Body.ProposerSlashings[0]
+
+	ctx := context.Background()
+	p := NewPool()
+	if err := p.InsertProposerSlashing(ctx, beaconState, slashing); err != nil {
+		t.Fatal(err)
+	}
+	beaconState.Validators[slashing.ProposerIndex].Slashed = true
+	if len(p.PendingProposerSlashings(ctx, beaconState)) != 0 {
+		t.Error("Expected slashing of an already slashed proposer to be dropped")
+	}
+	if len(p.pendingProposerSlashings) != 0 {
+		t.Error("Expected invalid slashing to be removed from pool")
+	}
+}
+
+func TestPool_AttesterSlashings(t *testing.T) {
+	beaconState, privKeys := testutil.DeterministicGenesisState(t, 64)
+	blk, err := testutil.GenerateFullBlock(beaconState, privKeys, &testutil.BlockGenConfig{NumAttesterSlashings: 1}, beaconState.Slot)
+	if err != nil {
+		t.Fatal(err)
+	}
+	slashing := blk.Block.Body.AttesterSlashings[0]
+
+	ctx := context.Background()
+	p := NewPool()
+	if err := p.InsertAttesterSlashing(ctx, beaconState, slashing); err != nil {
+		t.Fatal(err)
+	}
+	// Inserting the same slashing twice is a no-op.
+	if err := p.InsertAttesterSlashing(ctx, beaconState, slashing); err != nil {
+		t.Fatal(err)
+	}
+	pending := p.PendingAttesterSlashings(ctx, beaconState)
+	if !reflect.DeepEqual(pending, []*ethpb.AttesterSlashing{slashing}) {
+		t.Errorf("Unexpected pending attester slashings %v", pending)
+	}
+
+	p.MarkIncludedAttesterSlashing(slashing)
+	if len(p.PendingAttesterSlashings(ctx, beaconState)) != 0 {
+		t.Error("Expected included attester slashing to be removed from pool")
+	}
+}
+
+func TestPool_PendingAttesterSlashings_DropsSlashedAttesters(t *testing.T) {
+	beaconState, privKeys := testutil.DeterministicGenesisState(t, 64)
+	blk, err := testutil.GenerateFullBlock(beaconState, privKeys, &testutil.BlockGenConfig{NumAttesterSlashings: 1}, beaconState.Slot)
+	if err != nil {
+		t.Fatal(err)
+	}
+	slashing := blk.Block.Body.AttesterSlashings[0]
+
+	ctx := context.Background()
+	p := NewPool()
+	if err := p.InsertAttesterSlashing(ctx, beaconState, slashing); err != nil {
+		t.Fatal(err)
+	}
+	for _, idx := range slashing.Attestation_1.AttestingIndices {
+		beaconState.Validators[idx].Slashed = true
+	}
+	if len(p.PendingAttesterSlashings(ctx, beaconState)) != 0 {
+		t.Error("Expected slashing of already slashed attesters to be dropped")
+	}
+	if len(p.pendingAttesterSlashings) != 0 {
+		t.Error("Expected invalid slashing to be removed from pool")
+	}
+}
@@ -0,0 +1,15 @@
+package voluntaryexits
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+var (
+	numPendingExits = promauto.NewGauge(
+		prometheus.GaugeOpts{
+			Name: "num_pending_voluntary_exits",
+			Help: "Number of pending voluntary exits in the pool.",
+		},
+	)
+)
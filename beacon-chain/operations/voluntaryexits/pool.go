@@ -0,0 +1,129 @@
+package voluntaryexits
+
+import (
+	"context"
+	"sort"
+	"sync"
+
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/blocks"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"go.opencensus.io/trace"
+)
+
+// Pool is a concurrency safe pool of signed voluntary exits which are waiting
+// to be included in a beacon block. Pending exits are kept sorted by validator
+// index and there is at most one pending exit per validator.
+type Pool struct {
+	lock    sync.RWMutex
+	pending []*ethpb.SignedVoluntaryExit
+}
+
+// NewPool returns an initialized voluntary exit pool.
+func NewPool() *Pool {
+	return &Pool{
+		pending: make([]*ethpb.SignedVoluntaryExit, 0),
+	}
+}
+
+// PendingExits returns the voluntary exits which are ready for inclusion in a block
+// built on top of the given state, up to MAX_VOLUNTARY_EXITS. Exits which are no
+// longer valid against the state are removed from the pool, while exits which
+// specify a future epoch are kept until they become valid.
+func (p *Pool) PendingExits(ctx context.Context, state *pb.BeaconState) []*ethpb.SignedVoluntaryExit {
+	ctx, span := trace.StartSpan(ctx, "exitPool.PendingExits")
+	defer span.End()
+
+	p.lock.Lock()
+	defer p.lock.Unlock()
+
+	currentEpoch := helpers.CurrentEpoch(state)
+	maxExits := params.BeaconConfig().MaxVoluntaryExits
+	pending := make([]*ethpb.SignedVoluntaryExit, 0, maxExits)
+	for i := 0; i < len(p.pending); i++ {
+		if uint64(len(pending)) == maxExits {
+			break
+		}
+		exit := p.pending[i]
+		if exit.Exit.Epoch > currentEpoch {
+			continue
+		}
+		if err := blocks.VerifyExit(state, exit); err != nil {
+			log.WithError(err).WithField("validatorIndex", exit.Exit.ValidatorIndex).Debug(
+				"Removing invalid voluntary exit from pool")
+			p.pending = append(p.pending[:i], p.pending[i+1:]...)
+			i--
+			continue
+		}
+		pending = append(pending, exit)
+	}
+	numPendingExits.Set(float64(len(p.pending)))
+	span.AddAttributes(trace.Int64Attribute("numExits", int64(len(pending))))
+	return pending
+}
+
+// InsertVoluntaryExit into the pool. This method is a no-op if the pending exit already
+// exists for the validator, if the validator is already exiting in the given state, or if
+// the exit signature is invalid.
+func (p *Pool) InsertVoluntaryExit(ctx context.Context, state *pb.BeaconState, exit *ethpb.SignedVoluntaryExit) {
+	ctx, span := trace.StartSpan(ctx, "exitPool.InsertVoluntaryExit")
+	defer span.End()
+
+	if exit == nil || exit.Exit == nil {
+		return
+	}
+	if exit.Exit.ValidatorIndex >= uint64(len(state.Validators)) {
+		return
+	}
+	// Prevent inserting an exit for a validator which is already exiting.
+	if state.Validators[exit.Exit.ValidatorIndex].ExitEpoch != params.BeaconConfig().FarFutureEpoch {
+		return
+	}
+	// Only a single exit is kept per validator, so an exit with an invalid signature would
+	// prevent a valid one from being inserted.
+	set, err := blocks.ExitSignatureSet(state, exit)
+	if err == nil {
+		err = set.Verify()
+	}
+	if err != nil {
+		log.WithError(err).WithField("validatorIndex", exit.Exit.ValidatorIndex).Debug(
+			"Rejecting voluntary exit with invalid signature")
+		return
+	}
+
+	p.lock.Lock()
+	defer p.lock.Unlock()
+
+	// Insert into the sorted pending list, unless a pending exit already exists for the validator.
+	i := sort.Search(len(p.pending), func(j int) bool {
+		return p.pending[j].Exit.ValidatorIndex >= exit.Exit.ValidatorIndex
+	})
+	if i < len(p.pending) && p.pending[i].Exit.ValidatorIndex == exit.Exit.ValidatorIndex {
+		return
+	}
+	p.pending = append(p.pending, nil)
+	copy(p.pending[i+1:], p.pending[i:])
+	p.pending[i] = exit
+	numPendingExits.Set(float64(len(p.pending)))
+}
+
+// MarkIncluded is used when an exit has been included in a beacon block. Every block seen by this
+// node should call this method to remove the exit from the pending pool.
+func (p *Pool) MarkIncluded(exit *ethpb.SignedVoluntaryExit) {
+	if exit == nil || exit.Exit == nil {
+		return
+	}
+
+	p.lock.Lock()
+	defer p.lock.Unlock()
+
+	i := sort.Search(len(p.pending), func(j int) bool {
+		return p.pending[j].Exit.ValidatorIndex >= exit.Exit.ValidatorIndex
+	})
+	if i < len(p.pending) && p.pending[i].Exit.ValidatorIndex == exit.Exit.ValidatorIndex {
+		p.pending = append(p.pending[:i], p.pending[i+1:]...)
+	}
+	numPendingExits.Set(float64(len(p.pending)))
+}
@@ -0,0 +1,111 @@
+package voluntaryexits
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/go-ssz"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+	"github.com/prysmaticlabs/prysm/shared/bls"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/testutil"
+)
+
+// signedExit returns an exit of the validator at the given epoch, signed with its key.
+func signedExit(t *testing.T, state *pb.BeaconState, privKeys []*bls.SecretKey, idx uint64, epoch uint64) *ethpb.SignedVoluntaryExit {
+	exit := &ethpb.VoluntaryExit{ValidatorIndex: idx, Epoch: epoch}
+	root, err := ssz.HashTreeRoot(exit)
+	if err != nil {
+		t.Fatal(err)
+	}
+	domain := helpers.Domain(state.Fork, epoch, params.BeaconConfig().DomainVoluntaryExit)
+	return &ethpb.SignedVoluntaryExit{
+		Exit:      exit,
+		Signature: privKeys[idx].Sign(root[:], domain).Marshal(),
+	}
+}
+
+func TestPool_InsertVoluntaryExit(t *testing.T) {
+	state, privKeys := testutil.DeterministicGenesisState(t, 5)
+	state.Validators[2].ExitEpoch = 10
+	exits := make([]*ethpb.SignedVoluntaryExit, len(state.Validators))
+	for i := range exits {
+		exits[i] = signedExit(t, state, privKeys, uint64(i), 0)
+	}
+
+	p := NewPool()
+	ctx := context.Background()
+	p.InsertVoluntaryExit(ctx, state, exits[3])
+	p.InsertVoluntaryExit(ctx, state, exits[0])
+	p.InsertVoluntaryExit(ctx, state, exits[1])
+	// Duplicate exit for the same validator.
+	p.InsertVoluntaryExit(ctx, state, exits[1])
+	// Validator already exiting.
+	p.InsertVoluntaryExit(ctx, state, exits[2])
+	// Validator index out of range.
+	p.InsertVoluntaryExit(ctx, state, &ethpb.SignedVoluntaryExit{Exit: &ethpb.VoluntaryExit{ValidatorIndex: 100}})
+	// Exit signed by another validator.
+	p.InsertVoluntaryExit(ctx, state, &ethpb.SignedVoluntaryExit{Exit: exits[4].Exit, Signature: exits[0].Signature})
+
+	want := []*ethpb.SignedVoluntaryExit{exits[0], exits[1], exits[3]}
+	if !reflect.DeepEqual(p.pending, want) {
+		t.Errorf("Unexpected pending exits, wanted %v, received %v", want, p.pending)
+	}
+}
+
+func TestPool_MarkIncluded(t *testing.T) {
+	exit := func(idx uint64) *ethpb.SignedVoluntaryExit {
+		return &ethpb.SignedVoluntaryExit{Exit: &ethpb.VoluntaryExit{ValidatorIndex: idx}}
+	}
+	p := &Pool{
+		pending: []*ethpb.SignedVoluntaryExit{exit(1), exit(2), exit(3)},
+	}
+
+	p.MarkIncluded(exit(2))
+	// Not in the pool, should be a no-op.
+	p.MarkIncluded(exit(4))
+
+	want := []*ethpb.SignedVoluntaryExit{exit(1), exit(3)}
+	if !reflect.DeepEqual(p.pending, want) {
+		t.Errorf("Unexpected pending exits, wanted %v, received %v", want, p.pending)
+	}
+}
+
+func TestPool_PendingExits(t *testing.T) {
+	beaconState, privKeys := testutil.DeterministicGenesisState(t, 256)
+	// Moving the state forward due to PERSISTENT_COMMITTEE_PERIOD.
+	beaconState.Slot = 3 + params.BeaconConfig().PersistentCommitteePeriod*params.BeaconConfig().SlotsPerEpoch
+	blk, err := testutil.GenerateFullBlock(beaconState, privKeys, &testutil.BlockGenConfig{NumVoluntaryExits: 1}, beaconState.Slot)
+	if err != nil {
+		t.Fatal(err)
+	}
+	validExit := blk.Block.Body.VoluntaryExits[0]
+
+	futureIndex := (validExit.Exit.ValidatorIndex + 1) % 256
+	futureExit := signedExit(t, beaconState, privKeys, futureIndex, params.BeaconConfig().FarFutureEpoch-1)
+	invalidIndex := (validExit.Exit.ValidatorIndex + 2) % 256
+	invalidExit := signedExit(t, beaconState, privKeys, invalidIndex, 0)
+
+	p := NewPool()
+	ctx := context.Background()
+	p.InsertVoluntaryExit(ctx, beaconState, validExit)
+	p.InsertVoluntaryExit(ctx, beaconState, futureExit)
+	p.InsertVoluntaryExit(ctx, beaconState, invalidExit)
+	if len(p.pending) != 3 {
+		t.Fatalf("Expected 3 exits in pool, received %d", len(p.pending))
+	}
+	// The validator exits by other means once its exit is in the pool.
+	beaconState.Validators[invalidIndex].ExitEpoch = helpers.CurrentEpoch(beaconState)
+
+	pending := p.PendingExits(ctx, beaconState)
+	if !reflect.DeepEqual(pending, []*ethpb.SignedVoluntaryExit{validExit}) {
+		t.Errorf("Unexpected pending exits, received %v", pending)
+	}
+	// The exit of the exited validator is dropped, the one for a future epoch is kept.
+	if len(p.pending) != 2 {
+		t.Errorf("Expected 2 exits in pool, received %d", len(p.pending))
+	}
+}
@@ -65,5 +65,5 @@ func attestationToTopic(att *eth.Attestation) string {
 	if att == nil || att.Data == nil {
 		return ""
 	}
-	return fmt.Sprintf(attestationSubnetTopicFormat, att.Data.CommitteeIndex)
+	return fmt.Sprintf(attestationSubnetTopicFormat, AttestationSubnet(att.Data.CommitteeIndex))
 }
@@ -1,5 +1,9 @@
 package p2p
 
+import (
+	"github.com/prysmaticlabs/prysm/beacon-chain/p2p/peers"
+)
+
 // Config for the p2p service. These parameters are set from application level flags
 // to initialize the p2p service.
 type Config struct {
@@ -18,4 +22,5 @@ type Config struct {
 	WhitelistCIDR         string
 	EnableUPnP            bool
 	Encoding              string
+	PeerScoring           *peers.ScoringConfig
 }
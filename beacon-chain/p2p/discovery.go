@@ -27,6 +27,7 @@ type Listener interface {
 	LookupRandom() []*enode.Node
 	Ping(*enode.Node) error
 	RequestENR(*enode.Node) (*enode.Node, error)
+	LocalNode() *enode.LocalNode
 }
 
 func createListener(ipAddr net.IP, privKey *ecdsa.PrivateKey, cfg *Config) *discover.UDPv5 {
@@ -81,6 +82,7 @@ func createLocalNode(privKey *ecdsa.PrivateKey, ipAddr net.IP, udpPort int, tcpP
 	localNode.Set(ipEntry)
 	localNode.Set(udpEntry)
 	localNode.Set(tcpEntry)
+	localNode.Set(attSubnetsEntry(nil))
 	localNode.SetFallbackIP(ipAddr)
 	localNode.SetFallbackUDP(udpPort)
 
@@ -9,6 +9,7 @@ import (
 	pubsub "github.com/libp2p/go-libp2p-pubsub"
 	"github.com/prysmaticlabs/prysm/beacon-chain/p2p/encoder"
 	"github.com/prysmaticlabs/prysm/beacon-chain/p2p/peers"
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
 )
 
 // P2P represents the full p2p interface composed of all of the sub-interfaces.
@@ -21,6 +22,8 @@ type P2P interface {
 	Sender
 	ConnectionHandler
 	PeersProvider
+	SubnetAdvertiser
+	MetadataProvider
 }
 
 // Broadcaster broadcasts messages to peers over the p2p pubsub protocol.
@@ -53,6 +56,7 @@ type PubSubProvider interface {
 type PeerManager interface {
 	Disconnect(peer.ID) error
 	PeerID() peer.ID
+	SupportsRPC(pid peer.ID, message interface{}) bool
 }
 
 // Sender abstracts the sending functionality from libp2p.
@@ -60,6 +64,17 @@ type Sender interface {
 	Send(context.Context, interface{}, peer.ID) (network.Stream, error)
 }
 
+// SubnetAdvertiser advertises the long-lived attestation subnets of the node to its peers.
+type SubnetAdvertiser interface {
+	AdvertiseAttestationSubnets(subnets []uint64)
+}
+
+// MetadataProvider returns the metadata of the node, which is served to peers over the metadata
+// protocol.
+type MetadataProvider interface {
+	Metadata() *pb.MetaData
+}
+
 // PeersProvider abstracts obtaining our current list of known peers status.
 type PeersProvider interface {
 	Peers() *peers.Status
@@ -0,0 +1,34 @@
+package p2p
+
+import (
+	"bytes"
+
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+)
+
+// Metadata returns a copy of the metadata of the node, which peers request over the metadata
+// protocol when the sequence number in our pings is ahead of the metadata they know.
+func (s *Service) Metadata() *pb.MetaData {
+	s.metadataLock.RLock()
+	defer s.metadataLock.RUnlock()
+	attnets := make([]byte, len(s.metadata.Attnets))
+	copy(attnets, s.metadata.Attnets)
+	return &pb.MetaData{
+		SeqNumber: s.metadata.SeqNumber,
+		Attnets:   attnets,
+	}
+}
+
+// updateAttnets sets the attestation subnets bitvector in the metadata of the node. The
+// sequence number is only increased when the bitvector changes.
+func (s *Service) updateAttnets(attnets []byte) {
+	s.metadataLock.Lock()
+	defer s.metadataLock.Unlock()
+	if bytes.Equal(s.metadata.Attnets, attnets) {
+		return
+	}
+	s.metadata = &pb.MetaData{
+		SeqNumber: s.metadata.SeqNumber + 1,
+		Attnets:   attnets,
+	}
+}
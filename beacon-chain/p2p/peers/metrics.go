@@ -0,0 +1,13 @@
+package peers
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+var (
+	peersBannedCount = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "p2p_peers_banned_total",
+		Help: "The number of peers banned for their low score.",
+	})
+)
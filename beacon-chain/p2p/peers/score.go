@@ -0,0 +1,243 @@
+package peers
+
+import (
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"net"
+	"os"
+	"time"
+
+	"github.com/libp2p/go-libp2p-core/peer"
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/shared/roughtime"
+)
+
+// Points added to the score of a peer for its behaviour. Useful responses raise the score of a
+// peer, while invalid or missing data lowers it.
+const (
+	// ScoreUsefulBlock is added for every valid block a peer serves us by range.
+	ScoreUsefulBlock = 1
+	// ScoreTimeout is added when a peer does not answer a request in time.
+	ScoreTimeout = -5
+	// ScoreMalformedResponse is added when a peer sends a request or response chunk we cannot decode.
+	ScoreMalformedResponse = -10
+	// ScoreInvalidGossip is added when a peer relays a gossip message proven invalid.
+	ScoreInvalidGossip = -20
+)
+
+// maxScore caps the score a peer can build up, so a peer which served us well in the past can
+// still be disconnected quickly once it starts misbehaving.
+const maxScore = 100
+
+// scoreDecay is the number of points the score of every peer moves back towards zero on decay.
+const scoreDecay = 5
+
+// ScoringConfig holds the thresholds at which misbehaving peers are disconnected and banned.
+type ScoringConfig struct {
+	// DisconnectThreshold is the score at or below which a peer is considered bad and disconnected.
+	DisconnectThreshold int
+	// BanThreshold is the score at or below which a peer is banned.
+	BanThreshold int
+	// BanDuration is how long a banned peer is refused.
+	BanDuration time.Duration
+	// BanFile is the path the bans are persisted to across restarts. Bans are not persisted if empty.
+	BanFile string
+}
+
+// DefaultScoringConfig returns the scoring configuration used when none is provided.
+func DefaultScoringConfig() *ScoringConfig {
+	return &ScoringConfig{
+		DisconnectThreshold: -40,
+		BanThreshold:        -100,
+		BanDuration:         time.Hour,
+	}
+}
+
+// ScoreForError returns the points to add to the score of a peer whose request or response failed
+// with the given error. Timeouts are penalized less than malformed messages.
+func ScoreForError(err error) int {
+	cause := errors.Cause(err)
+	if cause == context.DeadlineExceeded {
+		return ScoreTimeout
+	}
+	if netErr, ok := cause.(net.Error); ok && netErr.Timeout() {
+		return ScoreTimeout
+	}
+	return ScoreMalformedResponse
+}
+
+// AddScore adds the points to the score of the given remote peer, and returns its new score.
+// A peer whose score drops to the ban threshold is banned for the configured duration.
+func (p *Status) AddScore(pid peer.ID, points int) int {
+	p.lock.Lock()
+	status := p.fetch(pid)
+	p.liftExpiredBan(status, roughtime.Now())
+	status.score += points
+	if status.score > maxScore {
+		status.score = maxScore
+	}
+	score := status.score
+	banned := false
+	if score <= p.scoring.BanThreshold && !status.bannedUntil.After(roughtime.Now()) {
+		status.bannedUntil = roughtime.Now().Add(p.scoring.BanDuration)
+		banned = true
+	}
+	p.lock.Unlock()
+
+	if banned {
+		peersBannedCount.Inc()
+		log.WithField("peer", pid.Pretty()).WithField("score", score).Debug("Banned peer")
+		p.persistBans()
+	}
+	return score
+}
+
+// Score returns the score of the given remote peer.
+// This will error if the peer does not exist.
+func (p *Status) Score(pid peer.ID) (int, error) {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+
+	if status, ok := p.status[pid]; ok {
+		p.liftExpiredBan(status, roughtime.Now())
+		return status.score, nil
+	}
+	return 0, ErrPeerUnknown
+}
+
+// IsBanned states if the peer is currently banned.
+// If the peer is unknown this will return `false`.
+func (p *Status) IsBanned(pid peer.ID) bool {
+	p.lock.RLock()
+	defer p.lock.RUnlock()
+
+	if status, ok := p.status[pid]; ok {
+		return status.bannedUntil.After(roughtime.Now())
+	}
+	return false
+}
+
+// BannedUntil returns the time until which the given remote peer is banned, which is in the
+// past for a peer that is not banned.
+// This will error if the peer does not exist.
+func (p *Status) BannedUntil(pid peer.ID) (time.Time, error) {
+	p.lock.RLock()
+	defer p.lock.RUnlock()
+
+	if status, ok := p.status[pid]; ok {
+		return status.bannedUntil, nil
+	}
+	return time.Time{}, ErrPeerUnknown
+}
+
+// Banned returns the peers that are currently banned.
+func (p *Status) Banned() []peer.ID {
+	p.lock.RLock()
+	defer p.lock.RUnlock()
+	now := roughtime.Now()
+	peers := make([]peer.ID, 0)
+	for pid, status := range p.status {
+		if status.bannedUntil.After(now) {
+			peers = append(peers, pid)
+		}
+	}
+	return peers
+}
+
+// decayScores moves the score of every peer towards zero and lifts the expired bans. It returns
+// true if a ban was lifted. The caller must hold the lock.
+func (p *Status) decayScores() bool {
+	now := roughtime.Now()
+	lifted := false
+	for _, status := range p.status {
+		if p.liftExpiredBan(status, now) {
+			lifted = true
+		}
+		switch {
+		case status.score > scoreDecay:
+			status.score -= scoreDecay
+		case status.score < -scoreDecay:
+			status.score += scoreDecay
+		default:
+			status.score = 0
+		}
+	}
+	return lifted
+}
+
+// liftExpiredBan lifts the ban of the peer once it expired, and resets its score to just above
+// the disconnect threshold, so the peer is usable again instead of being disconnected on its
+// first misstep. It returns true if a ban was lifted. The caller must hold the lock.
+func (p *Status) liftExpiredBan(status *peerStatus, now time.Time) bool {
+	if status.bannedUntil.IsZero() || status.bannedUntil.After(now) {
+		return false
+	}
+	status.bannedUntil = time.Time{}
+	if status.score <= p.scoring.DisconnectThreshold {
+		status.score = p.scoring.DisconnectThreshold + 1
+	}
+	return true
+}
+
+// LoadBans restores the bans which have not expired yet from the ban file, if one is configured.
+func (p *Status) LoadBans() error {
+	if p.scoring.BanFile == "" {
+		return nil
+	}
+	enc, err := ioutil.ReadFile(p.scoring.BanFile)
+	if os.IsNotExist(err) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	bans := make(map[string]int64)
+	if err := json.Unmarshal(enc, &bans); err != nil {
+		return err
+	}
+
+	p.lock.Lock()
+	defer p.lock.Unlock()
+	now := roughtime.Now()
+	for id, until := range bans {
+		pid, err := peer.IDB58Decode(id)
+		if err != nil {
+			log.WithError(err).WithField("peer", id).Warn("Ignoring ban of invalid peer ID")
+			continue
+		}
+		bannedUntil := time.Unix(until, 0)
+		if !bannedUntil.After(now) {
+			continue
+		}
+		status := p.fetch(pid)
+		status.bannedUntil = bannedUntil
+		status.score = p.scoring.BanThreshold
+	}
+	return nil
+}
+
+// persistBans writes the current bans to the ban file, if one is configured.
+func (p *Status) persistBans() {
+	if p.scoring.BanFile == "" {
+		return
+	}
+	bans := make(map[string]int64)
+	p.lock.RLock()
+	now := roughtime.Now()
+	for pid, status := range p.status {
+		if status.bannedUntil.After(now) {
+			bans[peer.IDB58Encode(pid)] = status.bannedUntil.Unix()
+		}
+	}
+	p.lock.RUnlock()
+
+	enc, err := json.Marshal(bans)
+	if err != nil {
+		log.WithError(err).Error("Could not encode peer bans")
+		return
+	}
+	if err := ioutil.WriteFile(p.scoring.BanFile, enc, 0600); err != nil {
+		log.WithError(err).Error("Could not persist peer bans")
+	}
+}
@@ -0,0 +1,158 @@
+package peers_test
+
+import (
+	"io/ioutil"
+	"os"
+	"path"
+	"testing"
+	"time"
+
+	"github.com/prysmaticlabs/prysm/beacon-chain/p2p/peers"
+	"github.com/prysmaticlabs/prysm/shared/roughtime"
+)
+
+func TestScore_DisconnectThreshold(t *testing.T) {
+	p := peers.NewStatusWithScoring(2, &peers.ScoringConfig{
+		DisconnectThreshold: -20,
+		BanThreshold:        -100,
+		BanDuration:         time.Hour,
+	})
+	pid := addPeer(t, p, peers.PeerConnected)
+
+	if score := p.AddScore(pid, peers.ScoreTimeout); score != peers.ScoreTimeout {
+		t.Errorf("Unexpected score: expected %d, received %d", peers.ScoreTimeout, score)
+	}
+	if p.IsBad(pid) {
+		t.Error("Peer marked as bad when should be good")
+	}
+	p.AddScore(pid, peers.ScoreInvalidGossip)
+	if !p.IsBad(pid) {
+		t.Error("Peer not marked as bad when it should be")
+	}
+	if p.IsBanned(pid) {
+		t.Error("Peer banned above the ban threshold")
+	}
+}
+
+func TestScore_Capped(t *testing.T) {
+	p := peers.NewStatus(2)
+	pid := addPeer(t, p, peers.PeerConnected)
+
+	for i := 0; i < 1000; i++ {
+		p.AddScore(pid, peers.ScoreUsefulBlock)
+	}
+	// A long record of useful blocks must not shield a peer from being disconnected.
+	for i := 0; i < 7; i++ {
+		p.AddScore(pid, peers.ScoreInvalidGossip)
+	}
+	if !p.IsBad(pid) {
+		t.Error("Peer not marked as bad when it should be")
+	}
+}
+
+func TestScore_BanPersistedAcrossRestarts(t *testing.T) {
+	dir, err := ioutil.TempDir("", "peerbans")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	cfg := &peers.ScoringConfig{
+		DisconnectThreshold: -20,
+		BanThreshold:        -40,
+		BanDuration:         time.Hour,
+		BanFile:             path.Join(dir, "bans.json"),
+	}
+
+	p := peers.NewStatusWithScoring(2, cfg)
+	banned := addPeer(t, p, peers.PeerConnected)
+	good := addPeer(t, p, peers.PeerConnected)
+	p.AddScore(banned, 2*peers.ScoreInvalidGossip)
+	if !p.IsBanned(banned) {
+		t.Fatal("Peer not banned at the ban threshold")
+	}
+	if len(p.Banned()) != 1 {
+		t.Errorf("Unexpected number of banned peers: expected 1, received %d", len(p.Banned()))
+	}
+
+	restarted := peers.NewStatusWithScoring(2, cfg)
+	if err := restarted.LoadBans(); err != nil {
+		t.Fatal(err)
+	}
+	if !restarted.IsBanned(banned) {
+		t.Error("Ban not restored after restart")
+	}
+	if !restarted.IsBad(banned) {
+		t.Error("Banned peer not marked as bad after restart")
+	}
+	if restarted.IsBanned(good) {
+		t.Error("Good peer banned after restart")
+	}
+}
+
+func TestDecay_LiftsExpiredBans(t *testing.T) {
+	p := peers.NewStatusWithScoring(2, &peers.ScoringConfig{
+		DisconnectThreshold: -20,
+		BanThreshold:        -20,
+		BanDuration:         10 * time.Millisecond,
+	})
+	pid := addPeer(t, p, peers.PeerConnected)
+	p.AddScore(pid, peers.ScoreInvalidGossip)
+	if !p.IsBanned(pid) {
+		t.Fatal("Peer not banned at the ban threshold")
+	}
+
+	time.Sleep(20 * time.Millisecond)
+	p.Decay()
+	if p.IsBanned(pid) {
+		t.Error("Expired ban not lifted")
+	}
+	score, err := p.Score(pid)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// The score is reset above the disconnect threshold when the ban is lifted, then decays.
+	if score != -20+1+5 {
+		t.Errorf("Unexpected score after decay: expected %d, received %d", -20+1+5, score)
+	}
+	if p.IsBad(pid) {
+		t.Error("Peer still marked as bad after its score decayed")
+	}
+}
+
+func TestScore_UsableOnceBanExpired(t *testing.T) {
+	p := peers.NewStatusWithScoring(2, &peers.ScoringConfig{
+		DisconnectThreshold: -40,
+		BanThreshold:        -100,
+		BanDuration:         10 * time.Millisecond,
+	})
+	pid := addPeer(t, p, peers.PeerConnected)
+	p.AddScore(pid, 5*peers.ScoreInvalidGossip)
+	if !p.IsBanned(pid) || !p.IsBad(pid) {
+		t.Fatal("Peer not banned at the ban threshold")
+	}
+
+	time.Sleep(20 * time.Millisecond)
+	bannedUntil, err := p.BannedUntil(pid)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if bannedUntil.After(roughtime.Now()) {
+		t.Fatalf("Ban did not expire, banned until %v", bannedUntil)
+	}
+	// The peer is usable without waiting for the scores to decay.
+	if p.IsBad(pid) {
+		t.Error("Peer still marked as bad once its ban expired")
+	}
+	score, err := p.Score(pid)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if score != -40+1 {
+		t.Errorf("Unexpected score once the ban expired: expected %d, received %d", -40+1, score)
+	}
+	// A single timeout disconnects the peer again, but does not ban it.
+	p.AddScore(pid, peers.ScoreTimeout)
+	if !p.IsBad(pid) || p.IsBanned(pid) {
+		t.Error("Expected the peer to be disconnected but not banned after a timeout")
+	}
+}
@@ -17,6 +17,10 @@
 //
 // Peer information is persistent for the run of the service.  This allows for collection of useful long-term statistics such as
 // number of bad responses obtained from the peer, giving the basis for decisions to not talk to known-bad peers.
+//
+// Each peer also has a score, raised by useful responses and lowered by invalid gossip, timeouts and malformed messages.  Peers
+// whose score drops to the disconnect threshold are considered bad, and peers whose score drops to the ban threshold are banned
+// for a while.  Bans can be persisted so they survive restarts.
 package peers
 
 import (
@@ -55,6 +59,7 @@ var (
 type Status struct {
 	lock            sync.RWMutex
 	maxBadResponses int
+	scoring         *ScoringConfig
 	status          map[peer.ID]*peerStatus
 }
 
@@ -65,13 +70,22 @@ type peerStatus struct {
 	peerState             PeerConnectionState
 	chainState            *pb.Status
 	chainStateLastUpdated time.Time
+	metadata              *pb.MetaData
 	badResponses          int
+	score                 int
+	bannedUntil           time.Time
 }
 
-// NewStatus creates a new status entity.
+// NewStatus creates a new status entity with the default scoring configuration.
 func NewStatus(maxBadResponses int) *Status {
+	return NewStatusWithScoring(maxBadResponses, DefaultScoringConfig())
+}
+
+// NewStatusWithScoring creates a new status entity with the given scoring configuration.
+func NewStatusWithScoring(maxBadResponses int, scoring *ScoringConfig) *Status {
 	return &Status{
 		maxBadResponses: maxBadResponses,
+		scoring:         scoring,
 		status:          make(map[peer.ID]*peerStatus),
 	}
 }
@@ -149,6 +163,28 @@ func (p *Status) ChainState(pid peer.ID) (*pb.Status, error) {
 	return nil, ErrPeerUnknown
 }
 
+// SetMetadata sets the metadata of the given remote peer.
+func (p *Status) SetMetadata(pid peer.ID, metadata *pb.MetaData) {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+
+	status := p.fetch(pid)
+	status.metadata = metadata
+}
+
+// Metadata gets the metadata of the given remote peer.
+// This can return nil if the metadata of the peer has not been requested yet.
+// This will error if the peer does not exist.
+func (p *Status) Metadata(pid peer.ID) (*pb.MetaData, error) {
+	p.lock.RLock()
+	defer p.lock.RUnlock()
+
+	if status, ok := p.status[pid]; ok {
+		return status.metadata, nil
+	}
+	return nil, ErrPeerUnknown
+}
+
 // SetConnectionState sets the connection state of the given remote peer.
 func (p *Status) SetConnectionState(pid peer.ID, state PeerConnectionState) {
 	p.lock.Lock()
@@ -203,14 +239,17 @@ func (p *Status) BadResponses(pid peer.ID) (int, error) {
 	return -1, ErrPeerUnknown
 }
 
-// IsBad states if the peer is to be considered bad.
+// IsBad states if the peer is to be considered bad, either from its bad responses, its score or a ban.
 // If the peer is unknown this will return `false`, which makes using this function easier than returning an error.
 func (p *Status) IsBad(pid peer.ID) bool {
-	p.lock.RLock()
-	defer p.lock.RUnlock()
+	p.lock.Lock()
+	defer p.lock.Unlock()
 
 	if status, ok := p.status[pid]; ok {
-		return status.badResponses >= p.maxBadResponses
+		p.liftExpiredBan(status, roughtime.Now())
+		return status.badResponses >= p.maxBadResponses ||
+			status.score <= p.scoring.DisconnectThreshold ||
+			status.bannedUntil.After(roughtime.Now())
 	}
 	return false
 }
@@ -304,17 +343,23 @@ func (p *Status) All() []peer.ID {
 	return pids
 }
 
-// Decay reduces the bad responses of all peers, giving reformed peers a chance to join the network.
+// Decay reduces the bad responses of all peers and moves their scores towards zero, giving reformed peers a chance to join the
+// network.  Expired bans are lifted as well.
 // This can be run periodically, although note that each time it runs it does give all bad peers another chance as well to clog up
 // the network with bad responses, so should not be run too frequently; once an hour would be reasonable.
 func (p *Status) Decay() {
 	p.lock.Lock()
-	defer p.lock.Unlock()
 	for _, status := range p.status {
 		if status.badResponses > 0 {
 			status.badResponses--
 		}
 	}
+	lifted := p.decayScores()
+	p.lock.Unlock()
+
+	if lifted {
+		p.persistBans()
+	}
 }
 
 // BestFinalized returns the highest finalized epoch that is agreed upon by the majority of
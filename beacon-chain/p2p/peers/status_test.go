@@ -104,6 +104,11 @@ func TestErrUnknownPeer(t *testing.T) {
 		t.Errorf("Unexpected error: expected %v, received %v", peers.ErrPeerUnknown, err)
 	}
 
+	_, err = p.Metadata(id)
+	if err != peers.ErrPeerUnknown {
+		t.Errorf("Unexpected error: expected %v, received %v", peers.ErrPeerUnknown, err)
+	}
+
 	_, err = p.ConnectionState(id)
 	if err != peers.ErrPeerUnknown {
 		t.Errorf("Unexpected error: expected %v, received %v", peers.ErrPeerUnknown, err)
@@ -182,6 +187,35 @@ func TestPeerChainState(t *testing.T) {
 	}
 }
 
+func TestPeerMetadata(t *testing.T) {
+	maxBadResponses := 2
+	p := peers.NewStatus(maxBadResponses)
+
+	id, err := peer.IDB58Decode("16Uiu2HAkyWZ4Ni1TpvDS8dPxsozmHY85KaiFjodQuV6Tz5tkHVeR")
+	if err != nil {
+		t.Fatal(err)
+	}
+	p.Add(id, nil, network.DirOutbound)
+
+	resMetadata, err := p.Metadata(id)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if resMetadata != nil {
+		t.Errorf("Unexpected metadata before it was set: %v", resMetadata)
+	}
+
+	seqNumber := uint64(5)
+	p.SetMetadata(id, &pb.MetaData{SeqNumber: seqNumber, Attnets: []byte{1, 0, 0, 0, 0, 0, 0, 0}})
+	resMetadata, err = p.Metadata(id)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if resMetadata.SeqNumber != seqNumber {
+		t.Errorf("Unexpected sequence number: expected %v, received %v", seqNumber, resMetadata.SeqNumber)
+	}
+}
+
 func TestPeerBadResponses(t *testing.T) {
 	maxBadResponses := 2
 	p := peers.NewStatus(maxBadResponses)
@@ -8,12 +8,15 @@ import (
 
 // RPCTopicMappings represent the protocol ID to protobuf message type map for easy
 // lookup. These mappings should be used for outbound sending only. Peers may respond
-// with a different message type as defined by the p2p protocol.
+// with a different message type as defined by the p2p protocol. Requests without a payload,
+// such as the metadata request, map to nil.
 var RPCTopicMappings = map[string]interface{}{
 	"/eth2/beacon_chain/req/status/1":                 &p2ppb.Status{},
 	"/eth2/beacon_chain/req/goodbye/1":                new(uint64),
 	"/eth2/beacon_chain/req/beacon_blocks_by_range/1": &p2ppb.BeaconBlocksByRangeRequest{},
 	"/eth2/beacon_chain/req/beacon_blocks_by_root/1":  [][32]byte{},
+	"/eth2/beacon_chain/req/ping/1":                   &p2ppb.Ping{},
+	"/eth2/beacon_chain/req/metadata/1":               nil,
 }
 
 // RPCTypeMapping is the inverse of RPCTopicMappings so that an arbitrary protobuf message
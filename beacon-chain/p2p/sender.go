@@ -51,3 +51,11 @@ func (s *Service) Send(ctx context.Context, message interface{}, pid peer.ID) (n
 
 	return stream, nil
 }
+
+// SupportsRPC returns true if the peer advertised the rpc protocol of the message when it was
+// identified.
+func (s *Service) SupportsRPC(pid peer.ID, message interface{}) bool {
+	topic := RPCTypeMapping[reflect.TypeOf(message)] + s.Encoding().ProtocolSuffix()
+	supported, err := s.host.Peerstore().SupportsProtocols(pid, topic)
+	return err == nil && len(supported) > 0
+}
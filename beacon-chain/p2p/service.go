@@ -3,7 +3,9 @@ package p2p
 import (
 	"context"
 	"crypto/ecdsa"
+	"path"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/dgraph-io/ristretto"
@@ -23,6 +25,7 @@ import (
 	"github.com/pkg/errors"
 	"github.com/prysmaticlabs/prysm/beacon-chain/p2p/encoder"
 	"github.com/prysmaticlabs/prysm/beacon-chain/p2p/peers"
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
 	"github.com/prysmaticlabs/prysm/shared"
 	"github.com/prysmaticlabs/prysm/shared/runutil"
 )
@@ -36,6 +39,9 @@ const prysmProtocolPrefix = "/prysm/0.0.0"
 // maxBadResponses is the maximum number of bad responses from a peer before we stop talking to it.
 const maxBadResponses = 3
 
+// peerBansPath is the file in the data directory the bans of misbehaving peers are persisted to.
+const peerBansPath = "peer-bans.json"
+
 // Service for managing peer to peer (p2p) networking.
 type Service struct {
 	ctx           context.Context
@@ -50,6 +56,8 @@ type Service struct {
 	privKey       *ecdsa.PrivateKey
 	dht           *kaddht.IpfsDHT
 	peers         *peers.Status
+	metadata      *pb.MetaData
+	metadataLock  sync.RWMutex
 }
 
 // NewService initializes a new p2p service compatible with shared.Service interface. No
@@ -68,6 +76,7 @@ func NewService(cfg *Config) (*Service, error) {
 		cancel:        cancel,
 		cfg:           cfg,
 		exclusionList: cache,
+		metadata:      &pb.MetaData{Attnets: attSubnetsBitvector(nil)},
 	}
 
 	dv5Nodes, kadDHTNodes := parseBootStrapAddrs(s.cfg.BootstrapNodeAddr)
@@ -124,7 +133,17 @@ func NewService(cfg *Config) (*Service, error) {
 	}
 	s.pubsub = gs
 
-	s.peers = peers.NewStatus(maxBadResponses)
+	scoring := peers.DefaultScoringConfig()
+	if cfg.PeerScoring != nil {
+		*scoring = *cfg.PeerScoring
+	}
+	if cfg.DataDir != "" {
+		scoring.BanFile = path.Join(cfg.DataDir, peerBansPath)
+	}
+	s.peers = peers.NewStatusWithScoring(maxBadResponses, scoring)
+	if err := s.peers.LoadBans(); err != nil {
+		log.WithError(err).Error("Could not load persisted peer bans")
+	}
 
 	return s, nil
 }
@@ -209,6 +228,7 @@ func (s *Service) Start() {
 		ensurePeerConnections(s.ctx, s.host, peersToWatch...)
 	})
 	runutil.RunEvery(s.ctx, time.Hour, s.Peers().Decay)
+	runutil.RunEvery(s.ctx, 5*time.Second, s.disconnectBadPeers)
 	runutil.RunEvery(s.ctx, 10*time.Second, s.updateMetrics)
 
 	multiAddrs := s.host.Network().ListenAddresses()
@@ -273,6 +293,20 @@ func (s *Service) Disconnect(pid peer.ID) error {
 	return s.host.Network().ClosePeer(pid)
 }
 
+// disconnectBadPeers disconnects from the connected peers whose score dropped too low or which
+// were banned since they connected.
+func (s *Service) disconnectBadPeers() {
+	for _, pid := range s.peers.Connected() {
+		if !s.peers.IsBad(pid) {
+			continue
+		}
+		log.WithField("peer", pid.Pretty()).Debug("Disconnecting from bad peer")
+		if err := s.Disconnect(pid); err != nil {
+			log.WithError(err).Error("Unable to disconnect from peer")
+		}
+	}
+}
+
 // Peers returns the peer status interface.
 func (s *Service) Peers() *peers.Status {
 	return s.peers
@@ -0,0 +1,49 @@
+package p2p
+
+import (
+	"github.com/ethereum/go-ethereum/p2p/enr"
+	"github.com/prysmaticlabs/prysm/shared/params"
+)
+
+// attSubnetEnrKey is the ENR key of the bitvector of the long-lived attestation subnets
+// the node is subscribed to.
+const attSubnetEnrKey = "attnets"
+
+// AttestationSubnet returns the gossip subnet the attestations of the committee are
+// broadcast on.
+func AttestationSubnet(committeeIndex uint64) uint64 {
+	return committeeIndex % params.BeaconConfig().AttestationSubnetCount
+}
+
+// AdvertiseAttestationSubnets sets the long-lived attestation subnets of the node in its ENR,
+// so that peers looking for nodes on a subnet can find them through discovery, and in the
+// metadata served to connected peers.
+func (s *Service) AdvertiseAttestationSubnets(subnets []uint64) {
+	s.updateAttnets(attSubnetsBitvector(subnets))
+	if s.dv5Listener == nil {
+		return
+	}
+	localNode := s.dv5Listener.LocalNode()
+	if localNode == nil {
+		return
+	}
+	// The record sequence number is only increased when the entry changes.
+	localNode.Set(attSubnetsEntry(subnets))
+}
+
+// attSubnetsEntry returns the ENR entry of the bitvector of the attestation subnets.
+func attSubnetsEntry(subnets []uint64) enr.Entry {
+	return enr.WithEntry(attSubnetEnrKey, attSubnetsBitvector(subnets))
+}
+
+// attSubnetsBitvector returns the bitvector with the bits of the attestation subnets set.
+func attSubnetsBitvector(subnets []uint64) []byte {
+	count := params.BeaconConfig().AttestationSubnetCount
+	bitV := make([]byte, (count+7)/8)
+	for _, subnet := range subnets {
+		if subnet < count {
+			bitV[subnet/8] |= 1 << (subnet % 8)
+		}
+	}
+	return bitV
+}
@@ -0,0 +1,70 @@
+package p2p
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/p2p/enr"
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+)
+
+func TestAttestationSubnet(t *testing.T) {
+	tests := []struct {
+		committeeIndex uint64
+		subnet         uint64
+	}{
+		{committeeIndex: 0, subnet: 0},
+		{committeeIndex: 63, subnet: 63},
+		{committeeIndex: 64, subnet: 0},
+		{committeeIndex: 130, subnet: 2},
+	}
+	for _, tt := range tests {
+		if subnet := AttestationSubnet(tt.committeeIndex); subnet != tt.subnet {
+			t.Errorf("Wrong subnet for committee %d, got %d wanted %d", tt.committeeIndex, subnet, tt.subnet)
+		}
+	}
+}
+
+func TestAdvertiseAttestationSubnets_SetsENR(t *testing.T) {
+	ipAddr, pkey := createAddrAndPrivKey(t)
+	localNode, err := createLocalNode(pkey, ipAddr, 2000, 3000)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var bitV []byte
+	if err := localNode.Node().Load(enr.WithEntry(attSubnetEnrKey, &bitV)); err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(bitV, make([]byte, 8)) {
+		t.Errorf("Expected no advertised subnets, received %#x", bitV)
+	}
+
+	localNode.Set(attSubnetsEntry([]uint64{1, 9, 63, 64}))
+	if err := localNode.Node().Load(enr.WithEntry(attSubnetEnrKey, &bitV)); err != nil {
+		t.Fatal(err)
+	}
+	want := []byte{0x02, 0x02, 0, 0, 0, 0, 0, 0x80}
+	if !bytes.Equal(bitV, want) {
+		t.Errorf("Wanted advertised subnets %#x, received %#x", want, bitV)
+	}
+}
+
+func TestAdvertiseAttestationSubnets_UpdatesMetadata(t *testing.T) {
+	s := &Service{metadata: &pb.MetaData{Attnets: attSubnetsBitvector(nil)}}
+
+	s.AdvertiseAttestationSubnets([]uint64{1, 9})
+	md := s.Metadata()
+	if md.SeqNumber != 1 {
+		t.Errorf("Wanted sequence number 1, received %d", md.SeqNumber)
+	}
+	want := []byte{0x02, 0x02, 0, 0, 0, 0, 0, 0}
+	if !bytes.Equal(md.Attnets, want) {
+		t.Errorf("Wanted attnets %#x, received %#x", want, md.Attnets)
+	}
+
+	// Advertising the same subnets again does not change the metadata.
+	s.AdvertiseAttestationSubnets([]uint64{9, 1})
+	if md := s.Metadata(); md.SeqNumber != 1 {
+		t.Errorf("Wanted sequence number 1 after advertising the same subnets, received %d", md.SeqNumber)
+	}
+}
@@ -29,6 +29,8 @@ var TopicMappings = map[reflect.Type]string{
 	reflect.TypeOf(new(uint64)):                      "/eth2/beacon_chain/req/goodbye/1",
 	reflect.TypeOf(&pb.BeaconBlocksByRangeRequest{}): "/eth2/beacon_chain/req/beacon_blocks_by_range/1",
 	reflect.TypeOf([][32]byte{}):                     "/eth2/beacon_chain/req/beacon_blocks_by_root/1",
+	reflect.TypeOf(&pb.Ping{}):                       "/eth2/beacon_chain/req/ping/1",
+	reflect.TypeOf(nil):                              "/eth2/beacon_chain/req/metadata/1",
 }
 
 // TestP2P represents a p2p implementation that can be used for testing.
@@ -38,6 +40,7 @@ type TestP2P struct {
 	pubsub          *pubsub.PubSub
 	BroadcastCalled bool
 	DelaySend       bool
+	LocalMetadata   *pb.MetaData
 	peers           *peers.Status
 }
 
@@ -153,6 +156,13 @@ func (p *TestP2P) PeerID() peer.ID {
 	return p.Host.ID()
 }
 
+// SupportsRPC returns true if the peer advertised the rpc protocol of the message.
+func (p *TestP2P) SupportsRPC(pid peer.ID, message interface{}) bool {
+	topic := TopicMappings[reflect.TypeOf(message)] + p.Encoding().ProtocolSuffix()
+	supported, err := p.Host.Peerstore().SupportsProtocols(pid, topic)
+	return err == nil && len(supported) > 0
+}
+
 // AddConnectionHandler handles the connection with a newly connected peer.
 func (p *TestP2P) AddConnectionHandler(f func(ctx context.Context, id peer.ID) error) {
 	p.Host.Network().Notify(&network.NotifyBundle{
@@ -223,6 +233,14 @@ func (p *TestP2P) Started() bool {
 	return true
 }
 
+// AdvertiseAttestationSubnets does nothing.
+func (p *TestP2P) AdvertiseAttestationSubnets(subnets []uint64) {}
+
+// Metadata returns the local metadata set on the test service.
+func (p *TestP2P) Metadata() *pb.MetaData {
+	return p.LocalMetadata
+}
+
 // Peers returns the peer status.
 func (p *TestP2P) Peers() *peers.Status {
 	return p.peers
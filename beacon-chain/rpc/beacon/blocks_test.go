@@ -21,6 +21,7 @@ import (
 	mockRPC "github.com/prysmaticlabs/prysm/beacon-chain/rpc/testing"
 	pbp2p "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
 	"github.com/prysmaticlabs/prysm/shared/params"
+	"google.golang.org/grpc"
 )
 
 func TestServer_ListBlocks_NoResults(t *testing.T) {
@@ -516,3 +517,68 @@ func TestServer_StreamChainHead_OnHeadUpdated(t *testing.T) {
 	}
 	<-exitRoutine
 }
+
+// blocksStream collects the blocks sent to the client.
+type blocksStream struct {
+	grpc.ServerStream
+	ctx    context.Context
+	blocks chan *ethpb.SignedBeaconBlock
+}
+
+func (s *blocksStream) Send(blk *ethpb.SignedBeaconBlock) error {
+	s.blocks <- blk
+	return nil
+}
+
+func (s *blocksStream) Context() context.Context {
+	return s.ctx
+}
+
+func TestServer_StreamBlocks_OnBlockProcessed(t *testing.T) {
+	db := dbTest.SetupDB(t)
+	defer dbTest.TeardownDB(t, db)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	// Two blocks at the same slot, of which only one can be the head.
+	blocks := []*ethpb.SignedBeaconBlock{
+		{Block: &ethpb.BeaconBlock{Slot: 4, ParentRoot: []byte{'A'}}},
+		{Block: &ethpb.BeaconBlock{Slot: 4, ParentRoot: []byte{'B'}}},
+	}
+	if err := db.SaveBlocks(ctx, blocks); err != nil {
+		t.Fatal(err)
+	}
+	chainService := &mock.ChainService{}
+	server := &Server{
+		Ctx:           context.Background(),
+		BeaconDB:      db,
+		StateNotifier: chainService.StateNotifier(),
+	}
+	stream := &blocksStream{ctx: ctx, blocks: make(chan *ethpb.SignedBeaconBlock, 2)}
+	exited := make(chan error)
+	go func() {
+		exited <- server.StreamBlocks(&ptypes.Empty{}, stream)
+	}()
+
+	for _, blk := range blocks {
+		root, err := ssz.HashTreeRoot(blk.Block)
+		if err != nil {
+			t.Fatal(err)
+		}
+		// Send in a loop to ensure it is delivered (busy wait for the service to subscribe to the state feed).
+		for sent := 0; sent == 0; {
+			sent = server.StateNotifier.StateFeed().Send(&feed.Event{
+				Type: statefeed.BlockProcessed,
+				Data: &statefeed.BlockProcessedData{Slot: blk.Block.Slot, BlockRoot: root},
+			})
+		}
+		if received := <-stream.blocks; !proto.Equal(received, blk) {
+			t.Errorf("Wanted block %v, received %v", blk, received)
+		}
+	}
+
+	cancel()
+	if err := <-exited; err == nil {
+		t.Error("Expected the stream to end with an error once the context is canceled")
+	}
+}
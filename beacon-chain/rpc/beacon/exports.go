@@ -0,0 +1,48 @@
+package beacon
+
+import (
+	"github.com/prysmaticlabs/go-ssz"
+	"github.com/prysmaticlabs/prysm/beacon-chain/db/export"
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/rpc/v1"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// exportStreamBufferSize is the number of objects which may wait to be sent to a client. Objects
+// are dropped for a client which falls further behind.
+const exportStreamBufferSize = 256
+
+// StreamExports to clients every time the database saves an object of the requested topics.
+func (bs *Server) StreamExports(req *pb.StreamExportsRequest, stream pb.ExportService_StreamExportsServer) error {
+	if bs.ExportStream == nil {
+		return status.Error(codes.Unimplemented, "Export stream is not enabled")
+	}
+	filter, err := export.NewFilter(req.Topics)
+	if err != nil {
+		return status.Errorf(codes.InvalidArgument, "Invalid topics: %v", err)
+	}
+	objects, unsubscribe := bs.ExportStream.Subscribe(filter, exportStreamBufferSize)
+	defer unsubscribe()
+	for {
+		select {
+		case obj, ok := <-objects:
+			if !ok {
+				return status.Error(codes.Aborted, "Export stream closed, exiting goroutine")
+			}
+			enc, err := ssz.Marshal(obj.Message)
+			if err != nil {
+				return status.Errorf(codes.Internal, "Could not marshal object: %v", err)
+			}
+			if err := stream.Send(&pb.ExportedObject{
+				Topic:  obj.Topic,
+				Object: enc,
+			}); err != nil {
+				return status.Errorf(codes.Unavailable, "Could not send over stream: %v", err)
+			}
+		case <-bs.Ctx.Done():
+			return status.Error(codes.Canceled, "Context canceled")
+		case <-stream.Context().Done():
+			return status.Error(codes.Canceled, "Context canceled")
+		}
+	}
+}
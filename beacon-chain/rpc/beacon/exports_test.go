@@ -0,0 +1,94 @@
+package beacon
+
+import (
+	"context"
+	"testing"
+
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/go-ssz"
+	"github.com/prysmaticlabs/prysm/beacon-chain/db/export"
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/rpc/v1"
+	"google.golang.org/grpc"
+)
+
+// exportsStream collects the objects sent to the client.
+type exportsStream struct {
+	grpc.ServerStream
+	ctx     context.Context
+	objects chan *pb.ExportedObject
+}
+
+func (s *exportsStream) Send(obj *pb.ExportedObject) error {
+	s.objects <- obj
+	return nil
+}
+
+func (s *exportsStream) Context() context.Context {
+	return s.ctx
+}
+
+func TestServer_StreamExports_FiltersTopics(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	sink := export.NewStreamSink()
+	server := &Server{
+		Ctx:          context.Background(),
+		ExportStream: sink,
+	}
+	stream := &exportsStream{ctx: ctx, objects: make(chan *pb.ExportedObject, 1)}
+	exited := make(chan error)
+	go func() {
+		exited <- server.StreamExports(&pb.StreamExportsRequest{Topics: []string{export.FinalizedCheckpointTopic}}, stream)
+	}()
+
+	checkpoint := &ethpb.Checkpoint{Epoch: 5, Root: make([]byte, 32)}
+	// Export in a loop to ensure it is delivered (busy wait for the server to subscribe to the sink).
+	for len(stream.objects) == 0 {
+		if err := sink.Export(ctx, export.BlockTopic, &ethpb.SignedBeaconBlock{Block: &ethpb.BeaconBlock{}}); err != nil {
+			t.Fatal(err)
+		}
+		if err := sink.Export(ctx, export.FinalizedCheckpointTopic, checkpoint); err != nil {
+			t.Fatal(err)
+		}
+	}
+	obj := <-stream.objects
+	if obj.Topic != export.FinalizedCheckpointTopic {
+		t.Errorf("Wanted topic %s, received %s", export.FinalizedCheckpointTopic, obj.Topic)
+	}
+	received := &ethpb.Checkpoint{}
+	if err := ssz.Unmarshal(obj.Object, received); err != nil {
+		t.Fatal(err)
+	}
+	if received.Epoch != 5 {
+		t.Errorf("Wanted checkpoint epoch 5, received %d", received.Epoch)
+	}
+
+	cancel()
+	for {
+		select {
+		case err := <-exited:
+			if err == nil {
+				t.Error("Expected the stream to end with an error once the context is canceled")
+			}
+			return
+		case <-stream.objects:
+			// Drain the objects exported before the stream noticed the cancellation.
+		}
+	}
+}
+
+func TestServer_StreamExports_NotEnabled(t *testing.T) {
+	server := &Server{Ctx: context.Background()}
+	stream := &exportsStream{ctx: context.Background()}
+	if err := server.StreamExports(&pb.StreamExportsRequest{}, stream); err == nil {
+		t.Error("Expected an error when the export stream is not enabled")
+	}
+}
+
+func TestServer_StreamExports_UnknownTopic(t *testing.T) {
+	server := &Server{Ctx: context.Background(), ExportStream: export.NewStreamSink()}
+	stream := &exportsStream{ctx: context.Background()}
+	if err := server.StreamExports(&pb.StreamExportsRequest{Topics: []string{"beacon_state"}}, stream); err == nil {
+		t.Error("Expected an error for an unknown topic")
+	}
+}
@@ -0,0 +1,43 @@
+package beacon
+
+import (
+	ptypes "github.com/gogo/protobuf/types"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/feed"
+	statefeed "github.com/prysmaticlabs/prysm/beacon-chain/core/feed/state"
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/rpc/v1"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// StreamChainReorgs to clients every time the new head of the chain is not a descendant of the
+// previous head.
+func (bs *Server) StreamChainReorgs(_ *ptypes.Empty, stream pb.ReorgService_StreamChainReorgsServer) error {
+	stateChannel := make(chan *feed.Event, 1)
+	stateSub := bs.StateNotifier.StateFeed().Subscribe(stateChannel)
+	defer stateSub.Unsubscribe()
+	for {
+		select {
+		case event := <-stateChannel:
+			if event.Type == statefeed.Reorg {
+				data := event.Data.(*statefeed.ReorgData)
+				if err := stream.Send(&pb.ChainReorg{
+					OldHeadRoot:        data.OldHeadRoot[:],
+					OldHeadSlot:        data.OldHeadSlot,
+					NewHeadRoot:        data.NewHeadRoot[:],
+					NewHeadSlot:        data.NewHeadSlot,
+					CommonAncestorRoot: data.CommonAncestorRoot[:],
+					CommonAncestorSlot: data.CommonAncestorSlot,
+					Depth:              data.Depth,
+				}); err != nil {
+					return status.Errorf(codes.Unavailable, "Could not send over stream: %v", err)
+				}
+			}
+		case <-stateSub.Err():
+			return status.Error(codes.Aborted, "Subscriber closed, exiting goroutine")
+		case <-bs.Ctx.Done():
+			return status.Error(codes.Canceled, "Context canceled")
+		case <-stream.Context().Done():
+			return status.Error(codes.Canceled, "Context canceled")
+		}
+	}
+}
@@ -0,0 +1,73 @@
+package beacon
+
+import (
+	"context"
+	"testing"
+
+	ptypes "github.com/gogo/protobuf/types"
+	mock "github.com/prysmaticlabs/prysm/beacon-chain/blockchain/testing"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/feed"
+	statefeed "github.com/prysmaticlabs/prysm/beacon-chain/core/feed/state"
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/rpc/v1"
+	"google.golang.org/grpc"
+)
+
+// reorgsStream collects the reorgs sent to the client.
+type reorgsStream struct {
+	grpc.ServerStream
+	ctx    context.Context
+	reorgs chan *pb.ChainReorg
+}
+
+func (s *reorgsStream) Send(reorg *pb.ChainReorg) error {
+	s.reorgs <- reorg
+	return nil
+}
+
+func (s *reorgsStream) Context() context.Context {
+	return s.ctx
+}
+
+func TestServer_StreamChainReorgs_OnReorg(t *testing.T) {
+	chainService := &mock.ChainService{}
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	server := &Server{
+		Ctx:           context.Background(),
+		StateNotifier: chainService.StateNotifier(),
+	}
+	stream := &reorgsStream{ctx: ctx, reorgs: make(chan *pb.ChainReorg, 1)}
+	exited := make(chan error)
+	go func() {
+		exited <- server.StreamChainReorgs(&ptypes.Empty{}, stream)
+	}()
+
+	event := &feed.Event{
+		Type: statefeed.Reorg,
+		Data: &statefeed.ReorgData{
+			OldHeadRoot:        [32]byte{'a'},
+			OldHeadSlot:        10,
+			NewHeadRoot:        [32]byte{'b'},
+			NewHeadSlot:        11,
+			CommonAncestorRoot: [32]byte{'c'},
+			CommonAncestorSlot: 8,
+			Depth:              2,
+		},
+	}
+	// Send in a loop to ensure it is delivered (busy wait for the service to subscribe to the state feed).
+	for sent := 0; sent == 0; {
+		sent = server.StateNotifier.StateFeed().Send(event)
+	}
+	reorg := <-stream.reorgs
+	if reorg.OldHeadSlot != 10 || reorg.NewHeadSlot != 11 || reorg.CommonAncestorSlot != 8 || reorg.Depth != 2 {
+		t.Errorf("Unexpected reorg %v", reorg)
+	}
+	if reorg.NewHeadRoot[0] != 'b' {
+		t.Errorf("Wanted new head root %#x, received %#x", [32]byte{'b'}, reorg.NewHeadRoot)
+	}
+
+	cancel()
+	if err := <-exited; err == nil {
+		t.Error("Expected the stream to end with an error once the context is canceled")
+	}
+}
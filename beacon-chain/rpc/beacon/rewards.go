@@ -0,0 +1,147 @@
+package beacon
+
+import (
+	"context"
+	"sort"
+	"strconv"
+
+	pbp2p "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/rpc/v1"
+	"github.com/prysmaticlabs/prysm/shared/pagination"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// maxRewardsEpochRange is the largest number of epochs of which the rewards can be listed in one
+// request, as the archived rewards of every epoch in the range are read to paginate them.
+const maxRewardsEpochRange = 256
+
+// ListValidatorRewards retrieves the breakdown of the rewards and penalties archived at the end of
+// the epochs in the requested range, for the requested validators or for all of them. Epochs
+// which were not archived are skipped.
+func (bs *Server) ListValidatorRewards(
+	ctx context.Context,
+	req *pb.ListValidatorRewardsRequest,
+) (*pb.ListValidatorRewardsResponse, error) {
+	if int(req.PageSize) > params.BeaconConfig().MaxPageSize {
+		return nil, status.Errorf(codes.InvalidArgument, "Requested page size %d can not be greater than max size %d",
+			req.PageSize, params.BeaconConfig().MaxPageSize)
+	}
+	if req.EndEpoch < req.StartEpoch {
+		return nil, status.Errorf(codes.InvalidArgument, "End epoch %d can not be before start epoch %d",
+			req.EndEpoch, req.StartEpoch)
+	}
+	if req.EndEpoch-req.StartEpoch >= maxRewardsEpochRange {
+		return nil, status.Errorf(codes.InvalidArgument, "Requested epoch range can not be greater than %d epochs",
+			maxRewardsEpochRange)
+	}
+
+	indices := make([]uint64, 0, len(req.Indices))
+	seen := make(map[uint64]bool)
+	for _, index := range req.Indices {
+		if !seen[index] {
+			seen[index] = true
+			indices = append(indices, index)
+		}
+	}
+	sort.Slice(indices, func(i, j int) bool {
+		return indices[i] < indices[j]
+	})
+
+	// The rewards of every epoch are counted first, so only the epochs of the requested page are
+	// kept in memory to build the response.
+	counts := make(map[uint64]int)
+	total := 0
+	for epoch := req.StartEpoch; epoch <= req.EndEpoch; epoch++ {
+		archived, err := bs.BeaconDB.ArchivedValidatorRewards(ctx, epoch)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "Could not retrieve rewards for epoch %d: %v", epoch, err)
+		}
+		if archived == nil {
+			continue
+		}
+		counts[epoch] = len(matchingRewardIndices(archived, indices))
+		total += counts[epoch]
+	}
+
+	// If there are no rewards, we simply return a response specifying this.
+	// Otherwise, attempting to paginate 0 rewards below would result in an error.
+	if total == 0 {
+		return &pb.ListValidatorRewardsResponse{
+			Rewards:       make([]*pb.ValidatorEpochRewards, 0),
+			TotalSize:     int32(0),
+			NextPageToken: strconv.Itoa(0),
+		}, nil
+	}
+
+	start, end, nextPageToken, err := pagination.StartAndEndPage(req.PageToken, int(req.PageSize), total)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not paginate results: %v", err)
+	}
+
+	res := make([]*pb.ValidatorEpochRewards, 0, end-start)
+	offset := 0
+	for epoch := req.StartEpoch; epoch <= req.EndEpoch && offset < end; epoch++ {
+		count := counts[epoch]
+		if offset+count <= start {
+			offset += count
+			continue
+		}
+		archived, err := bs.BeaconDB.ArchivedValidatorRewards(ctx, epoch)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "Could not retrieve rewards for epoch %d: %v", epoch, err)
+		}
+		if archived == nil {
+			return nil, status.Errorf(codes.Internal, "Rewards for epoch %d are no longer archived", epoch)
+		}
+		for _, index := range matchingRewardIndices(archived, indices) {
+			if offset >= start && offset < end {
+				res = append(res, validatorEpochRewards(epoch, index, archived.Rewards[index]))
+			}
+			offset++
+		}
+	}
+
+	return &pb.ListValidatorRewardsResponse{
+		Rewards:       res,
+		TotalSize:     int32(total),
+		NextPageToken: nextPageToken,
+	}, nil
+}
+
+// matchingRewardIndices of the archived rewards, which are all of the validators when no index is
+// requested, or the requested indices of the validators which existed at the epoch otherwise.
+func matchingRewardIndices(archived *pbp2p.ArchivedValidatorRewards, indices []uint64) []uint64 {
+	if len(indices) == 0 {
+		matching := make([]uint64, len(archived.Rewards))
+		for i := range matching {
+			matching[i] = uint64(i)
+		}
+		return matching
+	}
+	matching := make([]uint64, 0, len(indices))
+	for _, index := range indices {
+		if index < uint64(len(archived.Rewards)) {
+			matching = append(matching, index)
+		}
+	}
+	return matching
+}
+
+func validatorEpochRewards(epoch uint64, index uint64, r *pbp2p.ValidatorRewards) *pb.ValidatorEpochRewards {
+	return &pb.ValidatorEpochRewards{
+		Epoch:                epoch,
+		ValidatorIndex:       index,
+		SourceReward:         r.SourceReward,
+		TargetReward:         r.TargetReward,
+		HeadReward:           r.HeadReward,
+		InclusionDelayReward: r.InclusionDelayReward,
+		ProposerReward:       r.ProposerReward,
+		SourcePenalty:        r.SourcePenalty,
+		TargetPenalty:        r.TargetPenalty,
+		HeadPenalty:          r.HeadPenalty,
+		InactivityPenalty:    r.InactivityPenalty,
+		SlashingPenalty:      r.SlashingPenalty,
+	}
+}
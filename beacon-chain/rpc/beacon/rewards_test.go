@@ -0,0 +1,132 @@
+package beacon
+
+import (
+	"context"
+	"strconv"
+	"strings"
+	"testing"
+
+	dbTest "github.com/prysmaticlabs/prysm/beacon-chain/db/testing"
+	pbp2p "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/rpc/v1"
+)
+
+// saveValidatorRewards archives rewards for the validator count at every epoch, where the source
+// reward of a validator is its index plus 1000 times the epoch.
+func saveValidatorRewards(t *testing.T, bs *Server, epochs []uint64, validatorCount int) {
+	for _, epoch := range epochs {
+		rewards := make([]*pbp2p.ValidatorRewards, validatorCount)
+		for i := range rewards {
+			rewards[i] = &pbp2p.ValidatorRewards{SourceReward: epoch*1000 + uint64(i)}
+		}
+		if err := bs.BeaconDB.SaveArchivedValidatorRewards(context.Background(), epoch, &pbp2p.ArchivedValidatorRewards{Rewards: rewards}); err != nil {
+			t.Fatal(err)
+		}
+	}
+}
+
+func TestServer_ListValidatorRewards_Pagination(t *testing.T) {
+	db := dbTest.SetupDB(t)
+	defer dbTest.TeardownDB(t, db)
+	bs := &Server{BeaconDB: db}
+	// Epoch 2 was not archived.
+	saveValidatorRewards(t, bs, []uint64{1, 3}, 4)
+
+	req := &pb.ListValidatorRewardsRequest{StartEpoch: 0, EndEpoch: 3, PageSize: 3}
+	var received []*pb.ValidatorEpochRewards
+	for {
+		res, err := bs.ListValidatorRewards(context.Background(), req)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if res.TotalSize != 8 {
+			t.Errorf("Wanted total size 8, received %d", res.TotalSize)
+		}
+		received = append(received, res.Rewards...)
+		if res.NextPageToken == "" {
+			break
+		}
+		req.PageToken = res.NextPageToken
+	}
+	if len(received) != 8 {
+		t.Fatalf("Wanted 8 rewards, received %d", len(received))
+	}
+	for i, r := range received {
+		epoch := uint64(1)
+		if i >= 4 {
+			epoch = 3
+		}
+		index := uint64(i % 4)
+		if r.Epoch != epoch || r.ValidatorIndex != index || r.SourceReward != epoch*1000+index {
+			t.Errorf("Wanted rewards of validator %d at epoch %d, received %v", index, epoch, r)
+		}
+	}
+}
+
+func TestServer_ListValidatorRewards_FilterByIndices(t *testing.T) {
+	db := dbTest.SetupDB(t)
+	defer dbTest.TeardownDB(t, db)
+	bs := &Server{BeaconDB: db}
+	saveValidatorRewards(t, bs, []uint64{5, 6}, 4)
+
+	// Index 10 does not exist and index 2 is requested twice.
+	res, err := bs.ListValidatorRewards(context.Background(), &pb.ListValidatorRewardsRequest{
+		StartEpoch: 5,
+		EndEpoch:   6,
+		Indices:    []uint64{10, 2, 0, 2},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	wanted := []struct {
+		epoch uint64
+		index uint64
+	}{{5, 0}, {5, 2}, {6, 0}, {6, 2}}
+	if len(res.Rewards) != len(wanted) {
+		t.Fatalf("Wanted %d rewards, received %v", len(wanted), res.Rewards)
+	}
+	for i, w := range wanted {
+		if res.Rewards[i].Epoch != w.epoch || res.Rewards[i].ValidatorIndex != w.index {
+			t.Errorf("Wanted rewards of validator %d at epoch %d, received %v", w.index, w.epoch, res.Rewards[i])
+		}
+	}
+	if res.NextPageToken != "" {
+		t.Errorf("Wanted no next page token, received %s", res.NextPageToken)
+	}
+}
+
+func TestServer_ListValidatorRewards_NoRewards(t *testing.T) {
+	db := dbTest.SetupDB(t)
+	defer dbTest.TeardownDB(t, db)
+	bs := &Server{BeaconDB: db}
+
+	res, err := bs.ListValidatorRewards(context.Background(), &pb.ListValidatorRewardsRequest{StartEpoch: 0, EndEpoch: 10})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(res.Rewards) != 0 || res.TotalSize != 0 || res.NextPageToken != strconv.Itoa(0) {
+		t.Errorf("Wanted an empty response, received %v", res)
+	}
+}
+
+func TestServer_ListValidatorRewards_InvalidRange(t *testing.T) {
+	bs := &Server{}
+	tests := []struct {
+		req    *pb.ListValidatorRewardsRequest
+		wanted string
+	}{
+		{
+			req:    &pb.ListValidatorRewardsRequest{StartEpoch: 5, EndEpoch: 4},
+			wanted: "End epoch 4 can not be before start epoch 5",
+		},
+		{
+			req:    &pb.ListValidatorRewardsRequest{StartEpoch: 0, EndEpoch: maxRewardsEpochRange},
+			wanted: "Requested epoch range can not be greater than",
+		},
+	}
+	for _, tt := range tests {
+		if _, err := bs.ListValidatorRewards(context.Background(), tt.req); err == nil || !strings.Contains(err.Error(), tt.wanted) {
+			t.Errorf("Expected error %q, received %v", tt.wanted, err)
+		}
+	}
+}
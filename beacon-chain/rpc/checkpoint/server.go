@@ -0,0 +1,62 @@
+package checkpoint
+
+import (
+	"context"
+
+	ptypes "github.com/gogo/protobuf/types"
+	"github.com/prysmaticlabs/go-ssz"
+	"github.com/prysmaticlabs/prysm/beacon-chain/blockchain"
+	"github.com/prysmaticlabs/prysm/beacon-chain/db"
+	"github.com/prysmaticlabs/prysm/beacon-chain/state/stategen"
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/rpc/v1"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"go.opencensus.io/trace"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Server defines a server implementation of the gRPC checkpoint service, serving the finalized
+// state of the node for other nodes to start syncing from.
+type Server struct {
+	BeaconDB            db.Database
+	FinalizationFetcher blockchain.FinalizationFetcher
+	StateGen            *stategen.State
+}
+
+// FinalizedCheckpoint returns the SSZ encoded state and block of the latest finalized checkpoint
+// of the node.
+func (cs *Server) FinalizedCheckpoint(ctx context.Context, _ *ptypes.Empty) (*pb.CheckpointResponse, error) {
+	ctx, span := trace.StartSpan(ctx, "CheckpointServer.FinalizedCheckpoint")
+	defer span.End()
+
+	checkpoint := cs.FinalizationFetcher.FinalizedCheckpt()
+	root := bytesutil.ToBytes32(checkpoint.Root)
+	block, err := cs.BeaconDB.Block(ctx, root)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not get finalized block: %v", err)
+	}
+	if block == nil {
+		return nil, status.Errorf(codes.NotFound, "Finalized block %#x not found", root)
+	}
+	state, err := cs.StateGen.StateByRoot(ctx, root)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not get finalized state: %v", err)
+	}
+	if state == nil {
+		return nil, status.Errorf(codes.NotFound, "Finalized state %#x not found", root)
+	}
+
+	encodedState, err := ssz.Marshal(state)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not marshal finalized state: %v", err)
+	}
+	encodedBlock, err := ssz.Marshal(block)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not marshal finalized block: %v", err)
+	}
+	return &pb.CheckpointResponse{
+		State: encodedState,
+		Block: encodedBlock,
+		Epoch: checkpoint.Epoch,
+	}, nil
+}
@@ -0,0 +1,79 @@
+package checkpoint
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/gogo/protobuf/proto"
+	ptypes "github.com/gogo/protobuf/types"
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/go-ssz"
+	mock "github.com/prysmaticlabs/prysm/beacon-chain/blockchain/testing"
+	dbutil "github.com/prysmaticlabs/prysm/beacon-chain/db/testing"
+	"github.com/prysmaticlabs/prysm/beacon-chain/state/stategen"
+	pbp2p "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+	"github.com/prysmaticlabs/prysm/shared/testutil"
+)
+
+func TestFinalizedCheckpoint_OK(t *testing.T) {
+	db := dbutil.SetupDB(t)
+	defer dbutil.TeardownDB(t, db)
+	ctx := context.Background()
+
+	block := &ethpb.SignedBeaconBlock{Block: &ethpb.BeaconBlock{Slot: 64}}
+	root, err := ssz.HashTreeRoot(block.Block)
+	if err != nil {
+		t.Fatal(err)
+	}
+	state, _ := testutil.DeterministicGenesisState(t, 8)
+	state.Slot = 64
+	if err := db.SaveBlock(ctx, block); err != nil {
+		t.Fatal(err)
+	}
+	if err := db.SaveState(ctx, state, root); err != nil {
+		t.Fatal(err)
+	}
+
+	server := &Server{
+		BeaconDB:            db,
+		FinalizationFetcher: &mock.ChainService{FinalizedCheckPoint: &ethpb.Checkpoint{Epoch: 8, Root: root[:]}},
+		StateGen:            stategen.New(db, 0),
+	}
+	res, err := server.FinalizedCheckpoint(ctx, &ptypes.Empty{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if res.Epoch != 8 {
+		t.Errorf("Wanted epoch 8, received %d", res.Epoch)
+	}
+	receivedState := &pbp2p.BeaconState{}
+	if err := ssz.Unmarshal(res.State, receivedState); err != nil {
+		t.Fatal(err)
+	}
+	if !proto.Equal(state, receivedState) {
+		t.Errorf("Wanted state %v, received %v", state, receivedState)
+	}
+	receivedBlock := &ethpb.SignedBeaconBlock{}
+	if err := ssz.Unmarshal(res.Block, receivedBlock); err != nil {
+		t.Fatal(err)
+	}
+	if !proto.Equal(block, receivedBlock) {
+		t.Errorf("Wanted block %v, received %v", block, receivedBlock)
+	}
+}
+
+func TestFinalizedCheckpoint_MissingBlock(t *testing.T) {
+	db := dbutil.SetupDB(t)
+	defer dbutil.TeardownDB(t, db)
+
+	server := &Server{
+		BeaconDB:            db,
+		FinalizationFetcher: &mock.ChainService{FinalizedCheckPoint: &ethpb.Checkpoint{Root: []byte{'a'}}},
+		StateGen:            stategen.New(db, 0),
+	}
+	want := "Finalized block"
+	if _, err := server.FinalizedCheckpoint(context.Background(), &ptypes.Empty{}); err == nil || !strings.Contains(err.Error(), want) {
+		t.Errorf("Expected error containing %q, received %v", want, err)
+	}
+}
@@ -11,7 +11,9 @@ import (
 	"github.com/prysmaticlabs/prysm/beacon-chain/blockchain"
 	"github.com/prysmaticlabs/prysm/beacon-chain/db"
 	"github.com/prysmaticlabs/prysm/beacon-chain/p2p"
+	"github.com/prysmaticlabs/prysm/beacon-chain/p2p/peers"
 	"github.com/prysmaticlabs/prysm/beacon-chain/sync"
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/rpc/v1"
 	"github.com/prysmaticlabs/prysm/shared/version"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
@@ -108,3 +110,49 @@ func (ns *Server) ListPeers(ctx context.Context, _ *ptypes.Empty) (*ethpb.Peers,
 		Peers: res,
 	}, nil
 }
+
+// ListPeersWithScores lists every peer known to this node with its score, including the peers
+// which are disconnected or banned, from the best scored peer to the worst.
+func (ns *Server) ListPeersWithScores(ctx context.Context, _ *ptypes.Empty) (*pb.PeerScoresResponse, error) {
+	peerStatus := ns.PeersFetcher.Peers()
+	res := make([]*pb.PeerScore, 0)
+	for _, pid := range peerStatus.All() {
+		score, err := peerStatus.Score(pid)
+		if err != nil {
+			continue
+		}
+		address := ""
+		if multiaddr, err := peerStatus.Address(pid); err == nil && multiaddr != nil {
+			address = fmt.Sprintf("%s/p2p/%s", multiaddr.String(), pid.Pretty())
+		}
+		connectionState, err := peerStatus.ConnectionState(pid)
+		if err != nil {
+			continue
+		}
+		bannedUntil := uint64(0)
+		if peerStatus.IsBanned(pid) {
+			until, err := peerStatus.BannedUntil(pid)
+			if err != nil {
+				continue
+			}
+			bannedUntil = uint64(until.Unix())
+		}
+		res = append(res, &pb.PeerScore{
+			Address:     address,
+			PeerId:      pid.Pretty(),
+			Score:       int64(score),
+			Connected:   connectionState == peers.PeerConnected,
+			BannedUntil: bannedUntil,
+		})
+	}
+	sort.Slice(res, func(i, j int) bool {
+		if res[i].Score != res[j].Score {
+			return res[i].Score > res[j].Score
+		}
+		return res[i].PeerId < res[j].PeerId
+	})
+
+	return &pb.PeerScoresResponse{
+		Peers: res,
+	}, nil
+}
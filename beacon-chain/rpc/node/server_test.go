@@ -3,6 +3,7 @@ package node
 import (
 	"bytes"
 	"context"
+	"sort"
 	"testing"
 	"time"
 
@@ -11,6 +12,7 @@ import (
 	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
 	mock "github.com/prysmaticlabs/prysm/beacon-chain/blockchain/testing"
 	dbutil "github.com/prysmaticlabs/prysm/beacon-chain/db/testing"
+	"github.com/prysmaticlabs/prysm/beacon-chain/p2p/peers"
 	mockP2p "github.com/prysmaticlabs/prysm/beacon-chain/p2p/testing"
 	mockSync "github.com/prysmaticlabs/prysm/beacon-chain/sync/initial-sync/testing"
 	"github.com/prysmaticlabs/prysm/shared/version"
@@ -122,3 +124,37 @@ func TestNodeServer_ListPeers(t *testing.T) {
 		t.Errorf("Expected 2st peer to be an outbound (%d) connection, received %d", ethpb.PeerDirection_OUTBOUND, res.Peers[0].Direction)
 	}
 }
+
+func TestNodeServer_ListPeersWithScores(t *testing.T) {
+	peersProvider := &mockP2p.MockPeersProvider{}
+	ns := &Server{
+		PeersFetcher: peersProvider,
+	}
+	connected := peersProvider.Peers().Connected()
+	sort.Slice(connected, func(i, j int) bool { return connected[i] < connected[j] })
+	peersProvider.Peers().AddScore(connected[0], 3*peers.ScoreUsefulBlock)
+	peersProvider.Peers().AddScore(connected[1], -100)
+
+	res, err := ns.ListPeersWithScores(context.Background(), &ptypes.Empty{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(res.Peers) != 2 {
+		t.Fatalf("Expected 2 peers, received %d: %v", len(res.Peers), res.Peers)
+	}
+	if res.Peers[0].PeerId != connected[0].Pretty() || res.Peers[0].Score != 3 {
+		t.Errorf("Expected best peer %s with score 3, received %s with score %d", connected[0].Pretty(), res.Peers[0].PeerId, res.Peers[0].Score)
+	}
+	if res.Peers[0].BannedUntil != 0 {
+		t.Error("Expected best peer not to be banned")
+	}
+	if !res.Peers[0].Connected {
+		t.Error("Expected best peer to be connected")
+	}
+	if res.Peers[1].Score != -100 {
+		t.Errorf("Expected worst peer score -100, received %d", res.Peers[1].Score)
+	}
+	if res.Peers[1].BannedUntil <= uint64(time.Now().Unix()) {
+		t.Error("Expected worst peer to be banned")
+	}
+}
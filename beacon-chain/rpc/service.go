@@ -19,13 +19,18 @@ import (
 	opfeed "github.com/prysmaticlabs/prysm/beacon-chain/core/feed/operation"
 	statefeed "github.com/prysmaticlabs/prysm/beacon-chain/core/feed/state"
 	"github.com/prysmaticlabs/prysm/beacon-chain/db"
+	"github.com/prysmaticlabs/prysm/beacon-chain/db/export"
 	"github.com/prysmaticlabs/prysm/beacon-chain/operations/attestations"
+	"github.com/prysmaticlabs/prysm/beacon-chain/operations/slashings"
+	"github.com/prysmaticlabs/prysm/beacon-chain/operations/voluntaryexits"
 	"github.com/prysmaticlabs/prysm/beacon-chain/p2p"
 	"github.com/prysmaticlabs/prysm/beacon-chain/powchain"
 	"github.com/prysmaticlabs/prysm/beacon-chain/rpc/aggregator"
 	"github.com/prysmaticlabs/prysm/beacon-chain/rpc/beacon"
+	"github.com/prysmaticlabs/prysm/beacon-chain/rpc/checkpoint"
 	"github.com/prysmaticlabs/prysm/beacon-chain/rpc/node"
 	"github.com/prysmaticlabs/prysm/beacon-chain/rpc/validator"
+	"github.com/prysmaticlabs/prysm/beacon-chain/state/stategen"
 	"github.com/prysmaticlabs/prysm/beacon-chain/sync"
 	pbp2p "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
 	pb "github.com/prysmaticlabs/prysm/proto/beacon/rpc/v1"
@@ -64,6 +69,8 @@ type Service struct {
 	chainStartFetcher      powchain.ChainStartFetcher
 	mockEth1Votes          bool
 	attestationsPool       attestations.Pool
+	exitPool               *voluntaryexits.Pool
+	slashingsPool          *slashings.Pool
 	syncService            sync.Checker
 	port                   string
 	listener               net.Listener
@@ -84,6 +91,8 @@ type Service struct {
 	slasherCert            string
 	slasherCredentialError error
 	slasherClient          slashpb.SlasherClient
+	exportStream           *export.StreamSink
+	stateGen               *stategen.State
 }
 
 // Config options for the beacon node RPC server.
@@ -103,6 +112,8 @@ type Config struct {
 	GenesisTimeFetcher    blockchain.GenesisTimeFetcher
 	MockEth1Votes         bool
 	AttestationsPool      attestations.Pool
+	ExitPool              *voluntaryexits.Pool
+	SlashingsPool         *slashings.Pool
 	SyncService           sync.Checker
 	Broadcaster           p2p.Broadcaster
 	PeersFetcher          p2p.PeersProvider
@@ -112,6 +123,8 @@ type Config struct {
 	SlasherCert           string
 	StateNotifier         statefeed.Notifier
 	OperationNotifier     opfeed.Notifier
+	ExportStream          *export.StreamSink
+	StateGen              *stategen.State
 }
 
 // NewService instantiates a new RPC service instance that will
@@ -135,6 +148,8 @@ func NewService(ctx context.Context, cfg *Config) *Service {
 		chainStartFetcher:     cfg.ChainStartFetcher,
 		mockEth1Votes:         cfg.MockEth1Votes,
 		attestationsPool:      cfg.AttestationsPool,
+		exitPool:              cfg.ExitPool,
+		slashingsPool:         cfg.SlashingsPool,
 		syncService:           cfg.SyncService,
 		port:                  cfg.Port,
 		withCert:              cfg.CertFlag,
@@ -147,6 +162,8 @@ func NewService(ctx context.Context, cfg *Config) *Service {
 		operationNotifier:     cfg.OperationNotifier,
 		slasherProvider:       cfg.SlasherProvider,
 		slasherCert:           cfg.SlasherCert,
+		exportStream:          cfg.ExportStream,
+		stateGen:              cfg.StateGen,
 	}
 }
 
@@ -198,6 +215,8 @@ func (s *Service) Start() {
 		BeaconDB:               s.beaconDB,
 		AttestationCache:       cache.NewAttestationCache(),
 		AttPool:                s.attestationsPool,
+		ExitPool:               s.exitPool,
+		SlashingsPool:          s.slashingsPool,
 		HeadFetcher:            s.headFetcher,
 		ForkFetcher:            s.forkFetcher,
 		CanonicalStateChan:     s.canonicalStateChan,
@@ -214,6 +233,7 @@ func (s *Service) Start() {
 		Eth1BlockFetcher:       s.powChainService,
 		PendingDepositsFetcher: s.pendingDepositFetcher,
 		GenesisTime:            genesisTime,
+		StateGen:               s.stateGen,
 	}
 	nodeServer := &node.Server{
 		BeaconDB:           s.beaconDB,
@@ -233,6 +253,7 @@ func (s *Service) Start() {
 		CanonicalStateChan:   s.canonicalStateChan,
 		StateNotifier:        s.stateNotifier,
 		SlotTicker:           ticker,
+		ExportStream:         s.exportStream,
 	}
 	aggregatorServer := &aggregator.Server{
 		BeaconDB:    s.beaconDB,
@@ -241,7 +262,18 @@ func (s *Service) Start() {
 		AttPool:     s.attestationsPool,
 		P2p:         s.p2p,
 	}
+	checkpointServer := &checkpoint.Server{
+		BeaconDB:            s.beaconDB,
+		FinalizationFetcher: s.finalizationFetcher,
+		StateGen:            s.stateGen,
+	}
 	pb.RegisterAggregatorServiceServer(s.grpcServer, aggregatorServer)
+	pb.RegisterCheckpointServiceServer(s.grpcServer, checkpointServer)
+	pb.RegisterPeerServiceServer(s.grpcServer, nodeServer)
+	pb.RegisterReorgServiceServer(s.grpcServer, beaconChainServer)
+	pb.RegisterBlockServiceServer(s.grpcServer, beaconChainServer)
+	pb.RegisterRewardsServiceServer(s.grpcServer, beaconChainServer)
+	pb.RegisterExportServiceServer(s.grpcServer, beaconChainServer)
 	ethpb.RegisterNodeServer(s.grpcServer, nodeServer)
 	ethpb.RegisterBeaconChainServer(s.grpcServer, beaconChainServer)
 	ethpb.RegisterBeaconNodeValidatorServer(s.grpcServer, validatorServer)
@@ -2,10 +2,14 @@ package validator
 
 import (
 	"context"
+	"math/rand"
 
 	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/beacon-chain/cache"
 	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
 	"github.com/prysmaticlabs/prysm/beacon-chain/core/state"
+	"github.com/prysmaticlabs/prysm/beacon-chain/p2p"
+	"github.com/prysmaticlabs/prysm/shared/params"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 )
@@ -62,6 +66,13 @@ func (vs *Server) GetDuties(ctx context.Context, req *ethpb.DutiesRequest) (*eth
 				assignment.AttesterSlot = ca.AttesterSlot
 				assignment.ProposerSlot = proposerIndexToSlot[idx]
 				assignment.CommitteeIndex = ca.CommitteeIndex
+
+				// Record the subnets the node needs to subscribe to for the validator.
+				cache.SubnetIDs.AddAttesterSubnetID(ca.AttesterSlot, p2p.AttestationSubnet(ca.CommitteeIndex))
+				if _, ok := cache.SubnetIDs.PersistentSubnetIDs(pubKey, req.Epoch); !ok {
+					subnets, expiry := randomSubnets(req.Epoch)
+					cache.SubnetIDs.AddPersistentSubnetIDs(pubKey, subnets, expiry)
+				}
 			}
 		}
 
@@ -72,3 +83,16 @@ func (vs *Server) GetDuties(ctx context.Context, req *ethpb.DutiesRequest) (*eth
 		Duties: validatorAssignments,
 	}, nil
 }
+
+// randomSubnets picks the long-lived attestation subnets of a validator, along with the epoch
+// they expire at, which is randomly chosen so the subnets of the network do not rotate at once.
+func randomSubnets(epoch uint64) ([]uint64, uint64) {
+	cfg := params.BeaconConfig()
+	perm := rand.Perm(int(cfg.AttestationSubnetCount))
+	subnets := make([]uint64, 0, cfg.RandomSubnetsPerValidator)
+	for i := uint64(0); i < cfg.RandomSubnetsPerValidator && i < uint64(len(perm)); i++ {
+		subnets = append(subnets, uint64(perm[i]))
+	}
+	expiry := epoch + cfg.EpochsPerRandomSubnetSubscription + rand.Uint64()%cfg.EpochsPerRandomSubnetSubscription
+	return subnets, expiry
+}
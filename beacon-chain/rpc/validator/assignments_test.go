@@ -11,9 +11,11 @@ import (
 	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
 	"github.com/prysmaticlabs/go-ssz"
 	mockChain "github.com/prysmaticlabs/prysm/beacon-chain/blockchain/testing"
+	"github.com/prysmaticlabs/prysm/beacon-chain/cache"
 	blk "github.com/prysmaticlabs/prysm/beacon-chain/core/blocks"
 	"github.com/prysmaticlabs/prysm/beacon-chain/core/state"
 	dbutil "github.com/prysmaticlabs/prysm/beacon-chain/db/testing"
+	"github.com/prysmaticlabs/prysm/beacon-chain/p2p"
 	mockSync "github.com/prysmaticlabs/prysm/beacon-chain/sync/initial-sync/testing"
 	"github.com/prysmaticlabs/prysm/shared/params"
 	"github.com/prysmaticlabs/prysm/shared/testutil"
@@ -143,6 +145,19 @@ func TestGetDuties_OK(t *testing.T) {
 			res.Duties[0].AttesterSlot, state.Slot+params.BeaconConfig().SlotsPerEpoch)
 	}
 
+	subnet := p2p.AttestationSubnet(res.Duties[0].CommitteeIndex)
+	found := false
+	for _, s := range cache.SubnetIDs.AttesterSubnetIDs(res.Duties[0].AttesterSlot) {
+		found = found || s == subnet
+	}
+	if !found {
+		t.Errorf("Expected subnet %d to be recorded for slot %d", subnet, res.Duties[0].AttesterSlot)
+	}
+	if subnets, ok := cache.SubnetIDs.PersistentSubnetIDs(deposits[0].Data.PublicKey, 0); !ok ||
+		uint64(len(subnets)) != params.BeaconConfig().RandomSubnetsPerValidator {
+		t.Errorf("Expected persistent subnets to be recorded, received %v", subnets)
+	}
+
 	// Test the last validator in registry.
 	lastValidatorIndex := depChainStart - 1
 	req = &ethpb.DutiesRequest{
@@ -33,11 +33,12 @@ func TestProposeAttestation_OK(t *testing.T) {
 	ctx := context.Background()
 
 	attesterServer := &Server{
-		HeadFetcher:      &mock.ChainService{},
-		P2P:              &mockp2p.MockBroadcaster{},
-		BeaconDB:         db,
-		AttestationCache: cache.NewAttestationCache(),
-		AttPool:          attestations.NewPool(),
+		HeadFetcher:       &mock.ChainService{},
+		P2P:               &mockp2p.MockBroadcaster{},
+		BeaconDB:          db,
+		AttestationCache:  cache.NewAttestationCache(),
+		AttPool:           attestations.NewPool(),
+		OperationNotifier: (&mock.ChainService{}).OperationNotifier(),
 	}
 	head := &ethpb.SignedBeaconBlock{
 		Block: &ethpb.BeaconBlock{
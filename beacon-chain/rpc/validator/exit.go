@@ -33,5 +33,11 @@ func (vs *Server) ProposeExit(ctx context.Context, req *ethpb.SignedVoluntaryExi
 		},
 	})
 
+	// Save the voluntary exit in the pool for block inclusion and broadcast it to the network.
+	vs.ExitPool.InsertVoluntaryExit(ctx, s, req)
+	if err := vs.P2P.Broadcast(ctx, req); err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not broadcast voluntary exit: %v", err)
+	}
+
 	return nil, nil
 }
@@ -18,6 +18,7 @@ import (
 	"github.com/prysmaticlabs/prysm/shared/bytesutil"
 	"github.com/prysmaticlabs/prysm/shared/hashutil"
 	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/sliceutil"
 	"github.com/prysmaticlabs/prysm/shared/trieutil"
 	"go.opencensus.io/trace"
 	"google.golang.org/grpc/codes"
@@ -64,6 +65,13 @@ func (vs *Server) GetBlock(ctx context.Context, req *ethpb.BlockRequest) (*ethpb
 		return nil, status.Errorf(codes.Internal, "Could not filter attestations: %v", err)
 	}
 
+	// Pack slashings and voluntary exits which are still valid against the head state.
+	headState, err := vs.HeadFetcher.HeadState(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not get head state: %v", err)
+	}
+	proposerSlashings, attesterSlashings, exits := vs.slashingsAndExits(ctx, headState)
+
 	// Use zero hash as stub for state root to compute later.
 	stateRoot := params.BeaconConfig().ZeroHash[:]
 
@@ -74,14 +82,13 @@ func (vs *Server) GetBlock(ctx context.Context, req *ethpb.BlockRequest) (*ethpb
 		ParentRoot: parentRoot[:],
 		StateRoot:  stateRoot,
 		Body: &ethpb.BeaconBlockBody{
-			Eth1Data:     eth1Data,
-			Deposits:     deposits,
-			Attestations: atts,
-			RandaoReveal: req.RandaoReveal,
-			// TODO(2766): Implement rest of the retrievals for beacon block operations
-			ProposerSlashings: []*ethpb.ProposerSlashing{},
-			AttesterSlashings: []*ethpb.AttesterSlashing{},
-			VoluntaryExits:    []*ethpb.SignedVoluntaryExit{},
+			Eth1Data:          eth1Data,
+			Deposits:          deposits,
+			Attestations:      atts,
+			RandaoReveal:      req.RandaoReveal,
+			ProposerSlashings: proposerSlashings,
+			AttesterSlashings: attesterSlashings,
+			VoluntaryExits:    exits,
 			Graffiti:          graffiti[:],
 		},
 	}
@@ -97,6 +104,54 @@ func (vs *Server) GetBlock(ctx context.Context, req *ethpb.BlockRequest) (*ethpb
 	return blk, nil
 }
 
+// slashingsAndExits returns the pending slashings and voluntary exits to include in a block built on
+// top of the given state. The pools only check their operations against the state, so the operations
+// of validators which are already slashed or exited by an earlier operation of the block are dropped,
+// as processing them would fail.
+func (vs *Server) slashingsAndExits(
+	ctx context.Context,
+	headState *pbp2p.BeaconState,
+) ([]*ethpb.ProposerSlashing, []*ethpb.AttesterSlashing, []*ethpb.SignedVoluntaryExit) {
+	currentEpoch := helpers.CurrentEpoch(headState)
+	slashed := make(map[uint64]bool)
+	// There is at most one pending proposer slashing per proposer.
+	proposerSlashings := vs.SlashingsPool.PendingProposerSlashings(ctx, headState)
+	for _, slashing := range proposerSlashings {
+		slashed[slashing.ProposerIndex] = true
+	}
+
+	pendingAttesterSlashings := vs.SlashingsPool.PendingAttesterSlashings(ctx, headState)
+	attesterSlashings := make([]*ethpb.AttesterSlashing, 0, len(pendingAttesterSlashings))
+	for _, slashing := range pendingAttesterSlashings {
+		// An attester slashing is only valid if it slashes at least one validator.
+		indices := sliceutil.IntersectionUint64(slashing.Attestation_1.AttestingIndices, slashing.Attestation_2.AttestingIndices)
+		slashable := make([]uint64, 0, len(indices))
+		for _, idx := range indices {
+			if idx < uint64(len(headState.Validators)) && !slashed[idx] &&
+				helpers.IsSlashableValidator(headState.Validators[idx], currentEpoch) {
+				slashable = append(slashable, idx)
+			}
+		}
+		if len(slashable) == 0 {
+			continue
+		}
+		for _, idx := range slashable {
+			slashed[idx] = true
+		}
+		attesterSlashings = append(attesterSlashings, slashing)
+	}
+
+	// Slashing a validator also initiates its exit.
+	pendingExits := vs.ExitPool.PendingExits(ctx, headState)
+	exits := make([]*ethpb.SignedVoluntaryExit, 0, len(pendingExits))
+	for _, exit := range pendingExits {
+		if !slashed[exit.Exit.ValidatorIndex] {
+			exits = append(exits, exit)
+		}
+	}
+	return proposerSlashings, attesterSlashings, exits
+}
+
 // ProposeBlock is called by a proposer during its assigned slot to create a block in an attempt
 // to get it processed by the beacon node as the canonical head.
 func (vs *Server) ProposeBlock(ctx context.Context, blk *ethpb.SignedBeaconBlock) (*ethpb.ProposeResponse, error) {
@@ -197,7 +252,7 @@ func (vs *Server) randomETH1DataVote(ctx context.Context) (*ethpb.Eth1Data, erro
 // computeStateRoot computes the state root after a block has been processed through a state transition and
 // returns it to the validator client.
 func (vs *Server) computeStateRoot(ctx context.Context, block *ethpb.SignedBeaconBlock) ([]byte, error) {
-	beaconState, err := vs.BeaconDB.State(ctx, bytesutil.ToBytes32(block.Block.ParentRoot))
+	beaconState, err := vs.StateGen.StateByRoot(ctx, bytesutil.ToBytes32(block.Block.ParentRoot))
 	if err != nil {
 		return nil, errors.Wrap(err, "could not retrieve beacon state")
 	}
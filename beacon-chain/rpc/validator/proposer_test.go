@@ -16,7 +16,11 @@ import (
 	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
 	dbutil "github.com/prysmaticlabs/prysm/beacon-chain/db/testing"
 	"github.com/prysmaticlabs/prysm/beacon-chain/operations/attestations"
+	"github.com/prysmaticlabs/prysm/beacon-chain/operations/slashings"
+	"github.com/prysmaticlabs/prysm/beacon-chain/operations/voluntaryexits"
 	mockPOW "github.com/prysmaticlabs/prysm/beacon-chain/powchain/testing"
+	"github.com/prysmaticlabs/prysm/beacon-chain/state/stategen"
+	mockSync "github.com/prysmaticlabs/prysm/beacon-chain/sync/initial-sync/testing"
 	dbpb "github.com/prysmaticlabs/prysm/proto/beacon/db"
 	pbp2p "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
 	"github.com/prysmaticlabs/prysm/shared/bls"
@@ -109,6 +113,7 @@ func TestComputeStateRoot_OK(t *testing.T) {
 		ChainStartFetcher: &mockPOW.POWChain{},
 		Eth1InfoFetcher:   &mockPOW.POWChain{},
 		Eth1BlockFetcher:  &mockPOW.POWChain{},
+		StateGen:          stategen.New(db, 0),
 	}
 
 	req := &ethpb.SignedBeaconBlock{
@@ -149,6 +154,91 @@ func TestComputeStateRoot_OK(t *testing.T) {
 	}
 }
 
+func TestGetBlock_DropsExitsOfSlashedValidators(t *testing.T) {
+	db := dbutil.SetupDB(t)
+	defer dbutil.TeardownDB(t, db)
+	ctx := context.Background()
+
+	beaconState, privKeys := testutil.DeterministicGenesisState(t, 64)
+	// Moving the state forward due to PERSISTENT_COMMITTEE_PERIOD.
+	beaconState.Slot = params.BeaconConfig().PersistentCommitteePeriod * params.BeaconConfig().SlotsPerEpoch
+	stateRoot, err := stateutil.HashTreeRootState(beaconState)
+	if err != nil {
+		t.Fatalf("Could not hash genesis state: %v", err)
+	}
+	genesis := b.NewGenesisBlock(stateRoot[:])
+	if err := db.SaveBlock(ctx, genesis); err != nil {
+		t.Fatalf("Could not save genesis block: %v", err)
+	}
+	parentRoot, err := ssz.HashTreeRoot(genesis.Block)
+	if err != nil {
+		t.Fatalf("Could not get signing root %v", err)
+	}
+	if err := db.SaveState(ctx, beaconState, parentRoot); err != nil {
+		t.Fatalf("Could not save genesis state: %v", err)
+	}
+	if err := db.SaveHeadBlockRoot(ctx, parentRoot); err != nil {
+		t.Fatalf("Could not save genesis state: %v", err)
+	}
+
+	blk, err := testutil.GenerateFullBlock(beaconState, privKeys, &testutil.BlockGenConfig{NumProposerSlashings: 1}, beaconState.Slot)
+	if err != nil {
+		t.Fatal(err)
+	}
+	slashing := blk.Block.Body.ProposerSlashings[0]
+	slashingsPool := slashings.NewPool()
+	if err := slashingsPool.InsertProposerSlashing(ctx, beaconState, slashing); err != nil {
+		t.Fatal(err)
+	}
+	exit := func(idx uint64) *ethpb.SignedVoluntaryExit {
+		exit := &ethpb.VoluntaryExit{ValidatorIndex: idx, Epoch: helpers.CurrentEpoch(beaconState)}
+		root, err := ssz.HashTreeRoot(exit)
+		if err != nil {
+			t.Fatal(err)
+		}
+		domain := helpers.Domain(beaconState.Fork, exit.Epoch, params.BeaconConfig().DomainVoluntaryExit)
+		return &ethpb.SignedVoluntaryExit{Exit: exit, Signature: privKeys[idx].Sign(root[:], domain).Marshal()}
+	}
+	otherIndex := (slashing.ProposerIndex + 1) % uint64(len(beaconState.Validators))
+	exitPool := voluntaryexits.NewPool()
+	exitPool.InsertVoluntaryExit(ctx, beaconState, exit(slashing.ProposerIndex))
+	exitPool.InsertVoluntaryExit(ctx, beaconState, exit(otherIndex))
+
+	proposerServer := &Server{
+		BeaconDB:          db,
+		HeadFetcher:       &mock.ChainService{State: beaconState, Root: parentRoot[:]},
+		SyncChecker:       &mockSync.Sync{IsSyncing: false},
+		MockEth1Votes:     true,
+		AttPool:           attestations.NewPool(),
+		SlashingsPool:     slashingsPool,
+		ExitPool:          exitPool,
+		ChainStartFetcher: &mockPOW.POWChain{},
+		Eth1InfoFetcher:   &mockPOW.POWChain{},
+		Eth1BlockFetcher:  &mockPOW.POWChain{},
+		StateGen:          stategen.New(db, 0),
+	}
+	slot := beaconState.Slot + 1
+	beaconState.Slot++
+	randaoReveal, err := testutil.RandaoReveal(beaconState, helpers.CurrentEpoch(beaconState), privKeys)
+	if err != nil {
+		t.Fatal(err)
+	}
+	beaconState.Slot--
+
+	// Processing the exit of the slashed proposer would fail, so the block must leave it out.
+	block, err := proposerServer.GetBlock(ctx, &ethpb.BlockRequest{Slot: slot, RandaoReveal: randaoReveal})
+	if err != nil {
+		t.Fatalf("Could not get block: %v", err)
+	}
+	if len(block.Body.ProposerSlashings) != 1 {
+		t.Errorf("Wanted 1 proposer slashing, received %d", len(block.Body.ProposerSlashings))
+	}
+	exits := block.Body.VoluntaryExits
+	if len(exits) != 1 || exits[0].Exit.ValidatorIndex != otherIndex {
+		t.Errorf("Wanted only the exit of validator %d, received %v", otherIndex, exits)
+	}
+}
+
 func TestPendingDeposits_Eth1DataVoteOK(t *testing.T) {
 	ctx := context.Background()
 
@@ -0,0 +1,92 @@
+package stategen
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/pkg/errors"
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"go.opencensus.io/trace"
+)
+
+// StateByRoot returns the post state of the block root. The state is read from the hot state
+// cache or the DB when it is stored, otherwise it is regenerated by replaying the blocks since
+// the nearest stored ancestor state. Like the DB, it returns a nil state for an unknown block.
+func (s *State) StateByRoot(ctx context.Context, blockRoot [32]byte) (*pb.BeaconState, error) {
+	ctx, span := trace.StartSpan(ctx, "stateGen.StateByRoot")
+	defer span.End()
+
+	if state := s.hotStateCache.get(blockRoot); state != nil {
+		return state, nil
+	}
+	state, err := s.beaconDB.State(ctx, blockRoot)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not get state from db")
+	}
+	if state != nil {
+		return state, nil
+	}
+	return s.regenerateState(ctx, blockRoot)
+}
+
+// HasState returns true if the state of the block root is stored, without regenerating it.
+func (s *State) HasState(ctx context.Context, blockRoot [32]byte) bool {
+	return s.hotStateCache.has(blockRoot) || s.beaconDB.HasState(ctx, blockRoot)
+}
+
+// regenerateState walks back the ancestors of the block root until one of them has a stored
+// state, then replays the blocks from there up to the block root.
+func (s *State) regenerateState(ctx context.Context, blockRoot [32]byte) (*pb.BeaconState, error) {
+	ctx, span := trace.StartSpan(ctx, "stateGen.regenerateState")
+	defer span.End()
+
+	var blocks []*ethpb.SignedBeaconBlock
+	var startState *pb.BeaconState
+	root := blockRoot
+	for startState == nil {
+		if ctx.Err() != nil {
+			return nil, ctx.Err()
+		}
+		if state := s.hotStateCache.get(root); state != nil {
+			startState = state
+			break
+		}
+		if s.beaconDB.HasState(ctx, root) {
+			state, err := s.beaconDB.State(ctx, root)
+			if err != nil {
+				return nil, errors.Wrap(err, "could not get state from db")
+			}
+			startState = state
+			break
+		}
+		b, err := s.beaconDB.Block(ctx, root)
+		if err != nil {
+			return nil, errors.Wrap(err, "could not get block from db")
+		}
+		if (b == nil || b.Block == nil) && root == blockRoot {
+			return nil, nil
+		}
+		if b == nil || b.Block == nil {
+			return nil, fmt.Errorf("no stored state to regenerate the state of block %#x", blockRoot)
+		}
+		blocks = append(blocks, b)
+		root = bytesutil.ToBytes32(b.Block.ParentRoot)
+	}
+
+	// The ancestors were collected from the highest slot down.
+	for i, j := 0, len(blocks)-1; i < j; i, j = i+1, j-1 {
+		blocks[i], blocks[j] = blocks[j], blocks[i]
+	}
+	state, err := s.replayBlocks(ctx, startState, blocks)
+	if err != nil {
+		return nil, errors.Wrapf(err, "could not regenerate the state of block %#x", blockRoot)
+	}
+	span.AddAttributes(trace.Int64Attribute("replayedBlocks", int64(len(blocks))))
+
+	if state.Slot >= s.SplitSlot() {
+		s.hotStateCache.put(blockRoot, state)
+	}
+	return state, nil
+}
@@ -0,0 +1,108 @@
+package stategen
+
+import (
+	"context"
+	"testing"
+
+	"github.com/gogo/protobuf/proto"
+	"github.com/prysmaticlabs/go-ssz"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/blocks"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/state"
+	"github.com/prysmaticlabs/prysm/beacon-chain/db"
+	testDB "github.com/prysmaticlabs/prysm/beacon-chain/db/testing"
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+	"github.com/prysmaticlabs/prysm/shared/testutil"
+)
+
+// generateChain saves a genesis block and state followed by a block at every slot up to the
+// given slot, without saving their states. It returns the block roots and post states by slot.
+func generateChain(t *testing.T, beaconDB db.Database, slots uint64) ([][32]byte, []*pb.BeaconState) {
+	ctx := context.Background()
+	genesisState, privKeys := testutil.DeterministicGenesisState(t, 32)
+	stateRoot, err := ssz.HashTreeRoot(genesisState)
+	if err != nil {
+		t.Fatal(err)
+	}
+	genesisBlock := blocks.NewGenesisBlock(stateRoot[:])
+	genesisRoot, err := ssz.HashTreeRoot(genesisBlock.Block)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := beaconDB.SaveBlock(ctx, genesisBlock); err != nil {
+		t.Fatal(err)
+	}
+	if err := beaconDB.SaveGenesisBlockRoot(ctx, genesisRoot); err != nil {
+		t.Fatal(err)
+	}
+	if err := beaconDB.SaveState(ctx, genesisState, genesisRoot); err != nil {
+		t.Fatal(err)
+	}
+
+	roots := [][32]byte{genesisRoot}
+	states := []*pb.BeaconState{proto.Clone(genesisState).(*pb.BeaconState)}
+	beaconState := genesisState
+	for slot := uint64(1); slot <= slots; slot++ {
+		b, err := testutil.GenerateFullBlock(beaconState, privKeys, nil, slot)
+		if err != nil {
+			t.Fatal(err)
+		}
+		beaconState, err = state.ExecuteStateTransition(ctx, beaconState, b)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err := beaconDB.SaveBlock(ctx, b); err != nil {
+			t.Fatal(err)
+		}
+		root, err := ssz.HashTreeRoot(b.Block)
+		if err != nil {
+			t.Fatal(err)
+		}
+		roots = append(roots, root)
+		states = append(states, proto.Clone(beaconState).(*pb.BeaconState))
+	}
+	return roots, states
+}
+
+func TestStateByRoot_RegeneratesByReplay(t *testing.T) {
+	beaconDB := testDB.SetupDB(t)
+	defer testDB.TeardownDB(t, beaconDB)
+	ctx := context.Background()
+
+	roots, states := generateChain(t, beaconDB, 5)
+	s := New(beaconDB, 0)
+
+	received, err := s.StateByRoot(ctx, roots[5])
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !proto.Equal(received, states[5]) {
+		t.Errorf("Wanted state at slot %d, received state at slot %d", states[5].Slot, received.Slot)
+	}
+	if !s.hotStateCache.has(roots[5]) {
+		t.Error("Expected regenerated hot state to be cached")
+	}
+
+	// Mutating the returned state must not affect the cached one.
+	received.Slot = 100
+	cached, err := s.StateByRoot(ctx, roots[5])
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !proto.Equal(cached, states[5]) {
+		t.Error("Cached state was mutated by the caller")
+	}
+}
+
+func TestStateByRoot_UnknownRoot(t *testing.T) {
+	beaconDB := testDB.SetupDB(t)
+	defer testDB.TeardownDB(t, beaconDB)
+
+	s := New(beaconDB, 0)
+	received, err := s.StateByRoot(context.Background(), [32]byte{'a'})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if received != nil {
+		t.Error("Expected no state for an unknown block")
+	}
+}
@@ -0,0 +1,67 @@
+package stategen
+
+import (
+	"github.com/gogo/protobuf/proto"
+	lru "github.com/hashicorp/golang-lru"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+)
+
+// hotStateCacheSize is the number of non-finalized states kept in memory. Evicted states are
+// regenerated from the states persisted at the epoch boundaries.
+const hotStateCacheSize = 16
+
+var (
+	hotStateCacheHit = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "hot_state_cache_hit",
+		Help: "The total number of cache hits on the hot state cache.",
+	})
+	hotStateCacheMiss = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "hot_state_cache_miss",
+		Help: "The total number of cache misses on the hot state cache.",
+	})
+)
+
+// hotStateCache keeps the most recently used non-finalized states by block root.
+type hotStateCache struct {
+	cache *lru.Cache
+}
+
+func newHotStateCache() *hotStateCache {
+	cache, err := lru.New(hotStateCacheSize)
+	if err != nil {
+		panic(err)
+	}
+	return &hotStateCache{cache: cache}
+}
+
+// get returns a copy of the cached state of the block root, or nil if it is not cached.
+func (c *hotStateCache) get(root [32]byte) *pb.BeaconState {
+	item, ok := c.cache.Get(root)
+	if !ok {
+		hotStateCacheMiss.Inc()
+		return nil
+	}
+	hotStateCacheHit.Inc()
+	return proto.Clone(item.(*pb.BeaconState)).(*pb.BeaconState)
+}
+
+// put caches a copy of the state of the block root, so the caller may keep mutating the state.
+func (c *hotStateCache) put(root [32]byte, state *pb.BeaconState) {
+	c.cache.Add(root, proto.Clone(state).(*pb.BeaconState))
+}
+
+func (c *hotStateCache) has(root [32]byte) bool {
+	return c.cache.Contains(root)
+}
+
+// pruneBefore removes the cached states older than the slot, which are now finalized.
+func (c *hotStateCache) pruneBefore(slot uint64) {
+	for _, key := range c.cache.Keys() {
+		item, ok := c.cache.Peek(key)
+		if ok && item.(*pb.BeaconState).Slot < slot {
+			c.cache.Remove(key)
+		}
+	}
+}
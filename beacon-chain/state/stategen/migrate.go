@@ -0,0 +1,123 @@
+package stategen
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/beacon-chain/db/filters"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/sirupsen/logrus"
+	"go.opencensus.io/trace"
+)
+
+// MigrateToCold moves the split between the hot and cold sections up to the new finalized block.
+// The states of the canonical blocks at the archived points in between are persisted in full,
+// and every other state in between is deleted from the DB and the hot state cache.
+func (s *State) MigrateToCold(ctx context.Context, finalizedRoot [32]byte) error {
+	ctx, span := trace.StartSpan(ctx, "stateGen.MigrateToCold")
+	defer span.End()
+
+	s.splitLock.RLock()
+	splitSlot, splitRoot := s.splitSlot, s.splitRoot
+	s.splitLock.RUnlock()
+	if finalizedRoot == splitRoot {
+		return nil
+	}
+
+	finalizedBlock, err := s.beaconDB.Block(ctx, finalizedRoot)
+	if err != nil {
+		return errors.Wrap(err, "could not get finalized block")
+	}
+	if finalizedBlock == nil || finalizedBlock.Block == nil {
+		return fmt.Errorf("finalized block %#x does not exist", finalizedRoot)
+	}
+	finalizedSlot := finalizedBlock.Block.Slot
+	if finalizedSlot < splitSlot {
+		return nil
+	}
+
+	archived, err := s.archivedPoints(ctx, finalizedRoot, splitSlot)
+	if err != nil {
+		return errors.Wrap(err, "could not get archived points")
+	}
+	// The finalized state is the first state of the hot section, it must be stored in full.
+	archived[finalizedRoot] = true
+	for root := range archived {
+		if s.beaconDB.HasState(ctx, root) {
+			continue
+		}
+		state, err := s.StateByRoot(ctx, root)
+		if err != nil {
+			return err
+		}
+		if state == nil {
+			return fmt.Errorf("could not regenerate archived state %#x", root)
+		}
+		if err := s.beaconDB.SaveState(ctx, state, root); err != nil {
+			return errors.Wrap(err, "could not save archived state")
+		}
+	}
+
+	protected, err := s.protectedRoots(ctx)
+	if err != nil {
+		return err
+	}
+	roots, err := s.beaconDB.BlockRoots(ctx, filters.NewFilter().SetStartSlot(splitSlot).SetEndSlot(finalizedSlot))
+	if err != nil {
+		return errors.Wrap(err, "could not get block roots to migrate")
+	}
+	deleted := make([][32]byte, 0, len(roots))
+	for _, root := range roots {
+		if archived[root] || protected[root] || !s.beaconDB.HasState(ctx, root) {
+			continue
+		}
+		deleted = append(deleted, root)
+	}
+	if err := s.beaconDB.DeleteStates(ctx, deleted); err != nil {
+		return errors.Wrap(err, "could not delete migrated states")
+	}
+
+	s.splitLock.Lock()
+	s.splitSlot, s.splitRoot = finalizedSlot, finalizedRoot
+	s.splitLock.Unlock()
+	s.hotStateCache.pruneBefore(finalizedSlot)
+
+	log.WithFields(logrus.Fields{
+		"splitSlot":      finalizedSlot,
+		"archivedStates": len(archived),
+		"deletedStates":  len(deleted),
+	}).Debug("Migrated finalized states to the cold section")
+	return nil
+}
+
+// archivedPoints walks back the canonical chain from the finalized block down to the split slot,
+// and returns the roots of the first block of every archived point interval along the way. Its
+// state is the one stored in full for the interval.
+func (s *State) archivedPoints(ctx context.Context, finalizedRoot [32]byte, splitSlot uint64) (map[[32]byte]bool, error) {
+	archived := make(map[[32]byte]bool)
+	root := finalizedRoot
+	b, err := s.beaconDB.Block(ctx, root)
+	if err != nil {
+		return nil, err
+	}
+	for b != nil && b.Block != nil && b.Block.Slot > splitSlot {
+		if ctx.Err() != nil {
+			return nil, ctx.Err()
+		}
+		parentRoot := bytesutil.ToBytes32(b.Block.ParentRoot)
+		parent, err := s.beaconDB.Block(ctx, parentRoot)
+		if err != nil {
+			return nil, err
+		}
+		// A missing parent is the end of the stored chain, such as the origin of a checkpoint sync.
+		if parent == nil || parent.Block == nil {
+			break
+		}
+		if parent.Block.Slot/s.slotsPerArchivedPoint < b.Block.Slot/s.slotsPerArchivedPoint {
+			archived[root] = true
+		}
+		root, b = parentRoot, parent
+	}
+	return archived, nil
+}
@@ -0,0 +1,55 @@
+package stategen
+
+import (
+	"context"
+	"testing"
+
+	"github.com/gogo/protobuf/proto"
+	testDB "github.com/prysmaticlabs/prysm/beacon-chain/db/testing"
+)
+
+func TestMigrateToCold_KeepsArchivedPoints(t *testing.T) {
+	beaconDB := testDB.SetupDB(t)
+	defer testDB.TeardownDB(t, beaconDB)
+	ctx := context.Background()
+
+	roots, states := generateChain(t, beaconDB, 6)
+	for i := 1; i < len(roots); i++ {
+		if err := beaconDB.SaveState(ctx, states[i], roots[i]); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := beaconDB.SaveHeadBlockRoot(ctx, roots[6]); err != nil {
+		t.Fatal(err)
+	}
+
+	s := New(beaconDB, 2)
+	if err := s.Resume(ctx, roots[0]); err != nil {
+		t.Fatal(err)
+	}
+	if err := s.MigrateToCold(ctx, roots[5]); err != nil {
+		t.Fatal(err)
+	}
+	if s.SplitSlot() != 5 {
+		t.Errorf("Wanted split slot 5, received %d", s.SplitSlot())
+	}
+
+	// Genesis, the first blocks of the archived point intervals, the finalized block and the head.
+	kept := map[int]bool{0: true, 2: true, 4: true, 5: true, 6: true}
+	for i, root := range roots {
+		if has := beaconDB.HasState(ctx, root); has != kept[i] {
+			t.Errorf("Wanted state at slot %d stored: %v, received %v", i, kept[i], has)
+		}
+	}
+
+	regenerated, err := s.StateByRoot(ctx, roots[3])
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !proto.Equal(regenerated, states[3]) {
+		t.Error("Regenerated cold state does not match the original state")
+	}
+	if s.hotStateCache.has(roots[3]) {
+		t.Error("Cold state should not be cached")
+	}
+}
@@ -0,0 +1,36 @@
+package stategen
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/state"
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+	"go.opencensus.io/trace"
+)
+
+var replayBlockCount = promauto.NewHistogram(prometheus.HistogramOpts{
+	Name:    "replay_blocks_count",
+	Help:    "The number of blocks replayed to regenerate a state.",
+	Buckets: []float64{1, 8, 32, 64, 256, 1024, 2048, 4096},
+})
+
+// replayBlocks applies the blocks, sorted by increasing slot, on top of the state. The blocks
+// were verified when they were first processed, so their signatures are not verified again.
+func (s *State) replayBlocks(ctx context.Context, beaconState *pb.BeaconState, blocks []*ethpb.SignedBeaconBlock) (*pb.BeaconState, error) {
+	ctx, span := trace.StartSpan(ctx, "stateGen.replayBlocks")
+	defer span.End()
+
+	var err error
+	for _, b := range blocks {
+		beaconState, err = state.ExecuteStateTransitionNoVerify(ctx, beaconState, b)
+		if err != nil {
+			return nil, errors.Wrapf(err, "could not replay block at slot %d", b.Block.Slot)
+		}
+	}
+	replayBlockCount.Observe(float64(len(blocks)))
+	return beaconState, nil
+}
@@ -0,0 +1,99 @@
+// Package stategen splits the storage of beacon states between a hot section, holding the
+// non-finalized states in a small in-memory cache, and a cold section, holding finalized states in
+// full only at every archived point. Any other state is regenerated by replaying blocks from the
+// nearest stored state.
+package stategen
+
+import (
+	"context"
+	"sync"
+
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/go-ssz"
+	"github.com/prysmaticlabs/prysm/beacon-chain/db"
+	"github.com/prysmaticlabs/prysm/shared/params"
+)
+
+// State generates and stores the beacon states of the chain, split at the last finalized block.
+type State struct {
+	beaconDB              db.Database
+	hotStateCache         *hotStateCache
+	slotsPerArchivedPoint uint64
+	splitLock             sync.RWMutex
+	splitSlot             uint64
+	splitRoot             [32]byte
+}
+
+// New returns a state generator storing finalized states in full every slotsPerArchivedPoint
+// slots. A zero interval archives the first state of every epoch.
+func New(beaconDB db.Database, slotsPerArchivedPoint uint64) *State {
+	if slotsPerArchivedPoint == 0 {
+		slotsPerArchivedPoint = params.BeaconConfig().SlotsPerEpoch
+	}
+	return &State{
+		beaconDB:              beaconDB,
+		hotStateCache:         newHotStateCache(),
+		slotsPerArchivedPoint: slotsPerArchivedPoint,
+	}
+}
+
+// Resume sets the split between the hot and cold sections at the finalized block, which is
+// where the state generator resumes from when the node restarts.
+func (s *State) Resume(ctx context.Context, finalizedRoot [32]byte) error {
+	s.splitLock.Lock()
+	defer s.splitLock.Unlock()
+
+	b, err := s.beaconDB.Block(ctx, finalizedRoot)
+	if err != nil {
+		return errors.Wrap(err, "could not get finalized block")
+	}
+	s.splitRoot = finalizedRoot
+	s.splitSlot = 0
+	if b != nil && b.Block != nil {
+		s.splitSlot = b.Block.Slot
+	}
+	return nil
+}
+
+// SplitSlot returns the slot of the last finalized block, below which states are cold.
+func (s *State) SplitSlot() uint64 {
+	s.splitLock.RLock()
+	defer s.splitLock.RUnlock()
+	return s.splitSlot
+}
+
+// protectedRoots returns the roots of the states which must never be deleted: the genesis
+// state, the origin state of a node started from a checkpoint, and the head state.
+func (s *State) protectedRoots(ctx context.Context) (map[[32]byte]bool, error) {
+	protected := make(map[[32]byte]bool)
+	genesisBlock, err := s.beaconDB.GenesisBlock(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not get genesis block")
+	}
+	if genesisBlock != nil && genesisBlock.Block != nil {
+		r, err := ssz.HashTreeRoot(genesisBlock.Block)
+		if err != nil {
+			return nil, errors.Wrap(err, "could not get genesis block root")
+		}
+		protected[r] = true
+	}
+	originRoot, err := s.beaconDB.OriginBlockRoot(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not get origin block root")
+	}
+	if originRoot != [32]byte{} {
+		protected[originRoot] = true
+	}
+	headBlock, err := s.beaconDB.HeadBlock(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not get head block")
+	}
+	if headBlock != nil && headBlock.Block != nil {
+		r, err := ssz.HashTreeRoot(headBlock.Block)
+		if err != nil {
+			return nil, errors.Wrap(err, "could not get head block root")
+		}
+		protected[r] = true
+	}
+	return protected, nil
+}
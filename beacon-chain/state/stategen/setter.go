@@ -0,0 +1,30 @@
+package stategen
+
+import (
+	"context"
+
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+	"go.opencensus.io/trace"
+)
+
+// SaveState stores the post state of the block root. A state of the hot section is cached, and
+// persisted in the DB only at epoch boundaries so that evicted states can be regenerated from
+// there. A state of the cold section is persisted only at an archived point.
+func (s *State) SaveState(ctx context.Context, blockRoot [32]byte, state *pb.BeaconState) error {
+	ctx, span := trace.StartSpan(ctx, "stateGen.SaveState")
+	defer span.End()
+
+	if state.Slot < s.SplitSlot() {
+		if state.Slot%s.slotsPerArchivedPoint != 0 {
+			return nil
+		}
+		return s.beaconDB.SaveState(ctx, state, blockRoot)
+	}
+
+	s.hotStateCache.put(blockRoot, state)
+	if helpers.IsEpochStart(state.Slot) {
+		return s.beaconDB.SaveState(ctx, state, blockRoot)
+	}
+	return nil
+}
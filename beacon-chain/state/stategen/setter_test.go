@@ -0,0 +1,82 @@
+package stategen
+
+import (
+	"context"
+	"testing"
+
+	testDB "github.com/prysmaticlabs/prysm/beacon-chain/db/testing"
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+	"github.com/prysmaticlabs/prysm/shared/params"
+)
+
+func TestSaveState_HotStatesPersistedAtEpochBoundary(t *testing.T) {
+	beaconDB := testDB.SetupDB(t)
+	defer testDB.TeardownDB(t, beaconDB)
+	ctx := context.Background()
+	s := New(beaconDB, 0)
+
+	midEpochRoot := [32]byte{'a'}
+	if err := s.SaveState(ctx, midEpochRoot, &pb.BeaconState{Slot: 1}); err != nil {
+		t.Fatal(err)
+	}
+	if beaconDB.HasState(ctx, midEpochRoot) {
+		t.Error("Mid epoch hot state should not be persisted")
+	}
+	if !s.HasState(ctx, midEpochRoot) {
+		t.Error("Mid epoch hot state should be cached")
+	}
+
+	boundaryRoot := [32]byte{'b'}
+	if err := s.SaveState(ctx, boundaryRoot, &pb.BeaconState{Slot: params.BeaconConfig().SlotsPerEpoch}); err != nil {
+		t.Fatal(err)
+	}
+	if !beaconDB.HasState(ctx, boundaryRoot) {
+		t.Error("Epoch boundary hot state should be persisted")
+	}
+}
+
+func TestSaveState_ColdStatesPersistedAtArchivedPoint(t *testing.T) {
+	beaconDB := testDB.SetupDB(t)
+	defer testDB.TeardownDB(t, beaconDB)
+	ctx := context.Background()
+	s := New(beaconDB, 8)
+	s.splitSlot = 100
+
+	archivedRoot := [32]byte{'a'}
+	if err := s.SaveState(ctx, archivedRoot, &pb.BeaconState{Slot: 16}); err != nil {
+		t.Fatal(err)
+	}
+	if !beaconDB.HasState(ctx, archivedRoot) {
+		t.Error("Cold state at archived point should be persisted")
+	}
+
+	otherRoot := [32]byte{'b'}
+	if err := s.SaveState(ctx, otherRoot, &pb.BeaconState{Slot: 17}); err != nil {
+		t.Fatal(err)
+	}
+	if s.HasState(ctx, otherRoot) {
+		t.Error("Cold state between archived points should not be stored")
+	}
+}
+
+func TestSaveState_CachedHotStateNotMutatedByCaller(t *testing.T) {
+	beaconDB := testDB.SetupDB(t)
+	defer testDB.TeardownDB(t, beaconDB)
+	ctx := context.Background()
+	s := New(beaconDB, 0)
+
+	root := [32]byte{'a'}
+	state := &pb.BeaconState{Slot: 1}
+	if err := s.SaveState(ctx, root, state); err != nil {
+		t.Fatal(err)
+	}
+	state.Slot = 2
+
+	cached, err := s.StateByRoot(ctx, root)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if cached.Slot != 1 {
+		t.Errorf("Wanted cached state at slot 1, got %d", cached.Slot)
+	}
+}
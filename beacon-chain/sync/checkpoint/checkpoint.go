@@ -0,0 +1,128 @@
+// Package checkpoint loads the trusted finalized state a beacon node can start syncing from,
+// instead of replaying the chain from genesis.
+package checkpoint
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io/ioutil"
+
+	ptypes "github.com/gogo/protobuf/types"
+	"github.com/pkg/errors"
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/go-ssz"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+	rpcpb "github.com/prysmaticlabs/prysm/proto/beacon/rpc/v1"
+	"google.golang.org/grpc"
+)
+
+// maxCheckpointMsgSize is the maximum size of the checkpoint response received from a trusted
+// node, as the encoded state grows with the validator registry.
+const maxCheckpointMsgSize = 1 << 30
+
+// Checkpoint is a finalized beacon state along with the block it is the post-state of.
+type Checkpoint struct {
+	State *pb.BeaconState
+	Block *ethpb.SignedBeaconBlock
+	// Epoch of the finalized checkpoint the block is the root of.
+	Epoch uint64
+}
+
+// FromFiles loads the checkpoint from the SSZ encoded state and signed block files.
+func FromFiles(statePath string, blockPath string) (*Checkpoint, error) {
+	if statePath == "" || blockPath == "" {
+		return nil, errors.New("both a checkpoint state and block file are required")
+	}
+	encodedState, err := ioutil.ReadFile(statePath)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not read checkpoint state")
+	}
+	encodedBlock, err := ioutil.ReadFile(blockPath)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not read checkpoint block")
+	}
+	cp, err := decode(encodedState, encodedBlock)
+	if err != nil {
+		return nil, err
+	}
+	cp.Epoch = checkpointEpoch(cp.Block.Block.Slot)
+	return cp, nil
+}
+
+// FromNode fetches the latest finalized checkpoint of the trusted beacon node at the gRPC
+// endpoint.
+func FromNode(ctx context.Context, endpoint string) (*Checkpoint, error) {
+	conn, err := grpc.DialContext(
+		ctx,
+		endpoint,
+		grpc.WithInsecure(),
+		grpc.WithDefaultCallOptions(grpc.MaxCallRecvMsgSize(maxCheckpointMsgSize)),
+	)
+	if err != nil {
+		return nil, errors.Wrapf(err, "could not dial checkpoint node %s", endpoint)
+	}
+	defer func() {
+		if err := conn.Close(); err != nil {
+			log.WithError(err).Error("Failed to close connection to checkpoint node")
+		}
+	}()
+	res, err := rpcpb.NewCheckpointServiceClient(conn).FinalizedCheckpoint(ctx, &ptypes.Empty{})
+	if err != nil {
+		return nil, errors.Wrapf(err, "could not fetch finalized checkpoint from %s", endpoint)
+	}
+	cp, err := decode(res.State, res.Block)
+	if err != nil {
+		return nil, err
+	}
+	cp.Epoch = res.Epoch
+	return cp, nil
+}
+
+func decode(encodedState []byte, encodedBlock []byte) (*Checkpoint, error) {
+	state := &pb.BeaconState{}
+	if err := ssz.Unmarshal(encodedState, state); err != nil {
+		return nil, errors.Wrap(err, "could not unmarshal checkpoint state")
+	}
+	block := &ethpb.SignedBeaconBlock{}
+	if err := ssz.Unmarshal(encodedBlock, block); err != nil {
+		return nil, errors.Wrap(err, "could not unmarshal checkpoint block")
+	}
+	if block.Block == nil {
+		return nil, errors.New("nil checkpoint block")
+	}
+	return &Checkpoint{State: state, Block: block}, nil
+}
+
+// checkpointEpoch returns the first epoch the block at the slot can be the checkpoint root of.
+func checkpointEpoch(slot uint64) uint64 {
+	if helpers.IsEpochStart(slot) {
+		return helpers.SlotToEpoch(slot)
+	}
+	return helpers.SlotToEpoch(slot) + 1
+}
+
+// Verify the state is the post-state of the block, and that the checkpoint epoch can be
+// finalized by the block.
+func (c *Checkpoint) Verify() error {
+	if c.State.Slot != c.Block.Block.Slot {
+		return fmt.Errorf("checkpoint state slot %d does not match block slot %d", c.State.Slot, c.Block.Block.Slot)
+	}
+	stateRoot, err := ssz.HashTreeRoot(c.State)
+	if err != nil {
+		return errors.Wrap(err, "could not tree hash checkpoint state")
+	}
+	if !bytes.Equal(stateRoot[:], c.Block.Block.StateRoot) {
+		return fmt.Errorf("checkpoint state root %#x does not match block state root %#x", stateRoot, c.Block.Block.StateRoot)
+	}
+	if c.Epoch < checkpointEpoch(c.Block.Block.Slot) {
+		return fmt.Errorf("checkpoint block at slot %d cannot be the root of epoch %d", c.Block.Block.Slot, c.Epoch)
+	}
+	return nil
+}
+
+// Root of the checkpoint block.
+func (c *Checkpoint) Root() ([32]byte, error) {
+	return ssz.HashTreeRoot(c.Block.Block)
+}
@@ -0,0 +1,105 @@
+package checkpoint
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/gogo/protobuf/proto"
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/go-ssz"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/testutil"
+)
+
+func checkpointAtSlot(t *testing.T, slot uint64) *Checkpoint {
+	state, _ := testutil.DeterministicGenesisState(t, 8)
+	state.Slot = slot
+	stateRoot, err := ssz.HashTreeRoot(state)
+	if err != nil {
+		t.Fatal(err)
+	}
+	block := &ethpb.SignedBeaconBlock{Block: &ethpb.BeaconBlock{Slot: slot, StateRoot: stateRoot[:]}}
+	return &Checkpoint{State: state, Block: block, Epoch: checkpointEpoch(slot)}
+}
+
+func TestCheckpointEpoch(t *testing.T) {
+	slotsPerEpoch := params.BeaconConfig().SlotsPerEpoch
+	tests := []struct {
+		slot  uint64
+		epoch uint64
+	}{
+		{slot: 0, epoch: 0},
+		{slot: 1, epoch: 1},
+		{slot: slotsPerEpoch, epoch: 1},
+		{slot: slotsPerEpoch + 1, epoch: 2},
+	}
+	for _, tt := range tests {
+		if epoch := checkpointEpoch(tt.slot); epoch != tt.epoch {
+			t.Errorf("Wanted epoch %d for slot %d, received %d", tt.epoch, tt.slot, epoch)
+		}
+	}
+}
+
+func TestVerify(t *testing.T) {
+	cp := checkpointAtSlot(t, 64)
+	if err := cp.Verify(); err != nil {
+		t.Errorf("Expected checkpoint to verify, received %v", err)
+	}
+
+	cp.State.GenesisTime++
+	want := "does not match block state root"
+	if err := cp.Verify(); err == nil || !strings.Contains(err.Error(), want) {
+		t.Errorf("Expected error containing %q, received %v", want, err)
+	}
+
+	cp = checkpointAtSlot(t, 65)
+	cp.Epoch = 0
+	want = "cannot be the root of epoch"
+	if err := cp.Verify(); err == nil || !strings.Contains(err.Error(), want) {
+		t.Errorf("Expected error containing %q, received %v", want, err)
+	}
+}
+
+func TestFromFiles(t *testing.T) {
+	cp := checkpointAtSlot(t, 64)
+	dir, err := ioutil.TempDir("", "checkpoint")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	encodedState, err := ssz.Marshal(cp.State)
+	if err != nil {
+		t.Fatal(err)
+	}
+	encodedBlock, err := ssz.Marshal(cp.Block)
+	if err != nil {
+		t.Fatal(err)
+	}
+	statePath := filepath.Join(dir, "state.ssz")
+	blockPath := filepath.Join(dir, "block.ssz")
+	if err := ioutil.WriteFile(statePath, encodedState, 0600); err != nil {
+		t.Fatal(err)
+	}
+	if err := ioutil.WriteFile(blockPath, encodedBlock, 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	loaded, err := FromFiles(statePath, blockPath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !proto.Equal(cp.State, loaded.State) || !proto.Equal(cp.Block, loaded.Block) {
+		t.Error("Loaded checkpoint does not match the saved one")
+	}
+	if loaded.Epoch != cp.Epoch {
+		t.Errorf("Wanted epoch %d, received %d", cp.Epoch, loaded.Epoch)
+	}
+
+	if _, err := FromFiles(statePath, ""); err == nil {
+		t.Error("Expected error loading checkpoint without a block file")
+	}
+}
@@ -9,10 +9,14 @@ import (
 )
 
 const genericError = "internal service error"
+const rateLimitedError = "rate limited"
 
 var errWrongForkVersion = errors.New("wrong fork version")
 var errInvalidEpoch = errors.New("invalid epoch")
 
+// ErrRateLimited is returned when reading the response of a peer which throttled the request.
+var ErrRateLimited = errors.New(rateLimitedError)
+
 var responseCodeSuccess = byte(0x00)
 var responseCodeInvalidRequest = byte(0x01)
 var responseCodeServerError = byte(0x02)
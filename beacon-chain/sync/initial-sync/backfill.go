@@ -0,0 +1,187 @@
+package initialsync
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"math/rand"
+	"sort"
+	"time"
+
+	"github.com/libp2p/go-libp2p-core/peer"
+	"github.com/pkg/errors"
+	eth "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/go-ssz"
+	p2ppb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/sirupsen/logrus"
+)
+
+// backfillRetryDelay is the time to wait before requesting blocks again, after a peer failed to
+// serve a batch of blocks to backfill.
+const backfillRetryDelay = time.Second
+
+// backfillEmptyBatchRetries is the number of empty batches received for a range of slots before
+// the range is considered to only hold skipped slots.
+const backfillEmptyBatchRetries = 3
+
+// backfillBlocks fetches the blocks older than the checkpoint block the node started syncing
+// from, down to genesis. Blocks are requested from peers in batches of decreasing slots, and are
+// only saved once they chain up to the trusted checkpoint block by their parent roots. The oldest
+// backfilled block is saved, so that backfilling resumes from it after a restart.
+func (s *Service) backfillBlocks(ctx context.Context) error {
+	originRoot, err := s.db.OriginBlockRoot(ctx)
+	if err != nil {
+		return errors.Wrap(err, "could not get origin block root")
+	}
+	if originRoot == [32]byte{} {
+		log.Debug("Node was not started from a checkpoint, nothing to backfill")
+		return nil
+	}
+	lowestRoot, err := s.db.BackfillBlockRoot(ctx)
+	if err != nil {
+		return errors.Wrap(err, "could not get backfill block root")
+	}
+	if lowestRoot == [32]byte{} {
+		lowestRoot = originRoot
+	}
+	lowest, err := s.db.Block(ctx, lowestRoot)
+	if err != nil {
+		return errors.Wrap(err, "could not get backfill block")
+	}
+	if lowest == nil || lowest.Block == nil {
+		return fmt.Errorf("missing backfill block %#x in db", lowestRoot)
+	}
+
+	log.WithField("slot", lowest.Block.Slot).Info("Backfilling blocks older than checkpoint")
+	randGenerator := rand.New(rand.NewSource(time.Now().Unix()))
+	cursor := lowest.Block.Slot
+	// The peer which last failed to serve the range, so the range is retried on another peer.
+	var failedPeer peer.ID
+	emptyBatches := 0
+	for lowest.Block.Slot > 0 && cursor > 0 {
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+
+		// The rest of the chain is already known, e.g. from a previous backfill.
+		parentRoot := bytesutil.ToBytes32(lowest.Block.ParentRoot)
+		if s.db.HasBlock(ctx, parentRoot) {
+			parent, err := s.db.Block(ctx, parentRoot)
+			if err != nil {
+				return errors.Wrap(err, "could not get parent block")
+			}
+			lowest, lowestRoot = parent, parentRoot
+			cursor = lowest.Block.Slot
+			emptyBatches = 0
+			continue
+		}
+
+		peers := s.p2p.Peers().Connected()
+		if len(peers) == 0 {
+			log.Warn("No peers; waiting for reconnect")
+			time.Sleep(refreshTime)
+			continue
+		}
+		i := randGenerator.Intn(len(peers))
+		if peers[i] == failedPeer && len(peers) > 1 {
+			i = (i + 1) % len(peers)
+		}
+		pid := peers[i]
+
+		start := uint64(0)
+		if cursor > blockBatchSize {
+			start = cursor - blockBatchSize
+		}
+		req := &p2ppb.BeaconBlocksByRangeRequest{
+			HeadBlockRoot: lowest.Block.ParentRoot,
+			StartSlot:     start,
+			Count:         cursor - start,
+			Step:          1,
+		}
+		blocks, err := s.requestBlocks(ctx, req, pid)
+		if err != nil {
+			log.WithError(err).WithField("peer", pid.Pretty()).Debug("Could not request blocks to backfill")
+			failedPeer = pid
+			time.Sleep(backfillRetryDelay)
+			continue
+		}
+		chained, roots, err := chainBlocks(lowest.Block, blocks)
+		if err != nil {
+			log.WithError(err).WithField("peer", pid.Pretty()).Debug("Received blocks do not chain up to checkpoint")
+			failedPeer = pid
+			time.Sleep(backfillRetryDelay)
+			continue
+		}
+		if len(chained) == 0 {
+			// The peer may withhold the blocks of the range, so the range is only skipped once
+			// several peers served no block of it.
+			emptyBatches++
+			failedPeer = pid
+			if emptyBatches >= backfillEmptyBatchRetries {
+				log.WithFields(logrus.Fields{
+					"start": start,
+					"count": cursor - start,
+				}).Debug("No blocks received for range, skipping it")
+				cursor = start
+				emptyBatches = 0
+			}
+			continue
+		}
+		if err := s.db.SaveBlocks(ctx, chained); err != nil {
+			return errors.Wrap(err, "could not save backfilled blocks")
+		}
+		lowest, lowestRoot = chained[len(chained)-1], roots[len(roots)-1]
+		// The blocks of a partial batch may not reach the start of the range, so the next batch
+		// starts below the lowest block received.
+		cursor = lowest.Block.Slot
+		emptyBatches = 0
+		failedPeer = ""
+		if err := s.db.SaveBackfillBlockRoot(ctx, lowestRoot); err != nil {
+			return errors.Wrap(err, "could not save backfill block root")
+		}
+		log.WithField("slot", lowest.Block.Slot).Info("Backfilled blocks")
+	}
+
+	if lowest.Block.Slot == 0 {
+		if err := s.db.SaveGenesisBlockRoot(ctx, lowestRoot); err != nil {
+			return errors.Wrap(err, "could not save genesis block root")
+		}
+		log.Info("Backfilled blocks down to genesis")
+		return nil
+	}
+	log.WithField("slot", lowest.Block.Slot).Warn("No older blocks available from peers to backfill")
+	return nil
+}
+
+// chainBlocks sorts the blocks older than the child block by decreasing slot, and verifies that
+// each of them is the parent of the previous one, starting from the child block. It returns the
+// sorted blocks along with their roots.
+func chainBlocks(child *eth.BeaconBlock, blocks []*eth.SignedBeaconBlock) ([]*eth.SignedBeaconBlock, [][32]byte, error) {
+	older := make([]*eth.SignedBeaconBlock, 0, len(blocks))
+	for _, blk := range blocks {
+		if blk == nil || blk.Block == nil {
+			return nil, nil, errors.New("nil block")
+		}
+		if blk.Block.Slot < child.Slot {
+			older = append(older, blk)
+		}
+	}
+	sort.Slice(older, func(i, j int) bool {
+		return older[i].Block.Slot > older[j].Block.Slot
+	})
+	root := child.ParentRoot
+	roots := make([][32]byte, len(older))
+	for i, blk := range older {
+		blkRoot, err := ssz.HashTreeRoot(blk.Block)
+		if err != nil {
+			return nil, nil, errors.Wrap(err, "could not get block root")
+		}
+		if !bytes.Equal(blkRoot[:], root) {
+			return nil, nil, fmt.Errorf("block at slot %d with root %#x is not the expected parent %#x", blk.Block.Slot, blkRoot, root)
+		}
+		roots[i] = blkRoot
+		root = blk.Block.ParentRoot
+	}
+	return older, roots, nil
+}
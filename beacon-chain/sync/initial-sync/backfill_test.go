@@ -0,0 +1,146 @@
+package initialsync
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	eth "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	dbtest "github.com/prysmaticlabs/prysm/beacon-chain/db/testing"
+	p2pt "github.com/prysmaticlabs/prysm/beacon-chain/p2p/testing"
+)
+
+func setupBackfill(t *testing.T, peers []*peerData, originSlot uint64) *Service {
+	initializeRootCache(makeSequence(1, 131), t)
+
+	p := p2pt.NewTestP2P(t)
+	beaconDB := dbtest.SetupDB(t)
+	connectPeers(t, p, peers, p.Peers())
+
+	ctx := context.Background()
+	if err := beaconDB.SaveBlock(ctx, &eth.SignedBeaconBlock{Block: &eth.BeaconBlock{Slot: 0}}); err != nil {
+		t.Fatal(err)
+	}
+	parentRoot := rootCache[parentSlotCache[originSlot]]
+	origin := &eth.SignedBeaconBlock{Block: &eth.BeaconBlock{Slot: originSlot, ParentRoot: parentRoot[:]}}
+	if err := beaconDB.SaveBlock(ctx, origin); err != nil {
+		t.Fatal(err)
+	}
+	if err := beaconDB.SaveOriginBlockRoot(ctx, rootCache[originSlot]); err != nil {
+		t.Fatal(err)
+	}
+	return &Service{
+		p2p:          p,
+		db:           beaconDB,
+		chainStarted: true,
+	}
+}
+
+func TestBackfillBlocks_DownToGenesis(t *testing.T) {
+	s := setupBackfill(t, []*peerData{
+		{
+			blocks:         makeSequence(1, 131),
+			finalizedEpoch: 1,
+			headSlot:       131,
+		},
+	}, 100)
+	defer dbtest.TeardownDB(t, s.db)
+	ctx := context.Background()
+
+	if err := s.backfillBlocks(ctx); err != nil {
+		t.Fatal(err)
+	}
+	for slot := uint64(1); slot < 100; slot++ {
+		if !s.db.HasBlock(ctx, rootCache[slot]) {
+			t.Errorf("Block at slot %d was not backfilled", slot)
+		}
+	}
+	genesis, err := s.db.GenesisBlock(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if genesis == nil || genesis.Block.Slot != 0 {
+		t.Errorf("Expected genesis block root to be saved, received genesis block %v", genesis)
+	}
+	lowest, err := s.db.BackfillBlockRoot(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if lowest != rootCache[1] {
+		t.Errorf("Wanted backfill block root %#x, received %#x", rootCache[1], lowest)
+	}
+}
+
+func TestBackfillBlocks_RetriesEmptyBatch(t *testing.T) {
+	s := setupBackfill(t, []*peerData{
+		{
+			blocks:         []uint64{},
+			finalizedEpoch: 1,
+			headSlot:       131,
+		},
+		{
+			blocks:         makeSequence(1, 131),
+			finalizedEpoch: 1,
+			headSlot:       131,
+		},
+	}, 100)
+	defer dbtest.TeardownDB(t, s.db)
+	ctx := context.Background()
+
+	// A range served empty by the first peer is requested again from the other peer, instead of
+	// being skipped.
+	if err := s.backfillBlocks(ctx); err != nil {
+		t.Fatal(err)
+	}
+	for slot := uint64(1); slot < 100; slot++ {
+		if !s.db.HasBlock(ctx, rootCache[slot]) {
+			t.Errorf("Block at slot %d was not backfilled", slot)
+		}
+	}
+	genesis, err := s.db.GenesisBlock(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if genesis == nil || genesis.Block.Slot != 0 {
+		t.Errorf("Expected genesis block root to be saved, received genesis block %v", genesis)
+	}
+}
+
+func TestBackfillBlocks_RejectsForkedPeer(t *testing.T) {
+	s := setupBackfill(t, []*peerData{
+		{
+			blocks:         makeSequence(1, 131),
+			finalizedEpoch: 1,
+			headSlot:       131,
+			forkedPeer:     true,
+		},
+	}, 100)
+	defer dbtest.TeardownDB(t, s.db)
+	ctx, cancel := context.WithTimeout(context.Background(), 3*backfillRetryDelay)
+	defer cancel()
+
+	if err := s.backfillBlocks(ctx); err != context.DeadlineExceeded {
+		t.Errorf("Expected backfill to retry until the context deadline, received %v", err)
+	}
+	lowest, err := s.db.BackfillBlockRoot(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if lowest != [32]byte{} {
+		t.Errorf("Expected no block from a forked peer to be backfilled, received %#x", lowest)
+	}
+}
+
+func TestBackfillBlocks_NotStartedFromCheckpoint(t *testing.T) {
+	beaconDB := dbtest.SetupDB(t)
+	defer dbtest.TeardownDB(t, beaconDB)
+	s := &Service{
+		p2p: p2pt.NewTestP2P(t),
+		db:  beaconDB,
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+	if err := s.backfillBlocks(ctx); err != nil {
+		t.Errorf("Expected nothing to backfill, received %v", err)
+	}
+}
@@ -0,0 +1,293 @@
+package initialsync
+
+import (
+	"bytes"
+	"context"
+	"sort"
+	"time"
+
+	"github.com/libp2p/go-libp2p-core/peer"
+	"github.com/pkg/errors"
+	eth "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/go-ssz"
+	"github.com/prysmaticlabs/prysm/beacon-chain/p2p/peers"
+	p2ppb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+	"github.com/prysmaticlabs/prysm/shared/mathutil"
+	"go.opencensus.io/trace"
+)
+
+// rangesPerPeer is the number of slot ranges per peer which may be requested or waiting to be
+// processed at the same time. It bounds the blocks held in memory when a slow peer holds back
+// the processing of the ranges after its own.
+const rangesPerPeer = 2
+
+// blocksQueueConfig holds the parameters of a blocks queue.
+type blocksQueueConfig struct {
+	headBlockRoot []byte
+	parentRoot    []byte // Root of the block the first fetched block builds on, not checked when nil.
+	startSlot     uint64
+	highestSlot   uint64
+	rangeSize     uint64
+	peers         []peer.ID
+	request       func(context.Context, *p2ppb.BeaconBlocksByRangeRequest, peer.ID) ([]*eth.SignedBeaconBlock, error)
+	addScore      func(peer.ID, int) int
+}
+
+// slotRange of blocks requested from a single peer.
+type slotRange struct {
+	start uint64
+	count uint64
+	// retried is set once the range was requested again after an empty response, so another
+	// empty response confirms that its slots were skipped.
+	retried bool
+}
+
+// rangeResponse of a peer to the request of a slot range.
+type rangeResponse struct {
+	rng    *slotRange
+	pid    peer.ID
+	blocks []*eth.SignedBeaconBlock
+	err    error
+}
+
+// blocksQueue fetches the blocks between its start and highest slots. It schedules fixed-size
+// slot ranges across the peers concurrently, so a slow peer only holds back its own ranges, and
+// retries the ranges of a failed peer on the other peers. Responses are reordered, so the blocks
+// are processed in slot order, and verified to chain on the blocks processed before them. A
+// range failing verification is requested again from another peer.
+type blocksQueue struct {
+	cfg *blocksQueueConfig
+}
+
+// newBlocksQueue creates a queue from the given config.
+func newBlocksQueue(cfg *blocksQueueConfig) *blocksQueue {
+	if cfg.rangeSize == 0 {
+		cfg.rangeSize = blockBatchSize
+	}
+	return &blocksQueue{cfg: cfg}
+}
+
+// run fetches all the ranges of the queue and calls process on every block in slot order. It
+// returns once all the blocks were processed, or with an error once processing failed or no
+// peer is left to request the remaining ranges from.
+func (q *blocksQueue) run(ctx context.Context, process func(*eth.SignedBeaconBlock) error) error {
+	ctx, span := trace.StartSpan(ctx, "initialsync.blocksQueue.run")
+	defer span.End()
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	if len(q.cfg.peers) == 0 {
+		return errors.New("no peers to request blocks from")
+	}
+	window := len(q.cfg.peers) * rangesPerPeer
+	// Every scheduled range results in exactly one response, so neither channel blocks a sender
+	// while no more than window ranges are scheduled.
+	ranges := make(chan *slotRange, window)
+	responses := make(chan *rangeResponse, window)
+	cancelPeers := make(map[peer.ID]context.CancelFunc, len(q.cfg.peers))
+	for _, pid := range q.cfg.peers {
+		peerCtx, cancelPeer := context.WithCancel(ctx)
+		cancelPeers[pid] = cancelPeer
+		go q.fetchRanges(peerCtx, pid, ranges, responses)
+	}
+	activePeers := len(q.cfg.peers)
+	// dropPeer stops requesting ranges from the peer, so its ranges are picked up by the other
+	// peers. It returns false once no peer is left.
+	dropPeer := func(pid peer.ID) bool {
+		if cancel, ok := cancelPeers[pid]; ok {
+			cancel()
+			delete(cancelPeers, pid)
+			activePeers--
+		}
+		return activePeers > 0
+	}
+
+	next := q.cfg.startSlot
+	processed := q.cfg.startSlot
+	lastRoot := q.cfg.parentRoot
+	scheduled := 0
+	pending := make(map[uint64]*rangeResponse)
+	for {
+		for scheduled < window && next <= q.cfg.highestSlot {
+			count := mathutil.Min(q.cfg.rangeSize, q.cfg.highestSlot-next+1)
+			ranges <- &slotRange{start: next, count: count}
+			next += count
+			scheduled++
+		}
+		if scheduled == 0 {
+			return nil
+		}
+
+		var resp *rangeResponse
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case resp = <-responses:
+		}
+		if resp.err != nil {
+			// The failed peer stops fetching, so its range is picked up by another peer.
+			left := dropPeer(resp.pid)
+			failedRangeRequestsCounter.WithLabelValues(resp.pid.Pretty()).Inc()
+			log.WithError(resp.err).WithField("peer", resp.pid.Pretty()).WithField(
+				"remainingPeers", activePeers,
+			).Debug("Request failed, retrying range on other peers")
+			if !left {
+				return errors.Wrap(resp.err, "no peers left to request blocks")
+			}
+			ranges <- resp.rng
+			continue
+		}
+		pending[resp.rng.start] = resp
+
+		// Process the ranges which are next in slot order, once they are verified.
+		for {
+			batch, ready := q.nextSpan(pending, processed)
+			if !ready {
+				break
+			}
+			rejected, root, err := q.verifySpan(batch, lastRoot)
+			if err != nil {
+				// The rejected ranges are requested again from the other peers.
+				for _, resp := range rejected {
+					failedRangeRequestsCounter.WithLabelValues(resp.pid.Pretty()).Inc()
+					log.WithError(err).WithField("peer", resp.pid.Pretty()).WithField(
+						"start", resp.rng.start,
+					).Debug("Invalid range response, retrying range on other peers")
+					if _, active := cancelPeers[resp.pid]; active && q.cfg.addScore != nil {
+						q.cfg.addScore(resp.pid, peers.ScoreMalformedResponse)
+					}
+					if !dropPeer(resp.pid) {
+						return errors.Wrap(err, "no peers left to request blocks")
+					}
+					delete(pending, resp.rng.start)
+					if len(resp.blocks) == 0 {
+						resp.rng.retried = true
+					}
+					ranges <- resp.rng
+				}
+				break
+			}
+			for _, resp := range batch {
+				delete(pending, resp.rng.start)
+				for _, blk := range resp.blocks {
+					if err := process(blk); err != nil {
+						return err
+					}
+				}
+				processed += resp.rng.count
+				scheduled--
+			}
+			lastRoot = root
+		}
+	}
+}
+
+// nextSpan returns the received ranges from the start slot up to the first one with blocks. The
+// empty ranges of the span are only verified by the blocks after them, so the span is ready once
+// it ends with blocks, or with the last range of the queue.
+func (q *blocksQueue) nextSpan(pending map[uint64]*rangeResponse, start uint64) ([]*rangeResponse, bool) {
+	var span []*rangeResponse
+	for slot := start; slot <= q.cfg.highestSlot; {
+		resp, ok := pending[slot]
+		if !ok {
+			return nil, false
+		}
+		span = append(span, resp)
+		if len(resp.blocks) > 0 {
+			return span, true
+		}
+		slot += resp.rng.count
+	}
+	return span, len(span) > 0
+}
+
+// verifySpan checks the blocks of the span chain on the last processed block root, and returns
+// the root of the last block. Otherwise it returns the ranges to request again: the empty ranges
+// which may be withholding blocks, or the range with blocks once the empty ones are confirmed.
+func (q *blocksQueue) verifySpan(span []*rangeResponse, lastRoot []byte) ([]*rangeResponse, []byte, error) {
+	last := span[len(span)-1]
+	if len(last.blocks) == 0 {
+		return nil, lastRoot, nil
+	}
+	root, err := chainedRoot(last.blocks)
+	if err != nil {
+		return []*rangeResponse{last}, nil, err
+	}
+	if lastRoot == nil || bytes.Equal(last.blocks[0].Block.ParentRoot, lastRoot) {
+		return nil, root, nil
+	}
+	err = errors.Errorf("block at slot %d does not build on the last processed block", last.blocks[0].Block.Slot)
+	var rejected []*rangeResponse
+	for _, resp := range span[:len(span)-1] {
+		if !resp.rng.retried {
+			rejected = append(rejected, resp)
+		}
+	}
+	if len(rejected) == 0 {
+		rejected = append(rejected, last)
+	}
+	return rejected, nil, err
+}
+
+// chainedRoot returns the root of the last of the blocks, or an error if a block does not build
+// on the block before it.
+func chainedRoot(blocks []*eth.SignedBeaconBlock) ([]byte, error) {
+	var root [32]byte
+	for i, blk := range blocks {
+		if i > 0 && !bytes.Equal(blk.Block.ParentRoot, root[:]) {
+			return nil, errors.Errorf("block at slot %d does not build on the block before it", blk.Block.Slot)
+		}
+		var err error
+		root, err = ssz.HashTreeRoot(blk.Block)
+		if err != nil {
+			return nil, errors.Wrap(err, "could not compute block root")
+		}
+	}
+	return root[:], nil
+}
+
+// fetchRanges requests the scheduled ranges from the peer until the queue is done or drops the
+// peer, or the peer fails a request. Every received range is answered, so it is retried when the
+// peer is dropped while requesting it.
+func (q *blocksQueue) fetchRanges(ctx context.Context, pid peer.ID, ranges <-chan *slotRange, responses chan<- *rangeResponse) {
+	for {
+		var rng *slotRange
+		select {
+		case <-ctx.Done():
+			return
+		case rng = <-ranges:
+		}
+
+		start := time.Now()
+		req := &p2ppb.BeaconBlocksByRangeRequest{
+			HeadBlockRoot: q.cfg.headBlockRoot,
+			StartSlot:     rng.start,
+			Count:         rng.count,
+			Step:          1,
+		}
+		blocks, err := q.cfg.request(ctx, req, pid)
+		resp := &rangeResponse{rng: rng, pid: pid, err: err}
+		if err == nil {
+			resp.blocks = requestedBlocks(req, blocks)
+			recordPeerThroughput(pid, len(resp.blocks), time.Since(start))
+		}
+		responses <- resp
+		if err != nil {
+			return
+		}
+	}
+}
+
+// requestedBlocks returns the blocks of the response at the slots of the request, sorted by slot.
+func requestedBlocks(req *p2ppb.BeaconBlocksByRangeRequest, blocks []*eth.SignedBeaconBlock) []*eth.SignedBeaconBlock {
+	filtered := make([]*eth.SignedBeaconBlock, 0, len(blocks))
+	for _, blk := range blocks {
+		if isRequestedBlock(req, blk) {
+			filtered = append(filtered, blk)
+		}
+	}
+	sort.Slice(filtered, func(i, j int) bool {
+		return filtered[i].Block.Slot < filtered[j].Block.Slot
+	})
+	return filtered
+}
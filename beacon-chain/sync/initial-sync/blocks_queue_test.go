@@ -0,0 +1,179 @@
+package initialsync
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/libp2p/go-libp2p-core/peer"
+	eth "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/go-ssz"
+	"github.com/prysmaticlabs/prysm/beacon-chain/p2p/peers"
+	p2ppb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+)
+
+// testChain returns blocks at the slots 1 to n, each building on the block before it, and the
+// root the first block builds on.
+func testChain(t *testing.T, n uint64) (map[uint64]*eth.SignedBeaconBlock, []byte) {
+	blocks := make(map[uint64]*eth.SignedBeaconBlock)
+	parentRoot := []byte("genesis")
+	root := parentRoot
+	for slot := uint64(1); slot <= n; slot++ {
+		blk := &eth.SignedBeaconBlock{Block: &eth.BeaconBlock{Slot: slot, ParentRoot: root}}
+		r, err := ssz.HashTreeRoot(blk.Block)
+		if err != nil {
+			t.Fatal(err)
+		}
+		blocks[slot] = blk
+		root = r[:]
+	}
+	return blocks, parentRoot
+}
+
+// serveRange returns the block of the chain at every requested slot and one block past the
+// range, in reverse slot order.
+func serveRange(chain map[uint64]*eth.SignedBeaconBlock, req *p2ppb.BeaconBlocksByRangeRequest) []*eth.SignedBeaconBlock {
+	var blocks []*eth.SignedBeaconBlock
+	for i := int(req.Count); i >= 0; i-- {
+		if blk, ok := chain[req.StartSlot+uint64(i)]; ok {
+			blocks = append(blocks, blk)
+		}
+	}
+	return blocks
+}
+
+func TestBlocksQueue_ProcessesInOrderAndRetries(t *testing.T) {
+	slow := peer.ID("slow")
+	failing := peer.ID("failing")
+	fast := peer.ID("fast")
+	chain, parentRoot := testChain(t, 101)
+	request := func(ctx context.Context, req *p2ppb.BeaconBlocksByRangeRequest, pid peer.ID) ([]*eth.SignedBeaconBlock, error) {
+		switch pid {
+		case failing:
+			return nil, errors.New("peer failed")
+		case slow:
+			time.Sleep(50 * time.Millisecond)
+		}
+		return serveRange(chain, req), nil
+	}
+	queue := newBlocksQueue(&blocksQueueConfig{
+		parentRoot:  parentRoot,
+		startSlot:   1,
+		highestSlot: 100,
+		rangeSize:   8,
+		peers:       []peer.ID{slow, failing, fast},
+		request:     request,
+	})
+
+	var slots []uint64
+	if err := queue.run(context.Background(), func(blk *eth.SignedBeaconBlock) error {
+		slots = append(slots, blk.Block.Slot)
+		return nil
+	}); err != nil {
+		t.Fatal(err)
+	}
+	if len(slots) != 100 {
+		t.Fatalf("Wanted 100 blocks, received %d", len(slots))
+	}
+	for i, slot := range slots {
+		if slot != uint64(i+1) {
+			t.Fatalf("Wanted block %d at position %d, received %d", i+1, i, slot)
+		}
+	}
+}
+
+func TestBlocksQueue_AllPeersFail(t *testing.T) {
+	request := func(ctx context.Context, req *p2ppb.BeaconBlocksByRangeRequest, pid peer.ID) ([]*eth.SignedBeaconBlock, error) {
+		return nil, errors.New("peer failed")
+	}
+	queue := newBlocksQueue(&blocksQueueConfig{
+		startSlot:   1,
+		highestSlot: 64,
+		peers:       []peer.ID{peer.ID("a"), peer.ID("b")},
+		request:     request,
+	})
+	if err := queue.run(context.Background(), func(blk *eth.SignedBeaconBlock) error {
+		return nil
+	}); err == nil {
+		t.Error("Expected an error once all peers failed")
+	}
+}
+
+func TestBlocksQueue_ProcessingError(t *testing.T) {
+	chain, parentRoot := testChain(t, 65)
+	request := func(ctx context.Context, req *p2ppb.BeaconBlocksByRangeRequest, pid peer.ID) ([]*eth.SignedBeaconBlock, error) {
+		return serveRange(chain, req), nil
+	}
+	queue := newBlocksQueue(&blocksQueueConfig{
+		parentRoot:  parentRoot,
+		startSlot:   1,
+		highestSlot: 64,
+		rangeSize:   8,
+		peers:       []peer.ID{peer.ID("a")},
+		request:     request,
+	})
+	wanted := errors.New("invalid block")
+	if err := queue.run(context.Background(), func(blk *eth.SignedBeaconBlock) error {
+		if blk.Block.Slot == 10 {
+			return wanted
+		}
+		return nil
+	}); err != wanted {
+		t.Errorf("Wanted error %v, received %v", wanted, err)
+	}
+}
+
+func TestBlocksQueue_EmptyRangeRetriedOnOtherPeer(t *testing.T) {
+	withholding := peer.ID("withholding")
+	honest := peer.ID("honest")
+	chain, parentRoot := testChain(t, 65)
+	request := func(ctx context.Context, req *p2ppb.BeaconBlocksByRangeRequest, pid peer.ID) ([]*eth.SignedBeaconBlock, error) {
+		switch pid {
+		case withholding:
+			// Withhold the blocks of every range but the last one, whose empty response could
+			// not be told apart from skipped slots.
+			if req.StartSlot+req.Count <= 64 {
+				return nil, nil
+			}
+		case honest:
+			time.Sleep(10 * time.Millisecond)
+		}
+		return serveRange(chain, req), nil
+	}
+	scores := make(map[peer.ID]int)
+	queue := newBlocksQueue(&blocksQueueConfig{
+		parentRoot:  parentRoot,
+		startSlot:   1,
+		highestSlot: 64,
+		rangeSize:   8,
+		peers:       []peer.ID{withholding, honest},
+		request:     request,
+		addScore: func(pid peer.ID, points int) int {
+			scores[pid] += points
+			return scores[pid]
+		},
+	})
+
+	var slots []uint64
+	if err := queue.run(context.Background(), func(blk *eth.SignedBeaconBlock) error {
+		slots = append(slots, blk.Block.Slot)
+		return nil
+	}); err != nil {
+		t.Fatal(err)
+	}
+	if len(slots) != 64 {
+		t.Fatalf("Wanted 64 blocks, received %d", len(slots))
+	}
+	for i, slot := range slots {
+		if slot != uint64(i+1) {
+			t.Fatalf("Wanted block %d at position %d, received %d", i+1, i, slot)
+		}
+	}
+	if scores[withholding] != peers.ScoreMalformedResponse {
+		t.Errorf("Wanted the withholding peer to be scored %d, received %d", peers.ScoreMalformedResponse, scores[withholding])
+	}
+	if scores[honest] != 0 {
+		t.Errorf("Wanted the honest peer not to be scored, received %d", scores[honest])
+	}
+}
@@ -0,0 +1,41 @@
+package initialsync
+
+import (
+	"time"
+
+	"github.com/libp2p/go-libp2p-core/peer"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+var (
+	blocksFetchedCounter = promauto.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "initial_sync_blocks_fetched_total",
+			Help: "Count of blocks fetched from each peer during initial sync.",
+		},
+		[]string{"peer"},
+	)
+	peerThroughputGauge = promauto.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name: "initial_sync_peer_blocks_per_second",
+			Help: "Blocks per second served by each peer for its last range request during initial sync.",
+		},
+		[]string{"peer"},
+	)
+	failedRangeRequestsCounter = promauto.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "initial_sync_failed_range_requests_total",
+			Help: "Count of range requests which failed and were retried on other peers during initial sync.",
+		},
+		[]string{"peer"},
+	)
+)
+
+// recordPeerThroughput of a range request which returned the given number of blocks.
+func recordPeerThroughput(pid peer.ID, blocks int, elapsed time.Duration) {
+	blocksFetchedCounter.WithLabelValues(pid.Pretty()).Add(float64(blocks))
+	if elapsed > 0 {
+		peerThroughputGauge.WithLabelValues(pid.Pretty()).Set(float64(blocks) / elapsed.Seconds())
+	}
+}
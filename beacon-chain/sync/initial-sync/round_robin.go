@@ -14,6 +14,7 @@ import (
 	"github.com/pkg/errors"
 	eth "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
 	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+	"github.com/prysmaticlabs/prysm/beacon-chain/p2p/peers"
 	prysmsync "github.com/prysmaticlabs/prysm/beacon-chain/sync"
 	p2ppb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
 	"github.com/prysmaticlabs/prysm/shared/bytesutil"
@@ -45,7 +46,13 @@ func (s *Service) roundRobinSync(genesis time.Time) error {
 	randGenerator := rand.New(rand.NewSource(time.Now().Unix()))
 	var lastEmptyRequests int
 	// Step 1 - Sync to end of finalized epoch.
-	for s.chain.HeadSlot() < helpers.StartSlot(s.highestFinalizedEpoch()+1) {
+	parallelFetch := featureconfig.Get().InitSyncParallelFetch
+	if parallelFetch {
+		if err := s.syncToFinalizedEpoch(ctx, genesis, counter); err != nil {
+			return err
+		}
+	}
+	for !parallelFetch && s.chain.HeadSlot() < helpers.StartSlot(s.highestFinalizedEpoch()+1) {
 		root, finalizedEpoch, peers := s.p2p.Peers().BestFinalized(params.BeaconConfig().MaxPeersToSync)
 		if len(peers) == 0 {
 			log.Warn("No peers; waiting for reconnect")
@@ -264,6 +271,47 @@ func (s *Service) roundRobinSync(genesis time.Time) error {
 	return nil
 }
 
+// syncToFinalizedEpoch fetches the blocks up to the end of the finalized epoch with a blocks
+// queue, which requests ranges from the peers concurrently instead of in lockstep.
+func (s *Service) syncToFinalizedEpoch(ctx context.Context, genesis time.Time, counter *ratecounter.RateCounter) error {
+	for s.chain.HeadSlot() < helpers.StartSlot(s.highestFinalizedEpoch()+1) {
+		root, finalizedEpoch, peers := s.p2p.Peers().BestFinalized(params.BeaconConfig().MaxPeersToSync)
+		if len(peers) == 0 {
+			log.Warn("No peers; waiting for reconnect")
+			time.Sleep(refreshTime)
+			continue
+		}
+
+		headSlot := s.chain.HeadSlot()
+		queue := newBlocksQueue(&blocksQueueConfig{
+			headBlockRoot: root,
+			parentRoot:    s.chain.HeadRoot(),
+			startSlot:     headSlot + 1,
+			highestSlot:   helpers.StartSlot(finalizedEpoch + 1),
+			peers:         peers,
+			request:       s.requestBlocks,
+			addScore:      s.p2p.Peers().AddScore,
+		})
+		// The queue only hands over blocks which build on the head, so every block is processed.
+		if err := queue.run(ctx, func(blk *eth.SignedBeaconBlock) error {
+			s.logSyncStatus(genesis, blk.Block, peers, counter)
+			if featureconfig.Get().InitSyncNoVerify {
+				return s.chain.ReceiveBlockNoVerify(ctx, blk)
+			}
+			return s.chain.ReceiveBlockNoPubsubForkchoice(ctx, blk)
+		}); err != nil {
+			return err
+		}
+		// The queue verified the ranges it accepted as empty against the blocks after them, so no
+		// progress means the peers have no block after the head up to their finalized epoch.
+		if s.chain.HeadSlot() == headSlot {
+			log.WithField("finalizedEpoch", finalizedEpoch).Debug("No progress syncing to the finalized epoch")
+			break
+		}
+	}
+	return nil
+}
+
 // requestBlocks by range to a specific peer.
 func (s *Service) requestBlocks(ctx context.Context, req *p2ppb.BeaconBlocksByRangeRequest, pid peer.ID) ([]*eth.SignedBeaconBlock, error) {
 	log.WithFields(logrus.Fields{
@@ -275,25 +323,45 @@ func (s *Service) requestBlocks(ctx context.Context, req *p2ppb.BeaconBlocksByRa
 	}).Debug("Requesting blocks")
 	stream, err := s.p2p.Send(ctx, req, pid)
 	if err != nil {
+		s.p2p.Peers().AddScore(pid, peers.ScoreForError(err))
 		return nil, errors.Wrap(err, "failed to send request to peer")
 	}
 	defer stream.Close()
 
 	resp := make([]*eth.SignedBeaconBlock, 0, req.Count)
+	useful := 0
 	for {
 		blk, err := prysmsync.ReadChunkedBlock(stream, s.p2p)
 		if err == io.EOF {
 			break
 		}
 		if err != nil {
+			// A peer throttling our requests is not misbehaving.
+			if err != prysmsync.ErrRateLimited {
+				s.p2p.Peers().AddScore(pid, peers.ScoreForError(err))
+			}
 			return nil, errors.Wrap(err, "failed to read chunked block")
 		}
+		if isRequestedBlock(req, blk) {
+			useful++
+		}
 		resp = append(resp, blk)
 	}
+	s.p2p.Peers().AddScore(pid, useful*peers.ScoreUsefulBlock)
 
 	return resp, nil
 }
 
+// isRequestedBlock returns true if the block is at one of the slots of the range request. Only
+// those blocks raise the score of the peer which served them.
+func isRequestedBlock(req *p2ppb.BeaconBlocksByRangeRequest, blk *eth.SignedBeaconBlock) bool {
+	if blk == nil || blk.Block == nil || req.Step == 0 || blk.Block.Slot < req.StartSlot {
+		return false
+	}
+	offset := blk.Block.Slot - req.StartSlot
+	return offset%req.Step == 0 && offset/req.Step < req.Count
+}
+
 // highestFinalizedEpoch as reported by peers. This is the absolute highest finalized epoch as
 // reported by peers.
 func (s *Service) highestFinalizedEpoch() uint64 {
@@ -34,6 +34,8 @@ type Config struct {
 	DB            db.Database
 	Chain         blockchainService
 	StateNotifier statefeed.Notifier
+	// Backfill the blocks older than the checkpoint the node started syncing from.
+	Backfill bool
 }
 
 // Service service.
@@ -45,6 +47,7 @@ type Service struct {
 	synced        bool
 	chainStarted  bool
 	stateNotifier statefeed.Notifier
+	backfill      bool
 }
 
 // NewInitialSync configures the initial sync service responsible for bringing the node up to the
@@ -56,6 +59,7 @@ func NewInitialSync(cfg *Config) *Service {
 		p2p:           cfg.P2P,
 		db:            cfg.DB,
 		stateNotifier: cfg.StateNotifier,
+		backfill:      cfg.Backfill,
 	}
 }
 
@@ -100,6 +104,13 @@ func (s *Service) Start() {
 		time.Sleep(roughtime.Until(genesis))
 	}
 	s.chainStarted = true
+	if s.backfill {
+		go func() {
+			if err := s.backfillBlocks(s.ctx); err != nil {
+				log.WithError(err).Error("Could not backfill blocks")
+			}
+		}()
+	}
 	currentSlot := helpers.SlotsSince(genesis)
 	if helpers.SlotToEpoch(currentSlot) == 0 {
 		log.Info("Chain started within the last epoch - not syncing")
@@ -27,10 +27,23 @@ var (
 		},
 		[]string{"topic"},
 	)
+	rpcRequestsThrottledCounter = promauto.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "p2p_rpc_requests_throttled_total",
+			Help: "Count of RPC requests rejected because the peer exceeded its rate limit.",
+		},
+		[]string{"protocol"},
+	)
 	numberOfTimesResyncedCounter = promauto.NewCounter(
 		prometheus.CounterOpts{
 			Name: "number_of_times_resynced",
 			Help: "Count the number of times a node resyncs.",
 		},
 	)
+	attestationSubnetsSubscribed = promauto.NewGauge(
+		prometheus.GaugeOpts{
+			Name: "p2p_attestation_subnets_subscribed",
+			Help: "Number of attestation subnets the node is subscribed to.",
+		},
+	)
 )
@@ -0,0 +1,158 @@
+package sync
+
+import (
+	"sync"
+	"time"
+
+	libp2pcore "github.com/libp2p/go-libp2p-core"
+	"github.com/libp2p/go-libp2p-core/peer"
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+	"github.com/prysmaticlabs/prysm/shared/roughtime"
+)
+
+const (
+	// Protocols of the req/resp domain which read blocks from the database for the peer.
+	beaconBlocksByRangeProtocol = "/eth2/beacon_chain/req/beacon_blocks_by_range/1"
+	beaconBlocksByRootProtocol  = "/eth2/beacon_chain/req/beacon_blocks_by_root/1"
+)
+
+// rateLimitPrunePeriod is how often the buckets which refilled completely are dropped.
+const rateLimitPrunePeriod = time.Minute
+
+// rateLimit of a protocol. A peer may request up to capacity blocks at once, after which it may
+// request rate blocks per second.
+type rateLimit struct {
+	capacity float64
+	rate     float64
+}
+
+// defaultRateLimits of the protocols which serve blocks. A by range request may span up to 1000
+// slots, so the capacity allows a single request of that size.
+var defaultRateLimits = map[string]rateLimit{
+	beaconBlocksByRangeProtocol: {capacity: 1024, rate: 64},
+	beaconBlocksByRootProtocol:  {capacity: 256, rate: 32},
+}
+
+// tokenBucket of a peer for a single protocol.
+type tokenBucket struct {
+	tokens     float64
+	lastRefill time.Time
+}
+
+// rateLimiter keeps a token bucket per peer and per protocol, so a single peer cannot make the
+// node read an unbounded number of blocks from the database.
+type rateLimiter struct {
+	limits  map[string]rateLimit
+	buckets map[string]map[peer.ID]*tokenBucket
+	lock    sync.Mutex
+}
+
+// newRateLimiter with the given limits per protocol. Protocols without a limit are not throttled.
+func newRateLimiter(limits map[string]rateLimit) *rateLimiter {
+	buckets := make(map[string]map[peer.ID]*tokenBucket, len(limits))
+	for protocol := range limits {
+		buckets[protocol] = make(map[peer.ID]*tokenBucket)
+	}
+	return &rateLimiter{
+		limits:  limits,
+		buckets: buckets,
+	}
+}
+
+// allow takes cost tokens from the bucket of the peer for the protocol, and returns false if
+// there are not enough tokens left. A nil rate limiter allows every request.
+func (l *rateLimiter) allow(protocol string, pid peer.ID, cost uint64) bool {
+	if l == nil {
+		return true
+	}
+	limit, ok := l.limits[protocol]
+	if !ok {
+		return true
+	}
+	if cost == 0 {
+		cost = 1
+	}
+	// A request costing more than the capacity would never be allowed, so it takes the whole
+	// bucket instead.
+	if float64(cost) > limit.capacity {
+		cost = uint64(limit.capacity)
+	}
+
+	l.lock.Lock()
+	defer l.lock.Unlock()
+	now := roughtime.Now()
+	bucket, ok := l.buckets[protocol][pid]
+	if !ok {
+		bucket = &tokenBucket{tokens: limit.capacity, lastRefill: now}
+		l.buckets[protocol][pid] = bucket
+	}
+	bucket.refill(limit, now)
+	if bucket.tokens < float64(cost) {
+		return false
+	}
+	bucket.tokens -= float64(cost)
+	return true
+}
+
+// prune drops the buckets which refilled completely, as they are the same as new buckets. Buckets
+// are not dropped on disconnection, so a peer cannot reset its limits by reconnecting.
+func (l *rateLimiter) prune() {
+	if l == nil {
+		return
+	}
+	l.lock.Lock()
+	defer l.lock.Unlock()
+	now := roughtime.Now()
+	for protocol, buckets := range l.buckets {
+		limit := l.limits[protocol]
+		for pid, bucket := range buckets {
+			bucket.refill(limit, now)
+			if bucket.tokens >= limit.capacity {
+				delete(buckets, pid)
+			}
+		}
+	}
+}
+
+// refill the bucket with the tokens accrued since its last refill, up to its capacity.
+func (b *tokenBucket) refill(limit rateLimit, now time.Time) {
+	elapsed := now.Sub(b.lastRefill).Seconds()
+	if elapsed <= 0 {
+		return
+	}
+	b.tokens += elapsed * limit.rate
+	if b.tokens > limit.capacity {
+		b.tokens = limit.capacity
+	}
+	b.lastRefill = now
+}
+
+// requestCost is the number of blocks a request may read from the database.
+func requestCost(msg interface{}) uint64 {
+	switch m := msg.(type) {
+	case *pb.BeaconBlocksByRangeRequest:
+		return m.Count
+	case [][32]byte:
+		return uint64(len(m))
+	default:
+		return 1
+	}
+}
+
+// validateRequestRate responds with a server error and returns false if the peer exceeded its
+// rate limit for the protocol. The error message tells the requester its request was throttled.
+func (r *Service) validateRequestRate(protocol string, msg interface{}, stream libp2pcore.Stream) bool {
+	if r.rateLimiter.allow(protocol, stream.Conn().RemotePeer(), requestCost(msg)) {
+		return true
+	}
+	rpcRequestsThrottledCounter.WithLabelValues(protocol).Inc()
+	resp, err := r.generateErrorResponse(responseCodeServerError, rateLimitedError)
+	if err != nil {
+		log.WithError(err).Error("Failed to generate a response error")
+	} else {
+		if _, err := stream.Write(resp); err != nil {
+			log.WithError(err).Errorf("Failed to write to stream")
+		}
+	}
+	return false
+}
@@ -0,0 +1,117 @@
+package sync
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/libp2p/go-libp2p-core/network"
+	"github.com/libp2p/go-libp2p-core/peer"
+	"github.com/libp2p/go-libp2p-core/protocol"
+	p2ptest "github.com/prysmaticlabs/prysm/beacon-chain/p2p/testing"
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+	"github.com/prysmaticlabs/prysm/shared/testutil"
+)
+
+func TestRateLimiter_ExhaustsAndRefillsBucket(t *testing.T) {
+	limiter := newRateLimiter(map[string]rateLimit{
+		beaconBlocksByRangeProtocol: {capacity: 10, rate: 5},
+	})
+	pid := peer.ID("foo")
+
+	if !limiter.allow(beaconBlocksByRangeProtocol, pid, 8) {
+		t.Fatal("Request within the capacity was throttled")
+	}
+	if limiter.allow(beaconBlocksByRangeProtocol, pid, 8) {
+		t.Fatal("Request over the remaining tokens was allowed")
+	}
+	// Another peer has its own bucket.
+	if !limiter.allow(beaconBlocksByRangeProtocol, peer.ID("bar"), 8) {
+		t.Error("Request of another peer was throttled")
+	}
+	// Protocols without a limit are never throttled.
+	if !limiter.allow(beaconBlocksByRootProtocol, pid, 1000) {
+		t.Error("Request of an unlimited protocol was throttled")
+	}
+
+	// Two seconds refill 10 tokens, capped at the capacity.
+	limiter.buckets[beaconBlocksByRangeProtocol][pid].lastRefill = time.Now().Add(-2 * time.Second)
+	if !limiter.allow(beaconBlocksByRangeProtocol, pid, 10) {
+		t.Error("Request was throttled after the bucket refilled")
+	}
+
+	// A request over the capacity takes the whole bucket once it refilled.
+	limiter.buckets[beaconBlocksByRangeProtocol][pid].lastRefill = time.Now().Add(-2 * time.Second)
+	if !limiter.allow(beaconBlocksByRangeProtocol, pid, 1000) {
+		t.Error("Request over the capacity was throttled with a full bucket")
+	}
+	if limiter.allow(beaconBlocksByRangeProtocol, pid, 1) {
+		t.Error("Request was allowed with an empty bucket")
+	}
+}
+
+func TestRateLimiter_PrunesFullBuckets(t *testing.T) {
+	limiter := newRateLimiter(map[string]rateLimit{
+		beaconBlocksByRangeProtocol: {capacity: 10, rate: 5},
+	})
+	full := peer.ID("foo")
+	drained := peer.ID("bar")
+	limiter.allow(beaconBlocksByRangeProtocol, full, 1)
+	limiter.allow(beaconBlocksByRangeProtocol, drained, 10)
+	limiter.buckets[beaconBlocksByRangeProtocol][full].lastRefill = time.Now().Add(-time.Second)
+
+	limiter.prune()
+	if _, ok := limiter.buckets[beaconBlocksByRangeProtocol][full]; ok {
+		t.Error("Expected the refilled bucket to be pruned")
+	}
+	if _, ok := limiter.buckets[beaconBlocksByRangeProtocol][drained]; !ok {
+		t.Error("Expected the drained bucket to be kept")
+	}
+}
+
+func TestValidateRequestRate_RespondsWithError(t *testing.T) {
+	p1 := p2ptest.NewTestP2P(t)
+	p2 := p2ptest.NewTestP2P(t)
+	p1.Connect(p2)
+
+	r := &Service{
+		p2p: p1,
+		rateLimiter: newRateLimiter(map[string]rateLimit{
+			beaconBlocksByRangeProtocol: {capacity: 64, rate: 1},
+		}),
+	}
+	pcl := protocol.ID("/testing")
+
+	var wg sync.WaitGroup
+	wg.Add(1)
+	p2.Host.SetStreamHandler(pcl, func(stream network.Stream) {
+		defer wg.Done()
+		code, errMsg, err := ReadStatusCode(stream, r.p2p.Encoding())
+		if err != nil {
+			t.Fatal(err)
+		}
+		if code != responseCodeServerError {
+			t.Errorf("Wanted response code %d, received %d", responseCodeServerError, code)
+		}
+		if errMsg != rateLimitedError {
+			t.Errorf("Wanted error message %q, received %q", rateLimitedError, errMsg)
+		}
+	})
+
+	stream, err := p1.Host.NewStream(context.Background(), p2.Host.ID(), pcl)
+	if err != nil {
+		t.Fatal(err)
+	}
+	req := &pb.BeaconBlocksByRangeRequest{StartSlot: 1, Count: 64, Step: 1}
+	if !r.validateRequestRate(beaconBlocksByRangeProtocol, req, stream) {
+		t.Fatal("First request was throttled")
+	}
+	if r.validateRequestRate(beaconBlocksByRangeProtocol, req, stream) {
+		t.Fatal("Request over the rate limit was allowed")
+	}
+
+	if testutil.WaitTimeout(&wg, 1*time.Second) {
+		t.Fatal("Did not receive stream within 1 sec")
+	}
+}
@@ -7,6 +7,7 @@ import (
 
 	libp2pcore "github.com/libp2p/go-libp2p-core"
 	"github.com/libp2p/go-libp2p-core/network"
+	"github.com/prysmaticlabs/prysm/beacon-chain/p2p/peers"
 	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
 	"github.com/prysmaticlabs/prysm/shared/roughtime"
 	"github.com/prysmaticlabs/prysm/shared/traceutil"
@@ -41,20 +42,31 @@ func (r *Service) registerRPCHandlers() {
 		r.goodbyeRPCHandler,
 	)
 	r.registerRPC(
-		"/eth2/beacon_chain/req/beacon_blocks_by_range/1",
+		beaconBlocksByRangeProtocol,
 		&pb.BeaconBlocksByRangeRequest{},
 		r.beaconBlocksByRangeRPCHandler,
 	)
 	r.registerRPC(
-		"/eth2/beacon_chain/req/beacon_blocks_by_root/1",
+		beaconBlocksByRootProtocol,
 		[][32]byte{},
 		r.beaconBlocksRootRPCHandler,
 	)
+	r.registerRPC(
+		"/eth2/beacon_chain/req/ping/1",
+		&pb.Ping{},
+		r.pingHandler,
+	)
+	r.registerRPC(
+		"/eth2/beacon_chain/req/metadata/1",
+		nil,
+		r.metaDataHandler,
+	)
 }
 
-// registerRPC for a given topic with an expected protobuf message type.
-func (r *Service) registerRPC(topic string, base interface{}, handle rpcHandler) {
-	topic += r.p2p.Encoding().ProtocolSuffix()
+// registerRPC for a given topic with an expected protobuf message type. A nil message type
+// registers a request without a payload.
+func (r *Service) registerRPC(protocol string, base interface{}, handle rpcHandler) {
+	topic := protocol + r.p2p.Encoding().ProtocolSuffix()
 	log := log.WithField("topic", topic)
 	r.p2p.SetStreamHandler(topic, func(stream network.Stream) {
 		ctx, cancel := context.WithTimeout(context.Background(), ttfbTimeout)
@@ -71,6 +83,15 @@ func (r *Service) registerRPC(topic string, base interface{}, handle rpcHandler)
 			return
 		}
 
+		if base == nil {
+			if err := handle(ctx, nil, stream); err != nil {
+				messageFailedProcessingCounter.WithLabelValues(topic).Inc()
+				log.WithError(err).Error("Failed to handle p2p RPC")
+				traceutil.AnnotateError(span, err)
+			}
+			return
+		}
+
 		// Given we have an input argument that can be pointer or [][32]byte, this gives us
 		// a way to check for its reflect.Kind and based on the result, we can decode
 		// accordingly.
@@ -80,6 +101,12 @@ func (r *Service) registerRPC(topic string, base interface{}, handle rpcHandler)
 			if err := r.p2p.Encoding().DecodeWithLength(stream, msg.Interface()); err != nil {
 				log.WithError(err).Error("Failed to decode stream message")
 				traceutil.AnnotateError(span, err)
+				r.p2p.Peers().AddScore(stream.Conn().RemotePeer(), peers.ScoreForError(err))
+				return
+			}
+			if !r.validateRequestRate(protocol, msg.Interface(), stream) {
+				log.Debug("Throttled p2p RPC")
+				traceutil.AnnotateError(span, ErrRateLimited)
 				return
 			}
 			if err := handle(ctx, msg.Interface(), stream); err != nil {
@@ -94,6 +121,12 @@ func (r *Service) registerRPC(topic string, base interface{}, handle rpcHandler)
 			if err := r.p2p.Encoding().DecodeWithLength(stream, msg.Interface()); err != nil {
 				log.WithError(err).Error("Failed to decode stream message")
 				traceutil.AnnotateError(span, err)
+				r.p2p.Peers().AddScore(stream.Conn().RemotePeer(), peers.ScoreForError(err))
+				return
+			}
+			if !r.validateRequestRate(protocol, msg.Elem().Interface(), stream) {
+				log.Debug("Throttled p2p RPC")
+				traceutil.AnnotateError(span, ErrRateLimited)
 				return
 			}
 			if err := handle(ctx, msg.Elem().Interface(), stream); err != nil {
@@ -8,6 +8,7 @@ import (
 	"github.com/pkg/errors"
 	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
 	"github.com/prysmaticlabs/prysm/beacon-chain/db/filters"
+	"github.com/prysmaticlabs/prysm/beacon-chain/p2p/peers"
 	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
 	"github.com/prysmaticlabs/prysm/shared/traceutil"
 	"go.opencensus.io/trace"
@@ -46,6 +47,7 @@ func (r *Service) beaconBlocksByRangeRPCHandler(ctx context.Context, msg interfa
 				log.WithError(err).Errorf("Failed to write to stream")
 			}
 		}
+		r.p2p.Peers().AddScore(stream.Conn().RemotePeer(), peers.ScoreMalformedResponse)
 		err = errors.New("invalid range or step")
 		traceutil.AnnotateError(span, err)
 		return err
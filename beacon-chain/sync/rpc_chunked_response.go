@@ -47,6 +47,10 @@ func readResponseChunk(stream libp2pcore.Stream, p2p p2p.P2P, to interface{}) er
 		return err
 	}
 
+	// A throttled request is answered with a server error carrying the rate limit message.
+	if code == responseCodeServerError && errMsg == rateLimitedError {
+		return ErrRateLimited
+	}
 	if code != 0 {
 		return errors.New(errMsg)
 	}
@@ -0,0 +1,47 @@
+package sync
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	libp2pcore "github.com/libp2p/go-libp2p-core"
+	"github.com/libp2p/go-libp2p-core/peer"
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+)
+
+// metaDataHandler responds to the metadata request of the peer, which has no payload, with the
+// metadata of our node.
+func (r *Service) metaDataHandler(ctx context.Context, msg interface{}, stream libp2pcore.Stream) error {
+	defer stream.Close()
+	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+	setRPCStreamDeadlines(stream)
+
+	return r.chunkWriter(stream, r.p2p.Metadata())
+}
+
+// sendMetaDataRequest requests the metadata of the peer.
+func (r *Service) sendMetaDataRequest(ctx context.Context, id peer.ID) (*pb.MetaData, error) {
+	ctx, cancel := context.WithTimeout(ctx, 10*time.Second)
+	defer cancel()
+
+	stream, err := r.p2p.Send(ctx, nil, id)
+	if err != nil {
+		return nil, err
+	}
+
+	code, errMsg, err := ReadStatusCode(stream, r.p2p.Encoding())
+	if err != nil {
+		return nil, err
+	}
+	if code != 0 {
+		r.p2p.Peers().IncrementBadResponses(stream.Conn().RemotePeer())
+		return nil, errors.New(errMsg)
+	}
+	msg := &pb.MetaData{}
+	if err := r.p2p.Encoding().DecodeWithLength(stream, msg); err != nil {
+		return nil, err
+	}
+	return msg, nil
+}
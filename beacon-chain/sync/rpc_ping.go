@@ -0,0 +1,132 @@
+package sync
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	libp2pcore "github.com/libp2p/go-libp2p-core"
+	"github.com/libp2p/go-libp2p-core/peer"
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+	"github.com/prysmaticlabs/prysm/shared/runutil"
+)
+
+// pingInterval is the interval at which the connected peers are pinged. It is independent of the
+// status interval, so a dead connection is dropped after maxPingFailures short intervals.
+const pingInterval = 30 * time.Second
+
+// maxPingFailures is the number of consecutive pings a peer may fail to answer before it is
+// considered a dead connection.
+const maxPingFailures = 3
+
+// maintainPeerPings by frequently pinging the connected peers to detect dead connections and
+// stale metadata.
+func (r *Service) maintainPeerPings() {
+	ctx := context.Background()
+	runutil.RunEvery(r.ctx, pingInterval, func() {
+		r.forEachConnectedPeer(func(pid peer.ID) {
+			r.pingPeer(ctx, pid)
+		})
+	})
+}
+
+// pingPeer pings the peer if it supports pings, and disconnects it once it failed to answer
+// maxPingFailures pings in a row.
+func (r *Service) pingPeer(ctx context.Context, pid peer.ID) {
+	if !r.p2p.SupportsRPC(pid, &pb.Ping{}) {
+		return
+	}
+	if err := r.sendPingRequest(ctx, pid); err != nil {
+		failures := r.recordPingFailure(pid)
+		log.WithField("peer", pid).WithField("failures", failures).WithError(err).Debug("Failed to ping peer")
+		if failures >= maxPingFailures {
+			r.clearPingFailures(pid)
+			if err := r.p2p.Disconnect(pid); err != nil {
+				log.WithField("peer", pid).WithError(err).Error("Failed to disconnect peer")
+			}
+		}
+		return
+	}
+	r.clearPingFailures(pid)
+}
+
+// recordPingFailure increments and returns the number of consecutive pings the peer failed to
+// answer.
+func (r *Service) recordPingFailure(pid peer.ID) int {
+	r.pingFailuresLock.Lock()
+	defer r.pingFailuresLock.Unlock()
+	r.pingFailures[pid]++
+	return r.pingFailures[pid]
+}
+
+func (r *Service) clearPingFailures(pid peer.ID) {
+	r.pingFailuresLock.Lock()
+	defer r.pingFailuresLock.Unlock()
+	delete(r.pingFailures, pid)
+}
+
+// pingHandler reads the incoming ping rpc message from the peer and responds with the sequence
+// number of our metadata. The metadata of the peer is requested if its sequence number is ahead
+// of the one we know.
+func (r *Service) pingHandler(ctx context.Context, msg interface{}, stream libp2pcore.Stream) error {
+	defer stream.Close()
+	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+	setRPCStreamDeadlines(stream)
+
+	m := msg.(*pb.Ping)
+	resp := &pb.Ping{SeqNumber: r.p2p.Metadata().SeqNumber}
+	if err := r.chunkWriter(stream, resp); err != nil {
+		return err
+	}
+	r.refreshPeerMetadata(stream.Conn().RemotePeer(), m.SeqNumber)
+	return nil
+}
+
+// sendPingRequest pings the peer with the sequence number of our metadata, and requests the
+// metadata of the peer if the sequence number of its response is ahead of the one we know.
+func (r *Service) sendPingRequest(ctx context.Context, id peer.ID) error {
+	ctx, cancel := context.WithTimeout(ctx, 10*time.Second)
+	defer cancel()
+
+	stream, err := r.p2p.Send(ctx, &pb.Ping{SeqNumber: r.p2p.Metadata().SeqNumber}, id)
+	if err != nil {
+		return err
+	}
+
+	code, errMsg, err := ReadStatusCode(stream, r.p2p.Encoding())
+	if err != nil {
+		return err
+	}
+	if code != 0 {
+		r.p2p.Peers().IncrementBadResponses(stream.Conn().RemotePeer())
+		return errors.New(errMsg)
+	}
+	msg := &pb.Ping{}
+	if err := r.p2p.Encoding().DecodeWithLength(stream, msg); err != nil {
+		return err
+	}
+	r.refreshPeerMetadata(id, msg.SeqNumber)
+	return nil
+}
+
+// refreshPeerMetadata requests the metadata of the peer in the background if the given sequence
+// number is ahead of the metadata we know of the peer.
+func (r *Service) refreshPeerMetadata(id peer.ID, seqNumber uint64) {
+	md, err := r.p2p.Peers().Metadata(id)
+	if err != nil {
+		// Peer has vanished; nothing to do
+		return
+	}
+	if md != nil && md.SeqNumber >= seqNumber {
+		return
+	}
+	go func() {
+		md, err := r.sendMetaDataRequest(r.ctx, id)
+		if err != nil {
+			log.WithField("peer", id).WithError(err).Debug("Failed to request peer metadata")
+			return
+		}
+		r.p2p.Peers().SetMetadata(id, md)
+	}()
+}
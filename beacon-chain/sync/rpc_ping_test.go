@@ -0,0 +1,106 @@
+package sync
+
+import (
+	"bytes"
+	"context"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/libp2p/go-libp2p-core/network"
+	"github.com/libp2p/go-libp2p-core/peer"
+	p2ptest "github.com/prysmaticlabs/prysm/beacon-chain/p2p/testing"
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+)
+
+func TestPing_RequestsStaleMetadata(t *testing.T) {
+	p1 := p2ptest.NewTestP2P(t)
+	p2 := p2ptest.NewTestP2P(t)
+	p1.Connect(p2)
+	if len(p1.Host.Network().Peers()) != 1 {
+		t.Error("Expected peers to be connected")
+	}
+	p1.LocalMetadata = &pb.MetaData{SeqNumber: 1, Attnets: make([]byte, 8)}
+	p2.LocalMetadata = &pb.MetaData{SeqNumber: 2, Attnets: []byte{0x02, 0, 0, 0, 0, 0, 0, 0}}
+	p1.Peers().Add(p2.PeerID(), nil, network.DirOutbound)
+	p2.Peers().Add(p1.PeerID(), nil, network.DirInbound)
+
+	r1 := &Service{ctx: context.Background(), p2p: p1}
+	r2 := &Service{ctx: context.Background(), p2p: p2}
+	r1.registerRPCHandlers()
+	r2.registerRPCHandlers()
+
+	if err := r1.sendPingRequest(context.Background(), p2.PeerID()); err != nil {
+		t.Fatal(err)
+	}
+
+	// Both peers learn that the metadata of the other is ahead of what they know.
+	waitForMetadata := func(p *p2ptest.TestP2P, remote *p2ptest.TestP2P) {
+		for i := 0; i < 100; i++ {
+			md, err := p.Peers().Metadata(remote.PeerID())
+			if err != nil {
+				t.Fatal(err)
+			}
+			if md != nil {
+				if md.SeqNumber != remote.LocalMetadata.SeqNumber {
+					t.Errorf("Wanted sequence number %d, received %d", remote.LocalMetadata.SeqNumber, md.SeqNumber)
+				}
+				if !bytes.Equal(md.Attnets, remote.LocalMetadata.Attnets) {
+					t.Errorf("Wanted attnets %#x, received %#x", remote.LocalMetadata.Attnets, md.Attnets)
+				}
+				return
+			}
+			time.Sleep(10 * time.Millisecond)
+		}
+		t.Error("Did not receive metadata within 1 sec")
+	}
+	waitForMetadata(p1, p2)
+	waitForMetadata(p2, p1)
+}
+
+func TestPingPeer_DisconnectsAfterConsecutivePingFailures(t *testing.T) {
+	p1 := p2ptest.NewTestP2P(t)
+	p2 := p2ptest.NewTestP2P(t)
+	p1.Connect(p2)
+	p1.LocalMetadata = &pb.MetaData{SeqNumber: 1, Attnets: make([]byte, 8)}
+	p1.Peers().Add(p2.PeerID(), nil, network.DirOutbound)
+	p1.Peers().SetChainState(p2.PeerID(), &pb.Status{})
+
+	// The remote peer advertises pings but does not answer them.
+	pingTopic := p2ptest.TopicMappings[reflect.TypeOf(&pb.Ping{})] + p1.Encoding().ProtocolSuffix()
+	if err := p1.Host.Peerstore().AddProtocols(p2.PeerID(), pingTopic); err != nil {
+		t.Fatal(err)
+	}
+
+	r := &Service{ctx: context.Background(), p2p: p1, pingFailures: make(map[peer.ID]int)}
+	for i := 0; i < maxPingFailures-1; i++ {
+		r.pingPeer(context.Background(), p2.PeerID())
+	}
+	if p1.Host.Network().Connectedness(p2.PeerID()) != network.Connected {
+		t.Fatal("Peer should not be disconnected before failing the maximum number of pings")
+	}
+	r.pingPeer(context.Background(), p2.PeerID())
+	if p1.Host.Network().Connectedness(p2.PeerID()) == network.Connected {
+		t.Error("Peer should be disconnected after failing the maximum number of pings")
+	}
+}
+
+func TestPingPeer_SkipsPeersNotAdvertisingPing(t *testing.T) {
+	p1 := p2ptest.NewTestP2P(t)
+	p2 := p2ptest.NewTestP2P(t)
+	p1.Connect(p2)
+	p1.LocalMetadata = &pb.MetaData{SeqNumber: 1, Attnets: make([]byte, 8)}
+	p1.Peers().Add(p2.PeerID(), nil, network.DirOutbound)
+	p1.Peers().SetChainState(p2.PeerID(), &pb.Status{})
+
+	r := &Service{ctx: context.Background(), p2p: p1, pingFailures: make(map[peer.ID]int)}
+	for i := 0; i < maxPingFailures; i++ {
+		r.pingPeer(context.Background(), p2.PeerID())
+	}
+	if p1.Host.Network().Connectedness(p2.PeerID()) != network.Connected {
+		t.Error("Peer which does not advertise pings should not be disconnected")
+	}
+	if len(r.pingFailures) != 0 {
+		t.Errorf("Wanted no ping failures, received %d", len(r.pingFailures))
+	}
+}
@@ -3,6 +3,7 @@ package sync
 import (
 	"bytes"
 	"context"
+	"sync"
 	"time"
 
 	libp2pcore "github.com/libp2p/go-libp2p-core"
@@ -19,23 +20,17 @@ import (
 
 const statusInterval = 6 * time.Minute // 30 slots.
 
+// peerWorkers is the number of peers pinged or polled for their status in parallel.
+const peerWorkers = 16
+
 // maintainPeerStatuses by infrequently polling peers for their latest status.
 func (r *Service) maintainPeerStatuses() {
 	ctx := context.Background()
 	runutil.RunEvery(r.ctx, statusInterval, func() {
-		for _, pid := range r.p2p.Peers().Connected() {
-			// If the status hasn't been updated in the recent interval time.
-			lastUpdated, err := r.p2p.Peers().ChainStateLastUpdated(pid)
-			if err != nil {
-				// Peer has vanished; nothing to do
-				continue
-			}
-			if roughtime.Now().After(lastUpdated.Add(statusInterval)) {
-				if err := r.sendRPCStatusRequest(ctx, pid); err != nil {
-					log.WithField("peer", pid).WithError(err).Error("Failed to request peer status")
-				}
-			}
-		}
+		r.forEachConnectedPeer(func(pid peer.ID) {
+			r.maintainPeerStatus(ctx, pid)
+		})
+
 		if !r.initialSync.Syncing() {
 			_, highestEpoch, _ := r.p2p.Peers().BestFinalized(params.BeaconConfig().MaxPeersToSync)
 			if helpers.StartSlot(highestEpoch) > r.chain.HeadSlot() {
@@ -50,6 +45,43 @@ func (r *Service) maintainPeerStatuses() {
 	})
 }
 
+// forEachConnectedPeer calls f for each connected peer, on peerWorkers peers in parallel, and
+// returns once all the calls returned.
+func (r *Service) forEachConnectedPeer(f func(pid peer.ID)) {
+	pids := make(chan peer.ID)
+	var wg sync.WaitGroup
+	for i := 0; i < peerWorkers; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for pid := range pids {
+				f(pid)
+			}
+		}()
+	}
+	for _, pid := range r.p2p.Peers().Connected() {
+		pids <- pid
+	}
+	close(pids)
+	wg.Wait()
+}
+
+// maintainPeerStatus requests the status of the peer if it has not been updated in the recent
+// interval time.
+func (r *Service) maintainPeerStatus(ctx context.Context, pid peer.ID) {
+	// If the status hasn't been updated in the recent interval time.
+	lastUpdated, err := r.p2p.Peers().ChainStateLastUpdated(pid)
+	if err != nil {
+		// Peer has vanished; nothing to do
+		return
+	}
+	if roughtime.Now().After(lastUpdated.Add(statusInterval)) {
+		if err := r.sendRPCStatusRequest(ctx, pid); err != nil {
+			log.WithField("peer", pid).WithError(err).Error("Failed to request peer status")
+		}
+	}
+}
+
 // sendRPCStatusRequest for a given topic with an expected protobuf message type.
 func (r *Service) sendRPCStatusRequest(ctx context.Context, id peer.ID) error {
 	ctx, cancel := context.WithTimeout(ctx, 10*time.Second)
@@ -91,6 +123,7 @@ func (r *Service) sendRPCStatusRequest(ctx context.Context, id peer.ID) error {
 }
 
 func (r *Service) removeDisconnectedPeerStatus(ctx context.Context, pid peer.ID) error {
+	r.clearPingFailures(pid)
 	return nil
 }
 
@@ -4,26 +4,34 @@ import (
 	"context"
 	"sync"
 
+	"github.com/libp2p/go-libp2p-core/peer"
 	"github.com/pkg/errors"
 	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
 	"github.com/prysmaticlabs/prysm/beacon-chain/blockchain"
+	opfeed "github.com/prysmaticlabs/prysm/beacon-chain/core/feed/operation"
 	statefeed "github.com/prysmaticlabs/prysm/beacon-chain/core/feed/state"
 	"github.com/prysmaticlabs/prysm/beacon-chain/db"
 	"github.com/prysmaticlabs/prysm/beacon-chain/operations/attestations"
+	"github.com/prysmaticlabs/prysm/beacon-chain/operations/slashings"
+	"github.com/prysmaticlabs/prysm/beacon-chain/operations/voluntaryexits"
 	"github.com/prysmaticlabs/prysm/beacon-chain/p2p"
 	"github.com/prysmaticlabs/prysm/shared"
+	"github.com/prysmaticlabs/prysm/shared/runutil"
 )
 
 var _ = shared.Service(&Service{})
 
 // Config to set up the regular sync service.
 type Config struct {
-	P2P           p2p.P2P
-	DB            db.Database
-	AttPool       attestations.Pool
-	Chain         blockchainService
-	InitialSync   Checker
-	StateNotifier statefeed.Notifier
+	P2P               p2p.P2P
+	DB                db.Database
+	AttPool           attestations.Pool
+	ExitPool          *voluntaryexits.Pool
+	SlashingPool      *slashings.Pool
+	Chain             blockchainService
+	InitialSync       Checker
+	StateNotifier     statefeed.Notifier
+	OperationNotifier opfeed.Notifier
 }
 
 // This defines the interface for interacting with block chain service
@@ -45,11 +53,16 @@ func NewRegularSync(cfg *Config) *Service {
 		db:                  cfg.DB,
 		p2p:                 cfg.P2P,
 		attPool:             cfg.AttPool,
+		exitPool:            cfg.ExitPool,
+		slashingPool:        cfg.SlashingPool,
 		chain:               cfg.Chain,
 		initialSync:         cfg.InitialSync,
 		slotToPendingBlocks: make(map[uint64]*ethpb.SignedBeaconBlock),
 		seenPendingBlocks:   make(map[[32]byte]bool),
 		stateNotifier:       cfg.StateNotifier,
+		operationNotifier:   cfg.OperationNotifier,
+		rateLimiter:         newRateLimiter(defaultRateLimits),
+		pingFailures:        make(map[peer.ID]int),
 	}
 
 	r.registerRPCHandlers()
@@ -66,6 +79,8 @@ type Service struct {
 	p2p                 p2p.P2P
 	db                  db.Database
 	attPool             attestations.Pool
+	exitPool            *voluntaryexits.Pool
+	slashingPool        *slashings.Pool
 	chain               blockchainService
 	slotToPendingBlocks map[uint64]*ethpb.SignedBeaconBlock
 	seenPendingBlocks   map[[32]byte]bool
@@ -74,6 +89,10 @@ type Service struct {
 	initialSync         Checker
 	validateBlockLock   sync.RWMutex
 	stateNotifier       statefeed.Notifier
+	operationNotifier   opfeed.Notifier
+	rateLimiter         *rateLimiter
+	pingFailures        map[peer.ID]int
+	pingFailuresLock    sync.Mutex
 }
 
 // Start the regular sync service.
@@ -82,6 +101,8 @@ func (r *Service) Start() {
 	r.p2p.AddDisconnectionHandler(r.removeDisconnectedPeerStatus)
 	r.processPendingBlocksQueue()
 	r.maintainPeerStatuses()
+	r.maintainPeerPings()
+	runutil.RunEvery(r.ctx, rateLimitPrunePeriod, r.rateLimiter.prune)
 }
 
 // Stop the regular sync service.
@@ -9,11 +9,15 @@ import (
 	"github.com/gogo/protobuf/proto"
 	"github.com/libp2p/go-libp2p-core/peer"
 	pubsub "github.com/libp2p/go-libp2p-pubsub"
+	"github.com/prysmaticlabs/prysm/beacon-chain/cache"
 	"github.com/prysmaticlabs/prysm/beacon-chain/core/feed"
 	statefeed "github.com/prysmaticlabs/prysm/beacon-chain/core/feed/state"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
 	"github.com/prysmaticlabs/prysm/beacon-chain/p2p"
 	"github.com/prysmaticlabs/prysm/shared/messagehandler"
+	"github.com/prysmaticlabs/prysm/shared/params"
 	"github.com/prysmaticlabs/prysm/shared/roughtime"
+	"github.com/prysmaticlabs/prysm/shared/slotutil"
 	"github.com/prysmaticlabs/prysm/shared/traceutil"
 	"go.opencensus.io/trace"
 )
@@ -87,9 +91,8 @@ func (r *Service) registerSubscribers() {
 		r.validateAttesterSlashing,
 		r.attesterSlashingSubscriber,
 	)
-	r.subscribeDynamic(
+	r.subscribeDynamicWithSubnets(
 		"/eth2/committee_index%d_beacon_attestation",
-		r.currentCommitteeIndex,                     /* determineSubsLen */
 		r.validateCommitteeIndexBeaconAttestation,   /* validator */
 		r.committeeIndexBeaconAttestationSubscriber, /* message handler */
 	)
@@ -189,44 +192,83 @@ func wrapAndReportValidation(topic string, v pubsub.Validator) (string, pubsub.V
 	}
 }
 
-// subscribe to a dynamically increasing index of topics. This method expects a fmt compatible
-// string for the topic name and a maxID to represent the number of subscribed topics that should be
-// maintained. As the state feed emits a newly updated state, the maxID function will be called to
-// determine the appropriate number of topics. This method supports only sequential number ranges
-// for topics.
-func (r *Service) subscribeDynamic(topicFormat string, determineSubsLen func() int, validate pubsub.Validator, handle subHandler) {
+// subscribe to the attestation subnets needed by the validators attached to the node. This method
+// expects a fmt compatible string for the topic name, formatted with the subnet. Every slot, the
+// node subscribes to the long-lived subnets of the attached validators and to the subnets they
+// attest on during the current and next slots, and unsubscribes from the subnets which are no
+// longer needed. The long-lived subnets are advertised to peers in the ENR of the node.
+func (r *Service) subscribeDynamicWithSubnets(topicFormat string, validate pubsub.Validator, handle subHandler) {
 	base := p2p.GossipTopicMappings[topicFormat]
 	if base == nil {
 		panic(fmt.Sprintf("%s is not mapped to any message in GossipTopicMappings", topicFormat))
 	}
 
-	var subscriptions []*pubsub.Subscription
+	subscriptions := make(map[uint64]*pubsub.Subscription)
+	updateSubscriptions := func(slot uint64) {
+		persistent := cache.SubnetIDs.AllPersistentSubnetIDs(helpers.SlotToEpoch(slot))
+		r.p2p.AdvertiseAttestationSubnets(persistent)
+
+		wanted := make(map[uint64]bool)
+		for _, subnet := range persistent {
+			wanted[subnet] = true
+		}
+		for _, subnet := range cache.SubnetIDs.AttesterSubnetIDs(slot) {
+			wanted[subnet] = true
+		}
+		// Subscribe a slot ahead so that the mesh of the subnet is formed when attesting.
+		for _, subnet := range cache.SubnetIDs.AttesterSubnetIDs(slot + 1) {
+			wanted[subnet] = true
+		}
+
+		for subnet, sub := range subscriptions {
+			if wanted[subnet] {
+				continue
+			}
+			sub.Cancel()
+			topic := fmt.Sprintf(topicFormat, subnet) + r.p2p.Encoding().ProtocolSuffix()
+			if err := r.p2p.PubSub().UnregisterTopicValidator(topic); err != nil {
+				log.WithError(err).WithField("topic", topic).Error("Failed to unregister validator")
+			}
+			delete(subscriptions, subnet)
+		}
+		for subnet := range wanted {
+			if _, ok := subscriptions[subnet]; !ok {
+				subscriptions[subnet] = r.subscribeWithBase(base, fmt.Sprintf(topicFormat, subnet), validate, handle)
+			}
+		}
+		attestationSubnetsSubscribed.Set(float64(len(subscriptions)))
+	}
 
 	stateChannel := make(chan *feed.Event, 1)
 	stateSub := r.stateNotifier.StateFeed().Subscribe(stateChannel)
 	go func() {
-		for {
+		defer stateSub.Unsubscribe()
+		// Wait until the genesis time is known to follow the slots.
+		var genesis time.Time
+		for genesis.IsZero() {
 			select {
 			case <-r.ctx.Done():
-				stateSub.Unsubscribe()
 				return
-			case <-stateChannel:
-				// Update topic count.
-				wantedSubs := determineSubsLen()
-				// Resize as appropriate.
-				if len(subscriptions) > wantedSubs { // Reduce topics
-					var cancelSubs []*pubsub.Subscription
-					subscriptions, cancelSubs = subscriptions[:wantedSubs-1], subscriptions[wantedSubs:]
-					for i, sub := range cancelSubs {
-						sub.Cancel()
-						r.p2p.PubSub().UnregisterTopicValidator(fmt.Sprintf(topicFormat, i+wantedSubs))
-					}
-				} else if len(subscriptions) < wantedSubs { // Increase topics
-					for i := len(subscriptions); i < wantedSubs; i++ {
-						sub := r.subscribeWithBase(base, fmt.Sprintf(topicFormat, i), validate, handle)
-						subscriptions = append(subscriptions, sub)
-					}
+			case event := <-stateChannel:
+				if event.Type == statefeed.Initialized {
+					genesis = event.Data.(*statefeed.InitializedData).StartTime
 				}
+			case err := <-stateSub.Err():
+				log.WithError(err).Error("Subscription to state notifier failed")
+				return
+			}
+		}
+		stateSub.Unsubscribe()
+
+		updateSubscriptions(helpers.SlotsSince(genesis))
+		ticker := slotutil.GetSlotTicker(genesis, params.BeaconConfig().SecondsPerSlot)
+		defer ticker.Done()
+		for {
+			select {
+			case <-r.ctx.Done():
+				return
+			case slot := <-ticker.C():
+				updateSubscriptions(slot)
 			}
 		}
 	}()
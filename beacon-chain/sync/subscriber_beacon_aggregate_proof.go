@@ -6,6 +6,8 @@ import (
 
 	"github.com/gogo/protobuf/proto"
 	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/feed"
+	opfeed "github.com/prysmaticlabs/prysm/beacon-chain/core/feed/operation"
 )
 
 // beaconAggregateProofSubscriber forwards the incoming validated aggregated attestation and proof to the
@@ -16,5 +18,11 @@ func (r *Service) beaconAggregateProofSubscriber(ctx context.Context, msg proto.
 		return fmt.Errorf("message was not type *eth.AggregateAttestationAndProof, type=%T", msg)
 	}
 
+	r.sendOperationEvent(&feed.Event{
+		Type: opfeed.AggregatedAttReceived,
+		Data: &opfeed.AggregatedAttReceivedData{
+			Attestation: a,
+		},
+	})
 	return r.attPool.SaveAggregatedAttestation(a.Aggregate)
 }
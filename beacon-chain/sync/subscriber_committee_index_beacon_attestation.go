@@ -6,7 +6,8 @@ import (
 
 	"github.com/gogo/protobuf/proto"
 	eth "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
-	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/feed"
+	opfeed "github.com/prysmaticlabs/prysm/beacon-chain/core/feed/operation"
 )
 
 func (r *Service) committeeIndexBeaconAttestationSubscriber(ctx context.Context, msg proto.Message) error {
@@ -14,13 +15,11 @@ func (r *Service) committeeIndexBeaconAttestationSubscriber(ctx context.Context,
 	if !ok {
 		return fmt.Errorf("message was not type *eth.Attestation, type=%T", msg)
 	}
+	r.sendOperationEvent(&feed.Event{
+		Type: opfeed.UnaggregatedAttReceived,
+		Data: &opfeed.UnAggregatedAttReceivedData{
+			Attestation: a,
+		},
+	})
 	return r.attPool.SaveUnaggregatedAttestation(a)
 }
-
-func (r *Service) currentCommitteeIndex() int {
-	activeValidatorIndices, err := r.chain.HeadValidatorsIndices(helpers.SlotToEpoch(r.chain.HeadSlot()))
-	if err != nil {
-		panic(err)
-	}
-	return int(helpers.SlotCommitteeCount(uint64(len(activeValidatorIndices))))
-}
@@ -9,6 +9,7 @@ import (
 	"github.com/prysmaticlabs/go-bitfield"
 	"github.com/prysmaticlabs/go-ssz"
 	mock "github.com/prysmaticlabs/prysm/beacon-chain/blockchain/testing"
+	"github.com/prysmaticlabs/prysm/beacon-chain/cache"
 	"github.com/prysmaticlabs/prysm/beacon-chain/core/feed"
 	statefeed "github.com/prysmaticlabs/prysm/beacon-chain/core/feed/state"
 	dbtest "github.com/prysmaticlabs/prysm/beacon-chain/db/testing"
@@ -50,6 +51,8 @@ func TestService_committeeIndexBeaconAttestationSubscriber_ValidMessage(t *testi
 		stateNotifier: (&mock.ChainService{}).StateNotifier(),
 		initialSync:   &mockSync.Sync{IsSyncing: false},
 	}
+	// An attached validator attests on the subnet of committee 0 at slot 0.
+	cache.SubnetIDs.AddAttesterSubnetID(0, 0)
 	r.registerSubscribers()
 	r.stateNotifier.StateFeed().Send(&feed.Event{
 		Type: statefeed.Initialized,
@@ -2,21 +2,80 @@ package sync
 
 import (
 	"context"
+	"errors"
+	"fmt"
 
 	"github.com/gogo/protobuf/proto"
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/feed"
+	opfeed "github.com/prysmaticlabs/prysm/beacon-chain/core/feed/operation"
 )
 
+// voluntaryExitSubscriber forwards the incoming validated voluntary exit to the
+// exit pool for block inclusion.
 func (r *Service) voluntaryExitSubscriber(ctx context.Context, msg proto.Message) error {
-	// TODO(#3259): Requires handlers in operations service to be implemented.
+	ve, ok := msg.(*ethpb.SignedVoluntaryExit)
+	if !ok {
+		return fmt.Errorf("message was not type *eth.SignedVoluntaryExit, type=%T", msg)
+	}
+	if ve.Exit == nil {
+		return errors.New("exit can't be nil")
+	}
+
+	s, err := r.chain.HeadState(ctx)
+	if err != nil {
+		return err
+	}
+	r.sendOperationEvent(&feed.Event{
+		Type: opfeed.ExitReceived,
+		Data: &opfeed.ExitReceivedData{
+			Exit: ve,
+		},
+	})
+	r.exitPool.InsertVoluntaryExit(ctx, s, ve)
 	return nil
 }
 
+// attesterSlashingSubscriber forwards the incoming validated attester slashing to the
+// slashing pool for block inclusion.
 func (r *Service) attesterSlashingSubscriber(ctx context.Context, msg proto.Message) error {
-	// TODO(#3259): Requires handlers in operations service to be implemented.
-	return nil
+	as, ok := msg.(*ethpb.AttesterSlashing)
+	if !ok {
+		return fmt.Errorf("message was not type *eth.AttesterSlashing, type=%T", msg)
+	}
+	if as == nil || as.Attestation_1 == nil || as.Attestation_2 == nil {
+		return errors.New("attester slashing can't be nil")
+	}
+
+	s, err := r.chain.HeadState(ctx)
+	if err != nil {
+		return err
+	}
+	return r.slashingPool.InsertAttesterSlashing(ctx, s, as)
 }
 
+// proposerSlashingSubscriber forwards the incoming validated proposer slashing to the
+// slashing pool for block inclusion.
 func (r *Service) proposerSlashingSubscriber(ctx context.Context, msg proto.Message) error {
-	// TODO(#3259): Requires handlers in operations service to be implemented.
-	return nil
+	ps, ok := msg.(*ethpb.ProposerSlashing)
+	if !ok {
+		return fmt.Errorf("message was not type *eth.ProposerSlashing, type=%T", msg)
+	}
+	if ps == nil || ps.Header_1 == nil || ps.Header_2 == nil {
+		return errors.New("proposer slashing can't be nil")
+	}
+
+	s, err := r.chain.HeadState(ctx)
+	if err != nil {
+		return err
+	}
+	return r.slashingPool.InsertProposerSlashing(ctx, s, ps)
+}
+
+// sendOperationEvent to the operation feed, if the service was configured with an operation notifier.
+func (r *Service) sendOperationEvent(event *feed.Event) {
+	if r.operationNotifier == nil {
+		return
+	}
+	r.operationNotifier.OperationFeed().Send(event)
 }
@@ -0,0 +1,63 @@
+package sync
+
+import (
+	"context"
+	"testing"
+
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	mock "github.com/prysmaticlabs/prysm/beacon-chain/blockchain/testing"
+	"github.com/prysmaticlabs/prysm/beacon-chain/operations/slashings"
+	"github.com/prysmaticlabs/prysm/beacon-chain/operations/voluntaryexits"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/testutil"
+)
+
+func TestVoluntaryExitSubscriber_InsertsIntoPool(t *testing.T) {
+	beaconState, privKeys := testutil.DeterministicGenesisState(t, 256)
+	beaconState.Slot = 3 + params.BeaconConfig().PersistentCommitteePeriod*params.BeaconConfig().SlotsPerEpoch
+	blk, err := testutil.GenerateFullBlock(beaconState, privKeys, &testutil.BlockGenConfig{NumVoluntaryExits: 1}, beaconState.Slot)
+	if err != nil {
+		t.Fatal(err)
+	}
+	r := &Service{
+		chain:    &mock.ChainService{State: beaconState},
+		exitPool: voluntaryexits.NewPool(),
+	}
+
+	exit := blk.Block.Body.VoluntaryExits[0]
+	if err := r.voluntaryExitSubscriber(context.Background(), exit); err != nil {
+		t.Fatal(err)
+	}
+	pending := r.exitPool.PendingExits(context.Background(), beaconState)
+	if len(pending) != 1 || pending[0] != exit {
+		t.Errorf("Expected exit to be inserted into pool, received %v", pending)
+	}
+}
+
+func TestProposerSlashingSubscriber_InsertsIntoPool(t *testing.T) {
+	beaconState, privKeys := testutil.DeterministicGenesisState(t, 64)
+	blk, err := testutil.GenerateFullBlock(beaconState, privKeys, &testutil.BlockGenConfig{NumProposerSlashings: 1}, beaconState.Slot)
+	if err != nil {
+		t.Fatal(err)
+	}
+	r := &Service{
+		chain:        &mock.ChainService{State: beaconState},
+		slashingPool: slashings.NewPool(),
+	}
+
+	slashing := blk.Block.Body.ProposerSlashings[0]
+	if err := r.proposerSlashingSubscriber(context.Background(), slashing); err != nil {
+		t.Fatal(err)
+	}
+	pending := r.slashingPool.PendingProposerSlashings(context.Background(), beaconState)
+	if len(pending) != 1 || pending[0] != slashing {
+		t.Errorf("Expected slashing to be inserted into pool, received %v", pending)
+	}
+}
+
+func TestAttesterSlashingSubscriber_WrongType(t *testing.T) {
+	r := &Service{slashingPool: slashings.NewPool()}
+	if err := r.attesterSlashingSubscriber(context.Background(), &ethpb.ProposerSlashing{}); err == nil {
+		t.Error("Expected error for wrong message type")
+	}
+}
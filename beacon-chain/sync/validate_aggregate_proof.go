@@ -12,6 +12,7 @@ import (
 	"github.com/prysmaticlabs/prysm/beacon-chain/core/blocks"
 	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
 	"github.com/prysmaticlabs/prysm/beacon-chain/core/state"
+	"github.com/prysmaticlabs/prysm/beacon-chain/p2p/peers"
 	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
 	"github.com/prysmaticlabs/prysm/shared/bls"
 	"github.com/prysmaticlabs/prysm/shared/bytesutil"
@@ -41,6 +42,7 @@ func (r *Service) validateAggregateAndProof(ctx context.Context, pid peer.ID, ms
 	if err != nil {
 		log.WithError(err).Error("Failed to decode message")
 		traceutil.AnnotateError(span, err)
+		r.p2p.Peers().AddScore(pid, peers.ScoreInvalidGossip)
 		return false
 	}
 	m, ok := raw.(*ethpb.AggregateAttestationAndProof)
@@ -88,7 +90,8 @@ func (r *Service) validateAggregateAndProof(ctx context.Context, pid peer.ID, ms
 		}
 	}
 
-	// Verify validator index is within the aggregate's committee.
+	// Verify validator index is within the aggregate's committee. The committee is computed from
+	// our head state, which may differ from the peer's, so a mismatch does not score the peer.
 	if err := validateIndexInCommittee(ctx, s, m.Aggregate, m.AggregatorIndex); err != nil {
 		traceutil.AnnotateError(span, errors.Wrapf(err, "Could not validate index in committee"))
 		return false
@@ -97,10 +100,12 @@ func (r *Service) validateAggregateAndProof(ctx context.Context, pid peer.ID, ms
 	// Verify selection proof reflects to the right validator and signature is valid.
 	if err := validateSelection(ctx, s, m.Aggregate.Data, m.AggregatorIndex, m.SelectionProof); err != nil {
 		traceutil.AnnotateError(span, errors.Wrapf(err, "Could not validate selection for validator %d", m.AggregatorIndex))
+		r.p2p.Peers().AddScore(pid, peers.ScoreInvalidGossip)
 		return false
 	}
 
-	// Verify aggregated attestation has a valid signature.
+	// Verify aggregated attestation has a valid signature. The attestation is checked against our
+	// head state, which may differ from the peer's, so a rejection does not score the peer.
 	if err := blocks.VerifyAttestation(ctx, s, m.Aggregate); err != nil {
 		traceutil.AnnotateError(span, err)
 		return false
@@ -11,6 +11,7 @@ import (
 	eth "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
 	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
 	"github.com/prysmaticlabs/prysm/beacon-chain/p2p"
+	"github.com/prysmaticlabs/prysm/beacon-chain/p2p/peers"
 	"github.com/prysmaticlabs/prysm/shared/bls"
 	"github.com/prysmaticlabs/prysm/shared/bytesutil"
 	"github.com/prysmaticlabs/prysm/shared/params"
@@ -45,6 +46,7 @@ func (s *Service) validateCommitteeIndexBeaconAttestation(ctx context.Context, p
 	if err != nil {
 		log.WithError(err).Error("Failed to decode message")
 		traceutil.AnnotateError(span, err)
+		s.p2p.Peers().AddScore(pid, peers.ScoreInvalidGossip)
 		return false
 	}
 	// Restore topic.
@@ -56,12 +58,14 @@ func (s *Service) validateCommitteeIndexBeaconAttestation(ctx context.Context, p
 	}
 
 	// The attestation's committee index (attestation.data.index) is for the correct subnet.
-	if !strings.HasPrefix(originalTopic, fmt.Sprintf(format, att.Data.CommitteeIndex)) {
+	if !strings.HasPrefix(originalTopic, fmt.Sprintf(format, p2p.AttestationSubnet(att.Data.CommitteeIndex))) {
+		s.p2p.Peers().AddScore(pid, peers.ScoreInvalidGossip)
 		return false
 	}
 
 	// Attestation must be unaggregated.
 	if att.AggregationBits == nil || att.AggregationBits.Count() != 1 {
+		s.p2p.Peers().AddScore(pid, peers.ScoreInvalidGossip)
 		return false
 	}
 
@@ -85,6 +89,7 @@ func (s *Service) validateCommitteeIndexBeaconAttestation(ctx context.Context, p
 
 	// Attestation's signature is a valid BLS signature.
 	if _, err := bls.SignatureFromBytes(att.Signature); err != nil {
+		s.p2p.Peers().AddScore(pid, peers.ScoreInvalidGossip)
 		return false
 	}
 
@@ -8,6 +8,7 @@ import (
 	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
 	"github.com/prysmaticlabs/prysm/beacon-chain/core/blocks"
 	"github.com/prysmaticlabs/prysm/beacon-chain/core/state"
+	"github.com/prysmaticlabs/prysm/beacon-chain/p2p/peers"
 	"github.com/prysmaticlabs/prysm/shared/traceutil"
 	"go.opencensus.io/trace"
 )
@@ -33,6 +34,7 @@ func (r *Service) validateProposerSlashing(ctx context.Context, pid peer.ID, msg
 	if err != nil {
 		log.WithError(err).Error("Failed to decode message")
 		traceutil.AnnotateError(span, err)
+		r.p2p.Peers().AddScore(pid, peers.ScoreInvalidGossip)
 		return false
 	}
 
@@ -58,6 +60,8 @@ func (r *Service) validateProposerSlashing(ctx context.Context, pid peer.ID, msg
 		}
 	}
 
+	// The proposer may have just been slashed in a block, so a rejection against the head state
+	// does not score the relaying peer.
 	if err := blocks.VerifyProposerSlashing(s, slashing); err != nil {
 		return false
 	}
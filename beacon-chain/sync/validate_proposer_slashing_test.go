@@ -8,6 +8,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/libp2p/go-libp2p-core/network"
 	pubsub "github.com/libp2p/go-libp2p-pubsub"
 	pubsubpb "github.com/libp2p/go-libp2p-pubsub/pb"
 	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
@@ -206,3 +207,43 @@ func TestValidateProposerSlashing_Syncing(t *testing.T) {
 		t.Error("Did not fail validation")
 	}
 }
+
+func TestValidateProposerSlashing_AlreadySlashedDoesNotScorePeer(t *testing.T) {
+	p := p2ptest.NewTestP2P(t)
+	ctx := context.Background()
+	pid := p2ptest.NewTestP2P(t).PeerID()
+	p.Peers().Add(pid, nil, network.DirInbound)
+
+	slashing, s := setupValidProposerSlashing(t)
+	// The slashing was already processed in a block the relaying peer had not seen yet.
+	s.Validators[slashing.ProposerIndex].Slashed = true
+
+	r := &Service{
+		p2p:         p,
+		chain:       &mock.ChainService{State: s},
+		initialSync: &mockSync.Sync{IsSyncing: false},
+	}
+
+	buf := new(bytes.Buffer)
+	if _, err := p.Encoding().Encode(buf, slashing); err != nil {
+		t.Fatal(err)
+	}
+	m := &pubsub.Message{
+		Message: &pubsubpb.Message{
+			Data: buf.Bytes(),
+			TopicIDs: []string{
+				p2p.GossipTypeMapping[reflect.TypeOf(slashing)],
+			},
+		},
+	}
+	if r.validateProposerSlashing(ctx, pid, m) {
+		t.Error("Expected a slashing of an already slashed proposer to fail validation")
+	}
+	score, err := p.Peers().Score(pid)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if score != 0 {
+		t.Errorf("Expected the relaying peer not to be scored, got score %d", score)
+	}
+}
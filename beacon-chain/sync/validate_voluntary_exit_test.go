@@ -7,6 +7,7 @@ import (
 	"reflect"
 	"testing"
 
+	"github.com/libp2p/go-libp2p-core/network"
 	pubsub "github.com/libp2p/go-libp2p-pubsub"
 	pubsubpb "github.com/libp2p/go-libp2p-pubsub/pb"
 	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
@@ -128,3 +129,45 @@ func TestValidateVoluntaryExit_ValidExit_Syncing(t *testing.T) {
 		t.Error("Validation should have failed")
 	}
 }
+
+func TestValidateVoluntaryExit_IncludedExitDoesNotScorePeer(t *testing.T) {
+	p := p2ptest.NewTestP2P(t)
+	ctx := context.Background()
+	pid := p2ptest.NewTestP2P(t).PeerID()
+	p.Peers().Add(pid, nil, network.DirInbound)
+
+	exit, s := setupValidExit(t)
+	// The exit was already processed in a block the relaying peer had not seen yet.
+	s.Validators[exit.Exit.ValidatorIndex].ExitEpoch = helpers.CurrentEpoch(s) + 1
+
+	r := &Service{
+		p2p: p,
+		chain: &mock.ChainService{
+			State: s,
+		},
+		initialSync: &mockSync.Sync{IsSyncing: false},
+	}
+
+	buf := new(bytes.Buffer)
+	if _, err := p.Encoding().Encode(buf, exit); err != nil {
+		t.Fatal(err)
+	}
+	m := &pubsub.Message{
+		Message: &pubsubpb.Message{
+			Data: buf.Bytes(),
+			TopicIDs: []string{
+				p2p.GossipTypeMapping[reflect.TypeOf(exit)],
+			},
+		},
+	}
+	if r.validateVoluntaryExit(ctx, pid, m) {
+		t.Error("Expected an already included exit to fail validation")
+	}
+	score, err := p.Peers().Score(pid)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if score != 0 {
+		t.Errorf("Expected the relaying peer not to be scored, got score %d", score)
+	}
+}
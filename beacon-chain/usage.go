@@ -95,10 +95,16 @@ var appHelpFlagGroups = []flagGroup{
 			cmd.P2PMaxPeers,
 			cmd.P2PPrivKey,
 			cmd.P2PWhitelist,
+			cmd.P2PPeerDisconnectScore,
+			cmd.P2PPeerBanScore,
+			cmd.P2PPeerBanDuration,
 			cmd.StaticPeers,
 			cmd.EnableUPnPFlag,
 			cmd.P2PEncoding,
 			flags.MinSyncPeers,
+			flags.SlotsPerArchivedPoint,
+			flags.BackupEpochsFlag,
+			flags.BackupRetentionFlag,
 		},
 	},
 	{
@@ -127,6 +133,17 @@ var appHelpFlagGroups = []flagGroup{
 			flags.ArchiveValidatorSetChangesFlag,
 			flags.ArchiveBlocksFlag,
 			flags.ArchiveAttestationsFlag,
+			flags.ArchiveBackfillFlag,
+			flags.ArchiveBackfillDelayFlag,
+		},
+	},
+	{
+		Name: "checkpoint",
+		Flags: []cli.Flag{
+			flags.CheckpointStateFlag,
+			flags.CheckpointBlockFlag,
+			flags.CheckpointNodeFlag,
+			flags.CheckpointBackfillFlag,
 		},
 	},
 }
@@ -7,6 +7,7 @@ import (
 	fmt "fmt"
 	io "io"
 	math "math"
+	math_bits "math/bits"
 
 	_ "github.com/gogo/protobuf/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
@@ -22,7 +23,7 @@ var _ = math.Inf
 // is compatible with the proto package it is being compiled against.
 // A compilation error at this line likely means your copy of the
 // proto package needs to be updated.
-const _ = proto.GoGoProtoPackageIsVersion2 // please upgrade the proto package
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
 type ArchivedActiveSetChanges struct {
 	Activated            []uint64                     `protobuf:"varint,1,rep,packed,name=activated,proto3" json:"activated,omitempty"`
@@ -50,7 +51,7 @@ func (m *ArchivedActiveSetChanges) XXX_Marshal(b []byte, deterministic bool) ([]
 		return xxx_messageInfo_ArchivedActiveSetChanges.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
-		n, err := m.MarshalTo(b)
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
 			return nil, err
 		}
@@ -133,7 +134,7 @@ func (m *ArchivedCommitteeInfo) XXX_Marshal(b []byte, deterministic bool) ([]byt
 		return xxx_messageInfo_ArchivedCommitteeInfo.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
-		n, err := m.MarshalTo(b)
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
 			return nil, err
 		}
@@ -166,46 +167,286 @@ func (m *ArchivedCommitteeInfo) GetAttesterSeed() []byte {
 	return nil
 }
 
+type ArchivedValidatorRewards struct {
+	Rewards              []*ValidatorRewards `protobuf:"bytes,1,rep,name=rewards,proto3" json:"rewards,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}            `json:"-"`
+	XXX_unrecognized     []byte              `json:"-"`
+	XXX_sizecache        int32               `json:"-"`
+}
+
+func (m *ArchivedValidatorRewards) Reset()         { *m = ArchivedValidatorRewards{} }
+func (m *ArchivedValidatorRewards) String() string { return proto.CompactTextString(m) }
+func (*ArchivedValidatorRewards) ProtoMessage()    {}
+func (*ArchivedValidatorRewards) Descriptor() ([]byte, []int) {
+	return fileDescriptor_289929478e9672a3, []int{2}
+}
+func (m *ArchivedValidatorRewards) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ArchivedValidatorRewards) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ArchivedValidatorRewards.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ArchivedValidatorRewards) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ArchivedValidatorRewards.Merge(m, src)
+}
+func (m *ArchivedValidatorRewards) XXX_Size() int {
+	return m.Size()
+}
+func (m *ArchivedValidatorRewards) XXX_DiscardUnknown() {
+	xxx_messageInfo_ArchivedValidatorRewards.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ArchivedValidatorRewards proto.InternalMessageInfo
+
+func (m *ArchivedValidatorRewards) GetRewards() []*ValidatorRewards {
+	if m != nil {
+		return m.Rewards
+	}
+	return nil
+}
+
+type ValidatorRewards struct {
+	SourceReward         uint64   `protobuf:"varint,1,opt,name=source_reward,json=sourceReward,proto3" json:"source_reward,omitempty"`
+	TargetReward         uint64   `protobuf:"varint,2,opt,name=target_reward,json=targetReward,proto3" json:"target_reward,omitempty"`
+	HeadReward           uint64   `protobuf:"varint,3,opt,name=head_reward,json=headReward,proto3" json:"head_reward,omitempty"`
+	InclusionDelayReward uint64   `protobuf:"varint,4,opt,name=inclusion_delay_reward,json=inclusionDelayReward,proto3" json:"inclusion_delay_reward,omitempty"`
+	ProposerReward       uint64   `protobuf:"varint,5,opt,name=proposer_reward,json=proposerReward,proto3" json:"proposer_reward,omitempty"`
+	SourcePenalty        uint64   `protobuf:"varint,6,opt,name=source_penalty,json=sourcePenalty,proto3" json:"source_penalty,omitempty"`
+	TargetPenalty        uint64   `protobuf:"varint,7,opt,name=target_penalty,json=targetPenalty,proto3" json:"target_penalty,omitempty"`
+	HeadPenalty          uint64   `protobuf:"varint,8,opt,name=head_penalty,json=headPenalty,proto3" json:"head_penalty,omitempty"`
+	InactivityPenalty    uint64   `protobuf:"varint,9,opt,name=inactivity_penalty,json=inactivityPenalty,proto3" json:"inactivity_penalty,omitempty"`
+	SlashingPenalty      uint64   `protobuf:"varint,10,opt,name=slashing_penalty,json=slashingPenalty,proto3" json:"slashing_penalty,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ValidatorRewards) Reset()         { *m = ValidatorRewards{} }
+func (m *ValidatorRewards) String() string { return proto.CompactTextString(m) }
+func (*ValidatorRewards) ProtoMessage()    {}
+func (*ValidatorRewards) Descriptor() ([]byte, []int) {
+	return fileDescriptor_289929478e9672a3, []int{3}
+}
+func (m *ValidatorRewards) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ValidatorRewards) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ValidatorRewards.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ValidatorRewards) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ValidatorRewards.Merge(m, src)
+}
+func (m *ValidatorRewards) XXX_Size() int {
+	return m.Size()
+}
+func (m *ValidatorRewards) XXX_DiscardUnknown() {
+	xxx_messageInfo_ValidatorRewards.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ValidatorRewards proto.InternalMessageInfo
+
+func (m *ValidatorRewards) GetSourceReward() uint64 {
+	if m != nil {
+		return m.SourceReward
+	}
+	return 0
+}
+
+func (m *ValidatorRewards) GetTargetReward() uint64 {
+	if m != nil {
+		return m.TargetReward
+	}
+	return 0
+}
+
+func (m *ValidatorRewards) GetHeadReward() uint64 {
+	if m != nil {
+		return m.HeadReward
+	}
+	return 0
+}
+
+func (m *ValidatorRewards) GetInclusionDelayReward() uint64 {
+	if m != nil {
+		return m.InclusionDelayReward
+	}
+	return 0
+}
+
+func (m *ValidatorRewards) GetProposerReward() uint64 {
+	if m != nil {
+		return m.ProposerReward
+	}
+	return 0
+}
+
+func (m *ValidatorRewards) GetSourcePenalty() uint64 {
+	if m != nil {
+		return m.SourcePenalty
+	}
+	return 0
+}
+
+func (m *ValidatorRewards) GetTargetPenalty() uint64 {
+	if m != nil {
+		return m.TargetPenalty
+	}
+	return 0
+}
+
+func (m *ValidatorRewards) GetHeadPenalty() uint64 {
+	if m != nil {
+		return m.HeadPenalty
+	}
+	return 0
+}
+
+func (m *ValidatorRewards) GetInactivityPenalty() uint64 {
+	if m != nil {
+		return m.InactivityPenalty
+	}
+	return 0
+}
+
+func (m *ValidatorRewards) GetSlashingPenalty() uint64 {
+	if m != nil {
+		return m.SlashingPenalty
+	}
+	return 0
+}
+
+type ArchivedBalances struct {
+	Epoch                uint64   `protobuf:"varint,1,opt,name=epoch,proto3" json:"epoch,omitempty"`
+	Balances             []uint64 `protobuf:"varint,2,rep,packed,name=balances,proto3" json:"balances,omitempty" ssz-max:"1099511627776"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ArchivedBalances) Reset()         { *m = ArchivedBalances{} }
+func (m *ArchivedBalances) String() string { return proto.CompactTextString(m) }
+func (*ArchivedBalances) ProtoMessage()    {}
+func (*ArchivedBalances) Descriptor() ([]byte, []int) {
+	return fileDescriptor_289929478e9672a3, []int{4}
+}
+func (m *ArchivedBalances) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ArchivedBalances) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ArchivedBalances.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ArchivedBalances) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ArchivedBalances.Merge(m, src)
+}
+func (m *ArchivedBalances) XXX_Size() int {
+	return m.Size()
+}
+func (m *ArchivedBalances) XXX_DiscardUnknown() {
+	xxx_messageInfo_ArchivedBalances.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ArchivedBalances proto.InternalMessageInfo
+
+func (m *ArchivedBalances) GetEpoch() uint64 {
+	if m != nil {
+		return m.Epoch
+	}
+	return 0
+}
+
+func (m *ArchivedBalances) GetBalances() []uint64 {
+	if m != nil {
+		return m.Balances
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*ArchivedActiveSetChanges)(nil), "ethereum.beacon.p2p.v1.ArchivedActiveSetChanges")
 	proto.RegisterType((*ArchivedCommitteeInfo)(nil), "ethereum.beacon.p2p.v1.ArchivedCommitteeInfo")
+	proto.RegisterType((*ArchivedValidatorRewards)(nil), "ethereum.beacon.p2p.v1.ArchivedValidatorRewards")
+	proto.RegisterType((*ValidatorRewards)(nil), "ethereum.beacon.p2p.v1.ValidatorRewards")
+	proto.RegisterType((*ArchivedBalances)(nil), "ethereum.beacon.p2p.v1.ArchivedBalances")
 }
 
 func init() { proto.RegisterFile("proto/beacon/p2p/v1/archive.proto", fileDescriptor_289929478e9672a3) }
 
 var fileDescriptor_289929478e9672a3 = []byte{
-	// 396 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x92, 0x41, 0x8b, 0xd3, 0x40,
-	0x14, 0xc7, 0xc9, 0xee, 0xd2, 0xd5, 0xb1, 0xab, 0xec, 0x80, 0x4b, 0x58, 0xa4, 0x5b, 0x83, 0x60,
-	0x2f, 0x9d, 0x90, 0x14, 0x3c, 0x78, 0x6b, 0x8b, 0x07, 0x0f, 0x82, 0xa4, 0xd0, 0x6b, 0x99, 0x24,
-	0xaf, 0x99, 0xc1, 0x24, 0x33, 0x64, 0x26, 0xa1, 0xf6, 0x0b, 0xf8, 0xd5, 0x3c, 0xfa, 0x09, 0x44,
-	0x7a, 0xf3, 0xea, 0x27, 0x90, 0x4c, 0x92, 0x46, 0x0b, 0xdd, 0x5b, 0xde, 0x7b, 0xff, 0xff, 0xef,
-	0xfd, 0x67, 0x32, 0xe8, 0xb5, 0x2c, 0x84, 0x16, 0x6e, 0x08, 0x34, 0x12, 0xb9, 0x2b, 0x7d, 0xe9,
-	0x56, 0x9e, 0x4b, 0x8b, 0x88, 0xf1, 0x0a, 0x88, 0x99, 0xe1, 0x3b, 0xd0, 0x0c, 0x0a, 0x28, 0x33,
-	0xd2, 0xa8, 0x88, 0xf4, 0x25, 0xa9, 0xbc, 0xfb, 0x69, 0xc2, 0x35, 0x2b, 0x43, 0x12, 0x89, 0xcc,
-	0x4d, 0x44, 0x22, 0x5c, 0x23, 0x0f, 0xcb, 0xad, 0xa9, 0x1a, 0x6e, 0xfd, 0xd5, 0x60, 0xee, 0x1f,
-	0x40, 0x33, 0xb7, 0xf2, 0x68, 0x2a, 0x19, 0xf5, 0xda, 0x85, 0x9b, 0x30, 0x15, 0xd1, 0x97, 0x46,
-	0xe0, 0xfc, 0xbe, 0x40, 0xf6, 0xbc, 0xd9, 0x1c, 0xcf, 0x23, 0xcd, 0x2b, 0x58, 0x81, 0x5e, 0x32,
-	0x9a, 0x27, 0xa0, 0xf0, 0x2b, 0xf4, 0x94, 0xd6, 0x3d, 0xaa, 0x21, 0xb6, 0xad, 0xf1, 0xe5, 0xe4,
-	0x2a, 0xe8, 0x1b, 0xf8, 0x0e, 0x0d, 0x60, 0xc7, 0xeb, 0xd1, 0x85, 0x19, 0xb5, 0x15, 0xb6, 0xd1,
-	0xb5, 0x4a, 0xa9, 0x62, 0x10, 0xdb, 0x57, 0x66, 0xd0, 0x95, 0xf8, 0x13, 0x7a, 0x51, 0x89, 0xb4,
-	0xcc, 0x35, 0x2d, 0xbe, 0x6e, 0x6a, 0xb5, 0xb2, 0x07, 0xe3, 0xcb, 0xc9, 0x33, 0xff, 0x0d, 0x39,
-	0x1e, 0x17, 0x34, 0x23, 0x5d, 0x60, 0xb2, 0xee, 0xd4, 0x1f, 0x76, 0x5c, 0x07, 0xcf, 0xab, 0x7f,
-	0x4b, 0x85, 0xd7, 0x08, 0xcb, 0x42, 0x48, 0xa1, 0xa0, 0xd8, 0x98, 0x15, 0x3c, 0x4f, 0x94, 0x7d,
-	0x6d, 0x88, 0x6f, 0xcf, 0x10, 0x3f, 0xb7, 0x86, 0x55, 0xab, 0x0f, 0x6e, 0xe5, 0x49, 0xc7, 0x70,
-	0xa9, 0xd6, 0xa0, 0xf4, 0x7f, 0xdc, 0x27, 0x8f, 0x72, 0xe7, 0xad, 0xa1, 0xe7, 0xd2, 0x93, 0x8e,
-	0x72, 0xbe, 0x59, 0xe8, 0x65, 0x77, 0xd7, 0x4b, 0x91, 0x65, 0x5c, 0x6b, 0x80, 0x8f, 0xf9, 0x56,
-	0xe0, 0x77, 0xe8, 0xa6, 0x3f, 0x09, 0x98, 0xcb, 0xb6, 0x26, 0xc3, 0xc5, 0xed, 0x9f, 0x9f, 0x0f,
-	0x37, 0x4a, 0xed, 0xa7, 0x8a, 0xef, 0xe1, 0xbd, 0x33, 0xf3, 0x9d, 0x60, 0x78, 0x8c, 0x0b, 0x10,
-	0xd7, 0xbe, 0x3e, 0x29, 0x98, 0x3f, 0x71, 0xce, 0x77, 0x8c, 0x03, 0x10, 0x2f, 0x86, 0xdf, 0x0f,
-	0x23, 0xeb, 0xc7, 0x61, 0x64, 0xfd, 0x3a, 0x8c, 0xac, 0x70, 0x60, 0x9e, 0xc2, 0xec, 0x6f, 0x00,
-	0x00, 0x00, 0xff, 0xff, 0x33, 0xde, 0x85, 0x08, 0x97, 0x02, 0x00, 0x00,
+	// 643 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x94, 0xcd, 0x4e, 0xdb, 0x40,
+	0x10, 0xc7, 0x15, 0x12, 0x12, 0x58, 0xc2, 0xd7, 0x8a, 0x52, 0x8b, 0x56, 0x04, 0xdc, 0x56, 0xa4,
+	0x07, 0xec, 0x3a, 0xb4, 0x44, 0x70, 0x23, 0xb4, 0x87, 0x1e, 0x2a, 0x21, 0x23, 0x71, 0x6c, 0xb4,
+	0xb1, 0x87, 0x78, 0x55, 0xc7, 0x6b, 0x79, 0x37, 0x2e, 0xe1, 0x05, 0xfa, 0x28, 0x7d, 0x95, 0x1e,
+	0xfb, 0x04, 0xa8, 0xe2, 0xd6, 0x2b, 0x4f, 0x50, 0x79, 0x3f, 0xec, 0x96, 0x96, 0xde, 0x3c, 0xff,
+	0xf9, 0xcd, 0x7f, 0x77, 0x3c, 0x63, 0xa3, 0xdd, 0x34, 0x63, 0x82, 0xb9, 0x23, 0x20, 0x01, 0x4b,
+	0xdc, 0xb4, 0x97, 0xba, 0xb9, 0xe7, 0x92, 0x2c, 0x88, 0x68, 0x0e, 0x8e, 0xcc, 0xe1, 0x4d, 0x10,
+	0x11, 0x64, 0x30, 0x9d, 0x38, 0x8a, 0x72, 0xd2, 0x5e, 0xea, 0xe4, 0xde, 0xd6, 0xfe, 0x98, 0x8a,
+	0x68, 0x3a, 0x72, 0x02, 0x36, 0x71, 0xc7, 0x6c, 0xcc, 0x5c, 0x89, 0x8f, 0xa6, 0x97, 0x32, 0x52,
+	0xbe, 0xc5, 0x93, 0xb2, 0xd9, 0xea, 0x80, 0x88, 0xdc, 0xdc, 0x23, 0x71, 0x1a, 0x11, 0x4f, 0x1f,
+	0x38, 0x1c, 0xc5, 0x2c, 0xf8, 0xa4, 0x00, 0xfb, 0xe7, 0x1c, 0xb2, 0x4e, 0xd4, 0xc9, 0xe1, 0x49,
+	0x20, 0x68, 0x0e, 0xe7, 0x20, 0x4e, 0x23, 0x92, 0x8c, 0x81, 0xe3, 0xa7, 0x68, 0x91, 0x14, 0x1a,
+	0x11, 0x10, 0x5a, 0xb5, 0x9d, 0x7a, 0xb7, 0xe1, 0x57, 0x02, 0xde, 0x44, 0x4d, 0xb8, 0xa2, 0x45,
+	0x6a, 0x4e, 0xa6, 0x74, 0x84, 0x2d, 0xd4, 0xe2, 0x31, 0xe1, 0x11, 0x84, 0x56, 0x43, 0x26, 0x4c,
+	0x88, 0x3f, 0xa0, 0xd5, 0x9c, 0xc5, 0xd3, 0x44, 0x90, 0x6c, 0x36, 0x2c, 0x68, 0x6e, 0x35, 0x77,
+	0xea, 0xdd, 0xa5, 0xde, 0x73, 0xa7, 0x6c, 0x17, 0x44, 0xe4, 0x98, 0x0b, 0x3b, 0x17, 0x86, 0x7e,
+	0x77, 0x45, 0x85, 0xbf, 0x92, 0xff, 0x1e, 0x72, 0x7c, 0x81, 0x70, 0x9a, 0xb1, 0x94, 0x71, 0xc8,
+	0x86, 0xf2, 0x08, 0x9a, 0x8c, 0xb9, 0xd5, 0x92, 0x8e, 0x7b, 0x0f, 0x38, 0x9e, 0xe9, 0x82, 0x73,
+	0xcd, 0xfb, 0xeb, 0xe9, 0x3d, 0x45, 0xfa, 0x12, 0x21, 0x80, 0x8b, 0x3f, 0x7c, 0x17, 0xfe, 0xeb,
+	0x7b, 0xa2, 0x0b, 0x2a, 0x5f, 0x72, 0x4f, 0xe1, 0xf6, 0x97, 0x1a, 0x7a, 0x64, 0xde, 0xf5, 0x29,
+	0x9b, 0x4c, 0xa8, 0x10, 0x00, 0xef, 0x93, 0x4b, 0x86, 0x0f, 0xd1, 0x72, 0xd5, 0x09, 0xc8, 0x97,
+	0x5d, 0xeb, 0xb6, 0x07, 0xeb, 0x77, 0x37, 0x9d, 0x65, 0xce, 0xaf, 0xf7, 0x39, 0xbd, 0x86, 0x63,
+	0xfb, 0xa0, 0x67, 0xfb, 0xed, 0xf2, 0xba, 0x00, 0x61, 0x51, 0x57, 0xdd, 0x14, 0xe4, 0x24, 0x1e,
+	0xaa, 0x2b, 0xaf, 0x03, 0x10, 0xda, 0x1f, 0xab, 0xa1, 0x5f, 0x90, 0x98, 0x86, 0x44, 0xb0, 0xcc,
+	0x87, 0xcf, 0x24, 0x0b, 0x39, 0x1e, 0xa0, 0x56, 0xa6, 0x1e, 0xe5, 0xc8, 0x97, 0x7a, 0x5d, 0xe7,
+	0xdf, 0xbb, 0xe8, 0xdc, 0x2f, 0xf5, 0x4d, 0xa1, 0xfd, 0xb5, 0x8e, 0xd6, 0xfe, 0x32, 0x7e, 0x86,
+	0x96, 0x39, 0x9b, 0x66, 0x01, 0x0c, 0x15, 0x26, 0x9b, 0x6c, 0xf8, 0x6d, 0x25, 0x2a, 0xaa, 0x80,
+	0x04, 0xc9, 0xc6, 0x20, 0x0c, 0x34, 0xa7, 0x20, 0x25, 0x6a, 0xa8, 0x83, 0x96, 0x22, 0x20, 0xa1,
+	0x41, 0xea, 0x12, 0x41, 0x85, 0xa4, 0x81, 0xd7, 0x68, 0x93, 0x26, 0x41, 0x3c, 0xe5, 0x94, 0x25,
+	0xc3, 0x10, 0x62, 0x32, 0x33, 0x6c, 0x43, 0xb2, 0x1b, 0x65, 0xf6, 0x6d, 0x91, 0xd4, 0x55, 0x7b,
+	0x68, 0xb5, 0x9c, 0x82, 0xc6, 0xe7, 0x25, 0xbe, 0x62, 0x64, 0x0d, 0xbe, 0x40, 0x2b, 0xba, 0x93,
+	0x14, 0x12, 0x12, 0x8b, 0x99, 0xd5, 0x94, 0x9c, 0xee, 0xef, 0x4c, 0x89, 0x05, 0xa6, 0x7b, 0x31,
+	0x58, 0x4b, 0x61, 0x4a, 0x35, 0xd8, 0x2e, 0x6a, 0xcb, 0x6e, 0x0c, 0xb4, 0x20, 0x21, 0xd9, 0xa1,
+	0x41, 0xf6, 0x11, 0xa6, 0x89, 0xfc, 0xf2, 0xa8, 0x98, 0x95, 0xe0, 0xa2, 0x04, 0xd7, 0xab, 0x8c,
+	0xc1, 0x5f, 0xa2, 0x35, 0xb3, 0xb7, 0x25, 0x8c, 0x24, 0xbc, 0x6a, 0x74, 0x8d, 0xda, 0x04, 0xad,
+	0x99, 0x4d, 0x18, 0x90, 0x98, 0x24, 0x01, 0x70, 0xbc, 0x81, 0xe6, 0x21, 0x65, 0x41, 0xa4, 0x07,
+	0xa4, 0x02, 0xdc, 0x47, 0x0b, 0x23, 0x4d, 0xa8, 0x0f, 0x7e, 0xf0, 0xe4, 0xee, 0xa6, 0xf3, 0xb8,
+	0x58, 0xb3, 0x09, 0xb9, 0x3a, 0xb6, 0xbd, 0x57, 0x47, 0x47, 0x6f, 0x3c, 0xef, 0xb0, 0xd7, 0xef,
+	0xf7, 0x0f, 0x6d, 0xbf, 0x84, 0x07, 0xed, 0x6f, 0xb7, 0xdb, 0xb5, 0xef, 0xb7, 0xdb, 0xb5, 0x1f,
+	0xb7, 0xdb, 0xb5, 0x51, 0x53, 0xfe, 0x77, 0x0e, 0x7e, 0x0d, 0x00, 0xed, 0xf5, 0x6d, 0xdc, 0x04,
+	0x05, 0x00, 0x00,
 }
 
 func (m *ArchivedActiveSetChanges) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
 	if err != nil {
 		return nil, err
 	}
@@ -213,14 +454,65 @@ func (m *ArchivedActiveSetChanges) Marshal() (dAtA []byte, err error) {
 }
 
 func (m *ArchivedActiveSetChanges) MarshalTo(dAtA []byte) (int, error) {
-	var i int
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ArchivedActiveSetChanges) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Activated) > 0 {
-		dAtA2 := make([]byte, len(m.Activated)*10)
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.AttesterSlashings) > 0 {
+		for iNdEx := len(m.AttesterSlashings) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.AttesterSlashings[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintArchive(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	if len(m.ProposerSlashings) > 0 {
+		for iNdEx := len(m.ProposerSlashings) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ProposerSlashings[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintArchive(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if len(m.VoluntaryExits) > 0 {
+		for iNdEx := len(m.VoluntaryExits) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.VoluntaryExits[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintArchive(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.Slashed) > 0 {
+		dAtA2 := make([]byte, len(m.Slashed)*10)
 		var j1 int
-		for _, num := range m.Activated {
+		for _, num := range m.Slashed {
 			for num >= 1<<7 {
 				dAtA2[j1] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
@@ -229,10 +521,11 @@ func (m *ArchivedActiveSetChanges) MarshalTo(dAtA []byte) (int, error) {
 			dAtA2[j1] = uint8(num)
 			j1++
 		}
-		dAtA[i] = 0xa
-		i++
+		i -= j1
+		copy(dAtA[i:], dAtA2[:j1])
 		i = encodeVarintArchive(dAtA, i, uint64(j1))
-		i += copy(dAtA[i:], dAtA2[:j1])
+		i--
+		dAtA[i] = 0x22
 	}
 	if len(m.Exited) > 0 {
 		dAtA4 := make([]byte, len(m.Exited)*10)
@@ -246,15 +539,16 @@ func (m *ArchivedActiveSetChanges) MarshalTo(dAtA []byte) (int, error) {
 			dAtA4[j3] = uint8(num)
 			j3++
 		}
-		dAtA[i] = 0x12
-		i++
+		i -= j3
+		copy(dAtA[i:], dAtA4[:j3])
 		i = encodeVarintArchive(dAtA, i, uint64(j3))
-		i += copy(dAtA[i:], dAtA4[:j3])
+		i--
+		dAtA[i] = 0x12
 	}
-	if len(m.Slashed) > 0 {
-		dAtA6 := make([]byte, len(m.Slashed)*10)
+	if len(m.Activated) > 0 {
+		dAtA6 := make([]byte, len(m.Activated)*10)
 		var j5 int
-		for _, num := range m.Slashed {
+		for _, num := range m.Activated {
 			for num >= 1<<7 {
 				dAtA6[j5] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
@@ -263,57 +557,19 @@ func (m *ArchivedActiveSetChanges) MarshalTo(dAtA []byte) (int, error) {
 			dAtA6[j5] = uint8(num)
 			j5++
 		}
-		dAtA[i] = 0x22
-		i++
+		i -= j5
+		copy(dAtA[i:], dAtA6[:j5])
 		i = encodeVarintArchive(dAtA, i, uint64(j5))
-		i += copy(dAtA[i:], dAtA6[:j5])
-	}
-	if len(m.VoluntaryExits) > 0 {
-		for _, msg := range m.VoluntaryExits {
-			dAtA[i] = 0x32
-			i++
-			i = encodeVarintArchive(dAtA, i, uint64(msg.Size()))
-			n, err := msg.MarshalTo(dAtA[i:])
-			if err != nil {
-				return 0, err
-			}
-			i += n
-		}
-	}
-	if len(m.ProposerSlashings) > 0 {
-		for _, msg := range m.ProposerSlashings {
-			dAtA[i] = 0x3a
-			i++
-			i = encodeVarintArchive(dAtA, i, uint64(msg.Size()))
-			n, err := msg.MarshalTo(dAtA[i:])
-			if err != nil {
-				return 0, err
-			}
-			i += n
-		}
-	}
-	if len(m.AttesterSlashings) > 0 {
-		for _, msg := range m.AttesterSlashings {
-			dAtA[i] = 0x42
-			i++
-			i = encodeVarintArchive(dAtA, i, uint64(msg.Size()))
-			n, err := msg.MarshalTo(dAtA[i:])
-			if err != nil {
-				return 0, err
-			}
-			i += n
-		}
-	}
-	if m.XXX_unrecognized != nil {
-		i += copy(dAtA[i:], m.XXX_unrecognized)
+		i--
+		dAtA[i] = 0xa
 	}
-	return i, nil
+	return len(dAtA) - i, nil
 }
 
 func (m *ArchivedCommitteeInfo) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
 	if err != nil {
 		return nil, err
 	}
@@ -321,75 +577,253 @@ func (m *ArchivedCommitteeInfo) Marshal() (dAtA []byte, err error) {
 }
 
 func (m *ArchivedCommitteeInfo) MarshalTo(dAtA []byte) (int, error) {
-	var i int
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ArchivedCommitteeInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.ProposerSeed) > 0 {
-		dAtA[i] = 0xa
-		i++
-		i = encodeVarintArchive(dAtA, i, uint64(len(m.ProposerSeed)))
-		i += copy(dAtA[i:], m.ProposerSeed)
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
 	if len(m.AttesterSeed) > 0 {
-		dAtA[i] = 0x12
-		i++
+		i -= len(m.AttesterSeed)
+		copy(dAtA[i:], m.AttesterSeed)
 		i = encodeVarintArchive(dAtA, i, uint64(len(m.AttesterSeed)))
-		i += copy(dAtA[i:], m.AttesterSeed)
+		i--
+		dAtA[i] = 0x12
 	}
-	if m.XXX_unrecognized != nil {
-		i += copy(dAtA[i:], m.XXX_unrecognized)
+	if len(m.ProposerSeed) > 0 {
+		i -= len(m.ProposerSeed)
+		copy(dAtA[i:], m.ProposerSeed)
+		i = encodeVarintArchive(dAtA, i, uint64(len(m.ProposerSeed)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return i, nil
+	return len(dAtA) - i, nil
 }
 
-func encodeVarintArchive(dAtA []byte, offset int, v uint64) int {
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *ArchivedValidatorRewards) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return offset + 1
+	return dAtA[:n], nil
 }
-func (m *ArchivedActiveSetChanges) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *ArchivedValidatorRewards) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ArchivedValidatorRewards) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.Activated) > 0 {
-		l = 0
-		for _, e := range m.Activated {
-			l += sovArchive(uint64(e))
-		}
-		n += 1 + sovArchive(uint64(l)) + l
-	}
-	if len(m.Exited) > 0 {
-		l = 0
-		for _, e := range m.Exited {
-			l += sovArchive(uint64(e))
-		}
-		n += 1 + sovArchive(uint64(l)) + l
-	}
-	if len(m.Slashed) > 0 {
-		l = 0
-		for _, e := range m.Slashed {
-			l += sovArchive(uint64(e))
-		}
-		n += 1 + sovArchive(uint64(l)) + l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if len(m.VoluntaryExits) > 0 {
-		for _, e := range m.VoluntaryExits {
-			l = e.Size()
-			n += 1 + l + sovArchive(uint64(l))
+	if len(m.Rewards) > 0 {
+		for iNdEx := len(m.Rewards) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Rewards[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintArchive(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
 		}
 	}
-	if len(m.ProposerSlashings) > 0 {
-		for _, e := range m.ProposerSlashings {
-			l = e.Size()
-			n += 1 + l + sovArchive(uint64(l))
-		}
+	return len(dAtA) - i, nil
+}
+
+func (m *ValidatorRewards) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ValidatorRewards) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ValidatorRewards) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.SlashingPenalty != 0 {
+		i = encodeVarintArchive(dAtA, i, uint64(m.SlashingPenalty))
+		i--
+		dAtA[i] = 0x50
+	}
+	if m.InactivityPenalty != 0 {
+		i = encodeVarintArchive(dAtA, i, uint64(m.InactivityPenalty))
+		i--
+		dAtA[i] = 0x48
+	}
+	if m.HeadPenalty != 0 {
+		i = encodeVarintArchive(dAtA, i, uint64(m.HeadPenalty))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.TargetPenalty != 0 {
+		i = encodeVarintArchive(dAtA, i, uint64(m.TargetPenalty))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.SourcePenalty != 0 {
+		i = encodeVarintArchive(dAtA, i, uint64(m.SourcePenalty))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.ProposerReward != 0 {
+		i = encodeVarintArchive(dAtA, i, uint64(m.ProposerReward))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.InclusionDelayReward != 0 {
+		i = encodeVarintArchive(dAtA, i, uint64(m.InclusionDelayReward))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.HeadReward != 0 {
+		i = encodeVarintArchive(dAtA, i, uint64(m.HeadReward))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.TargetReward != 0 {
+		i = encodeVarintArchive(dAtA, i, uint64(m.TargetReward))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.SourceReward != 0 {
+		i = encodeVarintArchive(dAtA, i, uint64(m.SourceReward))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ArchivedBalances) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ArchivedBalances) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ArchivedBalances) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Balances) > 0 {
+		dAtA8 := make([]byte, len(m.Balances)*10)
+		var j7 int
+		for _, num := range m.Balances {
+			for num >= 1<<7 {
+				dAtA8[j7] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j7++
+			}
+			dAtA8[j7] = uint8(num)
+			j7++
+		}
+		i -= j7
+		copy(dAtA[i:], dAtA8[:j7])
+		i = encodeVarintArchive(dAtA, i, uint64(j7))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Epoch != 0 {
+		i = encodeVarintArchive(dAtA, i, uint64(m.Epoch))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintArchive(dAtA []byte, offset int, v uint64) int {
+	offset -= sovArchive(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *ArchivedActiveSetChanges) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Activated) > 0 {
+		l = 0
+		for _, e := range m.Activated {
+			l += sovArchive(uint64(e))
+		}
+		n += 1 + sovArchive(uint64(l)) + l
+	}
+	if len(m.Exited) > 0 {
+		l = 0
+		for _, e := range m.Exited {
+			l += sovArchive(uint64(e))
+		}
+		n += 1 + sovArchive(uint64(l)) + l
+	}
+	if len(m.Slashed) > 0 {
+		l = 0
+		for _, e := range m.Slashed {
+			l += sovArchive(uint64(e))
+		}
+		n += 1 + sovArchive(uint64(l)) + l
+	}
+	if len(m.VoluntaryExits) > 0 {
+		for _, e := range m.VoluntaryExits {
+			l = e.Size()
+			n += 1 + l + sovArchive(uint64(l))
+		}
+	}
+	if len(m.ProposerSlashings) > 0 {
+		for _, e := range m.ProposerSlashings {
+			l = e.Size()
+			n += 1 + l + sovArchive(uint64(l))
+		}
 	}
 	if len(m.AttesterSlashings) > 0 {
 		for _, e := range m.AttesterSlashings {
@@ -423,16 +857,91 @@ func (m *ArchivedCommitteeInfo) Size() (n int) {
 	return n
 }
 
-func sovArchive(x uint64) (n int) {
-	for {
-		n++
-		x >>= 7
-		if x == 0 {
-			break
+func (m *ArchivedValidatorRewards) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Rewards) > 0 {
+		for _, e := range m.Rewards {
+			l = e.Size()
+			n += 1 + l + sovArchive(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ValidatorRewards) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.SourceReward != 0 {
+		n += 1 + sovArchive(uint64(m.SourceReward))
+	}
+	if m.TargetReward != 0 {
+		n += 1 + sovArchive(uint64(m.TargetReward))
+	}
+	if m.HeadReward != 0 {
+		n += 1 + sovArchive(uint64(m.HeadReward))
+	}
+	if m.InclusionDelayReward != 0 {
+		n += 1 + sovArchive(uint64(m.InclusionDelayReward))
+	}
+	if m.ProposerReward != 0 {
+		n += 1 + sovArchive(uint64(m.ProposerReward))
+	}
+	if m.SourcePenalty != 0 {
+		n += 1 + sovArchive(uint64(m.SourcePenalty))
+	}
+	if m.TargetPenalty != 0 {
+		n += 1 + sovArchive(uint64(m.TargetPenalty))
+	}
+	if m.HeadPenalty != 0 {
+		n += 1 + sovArchive(uint64(m.HeadPenalty))
+	}
+	if m.InactivityPenalty != 0 {
+		n += 1 + sovArchive(uint64(m.InactivityPenalty))
+	}
+	if m.SlashingPenalty != 0 {
+		n += 1 + sovArchive(uint64(m.SlashingPenalty))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ArchivedBalances) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Epoch != 0 {
+		n += 1 + sovArchive(uint64(m.Epoch))
+	}
+	if len(m.Balances) > 0 {
+		l = 0
+		for _, e := range m.Balances {
+			l += sovArchive(uint64(e))
 		}
+		n += 1 + sovArchive(uint64(l)) + l
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
 	}
 	return n
 }
+
+func sovArchive(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
 func sozArchive(x uint64) (n int) {
 	return sovArchive(uint64((x << 1) ^ uint64((int64(x) >> 63))))
 }
@@ -690,14 +1199,437 @@ func (m *ArchivedActiveSetChanges) Unmarshal(dAtA []byte) error {
 					}
 					m.Slashed = append(m.Slashed, v)
 				}
-			} else {
-				return fmt.Errorf("proto: wrong wireType = %d for field Slashed", wireType)
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field Slashed", wireType)
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VoluntaryExits", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowArchive
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthArchive
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthArchive
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VoluntaryExits = append(m.VoluntaryExits, &v1alpha1.VoluntaryExit{})
+			if err := m.VoluntaryExits[len(m.VoluntaryExits)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposerSlashings", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowArchive
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthArchive
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthArchive
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProposerSlashings = append(m.ProposerSlashings, &v1alpha1.ProposerSlashing{})
+			if err := m.ProposerSlashings[len(m.ProposerSlashings)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AttesterSlashings", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowArchive
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthArchive
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthArchive
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AttesterSlashings = append(m.AttesterSlashings, &v1alpha1.AttesterSlashing{})
+			if err := m.AttesterSlashings[len(m.AttesterSlashings)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipArchive(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthArchive
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthArchive
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ArchivedCommitteeInfo) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowArchive
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ArchivedCommitteeInfo: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ArchivedCommitteeInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposerSeed", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowArchive
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthArchive
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthArchive
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProposerSeed = append(m.ProposerSeed[:0], dAtA[iNdEx:postIndex]...)
+			if m.ProposerSeed == nil {
+				m.ProposerSeed = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AttesterSeed", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowArchive
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthArchive
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthArchive
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AttesterSeed = append(m.AttesterSeed[:0], dAtA[iNdEx:postIndex]...)
+			if m.AttesterSeed == nil {
+				m.AttesterSeed = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipArchive(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthArchive
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthArchive
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ArchivedValidatorRewards) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowArchive
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ArchivedValidatorRewards: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ArchivedValidatorRewards: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rewards", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowArchive
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthArchive
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthArchive
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Rewards = append(m.Rewards, &ValidatorRewards{})
+			if err := m.Rewards[len(m.Rewards)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipArchive(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthArchive
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthArchive
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ValidatorRewards) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowArchive
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ValidatorRewards: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ValidatorRewards: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SourceReward", wireType)
+			}
+			m.SourceReward = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowArchive
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SourceReward |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TargetReward", wireType)
+			}
+			m.TargetReward = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowArchive
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TargetReward |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HeadReward", wireType)
+			}
+			m.HeadReward = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowArchive
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.HeadReward |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-		case 6:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field VoluntaryExits", wireType)
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InclusionDelayReward", wireType)
 			}
-			var msglen int
+			m.InclusionDelayReward = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowArchive
@@ -707,31 +1639,54 @@ func (m *ArchivedActiveSetChanges) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.InclusionDelayReward |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthArchive
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposerReward", wireType)
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthArchive
+			m.ProposerReward = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowArchive
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ProposerReward |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SourcePenalty", wireType)
 			}
-			m.VoluntaryExits = append(m.VoluntaryExits, &v1alpha1.VoluntaryExit{})
-			if err := m.VoluntaryExits[len(m.VoluntaryExits)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.SourcePenalty = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowArchive
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SourcePenalty |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
 		case 7:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ProposerSlashings", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TargetPenalty", wireType)
 			}
-			var msglen int
+			m.TargetPenalty = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowArchive
@@ -741,31 +1696,16 @@ func (m *ArchivedActiveSetChanges) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.TargetPenalty |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthArchive
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthArchive
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.ProposerSlashings = append(m.ProposerSlashings, &v1alpha1.ProposerSlashing{})
-			if err := m.ProposerSlashings[len(m.ProposerSlashings)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		case 8:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AttesterSlashings", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HeadPenalty", wireType)
 			}
-			var msglen int
+			m.HeadPenalty = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowArchive
@@ -775,26 +1715,49 @@ func (m *ArchivedActiveSetChanges) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.HeadPenalty |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthArchive
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InactivityPenalty", wireType)
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthArchive
+			m.InactivityPenalty = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowArchive
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.InactivityPenalty |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SlashingPenalty", wireType)
 			}
-			m.AttesterSlashings = append(m.AttesterSlashings, &v1alpha1.AttesterSlashing{})
-			if err := m.AttesterSlashings[len(m.AttesterSlashings)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.SlashingPenalty = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowArchive
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SlashingPenalty |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipArchive(dAtA[iNdEx:])
@@ -820,7 +1783,7 @@ func (m *ArchivedActiveSetChanges) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ArchivedCommitteeInfo) Unmarshal(dAtA []byte) error {
+func (m *ArchivedBalances) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -843,17 +1806,17 @@ func (m *ArchivedCommitteeInfo) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ArchivedCommitteeInfo: wiretype end group for non-group")
+			return fmt.Errorf("proto: ArchivedBalances: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ArchivedCommitteeInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ArchivedBalances: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ProposerSeed", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Epoch", wireType)
 			}
-			var byteLen int
+			m.Epoch = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowArchive
@@ -863,60 +1826,87 @@ func (m *ArchivedCommitteeInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				m.Epoch |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
-				return ErrInvalidLengthArchive
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthArchive
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.ProposerSeed = append(m.ProposerSeed[:0], dAtA[iNdEx:postIndex]...)
-			if m.ProposerSeed == nil {
-				m.ProposerSeed = []byte{}
-			}
-			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AttesterSeed", wireType)
-			}
-			var byteLen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowArchive
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowArchive
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
 				}
-				if iNdEx >= l {
+				m.Balances = append(m.Balances, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowArchive
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthArchive
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthArchive
+				}
+				if postIndex > l {
 					return io.ErrUnexpectedEOF
 				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				byteLen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
 				}
+				elementCount = count
+				if elementCount != 0 && len(m.Balances) == 0 {
+					m.Balances = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowArchive
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.Balances = append(m.Balances, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field Balances", wireType)
 			}
-			if byteLen < 0 {
-				return ErrInvalidLengthArchive
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthArchive
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.AttesterSeed = append(m.AttesterSeed[:0], dAtA[iNdEx:postIndex]...)
-			if m.AttesterSeed == nil {
-				m.AttesterSeed = []byte{}
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipArchive(dAtA[iNdEx:])
@@ -945,6 +1935,7 @@ func (m *ArchivedCommitteeInfo) Unmarshal(dAtA []byte) error {
 func skipArchive(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
+	depth := 0
 	for iNdEx < l {
 		var wire uint64
 		for shift := uint(0); ; shift += 7 {
@@ -976,10 +1967,8 @@ func skipArchive(dAtA []byte) (n int, err error) {
 					break
 				}
 			}
-			return iNdEx, nil
 		case 1:
 			iNdEx += 8
-			return iNdEx, nil
 		case 2:
 			var length int
 			for shift := uint(0); ; shift += 7 {
@@ -1000,55 +1989,30 @@ func skipArchive(dAtA []byte) (n int, err error) {
 				return 0, ErrInvalidLengthArchive
 			}
 			iNdEx += length
-			if iNdEx < 0 {
-				return 0, ErrInvalidLengthArchive
-			}
-			return iNdEx, nil
 		case 3:
-			for {
-				var innerWire uint64
-				var start int = iNdEx
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return 0, ErrIntOverflowArchive
-					}
-					if iNdEx >= l {
-						return 0, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					innerWire |= (uint64(b) & 0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				innerWireType := int(innerWire & 0x7)
-				if innerWireType == 4 {
-					break
-				}
-				next, err := skipArchive(dAtA[start:])
-				if err != nil {
-					return 0, err
-				}
-				iNdEx = start + next
-				if iNdEx < 0 {
-					return 0, ErrInvalidLengthArchive
-				}
-			}
-			return iNdEx, nil
+			depth++
 		case 4:
-			return iNdEx, nil
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupArchive
+			}
+			depth--
 		case 5:
 			iNdEx += 4
-			return iNdEx, nil
 		default:
 			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
 		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthArchive
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
 	}
-	panic("unreachable")
+	return 0, io.ErrUnexpectedEOF
 }
 
 var (
-	ErrInvalidLengthArchive = fmt.Errorf("proto: negative length found during unmarshaling")
-	ErrIntOverflowArchive   = fmt.Errorf("proto: integer overflow")
+	ErrInvalidLengthArchive        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowArchive          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupArchive = fmt.Errorf("proto: unexpected end of group")
 )
@@ -9,11 +9,14 @@ import (
 	fmt "fmt"
 	io "io"
 	math "math"
+	math_bits "math/bits"
 
 	proto "github.com/gogo/protobuf/proto"
 	types "github.com/gogo/protobuf/types"
 	v1alpha1 "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
 	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -25,7 +28,7 @@ var _ = math.Inf
 // is compatible with the proto package it is being compiled against.
 // A compilation error at this line likely means your copy of the
 // proto package needs to be updated.
-const _ = proto.GoGoProtoPackageIsVersion2 // please upgrade the proto package
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
 type ValidatorRole int32
 
@@ -104,6 +107,7 @@ func (ValidatorStatus) EnumDescriptor() ([]byte, []int) {
 type BlockRequest struct {
 	Slot                 uint64   `protobuf:"varint,1,opt,name=slot,proto3" json:"slot,omitempty"`
 	RandaoReveal         []byte   `protobuf:"bytes,2,opt,name=randao_reveal,json=randaoReveal,proto3" json:"randao_reveal,omitempty"`
+	Graffiti             []byte   `protobuf:"bytes,3,opt,name=graffiti,proto3" json:"graffiti,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -123,7 +127,7 @@ func (m *BlockRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error)
 		return xxx_messageInfo_BlockRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
-		n, err := m.MarshalTo(b)
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
 			return nil, err
 		}
@@ -156,6 +160,13 @@ func (m *BlockRequest) GetRandaoReveal() []byte {
 	return nil
 }
 
+func (m *BlockRequest) GetGraffiti() []byte {
+	if m != nil {
+		return m.Graffiti
+	}
+	return nil
+}
+
 type ProposeResponse struct {
 	BlockRoot            []byte   `protobuf:"bytes,1,opt,name=block_root,json=blockRoot,proto3" json:"block_root,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
@@ -177,7 +188,7 @@ func (m *ProposeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, err
 		return xxx_messageInfo_ProposeResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
-		n, err := m.MarshalTo(b)
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
 			return nil, err
 		}
@@ -227,7 +238,7 @@ func (m *AttestationRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte,
 		return xxx_messageInfo_AttestationRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
-		n, err := m.MarshalTo(b)
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
 			return nil, err
 		}
@@ -295,7 +306,7 @@ func (m *AttestResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, erro
 		return xxx_messageInfo_AttestResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
-		n, err := m.MarshalTo(b)
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
 			return nil, err
 		}
@@ -345,7 +356,7 @@ func (m *AggregationRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte,
 		return xxx_messageInfo_AggregationRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
-		n, err := m.MarshalTo(b)
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
 			return nil, err
 		}
@@ -413,7 +424,7 @@ func (m *AggregationResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte,
 		return xxx_messageInfo_AggregationResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
-		n, err := m.MarshalTo(b)
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
 			return nil, err
 		}
@@ -439,3344 +450,7092 @@ func (m *AggregationResponse) GetRoot() []byte {
 	return nil
 }
 
-type ValidatorPerformanceRequest struct {
-	Slot                 uint64   `protobuf:"varint,1,opt,name=slot,proto3" json:"slot,omitempty"`
-	PublicKeys           [][]byte `protobuf:"bytes,2,rep,name=public_keys,json=publicKeys,proto3" json:"public_keys,omitempty"`
+type CheckpointResponse struct {
+	State                []byte   `protobuf:"bytes,1,opt,name=state,proto3" json:"state,omitempty"`
+	Block                []byte   `protobuf:"bytes,2,opt,name=block,proto3" json:"block,omitempty"`
+	Epoch                uint64   `protobuf:"varint,3,opt,name=epoch,proto3" json:"epoch,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *ValidatorPerformanceRequest) Reset()         { *m = ValidatorPerformanceRequest{} }
-func (m *ValidatorPerformanceRequest) String() string { return proto.CompactTextString(m) }
-func (*ValidatorPerformanceRequest) ProtoMessage()    {}
-func (*ValidatorPerformanceRequest) Descriptor() ([]byte, []int) {
+func (m *CheckpointResponse) Reset()         { *m = CheckpointResponse{} }
+func (m *CheckpointResponse) String() string { return proto.CompactTextString(m) }
+func (*CheckpointResponse) ProtoMessage()    {}
+func (*CheckpointResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_9eb4e94b85965285, []int{6}
 }
-func (m *ValidatorPerformanceRequest) XXX_Unmarshal(b []byte) error {
+func (m *CheckpointResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *ValidatorPerformanceRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *CheckpointResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_ValidatorPerformanceRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_CheckpointResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
-		n, err := m.MarshalTo(b)
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
 			return nil, err
 		}
 		return b[:n], nil
 	}
 }
-func (m *ValidatorPerformanceRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ValidatorPerformanceRequest.Merge(m, src)
+func (m *CheckpointResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CheckpointResponse.Merge(m, src)
 }
-func (m *ValidatorPerformanceRequest) XXX_Size() int {
+func (m *CheckpointResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *ValidatorPerformanceRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_ValidatorPerformanceRequest.DiscardUnknown(m)
+func (m *CheckpointResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_CheckpointResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ValidatorPerformanceRequest proto.InternalMessageInfo
+var xxx_messageInfo_CheckpointResponse proto.InternalMessageInfo
 
-func (m *ValidatorPerformanceRequest) GetSlot() uint64 {
+func (m *CheckpointResponse) GetState() []byte {
 	if m != nil {
-		return m.Slot
+		return m.State
 	}
-	return 0
+	return nil
 }
 
-func (m *ValidatorPerformanceRequest) GetPublicKeys() [][]byte {
+func (m *CheckpointResponse) GetBlock() []byte {
 	if m != nil {
-		return m.PublicKeys
+		return m.Block
 	}
 	return nil
 }
 
-type ValidatorPerformanceResponse struct {
-	Balances                      []uint64 `protobuf:"varint,1,rep,packed,name=balances,proto3" json:"balances,omitempty"`
-	TotalValidators               uint64   `protobuf:"varint,2,opt,name=total_validators,json=totalValidators,proto3" json:"total_validators,omitempty"`
-	TotalActiveValidators         uint64   `protobuf:"varint,3,opt,name=total_active_validators,json=totalActiveValidators,proto3" json:"total_active_validators,omitempty"`
-	MissingValidators             [][]byte `protobuf:"bytes,4,rep,name=missing_validators,json=missingValidators,proto3" json:"missing_validators,omitempty"`
-	AverageActiveValidatorBalance float32  `protobuf:"fixed32,5,opt,name=average_active_validator_balance,json=averageActiveValidatorBalance,proto3" json:"average_active_validator_balance,omitempty"`
-	XXX_NoUnkeyedLiteral          struct{} `json:"-"`
-	XXX_unrecognized              []byte   `json:"-"`
-	XXX_sizecache                 int32    `json:"-"`
+func (m *CheckpointResponse) GetEpoch() uint64 {
+	if m != nil {
+		return m.Epoch
+	}
+	return 0
 }
 
-func (m *ValidatorPerformanceResponse) Reset()         { *m = ValidatorPerformanceResponse{} }
-func (m *ValidatorPerformanceResponse) String() string { return proto.CompactTextString(m) }
-func (*ValidatorPerformanceResponse) ProtoMessage()    {}
-func (*ValidatorPerformanceResponse) Descriptor() ([]byte, []int) {
+type PeerScore struct {
+	Address              string   `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	PeerId               string   `protobuf:"bytes,2,opt,name=peer_id,json=peerId,proto3" json:"peer_id,omitempty"`
+	Score                int64    `protobuf:"varint,3,opt,name=score,proto3" json:"score,omitempty"`
+	Connected            bool     `protobuf:"varint,4,opt,name=connected,proto3" json:"connected,omitempty"`
+	BannedUntil          uint64   `protobuf:"varint,5,opt,name=banned_until,json=bannedUntil,proto3" json:"banned_until,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *PeerScore) Reset()         { *m = PeerScore{} }
+func (m *PeerScore) String() string { return proto.CompactTextString(m) }
+func (*PeerScore) ProtoMessage()    {}
+func (*PeerScore) Descriptor() ([]byte, []int) {
 	return fileDescriptor_9eb4e94b85965285, []int{7}
 }
-func (m *ValidatorPerformanceResponse) XXX_Unmarshal(b []byte) error {
+func (m *PeerScore) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *ValidatorPerformanceResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *PeerScore) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_ValidatorPerformanceResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_PeerScore.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
-		n, err := m.MarshalTo(b)
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
 			return nil, err
 		}
 		return b[:n], nil
 	}
 }
-func (m *ValidatorPerformanceResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ValidatorPerformanceResponse.Merge(m, src)
+func (m *PeerScore) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PeerScore.Merge(m, src)
 }
-func (m *ValidatorPerformanceResponse) XXX_Size() int {
+func (m *PeerScore) XXX_Size() int {
 	return m.Size()
 }
-func (m *ValidatorPerformanceResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_ValidatorPerformanceResponse.DiscardUnknown(m)
+func (m *PeerScore) XXX_DiscardUnknown() {
+	xxx_messageInfo_PeerScore.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ValidatorPerformanceResponse proto.InternalMessageInfo
+var xxx_messageInfo_PeerScore proto.InternalMessageInfo
 
-func (m *ValidatorPerformanceResponse) GetBalances() []uint64 {
+func (m *PeerScore) GetAddress() string {
 	if m != nil {
-		return m.Balances
+		return m.Address
 	}
-	return nil
+	return ""
 }
 
-func (m *ValidatorPerformanceResponse) GetTotalValidators() uint64 {
+func (m *PeerScore) GetPeerId() string {
 	if m != nil {
-		return m.TotalValidators
+		return m.PeerId
 	}
-	return 0
+	return ""
 }
 
-func (m *ValidatorPerformanceResponse) GetTotalActiveValidators() uint64 {
+func (m *PeerScore) GetScore() int64 {
 	if m != nil {
-		return m.TotalActiveValidators
+		return m.Score
 	}
 	return 0
 }
 
-func (m *ValidatorPerformanceResponse) GetMissingValidators() [][]byte {
+func (m *PeerScore) GetConnected() bool {
 	if m != nil {
-		return m.MissingValidators
+		return m.Connected
 	}
-	return nil
+	return false
 }
 
-func (m *ValidatorPerformanceResponse) GetAverageActiveValidatorBalance() float32 {
+func (m *PeerScore) GetBannedUntil() uint64 {
 	if m != nil {
-		return m.AverageActiveValidatorBalance
+		return m.BannedUntil
 	}
 	return 0
 }
 
-type ValidatorActivationRequest struct {
-	PublicKeys           [][]byte `protobuf:"bytes,1,rep,name=public_keys,json=publicKeys,proto3" json:"public_keys,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+type PeerScoresResponse struct {
+	Peers                []*PeerScore `protobuf:"bytes,1,rep,name=peers,proto3" json:"peers,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
+	XXX_unrecognized     []byte       `json:"-"`
+	XXX_sizecache        int32        `json:"-"`
 }
 
-func (m *ValidatorActivationRequest) Reset()         { *m = ValidatorActivationRequest{} }
-func (m *ValidatorActivationRequest) String() string { return proto.CompactTextString(m) }
-func (*ValidatorActivationRequest) ProtoMessage()    {}
-func (*ValidatorActivationRequest) Descriptor() ([]byte, []int) {
+func (m *PeerScoresResponse) Reset()         { *m = PeerScoresResponse{} }
+func (m *PeerScoresResponse) String() string { return proto.CompactTextString(m) }
+func (*PeerScoresResponse) ProtoMessage()    {}
+func (*PeerScoresResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_9eb4e94b85965285, []int{8}
 }
-func (m *ValidatorActivationRequest) XXX_Unmarshal(b []byte) error {
+func (m *PeerScoresResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *ValidatorActivationRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *PeerScoresResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_ValidatorActivationRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_PeerScoresResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
-		n, err := m.MarshalTo(b)
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
 			return nil, err
 		}
 		return b[:n], nil
 	}
 }
-func (m *ValidatorActivationRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ValidatorActivationRequest.Merge(m, src)
+func (m *PeerScoresResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PeerScoresResponse.Merge(m, src)
 }
-func (m *ValidatorActivationRequest) XXX_Size() int {
+func (m *PeerScoresResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *ValidatorActivationRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_ValidatorActivationRequest.DiscardUnknown(m)
+func (m *PeerScoresResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_PeerScoresResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ValidatorActivationRequest proto.InternalMessageInfo
+var xxx_messageInfo_PeerScoresResponse proto.InternalMessageInfo
 
-func (m *ValidatorActivationRequest) GetPublicKeys() [][]byte {
+func (m *PeerScoresResponse) GetPeers() []*PeerScore {
 	if m != nil {
-		return m.PublicKeys
+		return m.Peers
 	}
 	return nil
 }
 
-type ValidatorActivationResponse struct {
-	ActivatedPublicKeys  [][]byte                              `protobuf:"bytes,1,rep,name=activated_public_keys,json=activatedPublicKeys,proto3" json:"activated_public_keys,omitempty"` // Deprecated: Do not use.
-	Statuses             []*ValidatorActivationResponse_Status `protobuf:"bytes,2,rep,name=statuses,proto3" json:"statuses,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}                              `json:"-"`
-	XXX_unrecognized     []byte                                `json:"-"`
-	XXX_sizecache        int32                                 `json:"-"`
+type ChainReorg struct {
+	OldHeadRoot          []byte   `protobuf:"bytes,1,opt,name=old_head_root,json=oldHeadRoot,proto3" json:"old_head_root,omitempty"`
+	OldHeadSlot          uint64   `protobuf:"varint,2,opt,name=old_head_slot,json=oldHeadSlot,proto3" json:"old_head_slot,omitempty"`
+	NewHeadRoot          []byte   `protobuf:"bytes,3,opt,name=new_head_root,json=newHeadRoot,proto3" json:"new_head_root,omitempty"`
+	NewHeadSlot          uint64   `protobuf:"varint,4,opt,name=new_head_slot,json=newHeadSlot,proto3" json:"new_head_slot,omitempty"`
+	CommonAncestorRoot   []byte   `protobuf:"bytes,5,opt,name=common_ancestor_root,json=commonAncestorRoot,proto3" json:"common_ancestor_root,omitempty"`
+	CommonAncestorSlot   uint64   `protobuf:"varint,6,opt,name=common_ancestor_slot,json=commonAncestorSlot,proto3" json:"common_ancestor_slot,omitempty"`
+	Depth                uint64   `protobuf:"varint,7,opt,name=depth,proto3" json:"depth,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *ValidatorActivationResponse) Reset()         { *m = ValidatorActivationResponse{} }
-func (m *ValidatorActivationResponse) String() string { return proto.CompactTextString(m) }
-func (*ValidatorActivationResponse) ProtoMessage()    {}
-func (*ValidatorActivationResponse) Descriptor() ([]byte, []int) {
+func (m *ChainReorg) Reset()         { *m = ChainReorg{} }
+func (m *ChainReorg) String() string { return proto.CompactTextString(m) }
+func (*ChainReorg) ProtoMessage()    {}
+func (*ChainReorg) Descriptor() ([]byte, []int) {
 	return fileDescriptor_9eb4e94b85965285, []int{9}
 }
-func (m *ValidatorActivationResponse) XXX_Unmarshal(b []byte) error {
+func (m *ChainReorg) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *ValidatorActivationResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *ChainReorg) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_ValidatorActivationResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_ChainReorg.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
-		n, err := m.MarshalTo(b)
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
 			return nil, err
 		}
 		return b[:n], nil
 	}
 }
-func (m *ValidatorActivationResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ValidatorActivationResponse.Merge(m, src)
+func (m *ChainReorg) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ChainReorg.Merge(m, src)
 }
-func (m *ValidatorActivationResponse) XXX_Size() int {
+func (m *ChainReorg) XXX_Size() int {
 	return m.Size()
 }
-func (m *ValidatorActivationResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_ValidatorActivationResponse.DiscardUnknown(m)
+func (m *ChainReorg) XXX_DiscardUnknown() {
+	xxx_messageInfo_ChainReorg.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ValidatorActivationResponse proto.InternalMessageInfo
+var xxx_messageInfo_ChainReorg proto.InternalMessageInfo
 
-// Deprecated: Do not use.
-func (m *ValidatorActivationResponse) GetActivatedPublicKeys() [][]byte {
+func (m *ChainReorg) GetOldHeadRoot() []byte {
 	if m != nil {
-		return m.ActivatedPublicKeys
+		return m.OldHeadRoot
 	}
 	return nil
 }
 
-func (m *ValidatorActivationResponse) GetStatuses() []*ValidatorActivationResponse_Status {
+func (m *ChainReorg) GetOldHeadSlot() uint64 {
 	if m != nil {
-		return m.Statuses
+		return m.OldHeadSlot
 	}
-	return nil
+	return 0
 }
 
-type ValidatorActivationResponse_Status struct {
-	PublicKey            []byte                   `protobuf:"bytes,1,opt,name=public_key,json=publicKey,proto3" json:"public_key,omitempty"`
-	Status               *ValidatorStatusResponse `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}                 `json:"-"`
-	XXX_unrecognized     []byte                   `json:"-"`
-	XXX_sizecache        int32                    `json:"-"`
+func (m *ChainReorg) GetNewHeadRoot() []byte {
+	if m != nil {
+		return m.NewHeadRoot
+	}
+	return nil
 }
 
-func (m *ValidatorActivationResponse_Status) Reset()         { *m = ValidatorActivationResponse_Status{} }
-func (m *ValidatorActivationResponse_Status) String() string { return proto.CompactTextString(m) }
-func (*ValidatorActivationResponse_Status) ProtoMessage()    {}
-func (*ValidatorActivationResponse_Status) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9eb4e94b85965285, []int{9, 0}
-}
-func (m *ValidatorActivationResponse_Status) XXX_Unmarshal(b []byte) error {
-	return m.Unmarshal(b)
-}
-func (m *ValidatorActivationResponse_Status) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	if deterministic {
-		return xxx_messageInfo_ValidatorActivationResponse_Status.Marshal(b, m, deterministic)
-	} else {
-		b = b[:cap(b)]
-		n, err := m.MarshalTo(b)
-		if err != nil {
-			return nil, err
-		}
-		return b[:n], nil
+func (m *ChainReorg) GetNewHeadSlot() uint64 {
+	if m != nil {
+		return m.NewHeadSlot
 	}
+	return 0
 }
-func (m *ValidatorActivationResponse_Status) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ValidatorActivationResponse_Status.Merge(m, src)
-}
-func (m *ValidatorActivationResponse_Status) XXX_Size() int {
-	return m.Size()
-}
-func (m *ValidatorActivationResponse_Status) XXX_DiscardUnknown() {
-	xxx_messageInfo_ValidatorActivationResponse_Status.DiscardUnknown(m)
-}
-
-var xxx_messageInfo_ValidatorActivationResponse_Status proto.InternalMessageInfo
 
-func (m *ValidatorActivationResponse_Status) GetPublicKey() []byte {
+func (m *ChainReorg) GetCommonAncestorRoot() []byte {
 	if m != nil {
-		return m.PublicKey
+		return m.CommonAncestorRoot
 	}
 	return nil
 }
 
-func (m *ValidatorActivationResponse_Status) GetStatus() *ValidatorStatusResponse {
+func (m *ChainReorg) GetCommonAncestorSlot() uint64 {
 	if m != nil {
-		return m.Status
+		return m.CommonAncestorSlot
 	}
-	return nil
+	return 0
 }
 
-type ExitedValidatorsRequest struct {
-	PublicKeys           [][]byte `protobuf:"bytes,1,rep,name=public_keys,json=publicKeys,proto3" json:"public_keys,omitempty"`
+func (m *ChainReorg) GetDepth() uint64 {
+	if m != nil {
+		return m.Depth
+	}
+	return 0
+}
+
+type ListValidatorRewardsRequest struct {
+	StartEpoch           uint64   `protobuf:"varint,1,opt,name=start_epoch,json=startEpoch,proto3" json:"start_epoch,omitempty"`
+	EndEpoch             uint64   `protobuf:"varint,2,opt,name=end_epoch,json=endEpoch,proto3" json:"end_epoch,omitempty"`
+	Indices              []uint64 `protobuf:"varint,3,rep,packed,name=indices,proto3" json:"indices,omitempty"`
+	PageSize             int32    `protobuf:"varint,4,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	PageToken            string   `protobuf:"bytes,5,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *ExitedValidatorsRequest) Reset()         { *m = ExitedValidatorsRequest{} }
-func (m *ExitedValidatorsRequest) String() string { return proto.CompactTextString(m) }
-func (*ExitedValidatorsRequest) ProtoMessage()    {}
-func (*ExitedValidatorsRequest) Descriptor() ([]byte, []int) {
+func (m *ListValidatorRewardsRequest) Reset()         { *m = ListValidatorRewardsRequest{} }
+func (m *ListValidatorRewardsRequest) String() string { return proto.CompactTextString(m) }
+func (*ListValidatorRewardsRequest) ProtoMessage()    {}
+func (*ListValidatorRewardsRequest) Descriptor() ([]byte, []int) {
 	return fileDescriptor_9eb4e94b85965285, []int{10}
 }
-func (m *ExitedValidatorsRequest) XXX_Unmarshal(b []byte) error {
+func (m *ListValidatorRewardsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *ExitedValidatorsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *ListValidatorRewardsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_ExitedValidatorsRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_ListValidatorRewardsRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
-		n, err := m.MarshalTo(b)
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
 			return nil, err
 		}
 		return b[:n], nil
 	}
 }
-func (m *ExitedValidatorsRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ExitedValidatorsRequest.Merge(m, src)
+func (m *ListValidatorRewardsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListValidatorRewardsRequest.Merge(m, src)
 }
-func (m *ExitedValidatorsRequest) XXX_Size() int {
+func (m *ListValidatorRewardsRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *ExitedValidatorsRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_ExitedValidatorsRequest.DiscardUnknown(m)
+func (m *ListValidatorRewardsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListValidatorRewardsRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ExitedValidatorsRequest proto.InternalMessageInfo
+var xxx_messageInfo_ListValidatorRewardsRequest proto.InternalMessageInfo
 
-func (m *ExitedValidatorsRequest) GetPublicKeys() [][]byte {
+func (m *ListValidatorRewardsRequest) GetStartEpoch() uint64 {
 	if m != nil {
-		return m.PublicKeys
+		return m.StartEpoch
+	}
+	return 0
+}
+
+func (m *ListValidatorRewardsRequest) GetEndEpoch() uint64 {
+	if m != nil {
+		return m.EndEpoch
+	}
+	return 0
+}
+
+func (m *ListValidatorRewardsRequest) GetIndices() []uint64 {
+	if m != nil {
+		return m.Indices
 	}
 	return nil
 }
 
-type ExitedValidatorsResponse struct {
-	PublicKeys           [][]byte `protobuf:"bytes,1,rep,name=public_keys,json=publicKeys,proto3" json:"public_keys,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+func (m *ListValidatorRewardsRequest) GetPageSize() int32 {
+	if m != nil {
+		return m.PageSize
+	}
+	return 0
 }
 
-func (m *ExitedValidatorsResponse) Reset()         { *m = ExitedValidatorsResponse{} }
-func (m *ExitedValidatorsResponse) String() string { return proto.CompactTextString(m) }
-func (*ExitedValidatorsResponse) ProtoMessage()    {}
-func (*ExitedValidatorsResponse) Descriptor() ([]byte, []int) {
+func (m *ListValidatorRewardsRequest) GetPageToken() string {
+	if m != nil {
+		return m.PageToken
+	}
+	return ""
+}
+
+type ListValidatorRewardsResponse struct {
+	Rewards              []*ValidatorEpochRewards `protobuf:"bytes,1,rep,name=rewards,proto3" json:"rewards,omitempty"`
+	NextPageToken        string                   `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	TotalSize            int32                    `protobuf:"varint,3,opt,name=total_size,json=totalSize,proto3" json:"total_size,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                 `json:"-"`
+	XXX_unrecognized     []byte                   `json:"-"`
+	XXX_sizecache        int32                    `json:"-"`
+}
+
+func (m *ListValidatorRewardsResponse) Reset()         { *m = ListValidatorRewardsResponse{} }
+func (m *ListValidatorRewardsResponse) String() string { return proto.CompactTextString(m) }
+func (*ListValidatorRewardsResponse) ProtoMessage()    {}
+func (*ListValidatorRewardsResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_9eb4e94b85965285, []int{11}
 }
-func (m *ExitedValidatorsResponse) XXX_Unmarshal(b []byte) error {
+func (m *ListValidatorRewardsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *ExitedValidatorsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *ListValidatorRewardsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_ExitedValidatorsResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_ListValidatorRewardsResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
-		n, err := m.MarshalTo(b)
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
 			return nil, err
 		}
 		return b[:n], nil
 	}
 }
-func (m *ExitedValidatorsResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ExitedValidatorsResponse.Merge(m, src)
+func (m *ListValidatorRewardsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListValidatorRewardsResponse.Merge(m, src)
 }
-func (m *ExitedValidatorsResponse) XXX_Size() int {
+func (m *ListValidatorRewardsResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *ExitedValidatorsResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_ExitedValidatorsResponse.DiscardUnknown(m)
+func (m *ListValidatorRewardsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListValidatorRewardsResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ExitedValidatorsResponse proto.InternalMessageInfo
+var xxx_messageInfo_ListValidatorRewardsResponse proto.InternalMessageInfo
 
-func (m *ExitedValidatorsResponse) GetPublicKeys() [][]byte {
+func (m *ListValidatorRewardsResponse) GetRewards() []*ValidatorEpochRewards {
 	if m != nil {
-		return m.PublicKeys
+		return m.Rewards
 	}
 	return nil
 }
 
-type ChainStartResponse struct {
-	Started              bool     `protobuf:"varint,1,opt,name=started,proto3" json:"started,omitempty"`
-	GenesisTime          uint64   `protobuf:"varint,2,opt,name=genesis_time,json=genesisTime,proto3" json:"genesis_time,omitempty"`
+func (m *ListValidatorRewardsResponse) GetNextPageToken() string {
+	if m != nil {
+		return m.NextPageToken
+	}
+	return ""
+}
+
+func (m *ListValidatorRewardsResponse) GetTotalSize() int32 {
+	if m != nil {
+		return m.TotalSize
+	}
+	return 0
+}
+
+type ValidatorEpochRewards struct {
+	Epoch                uint64   `protobuf:"varint,1,opt,name=epoch,proto3" json:"epoch,omitempty"`
+	ValidatorIndex       uint64   `protobuf:"varint,2,opt,name=validator_index,json=validatorIndex,proto3" json:"validator_index,omitempty"`
+	SourceReward         uint64   `protobuf:"varint,3,opt,name=source_reward,json=sourceReward,proto3" json:"source_reward,omitempty"`
+	TargetReward         uint64   `protobuf:"varint,4,opt,name=target_reward,json=targetReward,proto3" json:"target_reward,omitempty"`
+	HeadReward           uint64   `protobuf:"varint,5,opt,name=head_reward,json=headReward,proto3" json:"head_reward,omitempty"`
+	InclusionDelayReward uint64   `protobuf:"varint,6,opt,name=inclusion_delay_reward,json=inclusionDelayReward,proto3" json:"inclusion_delay_reward,omitempty"`
+	ProposerReward       uint64   `protobuf:"varint,7,opt,name=proposer_reward,json=proposerReward,proto3" json:"proposer_reward,omitempty"`
+	SourcePenalty        uint64   `protobuf:"varint,8,opt,name=source_penalty,json=sourcePenalty,proto3" json:"source_penalty,omitempty"`
+	TargetPenalty        uint64   `protobuf:"varint,9,opt,name=target_penalty,json=targetPenalty,proto3" json:"target_penalty,omitempty"`
+	HeadPenalty          uint64   `protobuf:"varint,10,opt,name=head_penalty,json=headPenalty,proto3" json:"head_penalty,omitempty"`
+	InactivityPenalty    uint64   `protobuf:"varint,11,opt,name=inactivity_penalty,json=inactivityPenalty,proto3" json:"inactivity_penalty,omitempty"`
+	SlashingPenalty      uint64   `protobuf:"varint,12,opt,name=slashing_penalty,json=slashingPenalty,proto3" json:"slashing_penalty,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *ChainStartResponse) Reset()         { *m = ChainStartResponse{} }
-func (m *ChainStartResponse) String() string { return proto.CompactTextString(m) }
-func (*ChainStartResponse) ProtoMessage()    {}
-func (*ChainStartResponse) Descriptor() ([]byte, []int) {
+func (m *ValidatorEpochRewards) Reset()         { *m = ValidatorEpochRewards{} }
+func (m *ValidatorEpochRewards) String() string { return proto.CompactTextString(m) }
+func (*ValidatorEpochRewards) ProtoMessage()    {}
+func (*ValidatorEpochRewards) Descriptor() ([]byte, []int) {
 	return fileDescriptor_9eb4e94b85965285, []int{12}
 }
-func (m *ChainStartResponse) XXX_Unmarshal(b []byte) error {
+func (m *ValidatorEpochRewards) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *ChainStartResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *ValidatorEpochRewards) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_ChainStartResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_ValidatorEpochRewards.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
-		n, err := m.MarshalTo(b)
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
 			return nil, err
 		}
 		return b[:n], nil
 	}
 }
-func (m *ChainStartResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ChainStartResponse.Merge(m, src)
+func (m *ValidatorEpochRewards) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ValidatorEpochRewards.Merge(m, src)
 }
-func (m *ChainStartResponse) XXX_Size() int {
+func (m *ValidatorEpochRewards) XXX_Size() int {
 	return m.Size()
 }
-func (m *ChainStartResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_ChainStartResponse.DiscardUnknown(m)
+func (m *ValidatorEpochRewards) XXX_DiscardUnknown() {
+	xxx_messageInfo_ValidatorEpochRewards.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ChainStartResponse proto.InternalMessageInfo
+var xxx_messageInfo_ValidatorEpochRewards proto.InternalMessageInfo
 
-func (m *ChainStartResponse) GetStarted() bool {
+func (m *ValidatorEpochRewards) GetEpoch() uint64 {
 	if m != nil {
-		return m.Started
+		return m.Epoch
 	}
-	return false
+	return 0
 }
 
-func (m *ChainStartResponse) GetGenesisTime() uint64 {
+func (m *ValidatorEpochRewards) GetValidatorIndex() uint64 {
 	if m != nil {
-		return m.GenesisTime
+		return m.ValidatorIndex
 	}
 	return 0
 }
 
-type ValidatorIndexRequest struct {
-	PublicKey            []byte   `protobuf:"bytes,1,opt,name=public_key,json=publicKey,proto3" json:"public_key,omitempty"`
+func (m *ValidatorEpochRewards) GetSourceReward() uint64 {
+	if m != nil {
+		return m.SourceReward
+	}
+	return 0
+}
+
+func (m *ValidatorEpochRewards) GetTargetReward() uint64 {
+	if m != nil {
+		return m.TargetReward
+	}
+	return 0
+}
+
+func (m *ValidatorEpochRewards) GetHeadReward() uint64 {
+	if m != nil {
+		return m.HeadReward
+	}
+	return 0
+}
+
+func (m *ValidatorEpochRewards) GetInclusionDelayReward() uint64 {
+	if m != nil {
+		return m.InclusionDelayReward
+	}
+	return 0
+}
+
+func (m *ValidatorEpochRewards) GetProposerReward() uint64 {
+	if m != nil {
+		return m.ProposerReward
+	}
+	return 0
+}
+
+func (m *ValidatorEpochRewards) GetSourcePenalty() uint64 {
+	if m != nil {
+		return m.SourcePenalty
+	}
+	return 0
+}
+
+func (m *ValidatorEpochRewards) GetTargetPenalty() uint64 {
+	if m != nil {
+		return m.TargetPenalty
+	}
+	return 0
+}
+
+func (m *ValidatorEpochRewards) GetHeadPenalty() uint64 {
+	if m != nil {
+		return m.HeadPenalty
+	}
+	return 0
+}
+
+func (m *ValidatorEpochRewards) GetInactivityPenalty() uint64 {
+	if m != nil {
+		return m.InactivityPenalty
+	}
+	return 0
+}
+
+func (m *ValidatorEpochRewards) GetSlashingPenalty() uint64 {
+	if m != nil {
+		return m.SlashingPenalty
+	}
+	return 0
+}
+
+type StreamExportsRequest struct {
+	Topics               []string `protobuf:"bytes,1,rep,name=topics,proto3" json:"topics,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *ValidatorIndexRequest) Reset()         { *m = ValidatorIndexRequest{} }
-func (m *ValidatorIndexRequest) String() string { return proto.CompactTextString(m) }
-func (*ValidatorIndexRequest) ProtoMessage()    {}
-func (*ValidatorIndexRequest) Descriptor() ([]byte, []int) {
+func (m *StreamExportsRequest) Reset()         { *m = StreamExportsRequest{} }
+func (m *StreamExportsRequest) String() string { return proto.CompactTextString(m) }
+func (*StreamExportsRequest) ProtoMessage()    {}
+func (*StreamExportsRequest) Descriptor() ([]byte, []int) {
 	return fileDescriptor_9eb4e94b85965285, []int{13}
 }
-func (m *ValidatorIndexRequest) XXX_Unmarshal(b []byte) error {
+func (m *StreamExportsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *ValidatorIndexRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *StreamExportsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_ValidatorIndexRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_StreamExportsRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
-		n, err := m.MarshalTo(b)
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
 			return nil, err
 		}
 		return b[:n], nil
 	}
 }
-func (m *ValidatorIndexRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ValidatorIndexRequest.Merge(m, src)
+func (m *StreamExportsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StreamExportsRequest.Merge(m, src)
 }
-func (m *ValidatorIndexRequest) XXX_Size() int {
+func (m *StreamExportsRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *ValidatorIndexRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_ValidatorIndexRequest.DiscardUnknown(m)
+func (m *StreamExportsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_StreamExportsRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ValidatorIndexRequest proto.InternalMessageInfo
+var xxx_messageInfo_StreamExportsRequest proto.InternalMessageInfo
 
-func (m *ValidatorIndexRequest) GetPublicKey() []byte {
+func (m *StreamExportsRequest) GetTopics() []string {
 	if m != nil {
-		return m.PublicKey
+		return m.Topics
 	}
 	return nil
 }
 
-type ValidatorIndexResponse struct {
-	Index                uint64   `protobuf:"varint,1,opt,name=index,proto3" json:"index,omitempty"`
+type ExportedObject struct {
+	Topic                string   `protobuf:"bytes,1,opt,name=topic,proto3" json:"topic,omitempty"`
+	Object               []byte   `protobuf:"bytes,2,opt,name=object,proto3" json:"object,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *ValidatorIndexResponse) Reset()         { *m = ValidatorIndexResponse{} }
-func (m *ValidatorIndexResponse) String() string { return proto.CompactTextString(m) }
-func (*ValidatorIndexResponse) ProtoMessage()    {}
-func (*ValidatorIndexResponse) Descriptor() ([]byte, []int) {
+func (m *ExportedObject) Reset()         { *m = ExportedObject{} }
+func (m *ExportedObject) String() string { return proto.CompactTextString(m) }
+func (*ExportedObject) ProtoMessage()    {}
+func (*ExportedObject) Descriptor() ([]byte, []int) {
 	return fileDescriptor_9eb4e94b85965285, []int{14}
 }
-func (m *ValidatorIndexResponse) XXX_Unmarshal(b []byte) error {
+func (m *ExportedObject) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *ValidatorIndexResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *ExportedObject) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_ValidatorIndexResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_ExportedObject.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
-		n, err := m.MarshalTo(b)
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
 			return nil, err
 		}
 		return b[:n], nil
 	}
 }
-func (m *ValidatorIndexResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ValidatorIndexResponse.Merge(m, src)
+func (m *ExportedObject) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ExportedObject.Merge(m, src)
 }
-func (m *ValidatorIndexResponse) XXX_Size() int {
+func (m *ExportedObject) XXX_Size() int {
 	return m.Size()
 }
-func (m *ValidatorIndexResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_ValidatorIndexResponse.DiscardUnknown(m)
+func (m *ExportedObject) XXX_DiscardUnknown() {
+	xxx_messageInfo_ExportedObject.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ValidatorIndexResponse proto.InternalMessageInfo
+var xxx_messageInfo_ExportedObject proto.InternalMessageInfo
 
-func (m *ValidatorIndexResponse) GetIndex() uint64 {
+func (m *ExportedObject) GetTopic() string {
 	if m != nil {
-		return m.Index
+		return m.Topic
 	}
-	return 0
+	return ""
 }
 
-type AssignmentRequest struct {
-	EpochStart           uint64   `protobuf:"varint,1,opt,name=epoch_start,json=epochStart,proto3" json:"epoch_start,omitempty"`
+func (m *ExportedObject) GetObject() []byte {
+	if m != nil {
+		return m.Object
+	}
+	return nil
+}
+
+type ValidatorPerformanceRequest struct {
+	Slot                 uint64   `protobuf:"varint,1,opt,name=slot,proto3" json:"slot,omitempty"`
 	PublicKeys           [][]byte `protobuf:"bytes,2,rep,name=public_keys,json=publicKeys,proto3" json:"public_keys,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *AssignmentRequest) Reset()         { *m = AssignmentRequest{} }
-func (m *AssignmentRequest) String() string { return proto.CompactTextString(m) }
-func (*AssignmentRequest) ProtoMessage()    {}
-func (*AssignmentRequest) Descriptor() ([]byte, []int) {
+func (m *ValidatorPerformanceRequest) Reset()         { *m = ValidatorPerformanceRequest{} }
+func (m *ValidatorPerformanceRequest) String() string { return proto.CompactTextString(m) }
+func (*ValidatorPerformanceRequest) ProtoMessage()    {}
+func (*ValidatorPerformanceRequest) Descriptor() ([]byte, []int) {
 	return fileDescriptor_9eb4e94b85965285, []int{15}
 }
-func (m *AssignmentRequest) XXX_Unmarshal(b []byte) error {
+func (m *ValidatorPerformanceRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *AssignmentRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *ValidatorPerformanceRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_AssignmentRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_ValidatorPerformanceRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
-		n, err := m.MarshalTo(b)
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
 			return nil, err
 		}
 		return b[:n], nil
 	}
 }
-func (m *AssignmentRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_AssignmentRequest.Merge(m, src)
+func (m *ValidatorPerformanceRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ValidatorPerformanceRequest.Merge(m, src)
 }
-func (m *AssignmentRequest) XXX_Size() int {
+func (m *ValidatorPerformanceRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *AssignmentRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_AssignmentRequest.DiscardUnknown(m)
+func (m *ValidatorPerformanceRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ValidatorPerformanceRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_AssignmentRequest proto.InternalMessageInfo
+var xxx_messageInfo_ValidatorPerformanceRequest proto.InternalMessageInfo
 
-func (m *AssignmentRequest) GetEpochStart() uint64 {
+func (m *ValidatorPerformanceRequest) GetSlot() uint64 {
 	if m != nil {
-		return m.EpochStart
+		return m.Slot
 	}
 	return 0
 }
 
-func (m *AssignmentRequest) GetPublicKeys() [][]byte {
+func (m *ValidatorPerformanceRequest) GetPublicKeys() [][]byte {
 	if m != nil {
 		return m.PublicKeys
 	}
 	return nil
 }
 
-type AssignmentResponse struct {
-	ValidatorAssignment  []*AssignmentResponse_ValidatorAssignment `protobuf:"bytes,1,rep,name=validator_assignment,json=validatorAssignment,proto3" json:"validator_assignment,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}                                  `json:"-"`
-	XXX_unrecognized     []byte                                    `json:"-"`
-	XXX_sizecache        int32                                     `json:"-"`
+type ValidatorPerformanceResponse struct {
+	Balances                      []uint64 `protobuf:"varint,1,rep,packed,name=balances,proto3" json:"balances,omitempty"`
+	TotalValidators               uint64   `protobuf:"varint,2,opt,name=total_validators,json=totalValidators,proto3" json:"total_validators,omitempty"`
+	TotalActiveValidators         uint64   `protobuf:"varint,3,opt,name=total_active_validators,json=totalActiveValidators,proto3" json:"total_active_validators,omitempty"`
+	MissingValidators             [][]byte `protobuf:"bytes,4,rep,name=missing_validators,json=missingValidators,proto3" json:"missing_validators,omitempty"`
+	AverageActiveValidatorBalance float32  `protobuf:"fixed32,5,opt,name=average_active_validator_balance,json=averageActiveValidatorBalance,proto3" json:"average_active_validator_balance,omitempty"`
+	XXX_NoUnkeyedLiteral          struct{} `json:"-"`
+	XXX_unrecognized              []byte   `json:"-"`
+	XXX_sizecache                 int32    `json:"-"`
 }
 
-func (m *AssignmentResponse) Reset()         { *m = AssignmentResponse{} }
-func (m *AssignmentResponse) String() string { return proto.CompactTextString(m) }
-func (*AssignmentResponse) ProtoMessage()    {}
-func (*AssignmentResponse) Descriptor() ([]byte, []int) {
+func (m *ValidatorPerformanceResponse) Reset()         { *m = ValidatorPerformanceResponse{} }
+func (m *ValidatorPerformanceResponse) String() string { return proto.CompactTextString(m) }
+func (*ValidatorPerformanceResponse) ProtoMessage()    {}
+func (*ValidatorPerformanceResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_9eb4e94b85965285, []int{16}
 }
-func (m *AssignmentResponse) XXX_Unmarshal(b []byte) error {
+func (m *ValidatorPerformanceResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *AssignmentResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *ValidatorPerformanceResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_AssignmentResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_ValidatorPerformanceResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
-		n, err := m.MarshalTo(b)
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
 			return nil, err
 		}
 		return b[:n], nil
 	}
 }
-func (m *AssignmentResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_AssignmentResponse.Merge(m, src)
+func (m *ValidatorPerformanceResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ValidatorPerformanceResponse.Merge(m, src)
 }
-func (m *AssignmentResponse) XXX_Size() int {
+func (m *ValidatorPerformanceResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *AssignmentResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_AssignmentResponse.DiscardUnknown(m)
+func (m *ValidatorPerformanceResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ValidatorPerformanceResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_AssignmentResponse proto.InternalMessageInfo
+var xxx_messageInfo_ValidatorPerformanceResponse proto.InternalMessageInfo
 
-func (m *AssignmentResponse) GetValidatorAssignment() []*AssignmentResponse_ValidatorAssignment {
+func (m *ValidatorPerformanceResponse) GetBalances() []uint64 {
 	if m != nil {
-		return m.ValidatorAssignment
+		return m.Balances
 	}
 	return nil
 }
 
-type AssignmentResponse_ValidatorAssignment struct {
-	Committee            []uint64        `protobuf:"varint,1,rep,packed,name=committee,proto3" json:"committee,omitempty"`
-	CommitteeIndex       uint64          `protobuf:"varint,2,opt,name=committee_index,json=committeeIndex,proto3" json:"committee_index,omitempty"`
-	AttesterSlot         uint64          `protobuf:"varint,3,opt,name=attester_slot,json=attesterSlot,proto3" json:"attester_slot,omitempty"`
-	ProposerSlot         uint64          `protobuf:"varint,4,opt,name=proposer_slot,json=proposerSlot,proto3" json:"proposer_slot,omitempty"`
-	PublicKey            []byte          `protobuf:"bytes,5,opt,name=public_key,json=publicKey,proto3" json:"public_key,omitempty"`
-	Status               ValidatorStatus `protobuf:"varint,6,opt,name=status,proto3,enum=ethereum.beacon.rpc.v1.ValidatorStatus" json:"status,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
-	XXX_unrecognized     []byte          `json:"-"`
-	XXX_sizecache        int32           `json:"-"`
+func (m *ValidatorPerformanceResponse) GetTotalValidators() uint64 {
+	if m != nil {
+		return m.TotalValidators
+	}
+	return 0
 }
 
-func (m *AssignmentResponse_ValidatorAssignment) Reset() {
-	*m = AssignmentResponse_ValidatorAssignment{}
+func (m *ValidatorPerformanceResponse) GetTotalActiveValidators() uint64 {
+	if m != nil {
+		return m.TotalActiveValidators
+	}
+	return 0
 }
-func (m *AssignmentResponse_ValidatorAssignment) String() string { return proto.CompactTextString(m) }
-func (*AssignmentResponse_ValidatorAssignment) ProtoMessage()    {}
-func (*AssignmentResponse_ValidatorAssignment) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9eb4e94b85965285, []int{16, 0}
+
+func (m *ValidatorPerformanceResponse) GetMissingValidators() [][]byte {
+	if m != nil {
+		return m.MissingValidators
+	}
+	return nil
 }
-func (m *AssignmentResponse_ValidatorAssignment) XXX_Unmarshal(b []byte) error {
+
+func (m *ValidatorPerformanceResponse) GetAverageActiveValidatorBalance() float32 {
+	if m != nil {
+		return m.AverageActiveValidatorBalance
+	}
+	return 0
+}
+
+type ValidatorActivationRequest struct {
+	PublicKeys           [][]byte `protobuf:"bytes,1,rep,name=public_keys,json=publicKeys,proto3" json:"public_keys,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ValidatorActivationRequest) Reset()         { *m = ValidatorActivationRequest{} }
+func (m *ValidatorActivationRequest) String() string { return proto.CompactTextString(m) }
+func (*ValidatorActivationRequest) ProtoMessage()    {}
+func (*ValidatorActivationRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9eb4e94b85965285, []int{17}
+}
+func (m *ValidatorActivationRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *AssignmentResponse_ValidatorAssignment) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *ValidatorActivationRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_AssignmentResponse_ValidatorAssignment.Marshal(b, m, deterministic)
+		return xxx_messageInfo_ValidatorActivationRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
-		n, err := m.MarshalTo(b)
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
 			return nil, err
 		}
 		return b[:n], nil
 	}
 }
-func (m *AssignmentResponse_ValidatorAssignment) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_AssignmentResponse_ValidatorAssignment.Merge(m, src)
+func (m *ValidatorActivationRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ValidatorActivationRequest.Merge(m, src)
 }
-func (m *AssignmentResponse_ValidatorAssignment) XXX_Size() int {
+func (m *ValidatorActivationRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *AssignmentResponse_ValidatorAssignment) XXX_DiscardUnknown() {
-	xxx_messageInfo_AssignmentResponse_ValidatorAssignment.DiscardUnknown(m)
+func (m *ValidatorActivationRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ValidatorActivationRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_AssignmentResponse_ValidatorAssignment proto.InternalMessageInfo
+var xxx_messageInfo_ValidatorActivationRequest proto.InternalMessageInfo
 
-func (m *AssignmentResponse_ValidatorAssignment) GetCommittee() []uint64 {
+func (m *ValidatorActivationRequest) GetPublicKeys() [][]byte {
 	if m != nil {
-		return m.Committee
+		return m.PublicKeys
 	}
 	return nil
 }
 
-func (m *AssignmentResponse_ValidatorAssignment) GetCommitteeIndex() uint64 {
-	if m != nil {
-		return m.CommitteeIndex
-	}
-	return 0
+type ValidatorActivationResponse struct {
+	ActivatedPublicKeys  [][]byte                              `protobuf:"bytes,1,rep,name=activated_public_keys,json=activatedPublicKeys,proto3" json:"activated_public_keys,omitempty"` // Deprecated: Do not use.
+	Statuses             []*ValidatorActivationResponse_Status `protobuf:"bytes,2,rep,name=statuses,proto3" json:"statuses,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                              `json:"-"`
+	XXX_unrecognized     []byte                                `json:"-"`
+	XXX_sizecache        int32                                 `json:"-"`
 }
 
-func (m *AssignmentResponse_ValidatorAssignment) GetAttesterSlot() uint64 {
-	if m != nil {
-		return m.AttesterSlot
-	}
-	return 0
+func (m *ValidatorActivationResponse) Reset()         { *m = ValidatorActivationResponse{} }
+func (m *ValidatorActivationResponse) String() string { return proto.CompactTextString(m) }
+func (*ValidatorActivationResponse) ProtoMessage()    {}
+func (*ValidatorActivationResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9eb4e94b85965285, []int{18}
 }
-
-func (m *AssignmentResponse_ValidatorAssignment) GetProposerSlot() uint64 {
-	if m != nil {
-		return m.ProposerSlot
+func (m *ValidatorActivationResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ValidatorActivationResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ValidatorActivationResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return 0
+}
+func (m *ValidatorActivationResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ValidatorActivationResponse.Merge(m, src)
+}
+func (m *ValidatorActivationResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *ValidatorActivationResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ValidatorActivationResponse.DiscardUnknown(m)
 }
 
-func (m *AssignmentResponse_ValidatorAssignment) GetPublicKey() []byte {
+var xxx_messageInfo_ValidatorActivationResponse proto.InternalMessageInfo
+
+// Deprecated: Do not use.
+func (m *ValidatorActivationResponse) GetActivatedPublicKeys() [][]byte {
 	if m != nil {
-		return m.PublicKey
+		return m.ActivatedPublicKeys
 	}
 	return nil
 }
 
-func (m *AssignmentResponse_ValidatorAssignment) GetStatus() ValidatorStatus {
+func (m *ValidatorActivationResponse) GetStatuses() []*ValidatorActivationResponse_Status {
 	if m != nil {
-		return m.Status
+		return m.Statuses
 	}
-	return ValidatorStatus_UNKNOWN_STATUS
+	return nil
 }
 
-type ValidatorStatusResponse struct {
-	Status                    ValidatorStatus `protobuf:"varint,1,opt,name=status,proto3,enum=ethereum.beacon.rpc.v1.ValidatorStatus" json:"status,omitempty"`
-	Eth1DepositBlockNumber    uint64          `protobuf:"varint,2,opt,name=eth1_deposit_block_number,json=eth1DepositBlockNumber,proto3" json:"eth1_deposit_block_number,omitempty"`
-	DepositInclusionSlot      uint64          `protobuf:"varint,3,opt,name=deposit_inclusion_slot,json=depositInclusionSlot,proto3" json:"deposit_inclusion_slot,omitempty"`
-	ActivationEpoch           uint64          `protobuf:"varint,4,opt,name=activation_epoch,json=activationEpoch,proto3" json:"activation_epoch,omitempty"`
-	PositionInActivationQueue uint64          `protobuf:"varint,5,opt,name=position_in_activation_queue,json=positionInActivationQueue,proto3" json:"position_in_activation_queue,omitempty"`
-	XXX_NoUnkeyedLiteral      struct{}        `json:"-"`
-	XXX_unrecognized          []byte          `json:"-"`
-	XXX_sizecache             int32           `json:"-"`
+type ValidatorActivationResponse_Status struct {
+	PublicKey            []byte                   `protobuf:"bytes,1,opt,name=public_key,json=publicKey,proto3" json:"public_key,omitempty"`
+	Status               *ValidatorStatusResponse `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                 `json:"-"`
+	XXX_unrecognized     []byte                   `json:"-"`
+	XXX_sizecache        int32                    `json:"-"`
 }
 
-func (m *ValidatorStatusResponse) Reset()         { *m = ValidatorStatusResponse{} }
-func (m *ValidatorStatusResponse) String() string { return proto.CompactTextString(m) }
-func (*ValidatorStatusResponse) ProtoMessage()    {}
-func (*ValidatorStatusResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9eb4e94b85965285, []int{17}
+func (m *ValidatorActivationResponse_Status) Reset()         { *m = ValidatorActivationResponse_Status{} }
+func (m *ValidatorActivationResponse_Status) String() string { return proto.CompactTextString(m) }
+func (*ValidatorActivationResponse_Status) ProtoMessage()    {}
+func (*ValidatorActivationResponse_Status) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9eb4e94b85965285, []int{18, 0}
 }
-func (m *ValidatorStatusResponse) XXX_Unmarshal(b []byte) error {
+func (m *ValidatorActivationResponse_Status) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *ValidatorStatusResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *ValidatorActivationResponse_Status) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_ValidatorStatusResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_ValidatorActivationResponse_Status.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
-		n, err := m.MarshalTo(b)
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
 			return nil, err
 		}
 		return b[:n], nil
 	}
 }
-func (m *ValidatorStatusResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ValidatorStatusResponse.Merge(m, src)
+func (m *ValidatorActivationResponse_Status) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ValidatorActivationResponse_Status.Merge(m, src)
 }
-func (m *ValidatorStatusResponse) XXX_Size() int {
+func (m *ValidatorActivationResponse_Status) XXX_Size() int {
 	return m.Size()
 }
-func (m *ValidatorStatusResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_ValidatorStatusResponse.DiscardUnknown(m)
-}
-
-var xxx_messageInfo_ValidatorStatusResponse proto.InternalMessageInfo
-
-func (m *ValidatorStatusResponse) GetStatus() ValidatorStatus {
-	if m != nil {
-		return m.Status
-	}
-	return ValidatorStatus_UNKNOWN_STATUS
-}
-
-func (m *ValidatorStatusResponse) GetEth1DepositBlockNumber() uint64 {
-	if m != nil {
-		return m.Eth1DepositBlockNumber
-	}
-	return 0
+func (m *ValidatorActivationResponse_Status) XXX_DiscardUnknown() {
+	xxx_messageInfo_ValidatorActivationResponse_Status.DiscardUnknown(m)
 }
 
-func (m *ValidatorStatusResponse) GetDepositInclusionSlot() uint64 {
-	if m != nil {
-		return m.DepositInclusionSlot
-	}
-	return 0
-}
+var xxx_messageInfo_ValidatorActivationResponse_Status proto.InternalMessageInfo
 
-func (m *ValidatorStatusResponse) GetActivationEpoch() uint64 {
+func (m *ValidatorActivationResponse_Status) GetPublicKey() []byte {
 	if m != nil {
-		return m.ActivationEpoch
+		return m.PublicKey
 	}
-	return 0
+	return nil
 }
 
-func (m *ValidatorStatusResponse) GetPositionInActivationQueue() uint64 {
+func (m *ValidatorActivationResponse_Status) GetStatus() *ValidatorStatusResponse {
 	if m != nil {
-		return m.PositionInActivationQueue
+		return m.Status
 	}
-	return 0
+	return nil
 }
 
-type DomainRequest struct {
-	Epoch                uint64   `protobuf:"varint,1,opt,name=epoch,proto3" json:"epoch,omitempty"`
-	Domain               []byte   `protobuf:"bytes,2,opt,name=domain,proto3" json:"domain,omitempty"`
+type ExitedValidatorsRequest struct {
+	PublicKeys           [][]byte `protobuf:"bytes,1,rep,name=public_keys,json=publicKeys,proto3" json:"public_keys,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *DomainRequest) Reset()         { *m = DomainRequest{} }
-func (m *DomainRequest) String() string { return proto.CompactTextString(m) }
-func (*DomainRequest) ProtoMessage()    {}
-func (*DomainRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9eb4e94b85965285, []int{18}
+func (m *ExitedValidatorsRequest) Reset()         { *m = ExitedValidatorsRequest{} }
+func (m *ExitedValidatorsRequest) String() string { return proto.CompactTextString(m) }
+func (*ExitedValidatorsRequest) ProtoMessage()    {}
+func (*ExitedValidatorsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9eb4e94b85965285, []int{19}
 }
-func (m *DomainRequest) XXX_Unmarshal(b []byte) error {
+func (m *ExitedValidatorsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *DomainRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *ExitedValidatorsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_DomainRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_ExitedValidatorsRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
-		n, err := m.MarshalTo(b)
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
 			return nil, err
 		}
 		return b[:n], nil
 	}
 }
-func (m *DomainRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_DomainRequest.Merge(m, src)
+func (m *ExitedValidatorsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ExitedValidatorsRequest.Merge(m, src)
 }
-func (m *DomainRequest) XXX_Size() int {
+func (m *ExitedValidatorsRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *DomainRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_DomainRequest.DiscardUnknown(m)
+func (m *ExitedValidatorsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ExitedValidatorsRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_DomainRequest proto.InternalMessageInfo
-
-func (m *DomainRequest) GetEpoch() uint64 {
-	if m != nil {
-		return m.Epoch
-	}
-	return 0
-}
+var xxx_messageInfo_ExitedValidatorsRequest proto.InternalMessageInfo
 
-func (m *DomainRequest) GetDomain() []byte {
+func (m *ExitedValidatorsRequest) GetPublicKeys() [][]byte {
 	if m != nil {
-		return m.Domain
+		return m.PublicKeys
 	}
 	return nil
 }
 
-type DomainResponse struct {
-	SignatureDomain      uint64   `protobuf:"varint,1,opt,name=signature_domain,json=signatureDomain,proto3" json:"signature_domain,omitempty"`
+type ExitedValidatorsResponse struct {
+	PublicKeys           [][]byte `protobuf:"bytes,1,rep,name=public_keys,json=publicKeys,proto3" json:"public_keys,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *DomainResponse) Reset()         { *m = DomainResponse{} }
-func (m *DomainResponse) String() string { return proto.CompactTextString(m) }
-func (*DomainResponse) ProtoMessage()    {}
-func (*DomainResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9eb4e94b85965285, []int{19}
+func (m *ExitedValidatorsResponse) Reset()         { *m = ExitedValidatorsResponse{} }
+func (m *ExitedValidatorsResponse) String() string { return proto.CompactTextString(m) }
+func (*ExitedValidatorsResponse) ProtoMessage()    {}
+func (*ExitedValidatorsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9eb4e94b85965285, []int{20}
 }
-func (m *DomainResponse) XXX_Unmarshal(b []byte) error {
+func (m *ExitedValidatorsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *DomainResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *ExitedValidatorsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_DomainResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_ExitedValidatorsResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
-		n, err := m.MarshalTo(b)
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
 			return nil, err
 		}
 		return b[:n], nil
 	}
 }
-func (m *DomainResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_DomainResponse.Merge(m, src)
+func (m *ExitedValidatorsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ExitedValidatorsResponse.Merge(m, src)
 }
-func (m *DomainResponse) XXX_Size() int {
+func (m *ExitedValidatorsResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *DomainResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_DomainResponse.DiscardUnknown(m)
+func (m *ExitedValidatorsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ExitedValidatorsResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_DomainResponse proto.InternalMessageInfo
+var xxx_messageInfo_ExitedValidatorsResponse proto.InternalMessageInfo
 
-func (m *DomainResponse) GetSignatureDomain() uint64 {
+func (m *ExitedValidatorsResponse) GetPublicKeys() [][]byte {
 	if m != nil {
-		return m.SignatureDomain
+		return m.PublicKeys
 	}
-	return 0
+	return nil
 }
 
-type BlockTreeResponse struct {
-	Tree                 []*BlockTreeResponse_TreeNode `protobuf:"bytes,1,rep,name=tree,proto3" json:"tree,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}                      `json:"-"`
-	XXX_unrecognized     []byte                        `json:"-"`
-	XXX_sizecache        int32                         `json:"-"`
+type ChainStartResponse struct {
+	Started              bool     `protobuf:"varint,1,opt,name=started,proto3" json:"started,omitempty"`
+	GenesisTime          uint64   `protobuf:"varint,2,opt,name=genesis_time,json=genesisTime,proto3" json:"genesis_time,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *BlockTreeResponse) Reset()         { *m = BlockTreeResponse{} }
-func (m *BlockTreeResponse) String() string { return proto.CompactTextString(m) }
-func (*BlockTreeResponse) ProtoMessage()    {}
-func (*BlockTreeResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9eb4e94b85965285, []int{20}
+func (m *ChainStartResponse) Reset()         { *m = ChainStartResponse{} }
+func (m *ChainStartResponse) String() string { return proto.CompactTextString(m) }
+func (*ChainStartResponse) ProtoMessage()    {}
+func (*ChainStartResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9eb4e94b85965285, []int{21}
 }
-func (m *BlockTreeResponse) XXX_Unmarshal(b []byte) error {
+func (m *ChainStartResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *BlockTreeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *ChainStartResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_BlockTreeResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_ChainStartResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
-		n, err := m.MarshalTo(b)
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
 			return nil, err
 		}
 		return b[:n], nil
 	}
 }
-func (m *BlockTreeResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_BlockTreeResponse.Merge(m, src)
+func (m *ChainStartResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ChainStartResponse.Merge(m, src)
 }
-func (m *BlockTreeResponse) XXX_Size() int {
+func (m *ChainStartResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *BlockTreeResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_BlockTreeResponse.DiscardUnknown(m)
+func (m *ChainStartResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ChainStartResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_BlockTreeResponse proto.InternalMessageInfo
+var xxx_messageInfo_ChainStartResponse proto.InternalMessageInfo
 
-func (m *BlockTreeResponse) GetTree() []*BlockTreeResponse_TreeNode {
+func (m *ChainStartResponse) GetStarted() bool {
 	if m != nil {
-		return m.Tree
+		return m.Started
 	}
-	return nil
+	return false
 }
 
-type BlockTreeResponse_TreeNode struct {
-	Block                *v1alpha1.BeaconBlock `protobuf:"bytes,1,opt,name=block,proto3" json:"block,omitempty"`
-	BlockRoot            []byte                `protobuf:"bytes,2,opt,name=block_root,json=blockRoot,proto3" json:"block_root,omitempty"`
-	ParticipatedVotes    uint64                `protobuf:"varint,3,opt,name=participated_votes,json=participatedVotes,proto3" json:"participated_votes,omitempty"`
-	TotalVotes           uint64                `protobuf:"varint,4,opt,name=total_votes,json=totalVotes,proto3" json:"total_votes,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
-	XXX_unrecognized     []byte                `json:"-"`
-	XXX_sizecache        int32                 `json:"-"`
+func (m *ChainStartResponse) GetGenesisTime() uint64 {
+	if m != nil {
+		return m.GenesisTime
+	}
+	return 0
 }
 
-func (m *BlockTreeResponse_TreeNode) Reset()         { *m = BlockTreeResponse_TreeNode{} }
-func (m *BlockTreeResponse_TreeNode) String() string { return proto.CompactTextString(m) }
-func (*BlockTreeResponse_TreeNode) ProtoMessage()    {}
-func (*BlockTreeResponse_TreeNode) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9eb4e94b85965285, []int{20, 0}
+type ValidatorIndexRequest struct {
+	PublicKey            []byte   `protobuf:"bytes,1,opt,name=public_key,json=publicKey,proto3" json:"public_key,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
-func (m *BlockTreeResponse_TreeNode) XXX_Unmarshal(b []byte) error {
+
+func (m *ValidatorIndexRequest) Reset()         { *m = ValidatorIndexRequest{} }
+func (m *ValidatorIndexRequest) String() string { return proto.CompactTextString(m) }
+func (*ValidatorIndexRequest) ProtoMessage()    {}
+func (*ValidatorIndexRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9eb4e94b85965285, []int{22}
+}
+func (m *ValidatorIndexRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *BlockTreeResponse_TreeNode) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *ValidatorIndexRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_BlockTreeResponse_TreeNode.Marshal(b, m, deterministic)
+		return xxx_messageInfo_ValidatorIndexRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
-		n, err := m.MarshalTo(b)
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
 			return nil, err
 		}
 		return b[:n], nil
 	}
 }
-func (m *BlockTreeResponse_TreeNode) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_BlockTreeResponse_TreeNode.Merge(m, src)
+func (m *ValidatorIndexRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ValidatorIndexRequest.Merge(m, src)
 }
-func (m *BlockTreeResponse_TreeNode) XXX_Size() int {
+func (m *ValidatorIndexRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *BlockTreeResponse_TreeNode) XXX_DiscardUnknown() {
-	xxx_messageInfo_BlockTreeResponse_TreeNode.DiscardUnknown(m)
+func (m *ValidatorIndexRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ValidatorIndexRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_BlockTreeResponse_TreeNode proto.InternalMessageInfo
+var xxx_messageInfo_ValidatorIndexRequest proto.InternalMessageInfo
 
-func (m *BlockTreeResponse_TreeNode) GetBlock() *v1alpha1.BeaconBlock {
+func (m *ValidatorIndexRequest) GetPublicKey() []byte {
 	if m != nil {
-		return m.Block
+		return m.PublicKey
 	}
 	return nil
 }
 
-func (m *BlockTreeResponse_TreeNode) GetBlockRoot() []byte {
-	if m != nil {
-		return m.BlockRoot
-	}
-	return nil
+type ValidatorIndexResponse struct {
+	Index                uint64   `protobuf:"varint,1,opt,name=index,proto3" json:"index,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *BlockTreeResponse_TreeNode) GetParticipatedVotes() uint64 {
-	if m != nil {
-		return m.ParticipatedVotes
+func (m *ValidatorIndexResponse) Reset()         { *m = ValidatorIndexResponse{} }
+func (m *ValidatorIndexResponse) String() string { return proto.CompactTextString(m) }
+func (*ValidatorIndexResponse) ProtoMessage()    {}
+func (*ValidatorIndexResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9eb4e94b85965285, []int{23}
+}
+func (m *ValidatorIndexResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ValidatorIndexResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ValidatorIndexResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return 0
+}
+func (m *ValidatorIndexResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ValidatorIndexResponse.Merge(m, src)
+}
+func (m *ValidatorIndexResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *ValidatorIndexResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ValidatorIndexResponse.DiscardUnknown(m)
 }
 
-func (m *BlockTreeResponse_TreeNode) GetTotalVotes() uint64 {
+var xxx_messageInfo_ValidatorIndexResponse proto.InternalMessageInfo
+
+func (m *ValidatorIndexResponse) GetIndex() uint64 {
 	if m != nil {
-		return m.TotalVotes
+		return m.Index
 	}
 	return 0
 }
 
-type TreeBlockSlotRequest struct {
-	SlotFrom             uint64   `protobuf:"varint,1,opt,name=slot_from,json=slotFrom,proto3" json:"slot_from,omitempty"`
-	SlotTo               uint64   `protobuf:"varint,2,opt,name=slot_to,json=slotTo,proto3" json:"slot_to,omitempty"`
+type AssignmentRequest struct {
+	EpochStart           uint64   `protobuf:"varint,1,opt,name=epoch_start,json=epochStart,proto3" json:"epoch_start,omitempty"`
+	PublicKeys           [][]byte `protobuf:"bytes,2,rep,name=public_keys,json=publicKeys,proto3" json:"public_keys,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *TreeBlockSlotRequest) Reset()         { *m = TreeBlockSlotRequest{} }
-func (m *TreeBlockSlotRequest) String() string { return proto.CompactTextString(m) }
-func (*TreeBlockSlotRequest) ProtoMessage()    {}
-func (*TreeBlockSlotRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9eb4e94b85965285, []int{21}
+func (m *AssignmentRequest) Reset()         { *m = AssignmentRequest{} }
+func (m *AssignmentRequest) String() string { return proto.CompactTextString(m) }
+func (*AssignmentRequest) ProtoMessage()    {}
+func (*AssignmentRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9eb4e94b85965285, []int{24}
 }
-func (m *TreeBlockSlotRequest) XXX_Unmarshal(b []byte) error {
+func (m *AssignmentRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *TreeBlockSlotRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *AssignmentRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_TreeBlockSlotRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_AssignmentRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
-		n, err := m.MarshalTo(b)
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
 			return nil, err
 		}
 		return b[:n], nil
 	}
 }
-func (m *TreeBlockSlotRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_TreeBlockSlotRequest.Merge(m, src)
+func (m *AssignmentRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AssignmentRequest.Merge(m, src)
 }
-func (m *TreeBlockSlotRequest) XXX_Size() int {
+func (m *AssignmentRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *TreeBlockSlotRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_TreeBlockSlotRequest.DiscardUnknown(m)
+func (m *AssignmentRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_AssignmentRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_TreeBlockSlotRequest proto.InternalMessageInfo
+var xxx_messageInfo_AssignmentRequest proto.InternalMessageInfo
 
-func (m *TreeBlockSlotRequest) GetSlotFrom() uint64 {
+func (m *AssignmentRequest) GetEpochStart() uint64 {
 	if m != nil {
-		return m.SlotFrom
+		return m.EpochStart
 	}
 	return 0
 }
 
-func (m *TreeBlockSlotRequest) GetSlotTo() uint64 {
+func (m *AssignmentRequest) GetPublicKeys() [][]byte {
 	if m != nil {
-		return m.SlotTo
+		return m.PublicKeys
 	}
-	return 0
+	return nil
 }
 
-func init() {
-	proto.RegisterEnum("ethereum.beacon.rpc.v1.ValidatorRole", ValidatorRole_name, ValidatorRole_value)
-	proto.RegisterEnum("ethereum.beacon.rpc.v1.ValidatorStatus", ValidatorStatus_name, ValidatorStatus_value)
-	proto.RegisterType((*BlockRequest)(nil), "ethereum.beacon.rpc.v1.BlockRequest")
-	proto.RegisterType((*ProposeResponse)(nil), "ethereum.beacon.rpc.v1.ProposeResponse")
-	proto.RegisterType((*AttestationRequest)(nil), "ethereum.beacon.rpc.v1.AttestationRequest")
-	proto.RegisterType((*AttestResponse)(nil), "ethereum.beacon.rpc.v1.AttestResponse")
-	proto.RegisterType((*AggregationRequest)(nil), "ethereum.beacon.rpc.v1.AggregationRequest")
-	proto.RegisterType((*AggregationResponse)(nil), "ethereum.beacon.rpc.v1.AggregationResponse")
-	proto.RegisterType((*ValidatorPerformanceRequest)(nil), "ethereum.beacon.rpc.v1.ValidatorPerformanceRequest")
-	proto.RegisterType((*ValidatorPerformanceResponse)(nil), "ethereum.beacon.rpc.v1.ValidatorPerformanceResponse")
-	proto.RegisterType((*ValidatorActivationRequest)(nil), "ethereum.beacon.rpc.v1.ValidatorActivationRequest")
-	proto.RegisterType((*ValidatorActivationResponse)(nil), "ethereum.beacon.rpc.v1.ValidatorActivationResponse")
-	proto.RegisterType((*ValidatorActivationResponse_Status)(nil), "ethereum.beacon.rpc.v1.ValidatorActivationResponse.Status")
-	proto.RegisterType((*ExitedValidatorsRequest)(nil), "ethereum.beacon.rpc.v1.ExitedValidatorsRequest")
-	proto.RegisterType((*ExitedValidatorsResponse)(nil), "ethereum.beacon.rpc.v1.ExitedValidatorsResponse")
-	proto.RegisterType((*ChainStartResponse)(nil), "ethereum.beacon.rpc.v1.ChainStartResponse")
-	proto.RegisterType((*ValidatorIndexRequest)(nil), "ethereum.beacon.rpc.v1.ValidatorIndexRequest")
-	proto.RegisterType((*ValidatorIndexResponse)(nil), "ethereum.beacon.rpc.v1.ValidatorIndexResponse")
-	proto.RegisterType((*AssignmentRequest)(nil), "ethereum.beacon.rpc.v1.AssignmentRequest")
-	proto.RegisterType((*AssignmentResponse)(nil), "ethereum.beacon.rpc.v1.AssignmentResponse")
-	proto.RegisterType((*AssignmentResponse_ValidatorAssignment)(nil), "ethereum.beacon.rpc.v1.AssignmentResponse.ValidatorAssignment")
-	proto.RegisterType((*ValidatorStatusResponse)(nil), "ethereum.beacon.rpc.v1.ValidatorStatusResponse")
-	proto.RegisterType((*DomainRequest)(nil), "ethereum.beacon.rpc.v1.DomainRequest")
-	proto.RegisterType((*DomainResponse)(nil), "ethereum.beacon.rpc.v1.DomainResponse")
-	proto.RegisterType((*BlockTreeResponse)(nil), "ethereum.beacon.rpc.v1.BlockTreeResponse")
-	proto.RegisterType((*BlockTreeResponse_TreeNode)(nil), "ethereum.beacon.rpc.v1.BlockTreeResponse.TreeNode")
-	proto.RegisterType((*TreeBlockSlotRequest)(nil), "ethereum.beacon.rpc.v1.TreeBlockSlotRequest")
+type AssignmentResponse struct {
+	ValidatorAssignment  []*AssignmentResponse_ValidatorAssignment `protobuf:"bytes,1,rep,name=validator_assignment,json=validatorAssignment,proto3" json:"validator_assignment,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                                  `json:"-"`
+	XXX_unrecognized     []byte                                    `json:"-"`
+	XXX_sizecache        int32                                     `json:"-"`
 }
 
-func init() { proto.RegisterFile("proto/beacon/rpc/v1/services.proto", fileDescriptor_9eb4e94b85965285) }
-
-var fileDescriptor_9eb4e94b85965285 = []byte{
-	// 1653 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x58, 0x4f, 0x6f, 0xdb, 0x46,
-	0x16, 0x0f, 0x65, 0x59, 0x76, 0x9e, 0x64, 0x99, 0x1e, 0x3b, 0xb6, 0xa2, 0x24, 0x8e, 0x97, 0x49,
-	0x36, 0xb6, 0x17, 0x91, 0x6c, 0x25, 0x08, 0x76, 0x13, 0x64, 0x03, 0xd9, 0x62, 0x6c, 0x21, 0x81,
-	0xac, 0x50, 0x8a, 0x9d, 0x45, 0x0e, 0x04, 0x25, 0x8d, 0x25, 0x22, 0x12, 0x87, 0x26, 0x47, 0x42,
-	0x7c, 0x59, 0x60, 0x2f, 0x5b, 0xf4, 0xd6, 0x1e, 0x8a, 0x1e, 0x8b, 0x7e, 0x86, 0x1e, 0xfa, 0x15,
-	0x72, 0xec, 0x07, 0xe8, 0xa1, 0x08, 0xfa, 0x41, 0x0a, 0xce, 0x0c, 0x29, 0xea, 0x9f, 0x2d, 0xe7,
-	0xc6, 0x79, 0x7f, 0x7f, 0xf3, 0xde, 0x9b, 0x37, 0x6f, 0x08, 0x8a, 0xed, 0x10, 0x4a, 0xb2, 0x35,
-	0x6c, 0xd4, 0x89, 0x95, 0x75, 0xec, 0x7a, 0xb6, 0xb7, 0x9b, 0x75, 0xb1, 0xd3, 0x33, 0xeb, 0xd8,
-	0xcd, 0x30, 0x26, 0x5a, 0xc5, 0xb4, 0x85, 0x1d, 0xdc, 0xed, 0x64, 0xb8, 0x58, 0xc6, 0xb1, 0xeb,
-	0x99, 0xde, 0x6e, 0xfa, 0x56, 0x93, 0x90, 0x66, 0x1b, 0x67, 0x99, 0x54, 0xad, 0x7b, 0x9a, 0xc5,
-	0x1d, 0x9b, 0x9e, 0x73, 0xa5, 0xf4, 0x5d, 0x4c, 0x5b, 0xd9, 0xde, 0xae, 0xd1, 0xb6, 0x5b, 0xc6,
-	0xae, 0xb0, 0xaf, 0xd7, 0xda, 0xa4, 0xfe, 0x51, 0x08, 0xac, 0x0f, 0x08, 0x18, 0x94, 0x62, 0x97,
-	0x1a, 0xd4, 0x24, 0x16, 0xe7, 0x2b, 0x07, 0x90, 0xd8, 0xf3, 0xc4, 0x35, 0x7c, 0xd6, 0xc5, 0x2e,
-	0x45, 0x08, 0xa2, 0x6e, 0x9b, 0xd0, 0x94, 0xb4, 0x21, 0x6d, 0x46, 0x35, 0xf6, 0x8d, 0xee, 0xc1,
-	0x82, 0x63, 0x58, 0x0d, 0x83, 0xe8, 0x0e, 0xee, 0x61, 0xa3, 0x9d, 0x8a, 0x6c, 0x48, 0x9b, 0x09,
-	0x2d, 0xc1, 0x89, 0x1a, 0xa3, 0x29, 0x3b, 0xb0, 0x58, 0x76, 0x88, 0x4d, 0x5c, 0xac, 0x61, 0xd7,
-	0x26, 0x96, 0x8b, 0xd1, 0x1d, 0x00, 0x06, 0x45, 0x77, 0x88, 0xb0, 0x98, 0xd0, 0xae, 0x33, 0x8a,
-	0x46, 0x08, 0x55, 0xbe, 0x95, 0x00, 0xe5, 0xfb, 0x80, 0x7c, 0x04, 0x77, 0x00, 0xec, 0x6e, 0xad,
-	0x6d, 0xd6, 0xf5, 0x8f, 0xf8, 0xdc, 0xd7, 0xe2, 0x94, 0xd7, 0xf8, 0x1c, 0xad, 0xc1, 0x9c, 0x4d,
-	0xea, 0x7a, 0xcd, 0xa4, 0x02, 0x46, 0xcc, 0x26, 0xf5, 0x3d, 0xb3, 0x8f, 0x7c, 0x26, 0x84, 0xfc,
-	0x21, 0x2c, 0xd6, 0x49, 0xa7, 0x63, 0x52, 0x8a, 0xb1, 0x6e, 0x5a, 0x0d, 0xfc, 0x29, 0x15, 0x65,
-	0xec, 0x64, 0x40, 0x2e, 0x7a, 0x54, 0xe5, 0x3e, 0x24, 0x39, 0x94, 0x00, 0x3c, 0x82, 0x68, 0x08,
-	0x36, 0xfb, 0x56, 0x7e, 0xf4, 0x10, 0x37, 0x9b, 0x0e, 0x6e, 0x0e, 0x20, 0x1e, 0x17, 0xb3, 0x31,
-	0x9e, 0x23, 0xe3, 0x3c, 0x0f, 0x6d, 0x77, 0x66, 0x78, 0xbb, 0x0f, 0x20, 0xe9, 0xd9, 0xd3, 0x5d,
-	0xb3, 0x69, 0x19, 0xb4, 0xeb, 0x60, 0xb6, 0x81, 0x84, 0xb6, 0xe0, 0x51, 0x2b, 0x3e, 0x51, 0xd9,
-	0x82, 0xe5, 0x01, 0x60, 0x17, 0x6c, 0x42, 0x83, 0x5b, 0xc7, 0x46, 0xdb, 0x6c, 0x18, 0x94, 0x38,
-	0x65, 0xec, 0x9c, 0x12, 0xa7, 0x63, 0x58, 0x75, 0x7c, 0xd1, 0x66, 0xee, 0x42, 0xbc, 0x8f, 0xd1,
-	0x4d, 0x45, 0x36, 0x66, 0x36, 0x13, 0x1a, 0x04, 0x20, 0x5d, 0xe5, 0x87, 0x08, 0xdc, 0x1e, 0x6f,
-	0x54, 0x00, 0x49, 0xc3, 0x7c, 0xcd, 0x68, 0x7b, 0x24, 0x37, 0x25, 0x6d, 0xcc, 0x6c, 0x46, 0xb5,
-	0x60, 0x8d, 0xb6, 0x40, 0xa6, 0x84, 0x1a, 0x6d, 0xbd, 0xe7, 0x5b, 0x70, 0x45, 0xac, 0x16, 0x19,
-	0x3d, 0x30, 0xec, 0xa2, 0xa7, 0xb0, 0xc6, 0x45, 0x8d, 0x3a, 0x35, 0x7b, 0x38, 0xac, 0xc1, 0xd3,
-	0x7e, 0x83, 0xb1, 0xf3, 0x8c, 0x1b, 0xd2, 0x7b, 0x04, 0xa8, 0x63, 0xba, 0xae, 0x69, 0x35, 0xc3,
-	0x2a, 0x51, 0xb6, 0x8f, 0x25, 0xc1, 0x09, 0x89, 0x1f, 0xc0, 0x86, 0xd1, 0xc3, 0x8e, 0xd1, 0xc4,
-	0x23, 0x8e, 0x74, 0x01, 0x3b, 0x35, 0xbb, 0x21, 0x6d, 0x46, 0xb4, 0x3b, 0x42, 0x6e, 0xc8, 0xe3,
-	0x1e, 0x17, 0x52, 0x5e, 0x40, 0x3a, 0xa0, 0x31, 0x91, 0x81, 0xba, 0x19, 0x0a, 0xab, 0x34, 0x12,
-	0xd6, 0x9f, 0x22, 0xa1, 0x5c, 0x85, 0xf5, 0x45, 0x54, 0x9f, 0xc2, 0x0d, 0x83, 0x53, 0x71, 0x43,
-	0x1f, 0x31, 0xb5, 0x17, 0x49, 0x49, 0xda, 0x72, 0x20, 0x50, 0x0e, 0xec, 0xa2, 0x63, 0x98, 0xf7,
-	0x0e, 0x5d, 0xd7, 0xc5, 0x3c, 0x99, 0xf1, 0xdc, 0xb3, 0xcc, 0xf8, 0xee, 0x93, 0xb9, 0xc0, 0x7d,
-	0xa6, 0xc2, 0x6c, 0x68, 0x81, 0xad, 0xb4, 0x0d, 0x31, 0x4e, 0xbb, 0xec, 0x10, 0x1f, 0x40, 0x8c,
-	0x2b, 0xb1, 0x44, 0xc7, 0x73, 0xd9, 0x4b, 0xdd, 0x0b, 0x5f, 0xc2, 0xb5, 0x26, 0xd4, 0x95, 0x67,
-	0xb0, 0xa6, 0x7e, 0x32, 0x29, 0x6e, 0xf4, 0xb3, 0x37, 0x75, 0x74, 0x9f, 0x43, 0x6a, 0x54, 0x57,
-	0x44, 0xf6, 0x52, 0xe5, 0xb7, 0x80, 0xf6, 0x5b, 0x86, 0x69, 0x55, 0xa8, 0xe1, 0xf4, 0x9b, 0x46,
-	0x0a, 0xe6, 0x5c, 0x8f, 0x80, 0x1b, 0x6c, 0xcf, 0xf3, 0x9a, 0xbf, 0x44, 0x7f, 0x83, 0x44, 0x13,
-	0x5b, 0xd8, 0x35, 0x5d, 0x9d, 0x9a, 0x1d, 0x2c, 0x0a, 0x3c, 0x2e, 0x68, 0x55, 0xb3, 0x83, 0x95,
-	0xa7, 0x70, 0x23, 0x40, 0xc2, 0x7a, 0xc3, 0x74, 0x1d, 0x51, 0xc9, 0xc0, 0xea, 0xb0, 0x9e, 0x80,
-	0xb3, 0x02, 0xb3, 0xbc, 0xf5, 0xf0, 0xc3, 0xcc, 0x17, 0xca, 0x3b, 0x58, 0xca, 0xbb, 0x5e, 0x3f,
-	0xe9, 0x60, 0x8b, 0x86, 0xa2, 0x85, 0x6d, 0x52, 0x6f, 0xe9, 0x0c, 0xb0, 0x50, 0x00, 0x46, 0x62,
-	0x5b, 0xbc, 0xbc, 0x07, 0x7c, 0x37, 0x03, 0x28, 0x6c, 0x57, 0x60, 0x38, 0x83, 0x95, 0xfe, 0xe1,
-	0x31, 0x02, 0x3e, 0x0b, 0x69, 0x3c, 0xf7, 0xef, 0x49, 0x89, 0x1f, 0xb5, 0x14, 0x2a, 0xc5, 0x3e,
-	0x6f, 0xb9, 0x37, 0x4a, 0x4c, 0xff, 0x3f, 0x02, 0xcb, 0x63, 0x84, 0xd1, 0x6d, 0xb8, 0x1e, 0x34,
-	0x5f, 0xd1, 0x85, 0xfa, 0x84, 0xe9, 0x3b, 0xf6, 0x3d, 0x58, 0xe0, 0xf7, 0x28, 0x76, 0xf4, 0xd0,
-	0x8d, 0x93, 0xf0, 0x89, 0x15, 0x71, 0x67, 0xda, 0xfc, 0x3a, 0x14, 0x42, 0xfc, 0xde, 0x49, 0xf8,
-	0x44, 0x26, 0x34, 0x98, 0xd8, 0xd9, 0xe1, 0x53, 0xf2, 0x32, 0x38, 0x25, 0xb1, 0x0d, 0x69, 0x33,
-	0x99, 0x7b, 0x38, 0xed, 0x29, 0xf1, 0x4f, 0xc7, 0xaf, 0x11, 0x58, 0x9b, 0x70, 0x82, 0x42, 0xc6,
-	0xa5, 0xaf, 0x32, 0x8e, 0xfe, 0x05, 0x37, 0x31, 0x6d, 0xed, 0xea, 0x0d, 0x6c, 0x13, 0xd7, 0xa4,
-	0x7c, 0xea, 0xd0, 0xad, 0x6e, 0xa7, 0x86, 0x1d, 0x11, 0x39, 0x6f, 0xa4, 0xd9, 0x2d, 0x70, 0x3e,
-	0x9b, 0x32, 0x4a, 0x8c, 0x8b, 0x9e, 0xc0, 0xaa, 0xaf, 0x65, 0x5a, 0xf5, 0x76, 0xd7, 0x35, 0x89,
-	0x15, 0x0e, 0xe5, 0x8a, 0xe0, 0x16, 0x7d, 0x26, 0x8b, 0xd6, 0x16, 0xc8, 0x46, 0xd0, 0x84, 0x74,
-	0x56, 0x9a, 0x22, 0xaa, 0x8b, 0x7d, 0xba, 0xea, 0x91, 0xd1, 0x4b, 0xb8, 0xcd, 0x0c, 0x78, 0x82,
-	0xa6, 0xa5, 0x87, 0xd4, 0xce, 0xba, 0xb8, 0xcb, 0x9b, 0x77, 0x54, 0xbb, 0xe9, 0xcb, 0x14, 0xad,
-	0x7e, 0x77, 0x7b, 0xeb, 0x09, 0x28, 0x2f, 0x60, 0xa1, 0x40, 0x3a, 0x86, 0x19, 0xf4, 0xea, 0x15,
-	0x98, 0xe5, 0x1e, 0xc5, 0x51, 0x62, 0x0b, 0xb4, 0x0a, 0xb1, 0x06, 0x13, 0xf3, 0x67, 0x11, 0xbe,
-	0x52, 0x9e, 0x43, 0xd2, 0x57, 0x17, 0xe1, 0xde, 0x02, 0x39, 0xb8, 0xc2, 0x75, 0xa1, 0xc3, 0x4d,
-	0x2d, 0x06, 0x74, 0xae, 0xa2, 0x7c, 0x1f, 0x81, 0x25, 0x16, 0xad, 0xaa, 0x83, 0xfb, 0x37, 0xe8,
-	0x2b, 0x88, 0x52, 0x47, 0xd4, 0x6d, 0x3c, 0x97, 0x9b, 0x94, 0xad, 0x11, 0xc5, 0x8c, 0xb7, 0x28,
-	0x91, 0x06, 0xd6, 0x98, 0x7e, 0xfa, 0x17, 0x09, 0xe6, 0x7d, 0x12, 0xfa, 0x27, 0xcc, 0xb2, 0xb4,
-	0x31, 0x28, 0xf1, 0x9c, 0xd2, 0xb7, 0x8a, 0x69, 0x2b, 0xe3, 0x8f, 0x8d, 0x99, 0x3d, 0xe6, 0x82,
-	0xcf, 0x89, 0x5c, 0x61, 0x68, 0xb6, 0x8b, 0x0c, 0xcd, 0x76, 0xde, 0x85, 0x6b, 0x1b, 0x0e, 0x35,
-	0xeb, 0xa6, 0xcd, 0x2e, 0xa7, 0x1e, 0xa1, 0xd8, 0xbf, 0xa3, 0x97, 0xc2, 0x9c, 0x63, 0x8f, 0xe1,
-	0x35, 0x17, 0x31, 0x02, 0x30, 0x39, 0x9e, 0x55, 0xe0, 0xb7, 0xbf, 0x47, 0x51, 0xde, 0xc0, 0x8a,
-	0x07, 0x9a, 0x41, 0xf0, 0x8a, 0xc1, 0x4f, 0xcb, 0x2d, 0xb8, 0xce, 0xc6, 0xa3, 0x53, 0x87, 0x74,
-	0x44, 0x3c, 0xe7, 0x3d, 0xc2, 0x2b, 0x87, 0x74, 0xbc, 0x51, 0x91, 0x31, 0x29, 0x11, 0xf5, 0x18,
-	0xf3, 0x96, 0x55, 0xb2, 0x7d, 0x08, 0x0b, 0x41, 0x55, 0x6b, 0xa4, 0x8d, 0x51, 0x1c, 0xe6, 0xde,
-	0x95, 0x5e, 0x97, 0x8e, 0x4e, 0x4a, 0xf2, 0x35, 0x94, 0x80, 0xf9, 0x7c, 0xb5, 0xaa, 0x56, 0xaa,
-	0xaa, 0x26, 0x4b, 0xde, 0xaa, 0xac, 0x1d, 0x95, 0x8f, 0x2a, 0xaa, 0x26, 0x47, 0x50, 0x12, 0x20,
-	0x7f, 0x70, 0xa0, 0xa9, 0x07, 0xf9, 0xea, 0x91, 0x26, 0xcf, 0x6c, 0xff, 0x2c, 0xc1, 0xe2, 0xd0,
-	0x01, 0x41, 0x08, 0x92, 0xc2, 0x98, 0x5e, 0xa9, 0xe6, 0xab, 0xef, 0x2a, 0xf2, 0x35, 0xb4, 0x02,
-	0x72, 0x41, 0x2d, 0x1f, 0x55, 0x8a, 0x55, 0x5d, 0x53, 0xf7, 0xd5, 0xe2, 0xb1, 0x5a, 0x90, 0x25,
-	0x4f, 0xb2, 0xac, 0x96, 0x0a, 0xc5, 0xd2, 0x81, 0x9e, 0xdf, 0xaf, 0x16, 0x8f, 0x55, 0x39, 0x82,
-	0x00, 0x62, 0xe2, 0x7b, 0xc6, 0xe3, 0x17, 0x4b, 0xc5, 0x6a, 0x31, 0x5f, 0x55, 0x0b, 0xba, 0xfa,
-	0xbe, 0x58, 0x95, 0xa3, 0x48, 0x86, 0xc4, 0x49, 0xb1, 0x7a, 0x58, 0xd0, 0xf2, 0x27, 0xf9, 0xbd,
-	0x37, 0xaa, 0x3c, 0xeb, 0x69, 0x78, 0x3c, 0xb5, 0x20, 0xc7, 0x3c, 0x0d, 0xfe, 0xad, 0x57, 0xde,
-	0xe4, 0x2b, 0x87, 0x6a, 0x41, 0x9e, 0xcb, 0xfd, 0x2e, 0xc1, 0x62, 0xde, 0xef, 0x4d, 0xfc, 0xcd,
-	0x81, 0x5a, 0x80, 0x44, 0x08, 0x43, 0x13, 0x38, 0xda, 0x9e, 0xd8, 0x8d, 0x47, 0xc6, 0xf4, 0xf4,
-	0xdf, 0x27, 0xd4, 0x4a, 0x48, 0xb4, 0x60, 0x50, 0x03, 0xe9, 0xb0, 0x54, 0xe9, 0xd6, 0x3a, 0xe6,
-	0x80, 0x23, 0xe5, 0x72, 0xe5, 0xb0, 0x83, 0x71, 0x60, 0xfc, 0xfa, 0xce, 0x7d, 0x96, 0x82, 0x97,
-	0x47, 0xb0, 0xbd, 0xf7, 0x90, 0x10, 0x38, 0x59, 0xc5, 0xa0, 0xfb, 0x17, 0x1e, 0x17, 0x7f, 0x4b,
-	0x53, 0x94, 0x3f, 0xfa, 0x00, 0x09, 0xe1, 0x8c, 0xaf, 0xa7, 0xd0, 0x49, 0x4f, 0x6c, 0xad, 0x43,
-	0x0f, 0xa6, 0xdc, 0x37, 0x12, 0x2c, 0xf9, 0x63, 0x3c, 0x09, 0x36, 0xe3, 0xc0, 0x9a, 0x88, 0xa0,
-	0x60, 0xe1, 0xbc, 0xd5, 0x28, 0x3b, 0x84, 0x9c, 0x5e, 0x90, 0xb0, 0x91, 0x57, 0x4a, 0xfa, 0x1f,
-	0x53, 0xc9, 0x0a, 0x24, 0x7f, 0xce, 0x81, 0xdc, 0xaf, 0x6b, 0x01, 0xe4, 0x03, 0x00, 0x6f, 0x51,
-	0x2c, 0xb1, 0x0f, 0x26, 0xd9, 0x1b, 0x68, 0x9c, 0x93, 0xd3, 0x38, 0xd4, 0x20, 0xff, 0x0b, 0x4b,
-	0x27, 0x86, 0x49, 0x5f, 0x85, 0x27, 0x4d, 0x94, 0xbb, 0xd2, 0x58, 0xca, 0x1d, 0x3e, 0xfe, 0x8a,
-	0x51, 0x76, 0x47, 0x42, 0x04, 0x92, 0x83, 0x53, 0x14, 0x7a, 0x74, 0xa9, 0xa1, 0xf0, 0x94, 0x96,
-	0xce, 0x4c, 0x2b, 0x2e, 0x36, 0xdc, 0x86, 0xe5, 0x7d, 0x7f, 0xb0, 0x08, 0x0d, 0x29, 0x5b, 0xd3,
-	0x4c, 0x44, 0xdc, 0xe3, 0xf6, 0xf4, 0xc3, 0x13, 0x3a, 0x1b, 0xed, 0x53, 0x57, 0xdc, 0xdf, 0x55,
-	0x67, 0x74, 0xf4, 0x3f, 0x09, 0x56, 0xc6, 0x3d, 0x0a, 0xd1, 0xe5, 0x19, 0x1a, 0x7d, 0x97, 0xa6,
-	0x9f, 0x5c, 0x4d, 0x49, 0x60, 0xe8, 0x82, 0x3c, 0x3c, 0xe3, 0xa3, 0x89, 0x1b, 0x99, 0xf0, 0x92,
-	0x48, 0xef, 0x4c, 0xaf, 0x20, 0xdc, 0xfe, 0x27, 0x28, 0xe6, 0xfe, 0x23, 0x01, 0xad, 0x66, 0xf8,
-	0x9f, 0x9c, 0x8c, 0xff, 0x27, 0x27, 0xa3, 0x76, 0x6c, 0x7a, 0x3e, 0x39, 0x8d, 0xa3, 0x0f, 0x8c,
-	0x1d, 0x09, 0xbd, 0x86, 0x85, 0x7d, 0xc3, 0x22, 0x96, 0x59, 0x37, 0xda, 0x87, 0xd8, 0x68, 0x4c,
-	0x34, 0x3b, 0x45, 0x67, 0xda, 0x4b, 0x7c, 0xfe, 0xb2, 0x2e, 0xfd, 0xf6, 0x65, 0x5d, 0xfa, 0xe3,
-	0xcb, 0xba, 0x54, 0x8b, 0x31, 0x0b, 0x8f, 0xff, 0x0a, 0x00, 0x00, 0xff, 0xff, 0xc8, 0x3c, 0x79,
-	0x88, 0xae, 0x12, 0x00, 0x00,
+func (m *AssignmentResponse) Reset()         { *m = AssignmentResponse{} }
+func (m *AssignmentResponse) String() string { return proto.CompactTextString(m) }
+func (*AssignmentResponse) ProtoMessage()    {}
+func (*AssignmentResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9eb4e94b85965285, []int{25}
 }
-
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
-
-// AttesterServiceClient is the client API for AttesterService service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type AttesterServiceClient interface {
-	RequestAttestation(ctx context.Context, in *AttestationRequest, opts ...grpc.CallOption) (*v1alpha1.AttestationData, error)
-	SubmitAttestation(ctx context.Context, in *v1alpha1.Attestation, opts ...grpc.CallOption) (*AttestResponse, error)
+func (m *AssignmentResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-type attesterServiceClient struct {
-	cc *grpc.ClientConn
+func (m *AssignmentResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AssignmentResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-
-func NewAttesterServiceClient(cc *grpc.ClientConn) AttesterServiceClient {
-	return &attesterServiceClient{cc}
+func (m *AssignmentResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AssignmentResponse.Merge(m, src)
 }
-
-func (c *attesterServiceClient) RequestAttestation(ctx context.Context, in *AttestationRequest, opts ...grpc.CallOption) (*v1alpha1.AttestationData, error) {
-	out := new(v1alpha1.AttestationData)
-	err := c.cc.Invoke(ctx, "/ethereum.beacon.rpc.v1.AttesterService/RequestAttestation", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *AssignmentResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *AssignmentResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_AssignmentResponse.DiscardUnknown(m)
 }
 
-func (c *attesterServiceClient) SubmitAttestation(ctx context.Context, in *v1alpha1.Attestation, opts ...grpc.CallOption) (*AttestResponse, error) {
-	out := new(AttestResponse)
-	err := c.cc.Invoke(ctx, "/ethereum.beacon.rpc.v1.AttesterService/SubmitAttestation", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_AssignmentResponse proto.InternalMessageInfo
+
+func (m *AssignmentResponse) GetValidatorAssignment() []*AssignmentResponse_ValidatorAssignment {
+	if m != nil {
+		return m.ValidatorAssignment
 	}
-	return out, nil
+	return nil
 }
 
-// AttesterServiceServer is the server API for AttesterService service.
-type AttesterServiceServer interface {
-	RequestAttestation(context.Context, *AttestationRequest) (*v1alpha1.AttestationData, error)
-	SubmitAttestation(context.Context, *v1alpha1.Attestation) (*AttestResponse, error)
+type AssignmentResponse_ValidatorAssignment struct {
+	Committee            []uint64        `protobuf:"varint,1,rep,packed,name=committee,proto3" json:"committee,omitempty"`
+	CommitteeIndex       uint64          `protobuf:"varint,2,opt,name=committee_index,json=committeeIndex,proto3" json:"committee_index,omitempty"`
+	AttesterSlot         uint64          `protobuf:"varint,3,opt,name=attester_slot,json=attesterSlot,proto3" json:"attester_slot,omitempty"`
+	ProposerSlot         uint64          `protobuf:"varint,4,opt,name=proposer_slot,json=proposerSlot,proto3" json:"proposer_slot,omitempty"`
+	PublicKey            []byte          `protobuf:"bytes,5,opt,name=public_key,json=publicKey,proto3" json:"public_key,omitempty"`
+	Status               ValidatorStatus `protobuf:"varint,6,opt,name=status,proto3,enum=ethereum.beacon.rpc.v1.ValidatorStatus" json:"status,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_unrecognized     []byte          `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
 }
 
-func RegisterAttesterServiceServer(s *grpc.Server, srv AttesterServiceServer) {
-	s.RegisterService(&_AttesterService_serviceDesc, srv)
+func (m *AssignmentResponse_ValidatorAssignment) Reset() {
+	*m = AssignmentResponse_ValidatorAssignment{}
 }
-
-func _AttesterService_RequestAttestation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(AttestationRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(AttesterServiceServer).RequestAttestation(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/ethereum.beacon.rpc.v1.AttesterService/RequestAttestation",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(AttesterServiceServer).RequestAttestation(ctx, req.(*AttestationRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+func (m *AssignmentResponse_ValidatorAssignment) String() string { return proto.CompactTextString(m) }
+func (*AssignmentResponse_ValidatorAssignment) ProtoMessage()    {}
+func (*AssignmentResponse_ValidatorAssignment) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9eb4e94b85965285, []int{25, 0}
 }
-
-func _AttesterService_SubmitAttestation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(v1alpha1.Attestation)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(AttesterServiceServer).SubmitAttestation(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/ethereum.beacon.rpc.v1.AttesterService/SubmitAttestation",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(AttesterServiceServer).SubmitAttestation(ctx, req.(*v1alpha1.Attestation))
+func (m *AssignmentResponse_ValidatorAssignment) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AssignmentResponse_ValidatorAssignment) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AssignmentResponse_ValidatorAssignment.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
 }
-
-var _AttesterService_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "ethereum.beacon.rpc.v1.AttesterService",
-	HandlerType: (*AttesterServiceServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "RequestAttestation",
-			Handler:    _AttesterService_RequestAttestation_Handler,
-		},
-		{
-			MethodName: "SubmitAttestation",
-			Handler:    _AttesterService_SubmitAttestation_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "proto/beacon/rpc/v1/services.proto",
+func (m *AssignmentResponse_ValidatorAssignment) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AssignmentResponse_ValidatorAssignment.Merge(m, src)
 }
-
-// ProposerServiceClient is the client API for ProposerService service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type ProposerServiceClient interface {
-	RequestBlock(ctx context.Context, in *BlockRequest, opts ...grpc.CallOption) (*v1alpha1.BeaconBlock, error)
-	ProposeBlock(ctx context.Context, in *v1alpha1.BeaconBlock, opts ...grpc.CallOption) (*ProposeResponse, error)
+func (m *AssignmentResponse_ValidatorAssignment) XXX_Size() int {
+	return m.Size()
 }
-
-type proposerServiceClient struct {
-	cc *grpc.ClientConn
+func (m *AssignmentResponse_ValidatorAssignment) XXX_DiscardUnknown() {
+	xxx_messageInfo_AssignmentResponse_ValidatorAssignment.DiscardUnknown(m)
 }
 
-func NewProposerServiceClient(cc *grpc.ClientConn) ProposerServiceClient {
-	return &proposerServiceClient{cc}
-}
+var xxx_messageInfo_AssignmentResponse_ValidatorAssignment proto.InternalMessageInfo
 
-func (c *proposerServiceClient) RequestBlock(ctx context.Context, in *BlockRequest, opts ...grpc.CallOption) (*v1alpha1.BeaconBlock, error) {
-	out := new(v1alpha1.BeaconBlock)
-	err := c.cc.Invoke(ctx, "/ethereum.beacon.rpc.v1.ProposerService/RequestBlock", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *AssignmentResponse_ValidatorAssignment) GetCommittee() []uint64 {
+	if m != nil {
+		return m.Committee
 	}
-	return out, nil
+	return nil
 }
 
-func (c *proposerServiceClient) ProposeBlock(ctx context.Context, in *v1alpha1.BeaconBlock, opts ...grpc.CallOption) (*ProposeResponse, error) {
-	out := new(ProposeResponse)
-	err := c.cc.Invoke(ctx, "/ethereum.beacon.rpc.v1.ProposerService/ProposeBlock", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *AssignmentResponse_ValidatorAssignment) GetCommitteeIndex() uint64 {
+	if m != nil {
+		return m.CommitteeIndex
 	}
-	return out, nil
+	return 0
 }
 
-// ProposerServiceServer is the server API for ProposerService service.
-type ProposerServiceServer interface {
-	RequestBlock(context.Context, *BlockRequest) (*v1alpha1.BeaconBlock, error)
-	ProposeBlock(context.Context, *v1alpha1.BeaconBlock) (*ProposeResponse, error)
+func (m *AssignmentResponse_ValidatorAssignment) GetAttesterSlot() uint64 {
+	if m != nil {
+		return m.AttesterSlot
+	}
+	return 0
 }
 
-func RegisterProposerServiceServer(s *grpc.Server, srv ProposerServiceServer) {
-	s.RegisterService(&_ProposerService_serviceDesc, srv)
+func (m *AssignmentResponse_ValidatorAssignment) GetProposerSlot() uint64 {
+	if m != nil {
+		return m.ProposerSlot
+	}
+	return 0
 }
 
-func _ProposerService_RequestBlock_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(BlockRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(ProposerServiceServer).RequestBlock(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/ethereum.beacon.rpc.v1.ProposerService/RequestBlock",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ProposerServiceServer).RequestBlock(ctx, req.(*BlockRequest))
+func (m *AssignmentResponse_ValidatorAssignment) GetPublicKey() []byte {
+	if m != nil {
+		return m.PublicKey
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _ProposerService_ProposeBlock_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(v1alpha1.BeaconBlock)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(ProposerServiceServer).ProposeBlock(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/ethereum.beacon.rpc.v1.ProposerService/ProposeBlock",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ProposerServiceServer).ProposeBlock(ctx, req.(*v1alpha1.BeaconBlock))
+func (m *AssignmentResponse_ValidatorAssignment) GetStatus() ValidatorStatus {
+	if m != nil {
+		return m.Status
 	}
-	return interceptor(ctx, in, info, handler)
+	return ValidatorStatus_UNKNOWN_STATUS
 }
 
-var _ProposerService_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "ethereum.beacon.rpc.v1.ProposerService",
-	HandlerType: (*ProposerServiceServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "RequestBlock",
-			Handler:    _ProposerService_RequestBlock_Handler,
-		},
-		{
-			MethodName: "ProposeBlock",
-			Handler:    _ProposerService_ProposeBlock_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "proto/beacon/rpc/v1/services.proto",
+type ValidatorStatusResponse struct {
+	Status                    ValidatorStatus `protobuf:"varint,1,opt,name=status,proto3,enum=ethereum.beacon.rpc.v1.ValidatorStatus" json:"status,omitempty"`
+	Eth1DepositBlockNumber    uint64          `protobuf:"varint,2,opt,name=eth1_deposit_block_number,json=eth1DepositBlockNumber,proto3" json:"eth1_deposit_block_number,omitempty"`
+	DepositInclusionSlot      uint64          `protobuf:"varint,3,opt,name=deposit_inclusion_slot,json=depositInclusionSlot,proto3" json:"deposit_inclusion_slot,omitempty"`
+	ActivationEpoch           uint64          `protobuf:"varint,4,opt,name=activation_epoch,json=activationEpoch,proto3" json:"activation_epoch,omitempty"`
+	PositionInActivationQueue uint64          `protobuf:"varint,5,opt,name=position_in_activation_queue,json=positionInActivationQueue,proto3" json:"position_in_activation_queue,omitempty"`
+	XXX_NoUnkeyedLiteral      struct{}        `json:"-"`
+	XXX_unrecognized          []byte          `json:"-"`
+	XXX_sizecache             int32           `json:"-"`
 }
 
-// AggregatorServiceClient is the client API for AggregatorService service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type AggregatorServiceClient interface {
-	SubmitAggregateAndProof(ctx context.Context, in *AggregationRequest, opts ...grpc.CallOption) (*AggregationResponse, error)
+func (m *ValidatorStatusResponse) Reset()         { *m = ValidatorStatusResponse{} }
+func (m *ValidatorStatusResponse) String() string { return proto.CompactTextString(m) }
+func (*ValidatorStatusResponse) ProtoMessage()    {}
+func (*ValidatorStatusResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9eb4e94b85965285, []int{26}
 }
-
-type aggregatorServiceClient struct {
-	cc *grpc.ClientConn
+func (m *ValidatorStatusResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func NewAggregatorServiceClient(cc *grpc.ClientConn) AggregatorServiceClient {
-	return &aggregatorServiceClient{cc}
+func (m *ValidatorStatusResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ValidatorStatusResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ValidatorStatusResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ValidatorStatusResponse.Merge(m, src)
+}
+func (m *ValidatorStatusResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *ValidatorStatusResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ValidatorStatusResponse.DiscardUnknown(m)
 }
 
-func (c *aggregatorServiceClient) SubmitAggregateAndProof(ctx context.Context, in *AggregationRequest, opts ...grpc.CallOption) (*AggregationResponse, error) {
-	out := new(AggregationResponse)
-	err := c.cc.Invoke(ctx, "/ethereum.beacon.rpc.v1.AggregatorService/SubmitAggregateAndProof", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_ValidatorStatusResponse proto.InternalMessageInfo
+
+func (m *ValidatorStatusResponse) GetStatus() ValidatorStatus {
+	if m != nil {
+		return m.Status
 	}
-	return out, nil
+	return ValidatorStatus_UNKNOWN_STATUS
 }
 
-// AggregatorServiceServer is the server API for AggregatorService service.
-type AggregatorServiceServer interface {
-	SubmitAggregateAndProof(context.Context, *AggregationRequest) (*AggregationResponse, error)
+func (m *ValidatorStatusResponse) GetEth1DepositBlockNumber() uint64 {
+	if m != nil {
+		return m.Eth1DepositBlockNumber
+	}
+	return 0
 }
 
-func RegisterAggregatorServiceServer(s *grpc.Server, srv AggregatorServiceServer) {
-	s.RegisterService(&_AggregatorService_serviceDesc, srv)
+func (m *ValidatorStatusResponse) GetDepositInclusionSlot() uint64 {
+	if m != nil {
+		return m.DepositInclusionSlot
+	}
+	return 0
 }
 
-func _AggregatorService_SubmitAggregateAndProof_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(AggregationRequest)
-	if err := dec(in); err != nil {
-		return nil, err
+func (m *ValidatorStatusResponse) GetActivationEpoch() uint64 {
+	if m != nil {
+		return m.ActivationEpoch
 	}
-	if interceptor == nil {
-		return srv.(AggregatorServiceServer).SubmitAggregateAndProof(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/ethereum.beacon.rpc.v1.AggregatorService/SubmitAggregateAndProof",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(AggregatorServiceServer).SubmitAggregateAndProof(ctx, req.(*AggregationRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+	return 0
 }
 
-var _AggregatorService_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "ethereum.beacon.rpc.v1.AggregatorService",
-	HandlerType: (*AggregatorServiceServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "SubmitAggregateAndProof",
-			Handler:    _AggregatorService_SubmitAggregateAndProof_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "proto/beacon/rpc/v1/services.proto",
+func (m *ValidatorStatusResponse) GetPositionInActivationQueue() uint64 {
+	if m != nil {
+		return m.PositionInActivationQueue
+	}
+	return 0
 }
 
-// ValidatorServiceClient is the client API for ValidatorService service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type ValidatorServiceClient interface {
-	DomainData(ctx context.Context, in *DomainRequest, opts ...grpc.CallOption) (*DomainResponse, error)
-	WaitForActivation(ctx context.Context, in *ValidatorActivationRequest, opts ...grpc.CallOption) (ValidatorService_WaitForActivationClient, error)
-	ValidatorIndex(ctx context.Context, in *ValidatorIndexRequest, opts ...grpc.CallOption) (*ValidatorIndexResponse, error)
-	CommitteeAssignment(ctx context.Context, in *AssignmentRequest, opts ...grpc.CallOption) (*AssignmentResponse, error)
-	ValidatorStatus(ctx context.Context, in *ValidatorIndexRequest, opts ...grpc.CallOption) (*ValidatorStatusResponse, error)
-	ValidatorPerformance(ctx context.Context, in *ValidatorPerformanceRequest, opts ...grpc.CallOption) (*ValidatorPerformanceResponse, error)
-	ExitedValidators(ctx context.Context, in *ExitedValidatorsRequest, opts ...grpc.CallOption) (*ExitedValidatorsResponse, error)
-	WaitForChainStart(ctx context.Context, in *types.Empty, opts ...grpc.CallOption) (ValidatorService_WaitForChainStartClient, error)
-	CanonicalHead(ctx context.Context, in *types.Empty, opts ...grpc.CallOption) (*v1alpha1.BeaconBlock, error)
+type DomainRequest struct {
+	Epoch                uint64   `protobuf:"varint,1,opt,name=epoch,proto3" json:"epoch,omitempty"`
+	Domain               []byte   `protobuf:"bytes,2,opt,name=domain,proto3" json:"domain,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-type validatorServiceClient struct {
-	cc *grpc.ClientConn
+func (m *DomainRequest) Reset()         { *m = DomainRequest{} }
+func (m *DomainRequest) String() string { return proto.CompactTextString(m) }
+func (*DomainRequest) ProtoMessage()    {}
+func (*DomainRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9eb4e94b85965285, []int{27}
 }
-
-func NewValidatorServiceClient(cc *grpc.ClientConn) ValidatorServiceClient {
-	return &validatorServiceClient{cc}
+func (m *DomainRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (c *validatorServiceClient) DomainData(ctx context.Context, in *DomainRequest, opts ...grpc.CallOption) (*DomainResponse, error) {
-	out := new(DomainResponse)
-	err := c.cc.Invoke(ctx, "/ethereum.beacon.rpc.v1.ValidatorService/DomainData", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *DomainRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DomainRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
 }
-
-func (c *validatorServiceClient) WaitForActivation(ctx context.Context, in *ValidatorActivationRequest, opts ...grpc.CallOption) (ValidatorService_WaitForActivationClient, error) {
-	stream, err := c.cc.NewStream(ctx, &_ValidatorService_serviceDesc.Streams[0], "/ethereum.beacon.rpc.v1.ValidatorService/WaitForActivation", opts...)
-	if err != nil {
-		return nil, err
-	}
-	x := &validatorServiceWaitForActivationClient{stream}
-	if err := x.ClientStream.SendMsg(in); err != nil {
-		return nil, err
-	}
-	if err := x.ClientStream.CloseSend(); err != nil {
-		return nil, err
-	}
-	return x, nil
+func (m *DomainRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DomainRequest.Merge(m, src)
 }
-
-type ValidatorService_WaitForActivationClient interface {
-	Recv() (*ValidatorActivationResponse, error)
-	grpc.ClientStream
+func (m *DomainRequest) XXX_Size() int {
+	return m.Size()
 }
-
-type validatorServiceWaitForActivationClient struct {
-	grpc.ClientStream
+func (m *DomainRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_DomainRequest.DiscardUnknown(m)
 }
 
-func (x *validatorServiceWaitForActivationClient) Recv() (*ValidatorActivationResponse, error) {
-	m := new(ValidatorActivationResponse)
-	if err := x.ClientStream.RecvMsg(m); err != nil {
-		return nil, err
-	}
-	return m, nil
-}
+var xxx_messageInfo_DomainRequest proto.InternalMessageInfo
 
-func (c *validatorServiceClient) ValidatorIndex(ctx context.Context, in *ValidatorIndexRequest, opts ...grpc.CallOption) (*ValidatorIndexResponse, error) {
-	out := new(ValidatorIndexResponse)
-	err := c.cc.Invoke(ctx, "/ethereum.beacon.rpc.v1.ValidatorService/ValidatorIndex", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *DomainRequest) GetEpoch() uint64 {
+	if m != nil {
+		return m.Epoch
 	}
-	return out, nil
+	return 0
 }
 
-func (c *validatorServiceClient) CommitteeAssignment(ctx context.Context, in *AssignmentRequest, opts ...grpc.CallOption) (*AssignmentResponse, error) {
-	out := new(AssignmentResponse)
-	err := c.cc.Invoke(ctx, "/ethereum.beacon.rpc.v1.ValidatorService/CommitteeAssignment", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *DomainRequest) GetDomain() []byte {
+	if m != nil {
+		return m.Domain
 	}
-	return out, nil
+	return nil
 }
 
-func (c *validatorServiceClient) ValidatorStatus(ctx context.Context, in *ValidatorIndexRequest, opts ...grpc.CallOption) (*ValidatorStatusResponse, error) {
-	out := new(ValidatorStatusResponse)
-	err := c.cc.Invoke(ctx, "/ethereum.beacon.rpc.v1.ValidatorService/ValidatorStatus", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+type DomainResponse struct {
+	SignatureDomain      uint64   `protobuf:"varint,1,opt,name=signature_domain,json=signatureDomain,proto3" json:"signature_domain,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (c *validatorServiceClient) ValidatorPerformance(ctx context.Context, in *ValidatorPerformanceRequest, opts ...grpc.CallOption) (*ValidatorPerformanceResponse, error) {
-	out := new(ValidatorPerformanceResponse)
-	err := c.cc.Invoke(ctx, "/ethereum.beacon.rpc.v1.ValidatorService/ValidatorPerformance", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *DomainResponse) Reset()         { *m = DomainResponse{} }
+func (m *DomainResponse) String() string { return proto.CompactTextString(m) }
+func (*DomainResponse) ProtoMessage()    {}
+func (*DomainResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9eb4e94b85965285, []int{28}
 }
-
-func (c *validatorServiceClient) ExitedValidators(ctx context.Context, in *ExitedValidatorsRequest, opts ...grpc.CallOption) (*ExitedValidatorsResponse, error) {
-	out := new(ExitedValidatorsResponse)
-	err := c.cc.Invoke(ctx, "/ethereum.beacon.rpc.v1.ValidatorService/ExitedValidators", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *DomainResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DomainResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DomainResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *DomainResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DomainResponse.Merge(m, src)
+}
+func (m *DomainResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *DomainResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_DomainResponse.DiscardUnknown(m)
 }
 
-func (c *validatorServiceClient) WaitForChainStart(ctx context.Context, in *types.Empty, opts ...grpc.CallOption) (ValidatorService_WaitForChainStartClient, error) {
-	stream, err := c.cc.NewStream(ctx, &_ValidatorService_serviceDesc.Streams[1], "/ethereum.beacon.rpc.v1.ValidatorService/WaitForChainStart", opts...)
-	if err != nil {
-		return nil, err
-	}
-	x := &validatorServiceWaitForChainStartClient{stream}
-	if err := x.ClientStream.SendMsg(in); err != nil {
-		return nil, err
-	}
-	if err := x.ClientStream.CloseSend(); err != nil {
-		return nil, err
+var xxx_messageInfo_DomainResponse proto.InternalMessageInfo
+
+func (m *DomainResponse) GetSignatureDomain() uint64 {
+	if m != nil {
+		return m.SignatureDomain
 	}
-	return x, nil
+	return 0
 }
 
-type ValidatorService_WaitForChainStartClient interface {
-	Recv() (*ChainStartResponse, error)
-	grpc.ClientStream
+type BlockTreeResponse struct {
+	Tree                 []*BlockTreeResponse_TreeNode `protobuf:"bytes,1,rep,name=tree,proto3" json:"tree,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                      `json:"-"`
+	XXX_unrecognized     []byte                        `json:"-"`
+	XXX_sizecache        int32                         `json:"-"`
 }
 
-type validatorServiceWaitForChainStartClient struct {
-	grpc.ClientStream
+func (m *BlockTreeResponse) Reset()         { *m = BlockTreeResponse{} }
+func (m *BlockTreeResponse) String() string { return proto.CompactTextString(m) }
+func (*BlockTreeResponse) ProtoMessage()    {}
+func (*BlockTreeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9eb4e94b85965285, []int{29}
 }
-
-func (x *validatorServiceWaitForChainStartClient) Recv() (*ChainStartResponse, error) {
-	m := new(ChainStartResponse)
-	if err := x.ClientStream.RecvMsg(m); err != nil {
-		return nil, err
+func (m *BlockTreeResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BlockTreeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BlockTreeResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return m, nil
+}
+func (m *BlockTreeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BlockTreeResponse.Merge(m, src)
+}
+func (m *BlockTreeResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *BlockTreeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_BlockTreeResponse.DiscardUnknown(m)
 }
 
-func (c *validatorServiceClient) CanonicalHead(ctx context.Context, in *types.Empty, opts ...grpc.CallOption) (*v1alpha1.BeaconBlock, error) {
-	out := new(v1alpha1.BeaconBlock)
-	err := c.cc.Invoke(ctx, "/ethereum.beacon.rpc.v1.ValidatorService/CanonicalHead", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_BlockTreeResponse proto.InternalMessageInfo
+
+func (m *BlockTreeResponse) GetTree() []*BlockTreeResponse_TreeNode {
+	if m != nil {
+		return m.Tree
 	}
-	return out, nil
+	return nil
 }
 
-// ValidatorServiceServer is the server API for ValidatorService service.
-type ValidatorServiceServer interface {
-	DomainData(context.Context, *DomainRequest) (*DomainResponse, error)
-	WaitForActivation(*ValidatorActivationRequest, ValidatorService_WaitForActivationServer) error
-	ValidatorIndex(context.Context, *ValidatorIndexRequest) (*ValidatorIndexResponse, error)
-	CommitteeAssignment(context.Context, *AssignmentRequest) (*AssignmentResponse, error)
-	ValidatorStatus(context.Context, *ValidatorIndexRequest) (*ValidatorStatusResponse, error)
-	ValidatorPerformance(context.Context, *ValidatorPerformanceRequest) (*ValidatorPerformanceResponse, error)
-	ExitedValidators(context.Context, *ExitedValidatorsRequest) (*ExitedValidatorsResponse, error)
-	WaitForChainStart(*types.Empty, ValidatorService_WaitForChainStartServer) error
-	CanonicalHead(context.Context, *types.Empty) (*v1alpha1.BeaconBlock, error)
+type BlockTreeResponse_TreeNode struct {
+	Block                *v1alpha1.BeaconBlock `protobuf:"bytes,1,opt,name=block,proto3" json:"block,omitempty"`
+	BlockRoot            []byte                `protobuf:"bytes,2,opt,name=block_root,json=blockRoot,proto3" json:"block_root,omitempty"`
+	ParticipatedVotes    uint64                `protobuf:"varint,3,opt,name=participated_votes,json=participatedVotes,proto3" json:"participated_votes,omitempty"`
+	TotalVotes           uint64                `protobuf:"varint,4,opt,name=total_votes,json=totalVotes,proto3" json:"total_votes,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
+	XXX_unrecognized     []byte                `json:"-"`
+	XXX_sizecache        int32                 `json:"-"`
 }
 
-func RegisterValidatorServiceServer(s *grpc.Server, srv ValidatorServiceServer) {
-	s.RegisterService(&_ValidatorService_serviceDesc, srv)
+func (m *BlockTreeResponse_TreeNode) Reset()         { *m = BlockTreeResponse_TreeNode{} }
+func (m *BlockTreeResponse_TreeNode) String() string { return proto.CompactTextString(m) }
+func (*BlockTreeResponse_TreeNode) ProtoMessage()    {}
+func (*BlockTreeResponse_TreeNode) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9eb4e94b85965285, []int{29, 0}
+}
+func (m *BlockTreeResponse_TreeNode) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BlockTreeResponse_TreeNode) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BlockTreeResponse_TreeNode.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *BlockTreeResponse_TreeNode) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BlockTreeResponse_TreeNode.Merge(m, src)
+}
+func (m *BlockTreeResponse_TreeNode) XXX_Size() int {
+	return m.Size()
+}
+func (m *BlockTreeResponse_TreeNode) XXX_DiscardUnknown() {
+	xxx_messageInfo_BlockTreeResponse_TreeNode.DiscardUnknown(m)
 }
 
-func _ValidatorService_DomainData_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DomainRequest)
-	if err := dec(in); err != nil {
-		return nil, err
+var xxx_messageInfo_BlockTreeResponse_TreeNode proto.InternalMessageInfo
+
+func (m *BlockTreeResponse_TreeNode) GetBlock() *v1alpha1.BeaconBlock {
+	if m != nil {
+		return m.Block
 	}
-	if interceptor == nil {
-		return srv.(ValidatorServiceServer).DomainData(ctx, in)
+	return nil
+}
+
+func (m *BlockTreeResponse_TreeNode) GetBlockRoot() []byte {
+	if m != nil {
+		return m.BlockRoot
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/ethereum.beacon.rpc.v1.ValidatorService/DomainData",
+	return nil
+}
+
+func (m *BlockTreeResponse_TreeNode) GetParticipatedVotes() uint64 {
+	if m != nil {
+		return m.ParticipatedVotes
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ValidatorServiceServer).DomainData(ctx, req.(*DomainRequest))
+	return 0
+}
+
+func (m *BlockTreeResponse_TreeNode) GetTotalVotes() uint64 {
+	if m != nil {
+		return m.TotalVotes
 	}
-	return interceptor(ctx, in, info, handler)
+	return 0
 }
 
-func _ValidatorService_WaitForActivation_Handler(srv interface{}, stream grpc.ServerStream) error {
-	m := new(ValidatorActivationRequest)
-	if err := stream.RecvMsg(m); err != nil {
-		return err
+type TreeBlockSlotRequest struct {
+	SlotFrom             uint64   `protobuf:"varint,1,opt,name=slot_from,json=slotFrom,proto3" json:"slot_from,omitempty"`
+	SlotTo               uint64   `protobuf:"varint,2,opt,name=slot_to,json=slotTo,proto3" json:"slot_to,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *TreeBlockSlotRequest) Reset()         { *m = TreeBlockSlotRequest{} }
+func (m *TreeBlockSlotRequest) String() string { return proto.CompactTextString(m) }
+func (*TreeBlockSlotRequest) ProtoMessage()    {}
+func (*TreeBlockSlotRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9eb4e94b85965285, []int{30}
+}
+func (m *TreeBlockSlotRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TreeBlockSlotRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TreeBlockSlotRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return srv.(ValidatorServiceServer).WaitForActivation(m, &validatorServiceWaitForActivationServer{stream})
+}
+func (m *TreeBlockSlotRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TreeBlockSlotRequest.Merge(m, src)
+}
+func (m *TreeBlockSlotRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *TreeBlockSlotRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_TreeBlockSlotRequest.DiscardUnknown(m)
 }
 
-type ValidatorService_WaitForActivationServer interface {
-	Send(*ValidatorActivationResponse) error
-	grpc.ServerStream
+var xxx_messageInfo_TreeBlockSlotRequest proto.InternalMessageInfo
+
+func (m *TreeBlockSlotRequest) GetSlotFrom() uint64 {
+	if m != nil {
+		return m.SlotFrom
+	}
+	return 0
 }
 
-type validatorServiceWaitForActivationServer struct {
-	grpc.ServerStream
+func (m *TreeBlockSlotRequest) GetSlotTo() uint64 {
+	if m != nil {
+		return m.SlotTo
+	}
+	return 0
 }
 
-func (x *validatorServiceWaitForActivationServer) Send(m *ValidatorActivationResponse) error {
-	return x.ServerStream.SendMsg(m)
+func init() {
+	proto.RegisterEnum("ethereum.beacon.rpc.v1.ValidatorRole", ValidatorRole_name, ValidatorRole_value)
+	proto.RegisterEnum("ethereum.beacon.rpc.v1.ValidatorStatus", ValidatorStatus_name, ValidatorStatus_value)
+	proto.RegisterType((*BlockRequest)(nil), "ethereum.beacon.rpc.v1.BlockRequest")
+	proto.RegisterType((*ProposeResponse)(nil), "ethereum.beacon.rpc.v1.ProposeResponse")
+	proto.RegisterType((*AttestationRequest)(nil), "ethereum.beacon.rpc.v1.AttestationRequest")
+	proto.RegisterType((*AttestResponse)(nil), "ethereum.beacon.rpc.v1.AttestResponse")
+	proto.RegisterType((*AggregationRequest)(nil), "ethereum.beacon.rpc.v1.AggregationRequest")
+	proto.RegisterType((*AggregationResponse)(nil), "ethereum.beacon.rpc.v1.AggregationResponse")
+	proto.RegisterType((*CheckpointResponse)(nil), "ethereum.beacon.rpc.v1.CheckpointResponse")
+	proto.RegisterType((*PeerScore)(nil), "ethereum.beacon.rpc.v1.PeerScore")
+	proto.RegisterType((*PeerScoresResponse)(nil), "ethereum.beacon.rpc.v1.PeerScoresResponse")
+	proto.RegisterType((*ChainReorg)(nil), "ethereum.beacon.rpc.v1.ChainReorg")
+	proto.RegisterType((*ListValidatorRewardsRequest)(nil), "ethereum.beacon.rpc.v1.ListValidatorRewardsRequest")
+	proto.RegisterType((*ListValidatorRewardsResponse)(nil), "ethereum.beacon.rpc.v1.ListValidatorRewardsResponse")
+	proto.RegisterType((*ValidatorEpochRewards)(nil), "ethereum.beacon.rpc.v1.ValidatorEpochRewards")
+	proto.RegisterType((*StreamExportsRequest)(nil), "ethereum.beacon.rpc.v1.StreamExportsRequest")
+	proto.RegisterType((*ExportedObject)(nil), "ethereum.beacon.rpc.v1.ExportedObject")
+	proto.RegisterType((*ValidatorPerformanceRequest)(nil), "ethereum.beacon.rpc.v1.ValidatorPerformanceRequest")
+	proto.RegisterType((*ValidatorPerformanceResponse)(nil), "ethereum.beacon.rpc.v1.ValidatorPerformanceResponse")
+	proto.RegisterType((*ValidatorActivationRequest)(nil), "ethereum.beacon.rpc.v1.ValidatorActivationRequest")
+	proto.RegisterType((*ValidatorActivationResponse)(nil), "ethereum.beacon.rpc.v1.ValidatorActivationResponse")
+	proto.RegisterType((*ValidatorActivationResponse_Status)(nil), "ethereum.beacon.rpc.v1.ValidatorActivationResponse.Status")
+	proto.RegisterType((*ExitedValidatorsRequest)(nil), "ethereum.beacon.rpc.v1.ExitedValidatorsRequest")
+	proto.RegisterType((*ExitedValidatorsResponse)(nil), "ethereum.beacon.rpc.v1.ExitedValidatorsResponse")
+	proto.RegisterType((*ChainStartResponse)(nil), "ethereum.beacon.rpc.v1.ChainStartResponse")
+	proto.RegisterType((*ValidatorIndexRequest)(nil), "ethereum.beacon.rpc.v1.ValidatorIndexRequest")
+	proto.RegisterType((*ValidatorIndexResponse)(nil), "ethereum.beacon.rpc.v1.ValidatorIndexResponse")
+	proto.RegisterType((*AssignmentRequest)(nil), "ethereum.beacon.rpc.v1.AssignmentRequest")
+	proto.RegisterType((*AssignmentResponse)(nil), "ethereum.beacon.rpc.v1.AssignmentResponse")
+	proto.RegisterType((*AssignmentResponse_ValidatorAssignment)(nil), "ethereum.beacon.rpc.v1.AssignmentResponse.ValidatorAssignment")
+	proto.RegisterType((*ValidatorStatusResponse)(nil), "ethereum.beacon.rpc.v1.ValidatorStatusResponse")
+	proto.RegisterType((*DomainRequest)(nil), "ethereum.beacon.rpc.v1.DomainRequest")
+	proto.RegisterType((*DomainResponse)(nil), "ethereum.beacon.rpc.v1.DomainResponse")
+	proto.RegisterType((*BlockTreeResponse)(nil), "ethereum.beacon.rpc.v1.BlockTreeResponse")
+	proto.RegisterType((*BlockTreeResponse_TreeNode)(nil), "ethereum.beacon.rpc.v1.BlockTreeResponse.TreeNode")
+	proto.RegisterType((*TreeBlockSlotRequest)(nil), "ethereum.beacon.rpc.v1.TreeBlockSlotRequest")
 }
 
-func _ValidatorService_ValidatorIndex_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ValidatorIndexRequest)
-	if err := dec(in); err != nil {
+func init() {
+	proto.RegisterFile("proto/beacon/rpc/v1/services.proto", fileDescriptor_9eb4e94b85965285)
+}
+
+var fileDescriptor_9eb4e94b85965285 = []byte{
+	// 2453 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x58, 0x4b, 0x73, 0xdb, 0xc8,
+	0x11, 0x5e, 0x90, 0x7a, 0x50, 0x4d, 0x4a, 0xa2, 0x46, 0xb2, 0xcc, 0xa5, 0xfc, 0x84, 0xbd, 0xb6,
+	0xec, 0xc4, 0x94, 0x44, 0xbb, 0x9c, 0x64, 0xb7, 0x76, 0xb7, 0x28, 0x89, 0x96, 0x59, 0x76, 0x24,
+	0x19, 0xa4, 0xe5, 0xdd, 0xda, 0x03, 0x0a, 0x04, 0x46, 0x24, 0x62, 0x12, 0x03, 0x03, 0x43, 0xd9,
+	0xf2, 0x21, 0x55, 0xb9, 0x24, 0x95, 0x5b, 0x52, 0x95, 0x4d, 0x8e, 0xa9, 0xfc, 0x81, 0x54, 0x6d,
+	0xe5, 0x90, 0xbf, 0xb0, 0xc7, 0xfc, 0x80, 0x1c, 0x52, 0xbe, 0xe6, 0x4f, 0x6c, 0xcd, 0x0b, 0x00,
+	0x5f, 0x12, 0xe5, 0x1b, 0xa6, 0xfb, 0xeb, 0xd7, 0xa0, 0x67, 0xa6, 0xbb, 0x41, 0xf7, 0x03, 0x42,
+	0xc9, 0x46, 0x13, 0x5b, 0x36, 0xf1, 0x36, 0x02, 0xdf, 0xde, 0x38, 0xd9, 0xda, 0x08, 0x71, 0x70,
+	0xe2, 0xda, 0x38, 0x2c, 0x71, 0x26, 0x5a, 0xc5, 0xb4, 0x8d, 0x03, 0xdc, 0xeb, 0x96, 0x04, 0xac,
+	0x14, 0xf8, 0x76, 0xe9, 0x64, 0xab, 0xb8, 0xd6, 0x22, 0xa4, 0xd5, 0xc1, 0x1b, 0x1c, 0xd5, 0xec,
+	0x1d, 0x6f, 0xe0, 0xae, 0x4f, 0x4f, 0x85, 0x50, 0xf1, 0x3a, 0xa6, 0xed, 0x8d, 0x93, 0x2d, 0xab,
+	0xe3, 0xb7, 0xad, 0x2d, 0xa9, 0xdf, 0x6c, 0x76, 0x88, 0xfd, 0x5a, 0x02, 0xae, 0xf5, 0x01, 0x2c,
+	0x4a, 0x71, 0x48, 0x2d, 0xea, 0x12, 0x4f, 0xf0, 0x75, 0x1b, 0x72, 0xdb, 0x0c, 0x6e, 0xe0, 0x37,
+	0x3d, 0x1c, 0x52, 0x84, 0x60, 0x2a, 0xec, 0x10, 0x5a, 0xd0, 0x6e, 0x68, 0xeb, 0x53, 0x06, 0xff,
+	0x46, 0xb7, 0x60, 0x3e, 0xb0, 0x3c, 0xc7, 0x22, 0x66, 0x80, 0x4f, 0xb0, 0xd5, 0x29, 0xa4, 0x6e,
+	0x68, 0xeb, 0x39, 0x23, 0x27, 0x88, 0x06, 0xa7, 0xa1, 0x22, 0x64, 0x5a, 0x81, 0x75, 0x7c, 0xec,
+	0x52, 0xb7, 0x90, 0xe6, 0xfc, 0x68, 0xad, 0x6f, 0xc2, 0xe2, 0x61, 0x40, 0x7c, 0x12, 0x62, 0x03,
+	0x87, 0x3e, 0xf1, 0x42, 0x8c, 0xae, 0x02, 0x70, 0x37, 0xcd, 0x80, 0x48, 0x6b, 0x39, 0x63, 0x8e,
+	0x53, 0x0c, 0x42, 0xa8, 0xfe, 0x47, 0x0d, 0x50, 0x25, 0x76, 0x56, 0x79, 0x77, 0x15, 0xc0, 0xef,
+	0x35, 0x3b, 0xae, 0x6d, 0xbe, 0xc6, 0xa7, 0x4a, 0x4a, 0x50, 0x9e, 0xe1, 0x53, 0x74, 0x19, 0x66,
+	0x7d, 0x62, 0x9b, 0x4d, 0x97, 0x4a, 0x17, 0x67, 0x7c, 0x62, 0x6f, 0xbb, 0x71, 0x54, 0xe9, 0x44,
+	0x54, 0x77, 0x61, 0xd1, 0x26, 0xdd, 0xae, 0x4b, 0x29, 0xc6, 0xa6, 0xeb, 0x39, 0xf8, 0x5d, 0x61,
+	0x8a, 0xb3, 0x17, 0x22, 0x72, 0x8d, 0x51, 0xf5, 0xdb, 0xb0, 0x20, 0x5c, 0x89, 0x9c, 0x47, 0x30,
+	0x95, 0x70, 0x9b, 0x7f, 0xeb, 0x7f, 0x63, 0x1e, 0xb7, 0x5a, 0x01, 0x6e, 0xf5, 0x79, 0x3c, 0x6a,
+	0x3f, 0x47, 0x58, 0x4e, 0x8d, 0xb2, 0x3c, 0x10, 0x6e, 0x7a, 0x30, 0xdc, 0xcf, 0x60, 0x81, 0xe9,
+	0x33, 0x43, 0xb7, 0xe5, 0x59, 0xb4, 0x17, 0x60, 0x1e, 0x40, 0xce, 0x98, 0x67, 0xd4, 0xba, 0x22,
+	0xea, 0xf7, 0x60, 0xb9, 0xcf, 0xb1, 0x33, 0x82, 0x38, 0x02, 0xb4, 0xd3, 0xc6, 0xf6, 0x6b, 0x9f,
+	0xb8, 0x5e, 0x1c, 0xee, 0x0a, 0x4c, 0xb3, 0xff, 0x80, 0x25, 0x54, 0x2c, 0x18, 0x95, 0xff, 0x2f,
+	0xb9, 0xd5, 0x62, 0xc1, 0xa8, 0xd8, 0x27, 0x76, 0x5b, 0x6e, 0xb5, 0x58, 0xe8, 0xdf, 0x6b, 0x30,
+	0x77, 0x88, 0x71, 0x50, 0xb7, 0x49, 0x80, 0x51, 0x01, 0x66, 0x2d, 0xc7, 0x09, 0x70, 0x18, 0x72,
+	0x8d, 0x73, 0x86, 0x5a, 0xf2, 0x1f, 0x88, 0x71, 0x60, 0xba, 0x0e, 0xd7, 0x3a, 0x67, 0xcc, 0xb0,
+	0x65, 0xcd, 0xe1, 0x2e, 0x30, 0x59, 0xae, 0x36, 0x6d, 0x88, 0x05, 0xba, 0x02, 0x73, 0x36, 0xf1,
+	0x3c, 0x6c, 0x53, 0xec, 0xf0, 0xd8, 0x33, 0x46, 0x4c, 0x40, 0x37, 0x21, 0xd7, 0xb4, 0x3c, 0x0f,
+	0x3b, 0x66, 0xcf, 0xa3, 0x6e, 0xa7, 0x30, 0xcd, 0x3d, 0xca, 0x0a, 0xda, 0x4b, 0x46, 0xd2, 0x7f,
+	0x0d, 0x28, 0x72, 0x2b, 0x8c, 0xe2, 0xfd, 0x05, 0x4c, 0x33, 0xb3, 0xcc, 0xbb, 0xf4, 0x7a, 0xb6,
+	0x7c, 0xb3, 0x34, 0xfa, 0x64, 0x96, 0x22, 0x51, 0x43, 0xe0, 0xf5, 0xbf, 0xa6, 0x00, 0x76, 0xda,
+	0x96, 0xeb, 0x19, 0x98, 0x04, 0x2d, 0xa4, 0xc3, 0x3c, 0xe9, 0x38, 0x66, 0x1b, 0x5b, 0x4e, 0x32,
+	0xcd, 0xb3, 0xa4, 0xe3, 0x3c, 0xc5, 0x96, 0xc3, 0x12, 0xbd, 0x0f, 0xc3, 0x13, 0x45, 0x64, 0x82,
+	0xc2, 0xd4, 0x3b, 0x02, 0xe3, 0xe1, 0xb7, 0x09, 0x3d, 0x22, 0x13, 0xb2, 0x1e, 0x7e, 0x9b, 0xd4,
+	0x13, 0x61, 0xb8, 0x1e, 0x91, 0xcb, 0x0a, 0xc3, 0xf5, 0x6c, 0xc2, 0x0a, 0x4b, 0x30, 0xe2, 0x99,
+	0x96, 0x67, 0xe3, 0x90, 0x92, 0x40, 0xa8, 0x9b, 0xe6, 0xea, 0x90, 0xe0, 0x55, 0x24, 0xcb, 0x20,
+	0xa3, 0x25, 0xb8, 0xf2, 0x19, 0xae, 0x7c, 0x40, 0x82, 0xdb, 0x58, 0x81, 0x69, 0x07, 0xfb, 0xb4,
+	0x5d, 0x98, 0x15, 0xff, 0x9f, 0x2f, 0xf4, 0x1f, 0x34, 0x58, 0x7b, 0xee, 0x86, 0xf4, 0xc8, 0xea,
+	0xb8, 0x8e, 0xc5, 0xb4, 0xe3, 0xb7, 0x56, 0xe0, 0x84, 0xea, 0x94, 0x5c, 0x87, 0x6c, 0x48, 0xad,
+	0x80, 0x9a, 0x22, 0x77, 0xc4, 0x61, 0x01, 0x4e, 0xaa, 0x32, 0x0a, 0x5a, 0x83, 0x39, 0xec, 0x39,
+	0x92, 0x2d, 0xb6, 0x28, 0x83, 0x3d, 0x47, 0x30, 0x0b, 0x30, 0xeb, 0x7a, 0x0e, 0xbb, 0x4a, 0x0b,
+	0xe9, 0x1b, 0xe9, 0xf5, 0x29, 0x43, 0x2d, 0x99, 0x98, 0x6f, 0xb5, 0xb0, 0x19, 0xba, 0xef, 0xc5,
+	0xe1, 0x98, 0x36, 0x32, 0x8c, 0x50, 0x77, 0xdf, 0xf3, 0x2b, 0x88, 0x33, 0x29, 0x79, 0x8d, 0x3d,
+	0xbe, 0x09, 0x73, 0x06, 0x87, 0x37, 0x18, 0x41, 0xff, 0xa7, 0x06, 0x57, 0x46, 0xfb, 0x2c, 0xd3,
+	0x64, 0x0f, 0x66, 0x03, 0x41, 0x92, 0x89, 0xf2, 0x60, 0x5c, 0xa2, 0x44, 0x2a, 0xb8, 0xbf, 0x4a,
+	0x8f, 0x92, 0x46, 0x77, 0x60, 0xd1, 0xc3, 0xef, 0xa8, 0x99, 0xf0, 0x46, 0x64, 0xff, 0x3c, 0x23,
+	0x1f, 0x2a, 0x8f, 0x98, 0xc3, 0x94, 0x50, 0xab, 0x23, 0xc2, 0x49, 0xf3, 0x70, 0xe6, 0x38, 0x85,
+	0xc5, 0xa3, 0xff, 0x3f, 0x0d, 0x97, 0x46, 0x5a, 0x8a, 0x0f, 0xa5, 0x96, 0x38, 0x94, 0xec, 0x1a,
+	0x3a, 0x51, 0xf0, 0xfe, 0x6b, 0x28, 0x22, 0x8b, 0x6b, 0xe8, 0x16, 0xcc, 0x87, 0xa4, 0x17, 0xd8,
+	0xd8, 0x14, 0x1e, 0xcb, 0xb3, 0x9d, 0x13, 0x44, 0x61, 0x84, 0x81, 0xa8, 0x15, 0xb4, 0x30, 0x55,
+	0x20, 0x91, 0x80, 0x39, 0x41, 0x94, 0xa0, 0xeb, 0x90, 0x15, 0x59, 0x2c, 0x20, 0xe2, 0x44, 0x02,
+	0x23, 0x49, 0xc0, 0x23, 0x58, 0x75, 0x3d, 0xbb, 0xd3, 0x0b, 0x5d, 0xe2, 0x99, 0x0e, 0xee, 0x58,
+	0xa7, 0x0a, 0x2b, 0x52, 0x6e, 0x25, 0xe2, 0xee, 0x32, 0xa6, 0x94, 0xba, 0x0b, 0x8b, 0xbe, 0x78,
+	0x5f, 0x02, 0x05, 0x17, 0xe9, 0xb7, 0xa0, 0xc8, 0x12, 0xc8, 0x6e, 0x4c, 0x11, 0x89, 0x8f, 0x3d,
+	0xab, 0x43, 0x4f, 0x0b, 0x19, 0x8e, 0x93, 0xf1, 0x1d, 0x0a, 0x22, 0x83, 0xc9, 0x58, 0x14, 0x6c,
+	0x4e, 0xc0, 0x04, 0x55, 0xc1, 0x6e, 0x42, 0x8e, 0x47, 0xa3, 0x40, 0x20, 0x8e, 0x1c, 0xa3, 0x29,
+	0xc8, 0x03, 0x40, 0xae, 0x67, 0xd9, 0xd4, 0x3d, 0x71, 0xe9, 0x69, 0x04, 0xcc, 0x72, 0xe0, 0x52,
+	0xcc, 0x51, 0xf0, 0x7b, 0x90, 0x0f, 0x3b, 0x56, 0xd8, 0x76, 0xbd, 0x56, 0x04, 0xce, 0x71, 0xf0,
+	0xa2, 0xa2, 0x4b, 0xa8, 0x5e, 0x82, 0x95, 0x3a, 0x0d, 0xb0, 0xd5, 0xad, 0xbe, 0xf3, 0x49, 0x40,
+	0xa3, 0xa3, 0xb4, 0x0a, 0x33, 0x94, 0xf8, 0xae, 0x2d, 0x92, 0x72, 0xce, 0x90, 0x2b, 0xfd, 0x2b,
+	0x58, 0x10, 0x48, 0xec, 0x1c, 0x34, 0x7f, 0x83, 0x6d, 0x7e, 0x54, 0x39, 0x4f, 0x5e, 0xc2, 0x62,
+	0xc1, 0xe4, 0x09, 0xe7, 0xab, 0x27, 0x54, 0xac, 0x74, 0x03, 0xd6, 0xa2, 0xe4, 0x3a, 0xc4, 0xc1,
+	0x31, 0x09, 0xba, 0xec, 0x4e, 0x38, 0xeb, 0x9d, 0xbb, 0x0e, 0xd9, 0xf8, 0xf9, 0x0a, 0x0b, 0xa9,
+	0x1b, 0xe9, 0xf5, 0x9c, 0x01, 0xd1, 0xfb, 0x15, 0xea, 0xdf, 0xa7, 0xe0, 0xca, 0x68, 0xa5, 0xf2,
+	0x88, 0x15, 0x21, 0xd3, 0xb4, 0x3a, 0x8c, 0x24, 0xc2, 0x99, 0x32, 0xa2, 0x35, 0xdb, 0x2b, 0x71,
+	0x1a, 0xa2, 0x6c, 0x0d, 0x65, 0xfe, 0x2e, 0x72, 0x7a, 0xa4, 0x38, 0x44, 0x8f, 0xe1, 0xb2, 0x80,
+	0xf2, 0xfd, 0xc6, 0x49, 0x09, 0x91, 0xca, 0x97, 0x38, 0xbb, 0xc2, 0xb9, 0x09, 0xb9, 0x07, 0x80,
+	0xba, 0x6e, 0x18, 0xb2, 0xbf, 0x91, 0x10, 0x99, 0xe2, 0x71, 0x2c, 0x49, 0x4e, 0x02, 0xbe, 0x07,
+	0x37, 0xac, 0x13, 0x1c, 0xb0, 0x53, 0x3c, 0x68, 0xc8, 0x94, 0x6e, 0xf3, 0x94, 0x4f, 0x19, 0x57,
+	0x25, 0x6e, 0xc0, 0xe2, 0xb6, 0x00, 0xe9, 0x5f, 0x42, 0x31, 0xa2, 0x71, 0x48, 0x5f, 0x49, 0x31,
+	0xb0, 0xad, 0xda, 0xd0, 0xb6, 0xfe, 0x3d, 0x05, 0x6b, 0x23, 0xe5, 0xe5, 0xae, 0x3e, 0x86, 0x4b,
+	0x96, 0xa0, 0x62, 0xc7, 0x1c, 0x52, 0xb5, 0x9d, 0x2a, 0x68, 0xc6, 0x72, 0x04, 0x38, 0x8c, 0xf4,
+	0xa2, 0x23, 0xc8, 0xb0, 0xa7, 0xbf, 0x17, 0x62, 0xf1, 0x33, 0xb3, 0xe5, 0xcf, 0xcf, 0xbd, 0xf1,
+	0x86, 0xcd, 0x97, 0xea, 0x5c, 0x87, 0x11, 0xe9, 0x2a, 0xfa, 0x30, 0x23, 0x68, 0xe7, 0xd5, 0x77,
+	0x7b, 0x30, 0x23, 0x84, 0xf8, 0x8f, 0xce, 0x96, 0x37, 0xce, 0x35, 0x2f, 0x6d, 0x49, 0xd3, 0x86,
+	0x14, 0xd7, 0x3f, 0x87, 0xcb, 0xd5, 0x77, 0x2e, 0xc5, 0x4e, 0xfc, 0xf7, 0x26, 0xde, 0xdd, 0x2f,
+	0xa0, 0x30, 0x2c, 0x2b, 0x77, 0xf6, 0x5c, 0xe1, 0x17, 0xac, 0xc0, 0xb2, 0x5c, 0xaf, 0xce, 0x9e,
+	0xb6, 0x48, 0xac, 0x00, 0xb3, 0xfc, 0xad, 0xc3, 0x0e, 0x8f, 0x39, 0x63, 0xa8, 0x25, 0xbb, 0x62,
+	0x5a, 0xd8, 0xc3, 0xa1, 0x1b, 0x9a, 0xd4, 0xed, 0x62, 0x55, 0x1d, 0x48, 0x5a, 0xc3, 0xed, 0x62,
+	0xfd, 0x71, 0xe2, 0xd6, 0xe7, 0xf7, 0xf5, 0x64, 0xc5, 0xb2, 0x5e, 0x82, 0xd5, 0x41, 0xb9, 0xb8,
+	0xde, 0x13, 0xcf, 0x81, 0x7c, 0x2e, 0xf8, 0x42, 0x7f, 0x09, 0x4b, 0x95, 0x90, 0x95, 0x9a, 0x5d,
+	0xec, 0xd1, 0xc4, 0x6e, 0xf1, 0xc7, 0xc4, 0xe4, 0x0e, 0x4b, 0x01, 0xe0, 0x24, 0x1e, 0xe2, 0xf9,
+	0x77, 0xc0, 0x9f, 0xd2, 0x80, 0x92, 0x7a, 0xa5, 0x0f, 0x6f, 0x60, 0x25, 0x3e, 0x3c, 0x56, 0xc4,
+	0x97, 0x2f, 0xed, 0x57, 0xe3, 0x7e, 0xfc, 0xb0, 0xa6, 0x44, 0x2a, 0xc6, 0xbc, 0xe5, 0x93, 0x61,
+	0x62, 0xf1, 0xf7, 0x29, 0x58, 0x1e, 0x01, 0x16, 0x55, 0xa6, 0xac, 0xcb, 0xe5, 0x2d, 0x14, 0x13,
+	0x26, 0x2f, 0xe6, 0x6f, 0xc1, 0xbc, 0x68, 0xbf, 0xb0, 0x2c, 0xa2, 0xe4, 0x2b, 0xaa, 0x88, 0x75,
+	0xd9, 0x6a, 0x45, 0x2f, 0x59, 0xa2, 0x8c, 0xcb, 0x29, 0x22, 0x07, 0xf5, 0xff, 0xd8, 0xe9, 0xc1,
+	0x53, 0xf2, 0x75, 0x74, 0x4a, 0xd8, 0x9b, 0xb9, 0x50, 0xbe, 0x3b, 0xe9, 0x29, 0x51, 0xa7, 0xe3,
+	0xdf, 0x29, 0xb8, 0x3c, 0xe6, 0x04, 0x25, 0x94, 0x6b, 0x1f, 0xa5, 0x1c, 0xfd, 0x0a, 0x3e, 0xc5,
+	0xb4, 0xbd, 0x65, 0x3a, 0xd8, 0x27, 0xa1, 0x4b, 0x45, 0xb3, 0x6a, 0x7a, 0xbd, 0x6e, 0x13, 0x07,
+	0x72, 0xe7, 0x58, 0x27, 0xbc, 0xb5, 0x2b, 0xf8, 0xbc, 0x39, 0xdd, 0xe7, 0x5c, 0x56, 0x1c, 0x28,
+	0xa9, 0xb8, 0x48, 0x48, 0x6c, 0xe5, 0x8a, 0xe4, 0xd6, 0x14, 0x93, 0xef, 0xd6, 0x3d, 0xc8, 0x5b,
+	0xd1, 0x25, 0x24, 0x2b, 0x48, 0xb1, 0xab, 0x8b, 0x31, 0x5d, 0x14, 0x92, 0x5f, 0xc3, 0x15, 0xae,
+	0x80, 0x01, 0x5d, 0xcf, 0x4c, 0x88, 0xbd, 0xe9, 0xe1, 0x1e, 0x96, 0xf5, 0xca, 0xa7, 0x0a, 0x53,
+	0xf3, 0xe2, 0xdb, 0xed, 0x05, 0x03, 0xe8, 0x5f, 0xc2, 0xfc, 0x2e, 0xe9, 0xf2, 0x06, 0x40, 0x9c,
+	0x8f, 0xd1, 0x95, 0xd7, 0x2a, 0xcc, 0x38, 0x1c, 0xa6, 0xde, 0x58, 0xb1, 0xd2, 0xbf, 0x80, 0x05,
+	0x25, 0x2e, 0xb7, 0x9b, 0x15, 0x04, 0xaa, 0x91, 0x33, 0xa5, 0x8c, 0x26, 0x0b, 0x02, 0x45, 0x17,
+	0x22, 0xfa, 0x9f, 0x53, 0xb0, 0xc4, 0x77, 0xab, 0x11, 0xe0, 0xf8, 0x05, 0x7d, 0x02, 0x53, 0x34,
+	0x90, 0x79, 0x9b, 0x2d, 0x97, 0xc7, 0xfd, 0xad, 0x21, 0xc1, 0x12, 0x5b, 0xec, 0x13, 0x07, 0x1b,
+	0x5c, 0xbe, 0xf8, 0x2f, 0x0d, 0x32, 0x8a, 0x84, 0x7e, 0xa9, 0x5a, 0x3f, 0x8d, 0x5f, 0xc3, 0x7a,
+	0xac, 0x15, 0xd3, 0x76, 0x49, 0x4d, 0x1b, 0x4a, 0xdb, 0xdc, 0x04, 0x57, 0xad, 0xda, 0xc3, 0xfe,
+	0xb6, 0x3f, 0x35, 0xd0, 0xf6, 0xb3, 0x07, 0xd7, 0xb7, 0x02, 0xea, 0xda, 0xae, 0xcf, 0x1f, 0xa7,
+	0x13, 0x42, 0xb1, 0x7a, 0xa3, 0x97, 0x92, 0x9c, 0x23, 0xc6, 0x60, 0x97, 0x8b, 0x2c, 0x01, 0x38,
+	0x4e, 0xfc, 0x55, 0x51, 0x23, 0x73, 0x80, 0xfe, 0x1c, 0x56, 0x98, 0xd3, 0xdc, 0x05, 0x96, 0x0c,
+	0xea, 0xb7, 0xac, 0xc1, 0x1c, 0xef, 0x9c, 0x8f, 0x03, 0xd2, 0x95, 0xfb, 0x99, 0x61, 0x84, 0x27,
+	0x01, 0xe9, 0xb2, 0x26, 0x94, 0x33, 0x29, 0x91, 0xf9, 0x38, 0xc3, 0x96, 0x0d, 0x72, 0xff, 0x29,
+	0xcc, 0xc7, 0xcd, 0x00, 0xe9, 0x60, 0x94, 0x85, 0xd9, 0x97, 0xfb, 0xcf, 0xf6, 0x0f, 0x5e, 0xed,
+	0xe7, 0x3f, 0x41, 0x39, 0xc8, 0x54, 0x1a, 0x8d, 0x6a, 0xbd, 0x51, 0x35, 0xf2, 0x1a, 0x5b, 0x1d,
+	0x1a, 0x07, 0x87, 0x07, 0xf5, 0xaa, 0x91, 0x4f, 0xa1, 0x05, 0x80, 0xca, 0xde, 0x9e, 0x51, 0xdd,
+	0xab, 0x34, 0x0e, 0x8c, 0x7c, 0xfa, 0xfe, 0x3f, 0x34, 0x58, 0x1c, 0x38, 0x20, 0x08, 0xc1, 0x82,
+	0x54, 0x66, 0xd6, 0x1b, 0x95, 0xc6, 0xcb, 0x7a, 0xfe, 0x13, 0xb4, 0x02, 0xf9, 0xdd, 0xea, 0xe1,
+	0x41, 0xbd, 0xd6, 0x30, 0x8d, 0xea, 0x4e, 0xb5, 0x76, 0x54, 0xdd, 0xcd, 0x6b, 0x0c, 0x79, 0x58,
+	0xdd, 0xdf, 0xad, 0xed, 0xef, 0x99, 0x95, 0x9d, 0x46, 0xed, 0xa8, 0x9a, 0x4f, 0x21, 0x80, 0x19,
+	0xf9, 0x9d, 0x66, 0xfc, 0xda, 0x7e, 0xad, 0x51, 0xab, 0x34, 0xaa, 0xbb, 0x66, 0xf5, 0x9b, 0x5a,
+	0x23, 0x3f, 0x85, 0xf2, 0x90, 0x7b, 0x55, 0x6b, 0x3c, 0xdd, 0x35, 0x2a, 0xaf, 0x2a, 0xdb, 0xcf,
+	0xab, 0xf9, 0x69, 0x26, 0xc1, 0x78, 0xd5, 0xdd, 0xfc, 0x0c, 0x93, 0x10, 0xdf, 0x66, 0xfd, 0x79,
+	0xa5, 0xfe, 0xb4, 0xba, 0x9b, 0x9f, 0x2d, 0xff, 0x57, 0x83, 0xc5, 0x8a, 0xba, 0x9b, 0xc4, 0xa8,
+	0x0a, 0xb5, 0x01, 0xc9, 0x2d, 0x4c, 0x0c, 0x67, 0xd0, 0xfd, 0xb1, 0xb7, 0xf1, 0xd0, 0x04, 0xa7,
+	0x78, 0x67, 0x4c, 0xae, 0x24, 0xa0, 0xbb, 0x16, 0xb5, 0x90, 0x09, 0x4b, 0xf5, 0x5e, 0xb3, 0xeb,
+	0xf6, 0x19, 0xd2, 0xcf, 0x17, 0x2e, 0xde, 0x39, 0xdb, 0x19, 0x95, 0xdf, 0xe5, 0x1f, 0xb5, 0x68,
+	0x28, 0x15, 0x85, 0xf7, 0x0d, 0xe4, 0xa4, 0x9f, 0x3c, 0x63, 0xd0, 0xed, 0x33, 0x8f, 0x8b, 0x0a,
+	0x69, 0x82, 0xf4, 0x47, 0xdf, 0x41, 0x4e, 0x1a, 0x13, 0xeb, 0x09, 0x64, 0x8a, 0x63, 0xaf, 0xd6,
+	0x81, 0x59, 0x5a, 0xf9, 0x0f, 0x1a, 0x2c, 0xa9, 0x09, 0x0f, 0x89, 0x82, 0x09, 0xe0, 0xb2, 0xdc,
+	0x41, 0xc9, 0xc2, 0x15, 0xcf, 0x39, 0x0c, 0x08, 0x39, 0x3e, 0xe3, 0x87, 0x0d, 0x0d, 0xb0, 0x8a,
+	0x3f, 0x9b, 0x08, 0x2b, 0x3d, 0xf1, 0x60, 0x29, 0x9e, 0x1f, 0x29, 0x47, 0xbe, 0x85, 0xe5, 0x27,
+	0xae, 0x67, 0x75, 0xdc, 0xf7, 0xd8, 0x89, 0xb9, 0x68, 0xb5, 0x24, 0x06, 0x9b, 0x25, 0x35, 0xd8,
+	0x2c, 0x55, 0xd9, 0x60, 0xb3, 0x38, 0xd6, 0xb9, 0xe1, 0xc9, 0x54, 0xb9, 0x0d, 0x59, 0x3e, 0x84,
+	0x89, 0x2d, 0xb1, 0x8e, 0x9d, 0x91, 0xc2, 0x57, 0x2e, 0x6d, 0x8b, 0xb9, 0xce, 0xc5, 0x2d, 0x0d,
+	0xcf, 0x84, 0xca, 0x16, 0x4b, 0x0d, 0x12, 0xb4, 0x94, 0xa9, 0x17, 0xb0, 0x24, 0xda, 0xaf, 0x78,
+	0xde, 0x33, 0xde, 0x90, 0x3e, 0x3e, 0x24, 0x25, 0xbc, 0xa9, 0x95, 0x9b, 0x72, 0x14, 0xab, 0x4c,
+	0x18, 0x90, 0x13, 0x26, 0x38, 0x75, 0xbc, 0xf6, 0xf5, 0x31, 0xb9, 0xc4, 0x26, 0x80, 0xd8, 0x49,
+	0x64, 0xd4, 0xa6, 0x56, 0xfe, 0x8b, 0x06, 0x0b, 0x72, 0x2a, 0xa0, 0xcc, 0xfc, 0x4e, 0x83, 0x95,
+	0x51, 0x73, 0x0e, 0xf4, 0x70, 0x9c, 0xd7, 0x67, 0x4c, 0x72, 0x8a, 0x8f, 0x2e, 0x26, 0x24, 0x77,
+	0xf7, 0x1d, 0xcc, 0x8b, 0xe6, 0x54, 0x39, 0xd5, 0x82, 0xf9, 0xbe, 0xee, 0x16, 0xfd, 0x7c, 0x9c,
+	0xde, 0x51, 0x4d, 0xf0, 0xf8, 0x4b, 0xa0, 0xbf, 0x05, 0xde, 0xd4, 0xca, 0x3f, 0x64, 0x20, 0x1f,
+	0xdf, 0xc4, 0xd2, 0xfa, 0x77, 0x00, 0xe2, 0x51, 0xe5, 0x57, 0xd1, 0x67, 0xe3, 0x94, 0xf5, 0x3d,
+	0xf5, 0xc5, 0x3b, 0xe7, 0xc1, 0xe4, 0x8b, 0xfc, 0x5b, 0x58, 0x7a, 0x65, 0xb9, 0xf4, 0x49, 0xb2,
+	0x37, 0x42, 0xe5, 0x0b, 0x35, 0x52, 0xc2, 0xe0, 0xc3, 0x8f, 0x68, 0xbe, 0x36, 0x35, 0x44, 0x60,
+	0xa1, 0xbf, 0xee, 0x47, 0xe7, 0xcf, 0xad, 0x92, 0x7d, 0x45, 0xb1, 0x34, 0x29, 0x5c, 0x06, 0xdc,
+	0x81, 0xe5, 0x1d, 0x55, 0x0a, 0x27, 0xca, 0xea, 0x7b, 0x93, 0xd4, 0xf0, 0xc2, 0xe2, 0xfd, 0xc9,
+	0xcb, 0x7d, 0xf4, 0x66, 0xf8, 0x65, 0xbd, 0x60, 0x7c, 0x17, 0xed, 0x2a, 0xf9, 0x09, 0x1a, 0x35,
+	0xc6, 0x40, 0xe7, 0xff, 0xa1, 0xe1, 0x49, 0x4a, 0xf1, 0xd1, 0xc5, 0x84, 0xa4, 0x0f, 0x3d, 0xc8,
+	0x0f, 0x76, 0xa5, 0x68, 0x63, 0xfc, 0x29, 0x18, 0xd9, 0xfb, 0x16, 0x37, 0x27, 0x17, 0x90, 0x66,
+	0xbf, 0x8d, 0x92, 0x39, 0x6e, 0x6b, 0x3f, 0xe6, 0x66, 0x1f, 0x6c, 0x89, 0x37, 0x35, 0xf4, 0x0c,
+	0xe6, 0x77, 0x2c, 0x8f, 0x78, 0xae, 0x6d, 0x75, 0xd8, 0x08, 0x7b, 0x92, 0xdb, 0x75, 0xec, 0xfb,
+	0xfb, 0x0c, 0xb2, 0xf2, 0xd5, 0x64, 0xa1, 0xa0, 0xdb, 0x63, 0x44, 0x8e, 0x48, 0xa7, 0xe7, 0x51,
+	0x2b, 0x38, 0x65, 0xa8, 0xe2, 0x18, 0x83, 0xdb, 0xb9, 0x1f, 0x3f, 0x5c, 0xd3, 0xfe, 0xf3, 0xe1,
+	0x9a, 0xf6, 0xbf, 0x0f, 0xd7, 0xb4, 0xe6, 0x0c, 0xe7, 0x3e, 0xfc, 0x69, 0x00, 0x1e, 0x68, 0x28,
+	0x05, 0xe4, 0x1b, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// AttesterServiceClient is the client API for AttesterService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type AttesterServiceClient interface {
+	RequestAttestation(ctx context.Context, in *AttestationRequest, opts ...grpc.CallOption) (*v1alpha1.AttestationData, error)
+	SubmitAttestation(ctx context.Context, in *v1alpha1.Attestation, opts ...grpc.CallOption) (*AttestResponse, error)
+}
+
+type attesterServiceClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewAttesterServiceClient(cc *grpc.ClientConn) AttesterServiceClient {
+	return &attesterServiceClient{cc}
+}
+
+func (c *attesterServiceClient) RequestAttestation(ctx context.Context, in *AttestationRequest, opts ...grpc.CallOption) (*v1alpha1.AttestationData, error) {
+	out := new(v1alpha1.AttestationData)
+	err := c.cc.Invoke(ctx, "/ethereum.beacon.rpc.v1.AttesterService/RequestAttestation", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(ValidatorServiceServer).ValidatorIndex(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/ethereum.beacon.rpc.v1.ValidatorService/ValidatorIndex",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ValidatorServiceServer).ValidatorIndex(ctx, req.(*ValidatorIndexRequest))
+	return out, nil
+}
+
+func (c *attesterServiceClient) SubmitAttestation(ctx context.Context, in *v1alpha1.Attestation, opts ...grpc.CallOption) (*AttestResponse, error) {
+	out := new(AttestResponse)
+	err := c.cc.Invoke(ctx, "/ethereum.beacon.rpc.v1.AttesterService/SubmitAttestation", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return interceptor(ctx, in, info, handler)
+	return out, nil
 }
 
-func _ValidatorService_CommitteeAssignment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(AssignmentRequest)
+// AttesterServiceServer is the server API for AttesterService service.
+type AttesterServiceServer interface {
+	RequestAttestation(context.Context, *AttestationRequest) (*v1alpha1.AttestationData, error)
+	SubmitAttestation(context.Context, *v1alpha1.Attestation) (*AttestResponse, error)
+}
+
+// UnimplementedAttesterServiceServer can be embedded to have forward compatible implementations.
+type UnimplementedAttesterServiceServer struct {
+}
+
+func (*UnimplementedAttesterServiceServer) RequestAttestation(ctx context.Context, req *AttestationRequest) (*v1alpha1.AttestationData, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RequestAttestation not implemented")
+}
+func (*UnimplementedAttesterServiceServer) SubmitAttestation(ctx context.Context, req *v1alpha1.Attestation) (*AttestResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SubmitAttestation not implemented")
+}
+
+func RegisterAttesterServiceServer(s *grpc.Server, srv AttesterServiceServer) {
+	s.RegisterService(&_AttesterService_serviceDesc, srv)
+}
+
+func _AttesterService_RequestAttestation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AttestationRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ValidatorServiceServer).CommitteeAssignment(ctx, in)
+		return srv.(AttesterServiceServer).RequestAttestation(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/ethereum.beacon.rpc.v1.ValidatorService/CommitteeAssignment",
+		FullMethod: "/ethereum.beacon.rpc.v1.AttesterService/RequestAttestation",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ValidatorServiceServer).CommitteeAssignment(ctx, req.(*AssignmentRequest))
+		return srv.(AttesterServiceServer).RequestAttestation(ctx, req.(*AttestationRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ValidatorService_ValidatorStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ValidatorIndexRequest)
+func _AttesterService_SubmitAttestation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(v1alpha1.Attestation)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ValidatorServiceServer).ValidatorStatus(ctx, in)
+		return srv.(AttesterServiceServer).SubmitAttestation(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/ethereum.beacon.rpc.v1.ValidatorService/ValidatorStatus",
+		FullMethod: "/ethereum.beacon.rpc.v1.AttesterService/SubmitAttestation",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ValidatorServiceServer).ValidatorStatus(ctx, req.(*ValidatorIndexRequest))
+		return srv.(AttesterServiceServer).SubmitAttestation(ctx, req.(*v1alpha1.Attestation))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ValidatorService_ValidatorPerformance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ValidatorPerformanceRequest)
+var _AttesterService_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "ethereum.beacon.rpc.v1.AttesterService",
+	HandlerType: (*AttesterServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "RequestAttestation",
+			Handler:    _AttesterService_RequestAttestation_Handler,
+		},
+		{
+			MethodName: "SubmitAttestation",
+			Handler:    _AttesterService_SubmitAttestation_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "proto/beacon/rpc/v1/services.proto",
+}
+
+// ProposerServiceClient is the client API for ProposerService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type ProposerServiceClient interface {
+	RequestBlock(ctx context.Context, in *BlockRequest, opts ...grpc.CallOption) (*v1alpha1.BeaconBlock, error)
+	ProposeBlock(ctx context.Context, in *v1alpha1.BeaconBlock, opts ...grpc.CallOption) (*ProposeResponse, error)
+}
+
+type proposerServiceClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewProposerServiceClient(cc *grpc.ClientConn) ProposerServiceClient {
+	return &proposerServiceClient{cc}
+}
+
+func (c *proposerServiceClient) RequestBlock(ctx context.Context, in *BlockRequest, opts ...grpc.CallOption) (*v1alpha1.BeaconBlock, error) {
+	out := new(v1alpha1.BeaconBlock)
+	err := c.cc.Invoke(ctx, "/ethereum.beacon.rpc.v1.ProposerService/RequestBlock", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *proposerServiceClient) ProposeBlock(ctx context.Context, in *v1alpha1.BeaconBlock, opts ...grpc.CallOption) (*ProposeResponse, error) {
+	out := new(ProposeResponse)
+	err := c.cc.Invoke(ctx, "/ethereum.beacon.rpc.v1.ProposerService/ProposeBlock", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// ProposerServiceServer is the server API for ProposerService service.
+type ProposerServiceServer interface {
+	RequestBlock(context.Context, *BlockRequest) (*v1alpha1.BeaconBlock, error)
+	ProposeBlock(context.Context, *v1alpha1.BeaconBlock) (*ProposeResponse, error)
+}
+
+// UnimplementedProposerServiceServer can be embedded to have forward compatible implementations.
+type UnimplementedProposerServiceServer struct {
+}
+
+func (*UnimplementedProposerServiceServer) RequestBlock(ctx context.Context, req *BlockRequest) (*v1alpha1.BeaconBlock, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RequestBlock not implemented")
+}
+func (*UnimplementedProposerServiceServer) ProposeBlock(ctx context.Context, req *v1alpha1.BeaconBlock) (*ProposeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ProposeBlock not implemented")
+}
+
+func RegisterProposerServiceServer(s *grpc.Server, srv ProposerServiceServer) {
+	s.RegisterService(&_ProposerService_serviceDesc, srv)
+}
+
+func _ProposerService_RequestBlock_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BlockRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ValidatorServiceServer).ValidatorPerformance(ctx, in)
+		return srv.(ProposerServiceServer).RequestBlock(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/ethereum.beacon.rpc.v1.ValidatorService/ValidatorPerformance",
+		FullMethod: "/ethereum.beacon.rpc.v1.ProposerService/RequestBlock",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ValidatorServiceServer).ValidatorPerformance(ctx, req.(*ValidatorPerformanceRequest))
+		return srv.(ProposerServiceServer).RequestBlock(ctx, req.(*BlockRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ValidatorService_ExitedValidators_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ExitedValidatorsRequest)
+func _ProposerService_ProposeBlock_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(v1alpha1.BeaconBlock)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ValidatorServiceServer).ExitedValidators(ctx, in)
+		return srv.(ProposerServiceServer).ProposeBlock(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/ethereum.beacon.rpc.v1.ValidatorService/ExitedValidators",
+		FullMethod: "/ethereum.beacon.rpc.v1.ProposerService/ProposeBlock",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ValidatorServiceServer).ExitedValidators(ctx, req.(*ExitedValidatorsRequest))
+		return srv.(ProposerServiceServer).ProposeBlock(ctx, req.(*v1alpha1.BeaconBlock))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ValidatorService_WaitForChainStart_Handler(srv interface{}, stream grpc.ServerStream) error {
-	m := new(types.Empty)
-	if err := stream.RecvMsg(m); err != nil {
-		return err
+var _ProposerService_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "ethereum.beacon.rpc.v1.ProposerService",
+	HandlerType: (*ProposerServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "RequestBlock",
+			Handler:    _ProposerService_RequestBlock_Handler,
+		},
+		{
+			MethodName: "ProposeBlock",
+			Handler:    _ProposerService_ProposeBlock_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "proto/beacon/rpc/v1/services.proto",
+}
+
+// AggregatorServiceClient is the client API for AggregatorService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type AggregatorServiceClient interface {
+	SubmitAggregateAndProof(ctx context.Context, in *AggregationRequest, opts ...grpc.CallOption) (*AggregationResponse, error)
+}
+
+type aggregatorServiceClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewAggregatorServiceClient(cc *grpc.ClientConn) AggregatorServiceClient {
+	return &aggregatorServiceClient{cc}
+}
+
+func (c *aggregatorServiceClient) SubmitAggregateAndProof(ctx context.Context, in *AggregationRequest, opts ...grpc.CallOption) (*AggregationResponse, error) {
+	out := new(AggregationResponse)
+	err := c.cc.Invoke(ctx, "/ethereum.beacon.rpc.v1.AggregatorService/SubmitAggregateAndProof", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return srv.(ValidatorServiceServer).WaitForChainStart(m, &validatorServiceWaitForChainStartServer{stream})
+	return out, nil
 }
 
-type ValidatorService_WaitForChainStartServer interface {
-	Send(*ChainStartResponse) error
-	grpc.ServerStream
+// AggregatorServiceServer is the server API for AggregatorService service.
+type AggregatorServiceServer interface {
+	SubmitAggregateAndProof(context.Context, *AggregationRequest) (*AggregationResponse, error)
 }
 
-type validatorServiceWaitForChainStartServer struct {
-	grpc.ServerStream
+// UnimplementedAggregatorServiceServer can be embedded to have forward compatible implementations.
+type UnimplementedAggregatorServiceServer struct {
 }
 
-func (x *validatorServiceWaitForChainStartServer) Send(m *ChainStartResponse) error {
-	return x.ServerStream.SendMsg(m)
+func (*UnimplementedAggregatorServiceServer) SubmitAggregateAndProof(ctx context.Context, req *AggregationRequest) (*AggregationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SubmitAggregateAndProof not implemented")
 }
 
-func _ValidatorService_CanonicalHead_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(types.Empty)
+func RegisterAggregatorServiceServer(s *grpc.Server, srv AggregatorServiceServer) {
+	s.RegisterService(&_AggregatorService_serviceDesc, srv)
+}
+
+func _AggregatorService_SubmitAggregateAndProof_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AggregationRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ValidatorServiceServer).CanonicalHead(ctx, in)
+		return srv.(AggregatorServiceServer).SubmitAggregateAndProof(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/ethereum.beacon.rpc.v1.ValidatorService/CanonicalHead",
+		FullMethod: "/ethereum.beacon.rpc.v1.AggregatorService/SubmitAggregateAndProof",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ValidatorServiceServer).CanonicalHead(ctx, req.(*types.Empty))
+		return srv.(AggregatorServiceServer).SubmitAggregateAndProof(ctx, req.(*AggregationRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-var _ValidatorService_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "ethereum.beacon.rpc.v1.ValidatorService",
-	HandlerType: (*ValidatorServiceServer)(nil),
+var _AggregatorService_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "ethereum.beacon.rpc.v1.AggregatorService",
+	HandlerType: (*AggregatorServiceServer)(nil),
 	Methods: []grpc.MethodDesc{
 		{
-			MethodName: "DomainData",
-			Handler:    _ValidatorService_DomainData_Handler,
-		},
-		{
-			MethodName: "ValidatorIndex",
-			Handler:    _ValidatorService_ValidatorIndex_Handler,
-		},
-		{
-			MethodName: "CommitteeAssignment",
-			Handler:    _ValidatorService_CommitteeAssignment_Handler,
-		},
-		{
-			MethodName: "ValidatorStatus",
-			Handler:    _ValidatorService_ValidatorStatus_Handler,
-		},
-		{
-			MethodName: "ValidatorPerformance",
-			Handler:    _ValidatorService_ValidatorPerformance_Handler,
-		},
-		{
-			MethodName: "ExitedValidators",
-			Handler:    _ValidatorService_ExitedValidators_Handler,
-		},
-		{
-			MethodName: "CanonicalHead",
-			Handler:    _ValidatorService_CanonicalHead_Handler,
-		},
-	},
-	Streams: []grpc.StreamDesc{
-		{
-			StreamName:    "WaitForActivation",
-			Handler:       _ValidatorService_WaitForActivation_Handler,
-			ServerStreams: true,
-		},
-		{
-			StreamName:    "WaitForChainStart",
-			Handler:       _ValidatorService_WaitForChainStart_Handler,
-			ServerStreams: true,
+			MethodName: "SubmitAggregateAndProof",
+			Handler:    _AggregatorService_SubmitAggregateAndProof_Handler,
 		},
 	},
+	Streams:  []grpc.StreamDesc{},
 	Metadata: "proto/beacon/rpc/v1/services.proto",
 }
 
-func (m *BlockRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+// CheckpointServiceClient is the client API for CheckpointService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type CheckpointServiceClient interface {
+	FinalizedCheckpoint(ctx context.Context, in *types.Empty, opts ...grpc.CallOption) (*CheckpointResponse, error)
 }
 
-func (m *BlockRequest) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if m.Slot != 0 {
-		dAtA[i] = 0x8
-		i++
-		i = encodeVarintServices(dAtA, i, uint64(m.Slot))
-	}
-	if len(m.RandaoReveal) > 0 {
-		dAtA[i] = 0x12
-		i++
-		i = encodeVarintServices(dAtA, i, uint64(len(m.RandaoReveal)))
-		i += copy(dAtA[i:], m.RandaoReveal)
-	}
-	if m.XXX_unrecognized != nil {
-		i += copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	return i, nil
+type checkpointServiceClient struct {
+	cc *grpc.ClientConn
 }
 
-func (m *ProposeResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
+func NewCheckpointServiceClient(cc *grpc.ClientConn) CheckpointServiceClient {
+	return &checkpointServiceClient{cc}
+}
+
+func (c *checkpointServiceClient) FinalizedCheckpoint(ctx context.Context, in *types.Empty, opts ...grpc.CallOption) (*CheckpointResponse, error) {
+	out := new(CheckpointResponse)
+	err := c.cc.Invoke(ctx, "/ethereum.beacon.rpc.v1.CheckpointService/FinalizedCheckpoint", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *ProposeResponse) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if len(m.BlockRoot) > 0 {
-		dAtA[i] = 0xa
-		i++
-		i = encodeVarintServices(dAtA, i, uint64(len(m.BlockRoot)))
-		i += copy(dAtA[i:], m.BlockRoot)
-	}
-	if m.XXX_unrecognized != nil {
-		i += copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	return i, nil
+// CheckpointServiceServer is the server API for CheckpointService service.
+type CheckpointServiceServer interface {
+	FinalizedCheckpoint(context.Context, *types.Empty) (*CheckpointResponse, error)
 }
 
-func (m *AttestationRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+// UnimplementedCheckpointServiceServer can be embedded to have forward compatible implementations.
+type UnimplementedCheckpointServiceServer struct {
 }
 
-func (m *AttestationRequest) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if len(m.PublicKey) > 0 {
-		dAtA[i] = 0xa
-		i++
-		i = encodeVarintServices(dAtA, i, uint64(len(m.PublicKey)))
-		i += copy(dAtA[i:], m.PublicKey)
-	}
-	if len(m.PocBit) > 0 {
-		dAtA[i] = 0x12
-		i++
-		i = encodeVarintServices(dAtA, i, uint64(len(m.PocBit)))
-		i += copy(dAtA[i:], m.PocBit)
+func (*UnimplementedCheckpointServiceServer) FinalizedCheckpoint(ctx context.Context, req *types.Empty) (*CheckpointResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FinalizedCheckpoint not implemented")
+}
+
+func RegisterCheckpointServiceServer(s *grpc.Server, srv CheckpointServiceServer) {
+	s.RegisterService(&_CheckpointService_serviceDesc, srv)
+}
+
+func _CheckpointService_FinalizedCheckpoint_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(types.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if m.Slot != 0 {
-		dAtA[i] = 0x18
-		i++
-		i = encodeVarintServices(dAtA, i, uint64(m.Slot))
+	if interceptor == nil {
+		return srv.(CheckpointServiceServer).FinalizedCheckpoint(ctx, in)
 	}
-	if m.CommitteeIndex != 0 {
-		dAtA[i] = 0x20
-		i++
-		i = encodeVarintServices(dAtA, i, uint64(m.CommitteeIndex))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethereum.beacon.rpc.v1.CheckpointService/FinalizedCheckpoint",
 	}
-	if m.XXX_unrecognized != nil {
-		i += copy(dAtA[i:], m.XXX_unrecognized)
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CheckpointServiceServer).FinalizedCheckpoint(ctx, req.(*types.Empty))
 	}
-	return i, nil
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *AttestResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+var _CheckpointService_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "ethereum.beacon.rpc.v1.CheckpointService",
+	HandlerType: (*CheckpointServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "FinalizedCheckpoint",
+			Handler:    _CheckpointService_FinalizedCheckpoint_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "proto/beacon/rpc/v1/services.proto",
 }
 
-func (m *AttestResponse) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if len(m.Root) > 0 {
-		dAtA[i] = 0xa
-		i++
-		i = encodeVarintServices(dAtA, i, uint64(len(m.Root)))
-		i += copy(dAtA[i:], m.Root)
-	}
-	if m.XXX_unrecognized != nil {
-		i += copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	return i, nil
+// PeerServiceClient is the client API for PeerService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type PeerServiceClient interface {
+	ListPeersWithScores(ctx context.Context, in *types.Empty, opts ...grpc.CallOption) (*PeerScoresResponse, error)
 }
 
-func (m *AggregationRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+type peerServiceClient struct {
+	cc *grpc.ClientConn
 }
 
-func (m *AggregationRequest) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if m.Slot != 0 {
-		dAtA[i] = 0x8
-		i++
-		i = encodeVarintServices(dAtA, i, uint64(m.Slot))
-	}
-	if m.CommitteeIndex != 0 {
-		dAtA[i] = 0x10
-		i++
-		i = encodeVarintServices(dAtA, i, uint64(m.CommitteeIndex))
-	}
-	if len(m.PublicKey) > 0 {
-		dAtA[i] = 0x1a
-		i++
-		i = encodeVarintServices(dAtA, i, uint64(len(m.PublicKey)))
-		i += copy(dAtA[i:], m.PublicKey)
-	}
-	if len(m.SlotSignature) > 0 {
-		dAtA[i] = 0x22
-		i++
-		i = encodeVarintServices(dAtA, i, uint64(len(m.SlotSignature)))
-		i += copy(dAtA[i:], m.SlotSignature)
-	}
-	if m.XXX_unrecognized != nil {
-		i += copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	return i, nil
+func NewPeerServiceClient(cc *grpc.ClientConn) PeerServiceClient {
+	return &peerServiceClient{cc}
 }
 
-func (m *AggregationResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
+func (c *peerServiceClient) ListPeersWithScores(ctx context.Context, in *types.Empty, opts ...grpc.CallOption) (*PeerScoresResponse, error) {
+	out := new(PeerScoresResponse)
+	err := c.cc.Invoke(ctx, "/ethereum.beacon.rpc.v1.PeerService/ListPeersWithScores", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *AggregationResponse) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if len(m.Root) > 0 {
-		dAtA[i] = 0xa
-		i++
-		i = encodeVarintServices(dAtA, i, uint64(len(m.Root)))
-		i += copy(dAtA[i:], m.Root)
-	}
-	if m.XXX_unrecognized != nil {
-		i += copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	return i, nil
+// PeerServiceServer is the server API for PeerService service.
+type PeerServiceServer interface {
+	ListPeersWithScores(context.Context, *types.Empty) (*PeerScoresResponse, error)
 }
 
-func (m *ValidatorPerformanceRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+// UnimplementedPeerServiceServer can be embedded to have forward compatible implementations.
+type UnimplementedPeerServiceServer struct {
 }
 
-func (m *ValidatorPerformanceRequest) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if m.Slot != 0 {
-		dAtA[i] = 0x8
-		i++
-		i = encodeVarintServices(dAtA, i, uint64(m.Slot))
-	}
-	if len(m.PublicKeys) > 0 {
-		for _, b := range m.PublicKeys {
-			dAtA[i] = 0x12
-			i++
-			i = encodeVarintServices(dAtA, i, uint64(len(b)))
-			i += copy(dAtA[i:], b)
-		}
-	}
-	if m.XXX_unrecognized != nil {
-		i += copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	return i, nil
+func (*UnimplementedPeerServiceServer) ListPeersWithScores(ctx context.Context, req *types.Empty) (*PeerScoresResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListPeersWithScores not implemented")
 }
 
-func (m *ValidatorPerformanceResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func RegisterPeerServiceServer(s *grpc.Server, srv PeerServiceServer) {
+	s.RegisterService(&_PeerService_serviceDesc, srv)
 }
 
-func (m *ValidatorPerformanceResponse) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if len(m.Balances) > 0 {
-		dAtA2 := make([]byte, len(m.Balances)*10)
-		var j1 int
-		for _, num := range m.Balances {
-			for num >= 1<<7 {
-				dAtA2[j1] = uint8(uint64(num)&0x7f | 0x80)
-				num >>= 7
-				j1++
-			}
-			dAtA2[j1] = uint8(num)
-			j1++
-		}
-		dAtA[i] = 0xa
-		i++
-		i = encodeVarintServices(dAtA, i, uint64(j1))
-		i += copy(dAtA[i:], dAtA2[:j1])
-	}
-	if m.TotalValidators != 0 {
-		dAtA[i] = 0x10
-		i++
-		i = encodeVarintServices(dAtA, i, uint64(m.TotalValidators))
-	}
-	if m.TotalActiveValidators != 0 {
-		dAtA[i] = 0x18
-		i++
-		i = encodeVarintServices(dAtA, i, uint64(m.TotalActiveValidators))
+func _PeerService_ListPeersWithScores_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(types.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if len(m.MissingValidators) > 0 {
-		for _, b := range m.MissingValidators {
-			dAtA[i] = 0x22
-			i++
-			i = encodeVarintServices(dAtA, i, uint64(len(b)))
-			i += copy(dAtA[i:], b)
-		}
+	if interceptor == nil {
+		return srv.(PeerServiceServer).ListPeersWithScores(ctx, in)
 	}
-	if m.AverageActiveValidatorBalance != 0 {
-		dAtA[i] = 0x2d
-		i++
-		encoding_binary.LittleEndian.PutUint32(dAtA[i:], uint32(math.Float32bits(float32(m.AverageActiveValidatorBalance))))
-		i += 4
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethereum.beacon.rpc.v1.PeerService/ListPeersWithScores",
 	}
-	if m.XXX_unrecognized != nil {
-		i += copy(dAtA[i:], m.XXX_unrecognized)
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PeerServiceServer).ListPeersWithScores(ctx, req.(*types.Empty))
 	}
-	return i, nil
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *ValidatorActivationRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+var _PeerService_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "ethereum.beacon.rpc.v1.PeerService",
+	HandlerType: (*PeerServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "ListPeersWithScores",
+			Handler:    _PeerService_ListPeersWithScores_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "proto/beacon/rpc/v1/services.proto",
 }
 
-func (m *ValidatorActivationRequest) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if len(m.PublicKeys) > 0 {
-		for _, b := range m.PublicKeys {
-			dAtA[i] = 0xa
-			i++
-			i = encodeVarintServices(dAtA, i, uint64(len(b)))
-			i += copy(dAtA[i:], b)
-		}
-	}
-	if m.XXX_unrecognized != nil {
-		i += copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	return i, nil
+// ReorgServiceClient is the client API for ReorgService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type ReorgServiceClient interface {
+	StreamChainReorgs(ctx context.Context, in *types.Empty, opts ...grpc.CallOption) (ReorgService_StreamChainReorgsClient, error)
 }
 
-func (m *ValidatorActivationResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+type reorgServiceClient struct {
+	cc *grpc.ClientConn
 }
 
-func (m *ValidatorActivationResponse) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if len(m.ActivatedPublicKeys) > 0 {
-		for _, b := range m.ActivatedPublicKeys {
-			dAtA[i] = 0xa
-			i++
-			i = encodeVarintServices(dAtA, i, uint64(len(b)))
-			i += copy(dAtA[i:], b)
-		}
-	}
-	if len(m.Statuses) > 0 {
-		for _, msg := range m.Statuses {
-			dAtA[i] = 0x12
-			i++
-			i = encodeVarintServices(dAtA, i, uint64(msg.Size()))
-			n, err := msg.MarshalTo(dAtA[i:])
-			if err != nil {
-				return 0, err
-			}
-			i += n
-		}
-	}
-	if m.XXX_unrecognized != nil {
-		i += copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	return i, nil
+func NewReorgServiceClient(cc *grpc.ClientConn) ReorgServiceClient {
+	return &reorgServiceClient{cc}
 }
 
-func (m *ValidatorActivationResponse_Status) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
+func (c *reorgServiceClient) StreamChainReorgs(ctx context.Context, in *types.Empty, opts ...grpc.CallOption) (ReorgService_StreamChainReorgsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_ReorgService_serviceDesc.Streams[0], "/ethereum.beacon.rpc.v1.ReorgService/StreamChainReorgs", opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *ValidatorActivationResponse_Status) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if len(m.PublicKey) > 0 {
-		dAtA[i] = 0xa
-		i++
-		i = encodeVarintServices(dAtA, i, uint64(len(m.PublicKey)))
-		i += copy(dAtA[i:], m.PublicKey)
-	}
-	if m.Status != nil {
-		dAtA[i] = 0x12
-		i++
-		i = encodeVarintServices(dAtA, i, uint64(m.Status.Size()))
-		n3, err := m.Status.MarshalTo(dAtA[i:])
-		if err != nil {
-			return 0, err
-		}
-		i += n3
+	x := &reorgServiceStreamChainReorgsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
 	}
-	if m.XXX_unrecognized != nil {
-		i += copy(dAtA[i:], m.XXX_unrecognized)
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
 	}
-	return i, nil
+	return x, nil
 }
 
-func (m *ExitedValidatorsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+type ReorgService_StreamChainReorgsClient interface {
+	Recv() (*ChainReorg, error)
+	grpc.ClientStream
 }
 
-func (m *ExitedValidatorsRequest) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if len(m.PublicKeys) > 0 {
-		for _, b := range m.PublicKeys {
-			dAtA[i] = 0xa
-			i++
-			i = encodeVarintServices(dAtA, i, uint64(len(b)))
-			i += copy(dAtA[i:], b)
-		}
-	}
-	if m.XXX_unrecognized != nil {
-		i += copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	return i, nil
+type reorgServiceStreamChainReorgsClient struct {
+	grpc.ClientStream
 }
 
-func (m *ExitedValidatorsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
+func (x *reorgServiceStreamChainReorgsClient) Recv() (*ChainReorg, error) {
+	m := new(ChainReorg)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return m, nil
 }
 
-func (m *ExitedValidatorsResponse) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if len(m.PublicKeys) > 0 {
-		for _, b := range m.PublicKeys {
-			dAtA[i] = 0xa
-			i++
-			i = encodeVarintServices(dAtA, i, uint64(len(b)))
-			i += copy(dAtA[i:], b)
-		}
-	}
-	if m.XXX_unrecognized != nil {
-		i += copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	return i, nil
+// ReorgServiceServer is the server API for ReorgService service.
+type ReorgServiceServer interface {
+	StreamChainReorgs(*types.Empty, ReorgService_StreamChainReorgsServer) error
 }
 
-func (m *ChainStartResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+// UnimplementedReorgServiceServer can be embedded to have forward compatible implementations.
+type UnimplementedReorgServiceServer struct {
 }
 
-func (m *ChainStartResponse) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if m.Started {
-		dAtA[i] = 0x8
-		i++
-		if m.Started {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i++
-	}
-	if m.GenesisTime != 0 {
-		dAtA[i] = 0x10
-		i++
-		i = encodeVarintServices(dAtA, i, uint64(m.GenesisTime))
-	}
-	if m.XXX_unrecognized != nil {
-		i += copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	return i, nil
+func (*UnimplementedReorgServiceServer) StreamChainReorgs(req *types.Empty, srv ReorgService_StreamChainReorgsServer) error {
+	return status.Errorf(codes.Unimplemented, "method StreamChainReorgs not implemented")
 }
 
-func (m *ValidatorIndexRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func RegisterReorgServiceServer(s *grpc.Server, srv ReorgServiceServer) {
+	s.RegisterService(&_ReorgService_serviceDesc, srv)
 }
 
-func (m *ValidatorIndexRequest) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if len(m.PublicKey) > 0 {
-		dAtA[i] = 0xa
-		i++
-		i = encodeVarintServices(dAtA, i, uint64(len(m.PublicKey)))
-		i += copy(dAtA[i:], m.PublicKey)
-	}
-	if m.XXX_unrecognized != nil {
-		i += copy(dAtA[i:], m.XXX_unrecognized)
+func _ReorgService_StreamChainReorgs_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(types.Empty)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
 	}
-	return i, nil
+	return srv.(ReorgServiceServer).StreamChainReorgs(m, &reorgServiceStreamChainReorgsServer{stream})
 }
 
-func (m *ValidatorIndexResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+type ReorgService_StreamChainReorgsServer interface {
+	Send(*ChainReorg) error
+	grpc.ServerStream
 }
 
-func (m *ValidatorIndexResponse) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if m.Index != 0 {
-		dAtA[i] = 0x8
-		i++
-		i = encodeVarintServices(dAtA, i, uint64(m.Index))
-	}
-	if m.XXX_unrecognized != nil {
-		i += copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	return i, nil
+type reorgServiceStreamChainReorgsServer struct {
+	grpc.ServerStream
 }
 
-func (m *AssignmentRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (x *reorgServiceStreamChainReorgsServer) Send(m *ChainReorg) error {
+	return x.ServerStream.SendMsg(m)
 }
 
-func (m *AssignmentRequest) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if m.EpochStart != 0 {
-		dAtA[i] = 0x8
-		i++
-		i = encodeVarintServices(dAtA, i, uint64(m.EpochStart))
-	}
-	if len(m.PublicKeys) > 0 {
-		for _, b := range m.PublicKeys {
-			dAtA[i] = 0x12
-			i++
-			i = encodeVarintServices(dAtA, i, uint64(len(b)))
-			i += copy(dAtA[i:], b)
-		}
-	}
-	if m.XXX_unrecognized != nil {
-		i += copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	return i, nil
+var _ReorgService_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "ethereum.beacon.rpc.v1.ReorgService",
+	HandlerType: (*ReorgServiceServer)(nil),
+	Methods:     []grpc.MethodDesc{},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "StreamChainReorgs",
+			Handler:       _ReorgService_StreamChainReorgs_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "proto/beacon/rpc/v1/services.proto",
 }
 
-func (m *AssignmentResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+// BlockServiceClient is the client API for BlockService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type BlockServiceClient interface {
+	StreamBlocks(ctx context.Context, in *types.Empty, opts ...grpc.CallOption) (BlockService_StreamBlocksClient, error)
 }
 
-func (m *AssignmentResponse) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if len(m.ValidatorAssignment) > 0 {
-		for _, msg := range m.ValidatorAssignment {
-			dAtA[i] = 0xa
-			i++
-			i = encodeVarintServices(dAtA, i, uint64(msg.Size()))
-			n, err := msg.MarshalTo(dAtA[i:])
-			if err != nil {
-				return 0, err
-			}
-			i += n
-		}
-	}
-	if m.XXX_unrecognized != nil {
-		i += copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	return i, nil
+type blockServiceClient struct {
+	cc *grpc.ClientConn
 }
 
-func (m *AssignmentResponse_ValidatorAssignment) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func NewBlockServiceClient(cc *grpc.ClientConn) BlockServiceClient {
+	return &blockServiceClient{cc}
 }
 
-func (m *AssignmentResponse_ValidatorAssignment) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if len(m.Committee) > 0 {
-		dAtA5 := make([]byte, len(m.Committee)*10)
-		var j4 int
-		for _, num := range m.Committee {
-			for num >= 1<<7 {
-				dAtA5[j4] = uint8(uint64(num)&0x7f | 0x80)
-				num >>= 7
-				j4++
-			}
-			dAtA5[j4] = uint8(num)
-			j4++
-		}
-		dAtA[i] = 0xa
-		i++
-		i = encodeVarintServices(dAtA, i, uint64(j4))
-		i += copy(dAtA[i:], dAtA5[:j4])
-	}
-	if m.CommitteeIndex != 0 {
-		dAtA[i] = 0x10
-		i++
-		i = encodeVarintServices(dAtA, i, uint64(m.CommitteeIndex))
-	}
-	if m.AttesterSlot != 0 {
-		dAtA[i] = 0x18
-		i++
-		i = encodeVarintServices(dAtA, i, uint64(m.AttesterSlot))
-	}
-	if m.ProposerSlot != 0 {
-		dAtA[i] = 0x20
-		i++
-		i = encodeVarintServices(dAtA, i, uint64(m.ProposerSlot))
-	}
-	if len(m.PublicKey) > 0 {
-		dAtA[i] = 0x2a
-		i++
-		i = encodeVarintServices(dAtA, i, uint64(len(m.PublicKey)))
-		i += copy(dAtA[i:], m.PublicKey)
+func (c *blockServiceClient) StreamBlocks(ctx context.Context, in *types.Empty, opts ...grpc.CallOption) (BlockService_StreamBlocksClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_BlockService_serviceDesc.Streams[0], "/ethereum.beacon.rpc.v1.BlockService/StreamBlocks", opts...)
+	if err != nil {
+		return nil, err
 	}
-	if m.Status != 0 {
-		dAtA[i] = 0x30
-		i++
-		i = encodeVarintServices(dAtA, i, uint64(m.Status))
+	x := &blockServiceStreamBlocksClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
 	}
-	if m.XXX_unrecognized != nil {
-		i += copy(dAtA[i:], m.XXX_unrecognized)
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
 	}
-	return i, nil
+	return x, nil
 }
 
-func (m *ValidatorStatusResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+type BlockService_StreamBlocksClient interface {
+	Recv() (*v1alpha1.SignedBeaconBlock, error)
+	grpc.ClientStream
 }
 
-func (m *ValidatorStatusResponse) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if m.Status != 0 {
-		dAtA[i] = 0x8
-		i++
-		i = encodeVarintServices(dAtA, i, uint64(m.Status))
-	}
-	if m.Eth1DepositBlockNumber != 0 {
-		dAtA[i] = 0x10
-		i++
-		i = encodeVarintServices(dAtA, i, uint64(m.Eth1DepositBlockNumber))
-	}
-	if m.DepositInclusionSlot != 0 {
-		dAtA[i] = 0x18
-		i++
-		i = encodeVarintServices(dAtA, i, uint64(m.DepositInclusionSlot))
-	}
-	if m.ActivationEpoch != 0 {
-		dAtA[i] = 0x20
-		i++
-		i = encodeVarintServices(dAtA, i, uint64(m.ActivationEpoch))
-	}
-	if m.PositionInActivationQueue != 0 {
-		dAtA[i] = 0x28
-		i++
-		i = encodeVarintServices(dAtA, i, uint64(m.PositionInActivationQueue))
-	}
-	if m.XXX_unrecognized != nil {
-		i += copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	return i, nil
+type blockServiceStreamBlocksClient struct {
+	grpc.ClientStream
 }
 
-func (m *DomainRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
+func (x *blockServiceStreamBlocksClient) Recv() (*v1alpha1.SignedBeaconBlock, error) {
+	m := new(v1alpha1.SignedBeaconBlock)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return m, nil
 }
 
-func (m *DomainRequest) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if m.Epoch != 0 {
-		dAtA[i] = 0x8
-		i++
-		i = encodeVarintServices(dAtA, i, uint64(m.Epoch))
-	}
-	if len(m.Domain) > 0 {
-		dAtA[i] = 0x12
-		i++
-		i = encodeVarintServices(dAtA, i, uint64(len(m.Domain)))
-		i += copy(dAtA[i:], m.Domain)
-	}
-	if m.XXX_unrecognized != nil {
-		i += copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	return i, nil
+// BlockServiceServer is the server API for BlockService service.
+type BlockServiceServer interface {
+	StreamBlocks(*types.Empty, BlockService_StreamBlocksServer) error
 }
 
-func (m *DomainResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+// UnimplementedBlockServiceServer can be embedded to have forward compatible implementations.
+type UnimplementedBlockServiceServer struct {
 }
 
-func (m *DomainResponse) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if m.SignatureDomain != 0 {
-		dAtA[i] = 0x8
-		i++
-		i = encodeVarintServices(dAtA, i, uint64(m.SignatureDomain))
-	}
-	if m.XXX_unrecognized != nil {
-		i += copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	return i, nil
+func (*UnimplementedBlockServiceServer) StreamBlocks(req *types.Empty, srv BlockService_StreamBlocksServer) error {
+	return status.Errorf(codes.Unimplemented, "method StreamBlocks not implemented")
 }
 
-func (m *BlockTreeResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func RegisterBlockServiceServer(s *grpc.Server, srv BlockServiceServer) {
+	s.RegisterService(&_BlockService_serviceDesc, srv)
 }
 
-func (m *BlockTreeResponse) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if len(m.Tree) > 0 {
-		for _, msg := range m.Tree {
-			dAtA[i] = 0xa
-			i++
-			i = encodeVarintServices(dAtA, i, uint64(msg.Size()))
-			n, err := msg.MarshalTo(dAtA[i:])
-			if err != nil {
-				return 0, err
-			}
-			i += n
-		}
-	}
-	if m.XXX_unrecognized != nil {
-		i += copy(dAtA[i:], m.XXX_unrecognized)
+func _BlockService_StreamBlocks_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(types.Empty)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
 	}
-	return i, nil
+	return srv.(BlockServiceServer).StreamBlocks(m, &blockServiceStreamBlocksServer{stream})
 }
 
-func (m *BlockTreeResponse_TreeNode) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+type BlockService_StreamBlocksServer interface {
+	Send(*v1alpha1.SignedBeaconBlock) error
+	grpc.ServerStream
 }
 
-func (m *BlockTreeResponse_TreeNode) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if m.Block != nil {
-		dAtA[i] = 0xa
-		i++
-		i = encodeVarintServices(dAtA, i, uint64(m.Block.Size()))
-		n6, err := m.Block.MarshalTo(dAtA[i:])
-		if err != nil {
-			return 0, err
-		}
-		i += n6
-	}
-	if len(m.BlockRoot) > 0 {
-		dAtA[i] = 0x12
-		i++
-		i = encodeVarintServices(dAtA, i, uint64(len(m.BlockRoot)))
-		i += copy(dAtA[i:], m.BlockRoot)
-	}
-	if m.ParticipatedVotes != 0 {
-		dAtA[i] = 0x18
-		i++
-		i = encodeVarintServices(dAtA, i, uint64(m.ParticipatedVotes))
-	}
-	if m.TotalVotes != 0 {
-		dAtA[i] = 0x20
-		i++
-		i = encodeVarintServices(dAtA, i, uint64(m.TotalVotes))
-	}
-	if m.XXX_unrecognized != nil {
-		i += copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	return i, nil
+type blockServiceStreamBlocksServer struct {
+	grpc.ServerStream
 }
 
-func (m *TreeBlockSlotRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
+func (x *blockServiceStreamBlocksServer) Send(m *v1alpha1.SignedBeaconBlock) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+var _BlockService_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "ethereum.beacon.rpc.v1.BlockService",
+	HandlerType: (*BlockServiceServer)(nil),
+	Methods:     []grpc.MethodDesc{},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "StreamBlocks",
+			Handler:       _BlockService_StreamBlocks_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "proto/beacon/rpc/v1/services.proto",
+}
+
+// RewardsServiceClient is the client API for RewardsService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type RewardsServiceClient interface {
+	ListValidatorRewards(ctx context.Context, in *ListValidatorRewardsRequest, opts ...grpc.CallOption) (*ListValidatorRewardsResponse, error)
+}
+
+type rewardsServiceClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewRewardsServiceClient(cc *grpc.ClientConn) RewardsServiceClient {
+	return &rewardsServiceClient{cc}
+}
+
+func (c *rewardsServiceClient) ListValidatorRewards(ctx context.Context, in *ListValidatorRewardsRequest, opts ...grpc.CallOption) (*ListValidatorRewardsResponse, error) {
+	out := new(ListValidatorRewardsResponse)
+	err := c.cc.Invoke(ctx, "/ethereum.beacon.rpc.v1.RewardsService/ListValidatorRewards", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *TreeBlockSlotRequest) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if m.SlotFrom != 0 {
-		dAtA[i] = 0x8
-		i++
-		i = encodeVarintServices(dAtA, i, uint64(m.SlotFrom))
-	}
-	if m.SlotTo != 0 {
-		dAtA[i] = 0x10
-		i++
-		i = encodeVarintServices(dAtA, i, uint64(m.SlotTo))
-	}
-	if m.XXX_unrecognized != nil {
-		i += copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	return i, nil
+// RewardsServiceServer is the server API for RewardsService service.
+type RewardsServiceServer interface {
+	ListValidatorRewards(context.Context, *ListValidatorRewardsRequest) (*ListValidatorRewardsResponse, error)
 }
 
-func encodeVarintServices(dAtA []byte, offset int, v uint64) int {
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
-	}
-	dAtA[offset] = uint8(v)
-	return offset + 1
+// UnimplementedRewardsServiceServer can be embedded to have forward compatible implementations.
+type UnimplementedRewardsServiceServer struct {
 }
-func (m *BlockRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Slot != 0 {
-		n += 1 + sovServices(uint64(m.Slot))
-	}
-	l = len(m.RandaoReveal)
-	if l > 0 {
-		n += 1 + l + sovServices(uint64(l))
-	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
-	}
-	return n
+
+func (*UnimplementedRewardsServiceServer) ListValidatorRewards(ctx context.Context, req *ListValidatorRewardsRequest) (*ListValidatorRewardsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListValidatorRewards not implemented")
 }
 
-func (m *ProposeResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.BlockRoot)
-	if l > 0 {
-		n += 1 + l + sovServices(uint64(l))
-	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
-	}
-	return n
+func RegisterRewardsServiceServer(s *grpc.Server, srv RewardsServiceServer) {
+	s.RegisterService(&_RewardsService_serviceDesc, srv)
 }
 
-func (m *AttestationRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.PublicKey)
-	if l > 0 {
-		n += 1 + l + sovServices(uint64(l))
-	}
-	l = len(m.PocBit)
-	if l > 0 {
-		n += 1 + l + sovServices(uint64(l))
+func _RewardsService_ListValidatorRewards_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListValidatorRewardsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if m.Slot != 0 {
-		n += 1 + sovServices(uint64(m.Slot))
+	if interceptor == nil {
+		return srv.(RewardsServiceServer).ListValidatorRewards(ctx, in)
 	}
-	if m.CommitteeIndex != 0 {
-		n += 1 + sovServices(uint64(m.CommitteeIndex))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethereum.beacon.rpc.v1.RewardsService/ListValidatorRewards",
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RewardsServiceServer).ListValidatorRewards(ctx, req.(*ListValidatorRewardsRequest))
 	}
-	return n
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *AttestResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Root)
-	if l > 0 {
-		n += 1 + l + sovServices(uint64(l))
-	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
-	}
-	return n
+var _RewardsService_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "ethereum.beacon.rpc.v1.RewardsService",
+	HandlerType: (*RewardsServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "ListValidatorRewards",
+			Handler:    _RewardsService_ListValidatorRewards_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "proto/beacon/rpc/v1/services.proto",
 }
 
-func (m *AggregationRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Slot != 0 {
-		n += 1 + sovServices(uint64(m.Slot))
-	}
-	if m.CommitteeIndex != 0 {
-		n += 1 + sovServices(uint64(m.CommitteeIndex))
-	}
-	l = len(m.PublicKey)
-	if l > 0 {
-		n += 1 + l + sovServices(uint64(l))
-	}
-	l = len(m.SlotSignature)
-	if l > 0 {
-		n += 1 + l + sovServices(uint64(l))
-	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
-	}
-	return n
+// ExportServiceClient is the client API for ExportService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type ExportServiceClient interface {
+	StreamExports(ctx context.Context, in *StreamExportsRequest, opts ...grpc.CallOption) (ExportService_StreamExportsClient, error)
 }
 
-func (m *AggregationResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Root)
-	if l > 0 {
-		n += 1 + l + sovServices(uint64(l))
-	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
-	}
-	return n
+type exportServiceClient struct {
+	cc *grpc.ClientConn
 }
 
-func (m *ValidatorPerformanceRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Slot != 0 {
-		n += 1 + sovServices(uint64(m.Slot))
+func NewExportServiceClient(cc *grpc.ClientConn) ExportServiceClient {
+	return &exportServiceClient{cc}
+}
+
+func (c *exportServiceClient) StreamExports(ctx context.Context, in *StreamExportsRequest, opts ...grpc.CallOption) (ExportService_StreamExportsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_ExportService_serviceDesc.Streams[0], "/ethereum.beacon.rpc.v1.ExportService/StreamExports", opts...)
+	if err != nil {
+		return nil, err
 	}
-	if len(m.PublicKeys) > 0 {
-		for _, b := range m.PublicKeys {
-			l = len(b)
-			n += 1 + l + sovServices(uint64(l))
-		}
+	x := &exportServiceStreamExportsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
 	}
-	return n
+	return x, nil
 }
 
-func (m *ValidatorPerformanceResponse) Size() (n int) {
-	if m == nil {
-		return 0
+type ExportService_StreamExportsClient interface {
+	Recv() (*ExportedObject, error)
+	grpc.ClientStream
+}
+
+type exportServiceStreamExportsClient struct {
+	grpc.ClientStream
+}
+
+func (x *exportServiceStreamExportsClient) Recv() (*ExportedObject, error) {
+	m := new(ExportedObject)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if len(m.Balances) > 0 {
-		l = 0
-		for _, e := range m.Balances {
-			l += sovServices(uint64(e))
-		}
-		n += 1 + sovServices(uint64(l)) + l
-	}
-	if m.TotalValidators != 0 {
-		n += 1 + sovServices(uint64(m.TotalValidators))
-	}
-	if m.TotalActiveValidators != 0 {
-		n += 1 + sovServices(uint64(m.TotalActiveValidators))
-	}
-	if len(m.MissingValidators) > 0 {
-		for _, b := range m.MissingValidators {
-			l = len(b)
-			n += 1 + l + sovServices(uint64(l))
-		}
-	}
-	if m.AverageActiveValidatorBalance != 0 {
-		n += 5
-	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
-	}
-	return n
+	return m, nil
 }
 
-func (m *ValidatorActivationRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.PublicKeys) > 0 {
-		for _, b := range m.PublicKeys {
-			l = len(b)
-			n += 1 + l + sovServices(uint64(l))
-		}
-	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
-	}
-	return n
+// ExportServiceServer is the server API for ExportService service.
+type ExportServiceServer interface {
+	StreamExports(*StreamExportsRequest, ExportService_StreamExportsServer) error
 }
 
-func (m *ValidatorActivationResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.ActivatedPublicKeys) > 0 {
-		for _, b := range m.ActivatedPublicKeys {
-			l = len(b)
-			n += 1 + l + sovServices(uint64(l))
-		}
-	}
-	if len(m.Statuses) > 0 {
-		for _, e := range m.Statuses {
-			l = e.Size()
-			n += 1 + l + sovServices(uint64(l))
-		}
-	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
-	}
-	return n
+// UnimplementedExportServiceServer can be embedded to have forward compatible implementations.
+type UnimplementedExportServiceServer struct {
 }
 
-func (m *ValidatorActivationResponse_Status) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.PublicKey)
-	if l > 0 {
-		n += 1 + l + sovServices(uint64(l))
-	}
-	if m.Status != nil {
-		l = m.Status.Size()
-		n += 1 + l + sovServices(uint64(l))
+func (*UnimplementedExportServiceServer) StreamExports(req *StreamExportsRequest, srv ExportService_StreamExportsServer) error {
+	return status.Errorf(codes.Unimplemented, "method StreamExports not implemented")
+}
+
+func RegisterExportServiceServer(s *grpc.Server, srv ExportServiceServer) {
+	s.RegisterService(&_ExportService_serviceDesc, srv)
+}
+
+func _ExportService_StreamExports_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(StreamExportsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	return srv.(ExportServiceServer).StreamExports(m, &exportServiceStreamExportsServer{stream})
+}
+
+type ExportService_StreamExportsServer interface {
+	Send(*ExportedObject) error
+	grpc.ServerStream
+}
+
+type exportServiceStreamExportsServer struct {
+	grpc.ServerStream
+}
+
+func (x *exportServiceStreamExportsServer) Send(m *ExportedObject) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+var _ExportService_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "ethereum.beacon.rpc.v1.ExportService",
+	HandlerType: (*ExportServiceServer)(nil),
+	Methods:     []grpc.MethodDesc{},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "StreamExports",
+			Handler:       _ExportService_StreamExports_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "proto/beacon/rpc/v1/services.proto",
+}
+
+// ValidatorServiceClient is the client API for ValidatorService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type ValidatorServiceClient interface {
+	DomainData(ctx context.Context, in *DomainRequest, opts ...grpc.CallOption) (*DomainResponse, error)
+	WaitForActivation(ctx context.Context, in *ValidatorActivationRequest, opts ...grpc.CallOption) (ValidatorService_WaitForActivationClient, error)
+	ValidatorIndex(ctx context.Context, in *ValidatorIndexRequest, opts ...grpc.CallOption) (*ValidatorIndexResponse, error)
+	CommitteeAssignment(ctx context.Context, in *AssignmentRequest, opts ...grpc.CallOption) (*AssignmentResponse, error)
+	ValidatorStatus(ctx context.Context, in *ValidatorIndexRequest, opts ...grpc.CallOption) (*ValidatorStatusResponse, error)
+	ValidatorPerformance(ctx context.Context, in *ValidatorPerformanceRequest, opts ...grpc.CallOption) (*ValidatorPerformanceResponse, error)
+	ExitedValidators(ctx context.Context, in *ExitedValidatorsRequest, opts ...grpc.CallOption) (*ExitedValidatorsResponse, error)
+	WaitForChainStart(ctx context.Context, in *types.Empty, opts ...grpc.CallOption) (ValidatorService_WaitForChainStartClient, error)
+	CanonicalHead(ctx context.Context, in *types.Empty, opts ...grpc.CallOption) (*v1alpha1.BeaconBlock, error)
+	ProposeExit(ctx context.Context, in *v1alpha1.VoluntaryExit, opts ...grpc.CallOption) (*types.Empty, error)
+}
+
+type validatorServiceClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewValidatorServiceClient(cc *grpc.ClientConn) ValidatorServiceClient {
+	return &validatorServiceClient{cc}
+}
+
+func (c *validatorServiceClient) DomainData(ctx context.Context, in *DomainRequest, opts ...grpc.CallOption) (*DomainResponse, error) {
+	out := new(DomainResponse)
+	err := c.cc.Invoke(ctx, "/ethereum.beacon.rpc.v1.ValidatorService/DomainData", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return out, nil
 }
 
-func (m *ExitedValidatorsRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (c *validatorServiceClient) WaitForActivation(ctx context.Context, in *ValidatorActivationRequest, opts ...grpc.CallOption) (ValidatorService_WaitForActivationClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_ValidatorService_serviceDesc.Streams[0], "/ethereum.beacon.rpc.v1.ValidatorService/WaitForActivation", opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if len(m.PublicKeys) > 0 {
-		for _, b := range m.PublicKeys {
-			l = len(b)
-			n += 1 + l + sovServices(uint64(l))
-		}
+	x := &validatorServiceWaitForActivationClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
 	}
-	return n
+	return x, nil
 }
 
-func (m *ExitedValidatorsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.PublicKeys) > 0 {
-		for _, b := range m.PublicKeys {
-			l = len(b)
-			n += 1 + l + sovServices(uint64(l))
-		}
+type ValidatorService_WaitForActivationClient interface {
+	Recv() (*ValidatorActivationResponse, error)
+	grpc.ClientStream
+}
+
+type validatorServiceWaitForActivationClient struct {
+	grpc.ClientStream
+}
+
+func (x *validatorServiceWaitForActivationClient) Recv() (*ValidatorActivationResponse, error) {
+	m := new(ValidatorActivationResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	return m, nil
+}
+
+func (c *validatorServiceClient) ValidatorIndex(ctx context.Context, in *ValidatorIndexRequest, opts ...grpc.CallOption) (*ValidatorIndexResponse, error) {
+	out := new(ValidatorIndexResponse)
+	err := c.cc.Invoke(ctx, "/ethereum.beacon.rpc.v1.ValidatorService/ValidatorIndex", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return out, nil
 }
 
-func (m *ChainStartResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (c *validatorServiceClient) CommitteeAssignment(ctx context.Context, in *AssignmentRequest, opts ...grpc.CallOption) (*AssignmentResponse, error) {
+	out := new(AssignmentResponse)
+	err := c.cc.Invoke(ctx, "/ethereum.beacon.rpc.v1.ValidatorService/CommitteeAssignment", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if m.Started {
-		n += 2
+	return out, nil
+}
+
+func (c *validatorServiceClient) ValidatorStatus(ctx context.Context, in *ValidatorIndexRequest, opts ...grpc.CallOption) (*ValidatorStatusResponse, error) {
+	out := new(ValidatorStatusResponse)
+	err := c.cc.Invoke(ctx, "/ethereum.beacon.rpc.v1.ValidatorService/ValidatorStatus", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	if m.GenesisTime != 0 {
-		n += 1 + sovServices(uint64(m.GenesisTime))
+	return out, nil
+}
+
+func (c *validatorServiceClient) ValidatorPerformance(ctx context.Context, in *ValidatorPerformanceRequest, opts ...grpc.CallOption) (*ValidatorPerformanceResponse, error) {
+	out := new(ValidatorPerformanceResponse)
+	err := c.cc.Invoke(ctx, "/ethereum.beacon.rpc.v1.ValidatorService/ValidatorPerformance", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	return out, nil
+}
+
+func (c *validatorServiceClient) ExitedValidators(ctx context.Context, in *ExitedValidatorsRequest, opts ...grpc.CallOption) (*ExitedValidatorsResponse, error) {
+	out := new(ExitedValidatorsResponse)
+	err := c.cc.Invoke(ctx, "/ethereum.beacon.rpc.v1.ValidatorService/ExitedValidators", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return out, nil
 }
 
-func (m *ValidatorIndexRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (c *validatorServiceClient) WaitForChainStart(ctx context.Context, in *types.Empty, opts ...grpc.CallOption) (ValidatorService_WaitForChainStartClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_ValidatorService_serviceDesc.Streams[1], "/ethereum.beacon.rpc.v1.ValidatorService/WaitForChainStart", opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.PublicKey)
-	if l > 0 {
-		n += 1 + l + sovServices(uint64(l))
+	x := &validatorServiceWaitForChainStartClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
 	}
-	return n
+	return x, nil
 }
 
-func (m *ValidatorIndexResponse) Size() (n int) {
-	if m == nil {
-		return 0
+type ValidatorService_WaitForChainStartClient interface {
+	Recv() (*ChainStartResponse, error)
+	grpc.ClientStream
+}
+
+type validatorServiceWaitForChainStartClient struct {
+	grpc.ClientStream
+}
+
+func (x *validatorServiceWaitForChainStartClient) Recv() (*ChainStartResponse, error) {
+	m := new(ChainStartResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if m.Index != 0 {
-		n += 1 + sovServices(uint64(m.Index))
+	return m, nil
+}
+
+func (c *validatorServiceClient) CanonicalHead(ctx context.Context, in *types.Empty, opts ...grpc.CallOption) (*v1alpha1.BeaconBlock, error) {
+	out := new(v1alpha1.BeaconBlock)
+	err := c.cc.Invoke(ctx, "/ethereum.beacon.rpc.v1.ValidatorService/CanonicalHead", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	return out, nil
+}
+
+func (c *validatorServiceClient) ProposeExit(ctx context.Context, in *v1alpha1.VoluntaryExit, opts ...grpc.CallOption) (*types.Empty, error) {
+	out := new(types.Empty)
+	err := c.cc.Invoke(ctx, "/ethereum.beacon.rpc.v1.ValidatorService/ProposeExit", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return out, nil
 }
 
-func (m *AssignmentRequest) Size() (n int) {
-	if m == nil {
-		return 0
+// ValidatorServiceServer is the server API for ValidatorService service.
+type ValidatorServiceServer interface {
+	DomainData(context.Context, *DomainRequest) (*DomainResponse, error)
+	WaitForActivation(*ValidatorActivationRequest, ValidatorService_WaitForActivationServer) error
+	ValidatorIndex(context.Context, *ValidatorIndexRequest) (*ValidatorIndexResponse, error)
+	CommitteeAssignment(context.Context, *AssignmentRequest) (*AssignmentResponse, error)
+	ValidatorStatus(context.Context, *ValidatorIndexRequest) (*ValidatorStatusResponse, error)
+	ValidatorPerformance(context.Context, *ValidatorPerformanceRequest) (*ValidatorPerformanceResponse, error)
+	ExitedValidators(context.Context, *ExitedValidatorsRequest) (*ExitedValidatorsResponse, error)
+	WaitForChainStart(*types.Empty, ValidatorService_WaitForChainStartServer) error
+	CanonicalHead(context.Context, *types.Empty) (*v1alpha1.BeaconBlock, error)
+	ProposeExit(context.Context, *v1alpha1.VoluntaryExit) (*types.Empty, error)
+}
+
+// UnimplementedValidatorServiceServer can be embedded to have forward compatible implementations.
+type UnimplementedValidatorServiceServer struct {
+}
+
+func (*UnimplementedValidatorServiceServer) DomainData(ctx context.Context, req *DomainRequest) (*DomainResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DomainData not implemented")
+}
+func (*UnimplementedValidatorServiceServer) WaitForActivation(req *ValidatorActivationRequest, srv ValidatorService_WaitForActivationServer) error {
+	return status.Errorf(codes.Unimplemented, "method WaitForActivation not implemented")
+}
+func (*UnimplementedValidatorServiceServer) ValidatorIndex(ctx context.Context, req *ValidatorIndexRequest) (*ValidatorIndexResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ValidatorIndex not implemented")
+}
+func (*UnimplementedValidatorServiceServer) CommitteeAssignment(ctx context.Context, req *AssignmentRequest) (*AssignmentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CommitteeAssignment not implemented")
+}
+func (*UnimplementedValidatorServiceServer) ValidatorStatus(ctx context.Context, req *ValidatorIndexRequest) (*ValidatorStatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ValidatorStatus not implemented")
+}
+func (*UnimplementedValidatorServiceServer) ValidatorPerformance(ctx context.Context, req *ValidatorPerformanceRequest) (*ValidatorPerformanceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ValidatorPerformance not implemented")
+}
+func (*UnimplementedValidatorServiceServer) ExitedValidators(ctx context.Context, req *ExitedValidatorsRequest) (*ExitedValidatorsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExitedValidators not implemented")
+}
+func (*UnimplementedValidatorServiceServer) WaitForChainStart(req *types.Empty, srv ValidatorService_WaitForChainStartServer) error {
+	return status.Errorf(codes.Unimplemented, "method WaitForChainStart not implemented")
+}
+func (*UnimplementedValidatorServiceServer) CanonicalHead(ctx context.Context, req *types.Empty) (*v1alpha1.BeaconBlock, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CanonicalHead not implemented")
+}
+func (*UnimplementedValidatorServiceServer) ProposeExit(ctx context.Context, req *v1alpha1.VoluntaryExit) (*types.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ProposeExit not implemented")
+}
+
+func RegisterValidatorServiceServer(s *grpc.Server, srv ValidatorServiceServer) {
+	s.RegisterService(&_ValidatorService_serviceDesc, srv)
+}
+
+func _ValidatorService_DomainData_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DomainRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if m.EpochStart != 0 {
-		n += 1 + sovServices(uint64(m.EpochStart))
+	if interceptor == nil {
+		return srv.(ValidatorServiceServer).DomainData(ctx, in)
 	}
-	if len(m.PublicKeys) > 0 {
-		for _, b := range m.PublicKeys {
-			l = len(b)
-			n += 1 + l + sovServices(uint64(l))
-		}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethereum.beacon.rpc.v1.ValidatorService/DomainData",
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ValidatorServiceServer).DomainData(ctx, req.(*DomainRequest))
 	}
-	return n
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *AssignmentResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.ValidatorAssignment) > 0 {
-		for _, e := range m.ValidatorAssignment {
-			l = e.Size()
-			n += 1 + l + sovServices(uint64(l))
-		}
-	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+func _ValidatorService_WaitForActivation_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ValidatorActivationRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
 	}
-	return n
+	return srv.(ValidatorServiceServer).WaitForActivation(m, &validatorServiceWaitForActivationServer{stream})
 }
 
-func (m *AssignmentResponse_ValidatorAssignment) Size() (n int) {
-	if m == nil {
-		return 0
+type ValidatorService_WaitForActivationServer interface {
+	Send(*ValidatorActivationResponse) error
+	grpc.ServerStream
+}
+
+type validatorServiceWaitForActivationServer struct {
+	grpc.ServerStream
+}
+
+func (x *validatorServiceWaitForActivationServer) Send(m *ValidatorActivationResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _ValidatorService_ValidatorIndex_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ValidatorIndexRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if len(m.Committee) > 0 {
-		l = 0
-		for _, e := range m.Committee {
-			l += sovServices(uint64(e))
-		}
-		n += 1 + sovServices(uint64(l)) + l
+	if interceptor == nil {
+		return srv.(ValidatorServiceServer).ValidatorIndex(ctx, in)
 	}
-	if m.CommitteeIndex != 0 {
-		n += 1 + sovServices(uint64(m.CommitteeIndex))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethereum.beacon.rpc.v1.ValidatorService/ValidatorIndex",
 	}
-	if m.AttesterSlot != 0 {
-		n += 1 + sovServices(uint64(m.AttesterSlot))
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ValidatorServiceServer).ValidatorIndex(ctx, req.(*ValidatorIndexRequest))
 	}
-	if m.ProposerSlot != 0 {
-		n += 1 + sovServices(uint64(m.ProposerSlot))
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ValidatorService_CommitteeAssignment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AssignmentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	l = len(m.PublicKey)
-	if l > 0 {
-		n += 1 + l + sovServices(uint64(l))
+	if interceptor == nil {
+		return srv.(ValidatorServiceServer).CommitteeAssignment(ctx, in)
 	}
-	if m.Status != 0 {
-		n += 1 + sovServices(uint64(m.Status))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethereum.beacon.rpc.v1.ValidatorService/CommitteeAssignment",
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ValidatorServiceServer).CommitteeAssignment(ctx, req.(*AssignmentRequest))
 	}
-	return n
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *ValidatorStatusResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _ValidatorService_ValidatorStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ValidatorIndexRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if m.Status != 0 {
-		n += 1 + sovServices(uint64(m.Status))
+	if interceptor == nil {
+		return srv.(ValidatorServiceServer).ValidatorStatus(ctx, in)
 	}
-	if m.Eth1DepositBlockNumber != 0 {
-		n += 1 + sovServices(uint64(m.Eth1DepositBlockNumber))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethereum.beacon.rpc.v1.ValidatorService/ValidatorStatus",
 	}
-	if m.DepositInclusionSlot != 0 {
-		n += 1 + sovServices(uint64(m.DepositInclusionSlot))
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ValidatorServiceServer).ValidatorStatus(ctx, req.(*ValidatorIndexRequest))
 	}
-	if m.ActivationEpoch != 0 {
-		n += 1 + sovServices(uint64(m.ActivationEpoch))
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ValidatorService_ValidatorPerformance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ValidatorPerformanceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if m.PositionInActivationQueue != 0 {
-		n += 1 + sovServices(uint64(m.PositionInActivationQueue))
+	if interceptor == nil {
+		return srv.(ValidatorServiceServer).ValidatorPerformance(ctx, in)
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethereum.beacon.rpc.v1.ValidatorService/ValidatorPerformance",
 	}
-	return n
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ValidatorServiceServer).ValidatorPerformance(ctx, req.(*ValidatorPerformanceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *DomainRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func _ValidatorService_ExitedValidators_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExitedValidatorsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if m.Epoch != 0 {
-		n += 1 + sovServices(uint64(m.Epoch))
+	if interceptor == nil {
+		return srv.(ValidatorServiceServer).ExitedValidators(ctx, in)
 	}
-	l = len(m.Domain)
-	if l > 0 {
-		n += 1 + l + sovServices(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethereum.beacon.rpc.v1.ValidatorService/ExitedValidators",
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ValidatorServiceServer).ExitedValidators(ctx, req.(*ExitedValidatorsRequest))
 	}
-	return n
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *DomainResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _ValidatorService_WaitForChainStart_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(types.Empty)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
 	}
-	var l int
-	_ = l
-	if m.SignatureDomain != 0 {
-		n += 1 + sovServices(uint64(m.SignatureDomain))
+	return srv.(ValidatorServiceServer).WaitForChainStart(m, &validatorServiceWaitForChainStartServer{stream})
+}
+
+type ValidatorService_WaitForChainStartServer interface {
+	Send(*ChainStartResponse) error
+	grpc.ServerStream
+}
+
+type validatorServiceWaitForChainStartServer struct {
+	grpc.ServerStream
+}
+
+func (x *validatorServiceWaitForChainStartServer) Send(m *ChainStartResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _ValidatorService_CanonicalHead_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(types.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	if interceptor == nil {
+		return srv.(ValidatorServiceServer).CanonicalHead(ctx, in)
 	}
-	return n
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethereum.beacon.rpc.v1.ValidatorService/CanonicalHead",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ValidatorServiceServer).CanonicalHead(ctx, req.(*types.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *BlockTreeResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _ValidatorService_ProposeExit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(v1alpha1.VoluntaryExit)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if len(m.Tree) > 0 {
-		for _, e := range m.Tree {
-			l = e.Size()
-			n += 1 + l + sovServices(uint64(l))
-		}
+	if interceptor == nil {
+		return srv.(ValidatorServiceServer).ProposeExit(ctx, in)
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethereum.beacon.rpc.v1.ValidatorService/ProposeExit",
 	}
-	return n
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ValidatorServiceServer).ProposeExit(ctx, req.(*v1alpha1.VoluntaryExit))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *BlockTreeResponse_TreeNode) Size() (n int) {
-	if m == nil {
-		return 0
+var _ValidatorService_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "ethereum.beacon.rpc.v1.ValidatorService",
+	HandlerType: (*ValidatorServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "DomainData",
+			Handler:    _ValidatorService_DomainData_Handler,
+		},
+		{
+			MethodName: "ValidatorIndex",
+			Handler:    _ValidatorService_ValidatorIndex_Handler,
+		},
+		{
+			MethodName: "CommitteeAssignment",
+			Handler:    _ValidatorService_CommitteeAssignment_Handler,
+		},
+		{
+			MethodName: "ValidatorStatus",
+			Handler:    _ValidatorService_ValidatorStatus_Handler,
+		},
+		{
+			MethodName: "ValidatorPerformance",
+			Handler:    _ValidatorService_ValidatorPerformance_Handler,
+		},
+		{
+			MethodName: "ExitedValidators",
+			Handler:    _ValidatorService_ExitedValidators_Handler,
+		},
+		{
+			MethodName: "CanonicalHead",
+			Handler:    _ValidatorService_CanonicalHead_Handler,
+		},
+		{
+			MethodName: "ProposeExit",
+			Handler:    _ValidatorService_ProposeExit_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "WaitForActivation",
+			Handler:       _ValidatorService_WaitForActivation_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "WaitForChainStart",
+			Handler:       _ValidatorService_WaitForChainStart_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "proto/beacon/rpc/v1/services.proto",
+}
+
+func (m *BlockRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *BlockRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BlockRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Block != nil {
-		l = m.Block.Size()
-		n += 1 + l + sovServices(uint64(l))
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Graffiti) > 0 {
+		i -= len(m.Graffiti)
+		copy(dAtA[i:], m.Graffiti)
+		i = encodeVarintServices(dAtA, i, uint64(len(m.Graffiti)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	l = len(m.BlockRoot)
-	if l > 0 {
-		n += 1 + l + sovServices(uint64(l))
+	if len(m.RandaoReveal) > 0 {
+		i -= len(m.RandaoReveal)
+		copy(dAtA[i:], m.RandaoReveal)
+		i = encodeVarintServices(dAtA, i, uint64(len(m.RandaoReveal)))
+		i--
+		dAtA[i] = 0x12
 	}
-	if m.ParticipatedVotes != 0 {
-		n += 1 + sovServices(uint64(m.ParticipatedVotes))
+	if m.Slot != 0 {
+		i = encodeVarintServices(dAtA, i, uint64(m.Slot))
+		i--
+		dAtA[i] = 0x8
 	}
-	if m.TotalVotes != 0 {
-		n += 1 + sovServices(uint64(m.TotalVotes))
+	return len(dAtA) - i, nil
+}
+
+func (m *ProposeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *ProposeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ProposeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
 	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	return n
+	if len(m.BlockRoot) > 0 {
+		i -= len(m.BlockRoot)
+		copy(dAtA[i:], m.BlockRoot)
+		i = encodeVarintServices(dAtA, i, uint64(len(m.BlockRoot)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *TreeBlockSlotRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *AttestationRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *AttestationRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AttestationRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.SlotFrom != 0 {
-		n += 1 + sovServices(uint64(m.SlotFrom))
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if m.SlotTo != 0 {
-		n += 1 + sovServices(uint64(m.SlotTo))
+	if m.CommitteeIndex != 0 {
+		i = encodeVarintServices(dAtA, i, uint64(m.CommitteeIndex))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Slot != 0 {
+		i = encodeVarintServices(dAtA, i, uint64(m.Slot))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.PocBit) > 0 {
+		i -= len(m.PocBit)
+		copy(dAtA[i:], m.PocBit)
+		i = encodeVarintServices(dAtA, i, uint64(len(m.PocBit)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.PublicKey) > 0 {
+		i -= len(m.PublicKey)
+		copy(dAtA[i:], m.PublicKey)
+		i = encodeVarintServices(dAtA, i, uint64(len(m.PublicKey)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AttestResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *AttestResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AttestResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
 	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	return n
+	if len(m.Root) > 0 {
+		i -= len(m.Root)
+		copy(dAtA[i:], m.Root)
+		i = encodeVarintServices(dAtA, i, uint64(len(m.Root)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func sovServices(x uint64) (n int) {
-	for {
-		n++
-		x >>= 7
-		if x == 0 {
-			break
-		}
+func (m *AggregationRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
-func sozServices(x uint64) (n int) {
-	return sovServices(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+
+func (m *AggregationRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
-func (m *BlockRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowServices
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+
+func (m *AggregationRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.SlotSignature) > 0 {
+		i -= len(m.SlotSignature)
+		copy(dAtA[i:], m.SlotSignature)
+		i = encodeVarintServices(dAtA, i, uint64(len(m.SlotSignature)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.PublicKey) > 0 {
+		i -= len(m.PublicKey)
+		copy(dAtA[i:], m.PublicKey)
+		i = encodeVarintServices(dAtA, i, uint64(len(m.PublicKey)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.CommitteeIndex != 0 {
+		i = encodeVarintServices(dAtA, i, uint64(m.CommitteeIndex))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Slot != 0 {
+		i = encodeVarintServices(dAtA, i, uint64(m.Slot))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AggregationResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AggregationResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AggregationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Root) > 0 {
+		i -= len(m.Root)
+		copy(dAtA[i:], m.Root)
+		i = encodeVarintServices(dAtA, i, uint64(len(m.Root)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *CheckpointResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CheckpointResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CheckpointResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Epoch != 0 {
+		i = encodeVarintServices(dAtA, i, uint64(m.Epoch))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Block) > 0 {
+		i -= len(m.Block)
+		copy(dAtA[i:], m.Block)
+		i = encodeVarintServices(dAtA, i, uint64(len(m.Block)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.State) > 0 {
+		i -= len(m.State)
+		copy(dAtA[i:], m.State)
+		i = encodeVarintServices(dAtA, i, uint64(len(m.State)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PeerScore) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PeerScore) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PeerScore) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.BannedUntil != 0 {
+		i = encodeVarintServices(dAtA, i, uint64(m.BannedUntil))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.Connected {
+		i--
+		if m.Connected {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Score != 0 {
+		i = encodeVarintServices(dAtA, i, uint64(m.Score))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.PeerId) > 0 {
+		i -= len(m.PeerId)
+		copy(dAtA[i:], m.PeerId)
+		i = encodeVarintServices(dAtA, i, uint64(len(m.PeerId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintServices(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PeerScoresResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PeerScoresResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PeerScoresResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Peers) > 0 {
+		for iNdEx := len(m.Peers) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Peers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintServices(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ChainReorg) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ChainReorg) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ChainReorg) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Depth != 0 {
+		i = encodeVarintServices(dAtA, i, uint64(m.Depth))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.CommonAncestorSlot != 0 {
+		i = encodeVarintServices(dAtA, i, uint64(m.CommonAncestorSlot))
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.CommonAncestorRoot) > 0 {
+		i -= len(m.CommonAncestorRoot)
+		copy(dAtA[i:], m.CommonAncestorRoot)
+		i = encodeVarintServices(dAtA, i, uint64(len(m.CommonAncestorRoot)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.NewHeadSlot != 0 {
+		i = encodeVarintServices(dAtA, i, uint64(m.NewHeadSlot))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.NewHeadRoot) > 0 {
+		i -= len(m.NewHeadRoot)
+		copy(dAtA[i:], m.NewHeadRoot)
+		i = encodeVarintServices(dAtA, i, uint64(len(m.NewHeadRoot)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.OldHeadSlot != 0 {
+		i = encodeVarintServices(dAtA, i, uint64(m.OldHeadSlot))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.OldHeadRoot) > 0 {
+		i -= len(m.OldHeadRoot)
+		copy(dAtA[i:], m.OldHeadRoot)
+		i = encodeVarintServices(dAtA, i, uint64(len(m.OldHeadRoot)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ListValidatorRewardsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ListValidatorRewardsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ListValidatorRewardsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.PageToken) > 0 {
+		i -= len(m.PageToken)
+		copy(dAtA[i:], m.PageToken)
+		i = encodeVarintServices(dAtA, i, uint64(len(m.PageToken)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.PageSize != 0 {
+		i = encodeVarintServices(dAtA, i, uint64(m.PageSize))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Indices) > 0 {
+		dAtA2 := make([]byte, len(m.Indices)*10)
+		var j1 int
+		for _, num := range m.Indices {
+			for num >= 1<<7 {
+				dAtA2[j1] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j1++
+			}
+			dAtA2[j1] = uint8(num)
+			j1++
+		}
+		i -= j1
+		copy(dAtA[i:], dAtA2[:j1])
+		i = encodeVarintServices(dAtA, i, uint64(j1))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.EndEpoch != 0 {
+		i = encodeVarintServices(dAtA, i, uint64(m.EndEpoch))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.StartEpoch != 0 {
+		i = encodeVarintServices(dAtA, i, uint64(m.StartEpoch))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ListValidatorRewardsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ListValidatorRewardsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ListValidatorRewardsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.TotalSize != 0 {
+		i = encodeVarintServices(dAtA, i, uint64(m.TotalSize))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.NextPageToken) > 0 {
+		i -= len(m.NextPageToken)
+		copy(dAtA[i:], m.NextPageToken)
+		i = encodeVarintServices(dAtA, i, uint64(len(m.NextPageToken)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Rewards) > 0 {
+		for iNdEx := len(m.Rewards) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Rewards[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintServices(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ValidatorEpochRewards) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ValidatorEpochRewards) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ValidatorEpochRewards) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.SlashingPenalty != 0 {
+		i = encodeVarintServices(dAtA, i, uint64(m.SlashingPenalty))
+		i--
+		dAtA[i] = 0x60
+	}
+	if m.InactivityPenalty != 0 {
+		i = encodeVarintServices(dAtA, i, uint64(m.InactivityPenalty))
+		i--
+		dAtA[i] = 0x58
+	}
+	if m.HeadPenalty != 0 {
+		i = encodeVarintServices(dAtA, i, uint64(m.HeadPenalty))
+		i--
+		dAtA[i] = 0x50
+	}
+	if m.TargetPenalty != 0 {
+		i = encodeVarintServices(dAtA, i, uint64(m.TargetPenalty))
+		i--
+		dAtA[i] = 0x48
+	}
+	if m.SourcePenalty != 0 {
+		i = encodeVarintServices(dAtA, i, uint64(m.SourcePenalty))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.ProposerReward != 0 {
+		i = encodeVarintServices(dAtA, i, uint64(m.ProposerReward))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.InclusionDelayReward != 0 {
+		i = encodeVarintServices(dAtA, i, uint64(m.InclusionDelayReward))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.HeadReward != 0 {
+		i = encodeVarintServices(dAtA, i, uint64(m.HeadReward))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.TargetReward != 0 {
+		i = encodeVarintServices(dAtA, i, uint64(m.TargetReward))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.SourceReward != 0 {
+		i = encodeVarintServices(dAtA, i, uint64(m.SourceReward))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.ValidatorIndex != 0 {
+		i = encodeVarintServices(dAtA, i, uint64(m.ValidatorIndex))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Epoch != 0 {
+		i = encodeVarintServices(dAtA, i, uint64(m.Epoch))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *StreamExportsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *StreamExportsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *StreamExportsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Topics) > 0 {
+		for iNdEx := len(m.Topics) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Topics[iNdEx])
+			copy(dAtA[i:], m.Topics[iNdEx])
+			i = encodeVarintServices(dAtA, i, uint64(len(m.Topics[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ExportedObject) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ExportedObject) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ExportedObject) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Object) > 0 {
+		i -= len(m.Object)
+		copy(dAtA[i:], m.Object)
+		i = encodeVarintServices(dAtA, i, uint64(len(m.Object)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Topic) > 0 {
+		i -= len(m.Topic)
+		copy(dAtA[i:], m.Topic)
+		i = encodeVarintServices(dAtA, i, uint64(len(m.Topic)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ValidatorPerformanceRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ValidatorPerformanceRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ValidatorPerformanceRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.PublicKeys) > 0 {
+		for iNdEx := len(m.PublicKeys) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.PublicKeys[iNdEx])
+			copy(dAtA[i:], m.PublicKeys[iNdEx])
+			i = encodeVarintServices(dAtA, i, uint64(len(m.PublicKeys[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.Slot != 0 {
+		i = encodeVarintServices(dAtA, i, uint64(m.Slot))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ValidatorPerformanceResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ValidatorPerformanceResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ValidatorPerformanceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.AverageActiveValidatorBalance != 0 {
+		i -= 4
+		encoding_binary.LittleEndian.PutUint32(dAtA[i:], uint32(math.Float32bits(float32(m.AverageActiveValidatorBalance))))
+		i--
+		dAtA[i] = 0x2d
+	}
+	if len(m.MissingValidators) > 0 {
+		for iNdEx := len(m.MissingValidators) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.MissingValidators[iNdEx])
+			copy(dAtA[i:], m.MissingValidators[iNdEx])
+			i = encodeVarintServices(dAtA, i, uint64(len(m.MissingValidators[iNdEx])))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if m.TotalActiveValidators != 0 {
+		i = encodeVarintServices(dAtA, i, uint64(m.TotalActiveValidators))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.TotalValidators != 0 {
+		i = encodeVarintServices(dAtA, i, uint64(m.TotalValidators))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Balances) > 0 {
+		dAtA4 := make([]byte, len(m.Balances)*10)
+		var j3 int
+		for _, num := range m.Balances {
+			for num >= 1<<7 {
+				dAtA4[j3] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j3++
+			}
+			dAtA4[j3] = uint8(num)
+			j3++
+		}
+		i -= j3
+		copy(dAtA[i:], dAtA4[:j3])
+		i = encodeVarintServices(dAtA, i, uint64(j3))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ValidatorActivationRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ValidatorActivationRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ValidatorActivationRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.PublicKeys) > 0 {
+		for iNdEx := len(m.PublicKeys) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.PublicKeys[iNdEx])
+			copy(dAtA[i:], m.PublicKeys[iNdEx])
+			i = encodeVarintServices(dAtA, i, uint64(len(m.PublicKeys[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ValidatorActivationResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ValidatorActivationResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ValidatorActivationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Statuses) > 0 {
+		for iNdEx := len(m.Statuses) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Statuses[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintServices(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.ActivatedPublicKeys) > 0 {
+		for iNdEx := len(m.ActivatedPublicKeys) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.ActivatedPublicKeys[iNdEx])
+			copy(dAtA[i:], m.ActivatedPublicKeys[iNdEx])
+			i = encodeVarintServices(dAtA, i, uint64(len(m.ActivatedPublicKeys[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ValidatorActivationResponse_Status) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ValidatorActivationResponse_Status) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ValidatorActivationResponse_Status) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Status != nil {
+		{
+			size, err := m.Status.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintServices(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.PublicKey) > 0 {
+		i -= len(m.PublicKey)
+		copy(dAtA[i:], m.PublicKey)
+		i = encodeVarintServices(dAtA, i, uint64(len(m.PublicKey)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ExitedValidatorsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ExitedValidatorsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ExitedValidatorsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.PublicKeys) > 0 {
+		for iNdEx := len(m.PublicKeys) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.PublicKeys[iNdEx])
+			copy(dAtA[i:], m.PublicKeys[iNdEx])
+			i = encodeVarintServices(dAtA, i, uint64(len(m.PublicKeys[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ExitedValidatorsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ExitedValidatorsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ExitedValidatorsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.PublicKeys) > 0 {
+		for iNdEx := len(m.PublicKeys) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.PublicKeys[iNdEx])
+			copy(dAtA[i:], m.PublicKeys[iNdEx])
+			i = encodeVarintServices(dAtA, i, uint64(len(m.PublicKeys[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ChainStartResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ChainStartResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ChainStartResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.GenesisTime != 0 {
+		i = encodeVarintServices(dAtA, i, uint64(m.GenesisTime))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Started {
+		i--
+		if m.Started {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ValidatorIndexRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ValidatorIndexRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ValidatorIndexRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.PublicKey) > 0 {
+		i -= len(m.PublicKey)
+		copy(dAtA[i:], m.PublicKey)
+		i = encodeVarintServices(dAtA, i, uint64(len(m.PublicKey)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ValidatorIndexResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ValidatorIndexResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ValidatorIndexResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Index != 0 {
+		i = encodeVarintServices(dAtA, i, uint64(m.Index))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AssignmentRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AssignmentRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AssignmentRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.PublicKeys) > 0 {
+		for iNdEx := len(m.PublicKeys) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.PublicKeys[iNdEx])
+			copy(dAtA[i:], m.PublicKeys[iNdEx])
+			i = encodeVarintServices(dAtA, i, uint64(len(m.PublicKeys[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.EpochStart != 0 {
+		i = encodeVarintServices(dAtA, i, uint64(m.EpochStart))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AssignmentResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AssignmentResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AssignmentResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.ValidatorAssignment) > 0 {
+		for iNdEx := len(m.ValidatorAssignment) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ValidatorAssignment[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintServices(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AssignmentResponse_ValidatorAssignment) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AssignmentResponse_ValidatorAssignment) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AssignmentResponse_ValidatorAssignment) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Status != 0 {
+		i = encodeVarintServices(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.PublicKey) > 0 {
+		i -= len(m.PublicKey)
+		copy(dAtA[i:], m.PublicKey)
+		i = encodeVarintServices(dAtA, i, uint64(len(m.PublicKey)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.ProposerSlot != 0 {
+		i = encodeVarintServices(dAtA, i, uint64(m.ProposerSlot))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.AttesterSlot != 0 {
+		i = encodeVarintServices(dAtA, i, uint64(m.AttesterSlot))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.CommitteeIndex != 0 {
+		i = encodeVarintServices(dAtA, i, uint64(m.CommitteeIndex))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Committee) > 0 {
+		dAtA7 := make([]byte, len(m.Committee)*10)
+		var j6 int
+		for _, num := range m.Committee {
+			for num >= 1<<7 {
+				dAtA7[j6] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j6++
+			}
+			dAtA7[j6] = uint8(num)
+			j6++
+		}
+		i -= j6
+		copy(dAtA[i:], dAtA7[:j6])
+		i = encodeVarintServices(dAtA, i, uint64(j6))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ValidatorStatusResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ValidatorStatusResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ValidatorStatusResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.PositionInActivationQueue != 0 {
+		i = encodeVarintServices(dAtA, i, uint64(m.PositionInActivationQueue))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.ActivationEpoch != 0 {
+		i = encodeVarintServices(dAtA, i, uint64(m.ActivationEpoch))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.DepositInclusionSlot != 0 {
+		i = encodeVarintServices(dAtA, i, uint64(m.DepositInclusionSlot))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Eth1DepositBlockNumber != 0 {
+		i = encodeVarintServices(dAtA, i, uint64(m.Eth1DepositBlockNumber))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Status != 0 {
+		i = encodeVarintServices(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DomainRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DomainRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DomainRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Domain) > 0 {
+		i -= len(m.Domain)
+		copy(dAtA[i:], m.Domain)
+		i = encodeVarintServices(dAtA, i, uint64(len(m.Domain)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Epoch != 0 {
+		i = encodeVarintServices(dAtA, i, uint64(m.Epoch))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DomainResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DomainResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DomainResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.SignatureDomain != 0 {
+		i = encodeVarintServices(dAtA, i, uint64(m.SignatureDomain))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *BlockTreeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BlockTreeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BlockTreeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Tree) > 0 {
+		for iNdEx := len(m.Tree) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Tree[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintServices(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *BlockTreeResponse_TreeNode) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BlockTreeResponse_TreeNode) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BlockTreeResponse_TreeNode) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.TotalVotes != 0 {
+		i = encodeVarintServices(dAtA, i, uint64(m.TotalVotes))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.ParticipatedVotes != 0 {
+		i = encodeVarintServices(dAtA, i, uint64(m.ParticipatedVotes))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.BlockRoot) > 0 {
+		i -= len(m.BlockRoot)
+		copy(dAtA[i:], m.BlockRoot)
+		i = encodeVarintServices(dAtA, i, uint64(len(m.BlockRoot)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Block != nil {
+		{
+			size, err := m.Block.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintServices(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TreeBlockSlotRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TreeBlockSlotRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TreeBlockSlotRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.SlotTo != 0 {
+		i = encodeVarintServices(dAtA, i, uint64(m.SlotTo))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.SlotFrom != 0 {
+		i = encodeVarintServices(dAtA, i, uint64(m.SlotFrom))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintServices(dAtA []byte, offset int, v uint64) int {
+	offset -= sovServices(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *BlockRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Slot != 0 {
+		n += 1 + sovServices(uint64(m.Slot))
+	}
+	l = len(m.RandaoReveal)
+	if l > 0 {
+		n += 1 + l + sovServices(uint64(l))
+	}
+	l = len(m.Graffiti)
+	if l > 0 {
+		n += 1 + l + sovServices(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ProposeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.BlockRoot)
+	if l > 0 {
+		n += 1 + l + sovServices(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *AttestationRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.PublicKey)
+	if l > 0 {
+		n += 1 + l + sovServices(uint64(l))
+	}
+	l = len(m.PocBit)
+	if l > 0 {
+		n += 1 + l + sovServices(uint64(l))
+	}
+	if m.Slot != 0 {
+		n += 1 + sovServices(uint64(m.Slot))
+	}
+	if m.CommitteeIndex != 0 {
+		n += 1 + sovServices(uint64(m.CommitteeIndex))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *AttestResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Root)
+	if l > 0 {
+		n += 1 + l + sovServices(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *AggregationRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Slot != 0 {
+		n += 1 + sovServices(uint64(m.Slot))
+	}
+	if m.CommitteeIndex != 0 {
+		n += 1 + sovServices(uint64(m.CommitteeIndex))
+	}
+	l = len(m.PublicKey)
+	if l > 0 {
+		n += 1 + l + sovServices(uint64(l))
+	}
+	l = len(m.SlotSignature)
+	if l > 0 {
+		n += 1 + l + sovServices(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *AggregationResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Root)
+	if l > 0 {
+		n += 1 + l + sovServices(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *CheckpointResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.State)
+	if l > 0 {
+		n += 1 + l + sovServices(uint64(l))
+	}
+	l = len(m.Block)
+	if l > 0 {
+		n += 1 + l + sovServices(uint64(l))
+	}
+	if m.Epoch != 0 {
+		n += 1 + sovServices(uint64(m.Epoch))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *PeerScore) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovServices(uint64(l))
+	}
+	l = len(m.PeerId)
+	if l > 0 {
+		n += 1 + l + sovServices(uint64(l))
+	}
+	if m.Score != 0 {
+		n += 1 + sovServices(uint64(m.Score))
+	}
+	if m.Connected {
+		n += 2
+	}
+	if m.BannedUntil != 0 {
+		n += 1 + sovServices(uint64(m.BannedUntil))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *PeerScoresResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Peers) > 0 {
+		for _, e := range m.Peers {
+			l = e.Size()
+			n += 1 + l + sovServices(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ChainReorg) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.OldHeadRoot)
+	if l > 0 {
+		n += 1 + l + sovServices(uint64(l))
+	}
+	if m.OldHeadSlot != 0 {
+		n += 1 + sovServices(uint64(m.OldHeadSlot))
+	}
+	l = len(m.NewHeadRoot)
+	if l > 0 {
+		n += 1 + l + sovServices(uint64(l))
+	}
+	if m.NewHeadSlot != 0 {
+		n += 1 + sovServices(uint64(m.NewHeadSlot))
+	}
+	l = len(m.CommonAncestorRoot)
+	if l > 0 {
+		n += 1 + l + sovServices(uint64(l))
+	}
+	if m.CommonAncestorSlot != 0 {
+		n += 1 + sovServices(uint64(m.CommonAncestorSlot))
+	}
+	if m.Depth != 0 {
+		n += 1 + sovServices(uint64(m.Depth))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ListValidatorRewardsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.StartEpoch != 0 {
+		n += 1 + sovServices(uint64(m.StartEpoch))
+	}
+	if m.EndEpoch != 0 {
+		n += 1 + sovServices(uint64(m.EndEpoch))
+	}
+	if len(m.Indices) > 0 {
+		l = 0
+		for _, e := range m.Indices {
+			l += sovServices(uint64(e))
+		}
+		n += 1 + sovServices(uint64(l)) + l
+	}
+	if m.PageSize != 0 {
+		n += 1 + sovServices(uint64(m.PageSize))
+	}
+	l = len(m.PageToken)
+	if l > 0 {
+		n += 1 + l + sovServices(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ListValidatorRewardsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Rewards) > 0 {
+		for _, e := range m.Rewards {
+			l = e.Size()
+			n += 1 + l + sovServices(uint64(l))
+		}
+	}
+	l = len(m.NextPageToken)
+	if l > 0 {
+		n += 1 + l + sovServices(uint64(l))
+	}
+	if m.TotalSize != 0 {
+		n += 1 + sovServices(uint64(m.TotalSize))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ValidatorEpochRewards) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Epoch != 0 {
+		n += 1 + sovServices(uint64(m.Epoch))
+	}
+	if m.ValidatorIndex != 0 {
+		n += 1 + sovServices(uint64(m.ValidatorIndex))
+	}
+	if m.SourceReward != 0 {
+		n += 1 + sovServices(uint64(m.SourceReward))
+	}
+	if m.TargetReward != 0 {
+		n += 1 + sovServices(uint64(m.TargetReward))
+	}
+	if m.HeadReward != 0 {
+		n += 1 + sovServices(uint64(m.HeadReward))
+	}
+	if m.InclusionDelayReward != 0 {
+		n += 1 + sovServices(uint64(m.InclusionDelayReward))
+	}
+	if m.ProposerReward != 0 {
+		n += 1 + sovServices(uint64(m.ProposerReward))
+	}
+	if m.SourcePenalty != 0 {
+		n += 1 + sovServices(uint64(m.SourcePenalty))
+	}
+	if m.TargetPenalty != 0 {
+		n += 1 + sovServices(uint64(m.TargetPenalty))
+	}
+	if m.HeadPenalty != 0 {
+		n += 1 + sovServices(uint64(m.HeadPenalty))
+	}
+	if m.InactivityPenalty != 0 {
+		n += 1 + sovServices(uint64(m.InactivityPenalty))
+	}
+	if m.SlashingPenalty != 0 {
+		n += 1 + sovServices(uint64(m.SlashingPenalty))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *StreamExportsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Topics) > 0 {
+		for _, s := range m.Topics {
+			l = len(s)
+			n += 1 + l + sovServices(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ExportedObject) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Topic)
+	if l > 0 {
+		n += 1 + l + sovServices(uint64(l))
+	}
+	l = len(m.Object)
+	if l > 0 {
+		n += 1 + l + sovServices(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ValidatorPerformanceRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Slot != 0 {
+		n += 1 + sovServices(uint64(m.Slot))
+	}
+	if len(m.PublicKeys) > 0 {
+		for _, b := range m.PublicKeys {
+			l = len(b)
+			n += 1 + l + sovServices(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ValidatorPerformanceResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Balances) > 0 {
+		l = 0
+		for _, e := range m.Balances {
+			l += sovServices(uint64(e))
+		}
+		n += 1 + sovServices(uint64(l)) + l
+	}
+	if m.TotalValidators != 0 {
+		n += 1 + sovServices(uint64(m.TotalValidators))
+	}
+	if m.TotalActiveValidators != 0 {
+		n += 1 + sovServices(uint64(m.TotalActiveValidators))
+	}
+	if len(m.MissingValidators) > 0 {
+		for _, b := range m.MissingValidators {
+			l = len(b)
+			n += 1 + l + sovServices(uint64(l))
+		}
+	}
+	if m.AverageActiveValidatorBalance != 0 {
+		n += 5
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ValidatorActivationRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.PublicKeys) > 0 {
+		for _, b := range m.PublicKeys {
+			l = len(b)
+			n += 1 + l + sovServices(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ValidatorActivationResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.ActivatedPublicKeys) > 0 {
+		for _, b := range m.ActivatedPublicKeys {
+			l = len(b)
+			n += 1 + l + sovServices(uint64(l))
+		}
+	}
+	if len(m.Statuses) > 0 {
+		for _, e := range m.Statuses {
+			l = e.Size()
+			n += 1 + l + sovServices(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ValidatorActivationResponse_Status) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.PublicKey)
+	if l > 0 {
+		n += 1 + l + sovServices(uint64(l))
+	}
+	if m.Status != nil {
+		l = m.Status.Size()
+		n += 1 + l + sovServices(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ExitedValidatorsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.PublicKeys) > 0 {
+		for _, b := range m.PublicKeys {
+			l = len(b)
+			n += 1 + l + sovServices(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ExitedValidatorsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.PublicKeys) > 0 {
+		for _, b := range m.PublicKeys {
+			l = len(b)
+			n += 1 + l + sovServices(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ChainStartResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Started {
+		n += 2
+	}
+	if m.GenesisTime != 0 {
+		n += 1 + sovServices(uint64(m.GenesisTime))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ValidatorIndexRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.PublicKey)
+	if l > 0 {
+		n += 1 + l + sovServices(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ValidatorIndexResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Index != 0 {
+		n += 1 + sovServices(uint64(m.Index))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *AssignmentRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.EpochStart != 0 {
+		n += 1 + sovServices(uint64(m.EpochStart))
+	}
+	if len(m.PublicKeys) > 0 {
+		for _, b := range m.PublicKeys {
+			l = len(b)
+			n += 1 + l + sovServices(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *AssignmentResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.ValidatorAssignment) > 0 {
+		for _, e := range m.ValidatorAssignment {
+			l = e.Size()
+			n += 1 + l + sovServices(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *AssignmentResponse_ValidatorAssignment) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Committee) > 0 {
+		l = 0
+		for _, e := range m.Committee {
+			l += sovServices(uint64(e))
+		}
+		n += 1 + sovServices(uint64(l)) + l
+	}
+	if m.CommitteeIndex != 0 {
+		n += 1 + sovServices(uint64(m.CommitteeIndex))
+	}
+	if m.AttesterSlot != 0 {
+		n += 1 + sovServices(uint64(m.AttesterSlot))
+	}
+	if m.ProposerSlot != 0 {
+		n += 1 + sovServices(uint64(m.ProposerSlot))
+	}
+	l = len(m.PublicKey)
+	if l > 0 {
+		n += 1 + l + sovServices(uint64(l))
+	}
+	if m.Status != 0 {
+		n += 1 + sovServices(uint64(m.Status))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ValidatorStatusResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Status != 0 {
+		n += 1 + sovServices(uint64(m.Status))
+	}
+	if m.Eth1DepositBlockNumber != 0 {
+		n += 1 + sovServices(uint64(m.Eth1DepositBlockNumber))
+	}
+	if m.DepositInclusionSlot != 0 {
+		n += 1 + sovServices(uint64(m.DepositInclusionSlot))
+	}
+	if m.ActivationEpoch != 0 {
+		n += 1 + sovServices(uint64(m.ActivationEpoch))
+	}
+	if m.PositionInActivationQueue != 0 {
+		n += 1 + sovServices(uint64(m.PositionInActivationQueue))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *DomainRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Epoch != 0 {
+		n += 1 + sovServices(uint64(m.Epoch))
+	}
+	l = len(m.Domain)
+	if l > 0 {
+		n += 1 + l + sovServices(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *DomainResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.SignatureDomain != 0 {
+		n += 1 + sovServices(uint64(m.SignatureDomain))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *BlockTreeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Tree) > 0 {
+		for _, e := range m.Tree {
+			l = e.Size()
+			n += 1 + l + sovServices(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *BlockTreeResponse_TreeNode) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Block != nil {
+		l = m.Block.Size()
+		n += 1 + l + sovServices(uint64(l))
+	}
+	l = len(m.BlockRoot)
+	if l > 0 {
+		n += 1 + l + sovServices(uint64(l))
+	}
+	if m.ParticipatedVotes != 0 {
+		n += 1 + sovServices(uint64(m.ParticipatedVotes))
+	}
+	if m.TotalVotes != 0 {
+		n += 1 + sovServices(uint64(m.TotalVotes))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *TreeBlockSlotRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.SlotFrom != 0 {
+		n += 1 + sovServices(uint64(m.SlotFrom))
+	}
+	if m.SlotTo != 0 {
+		n += 1 + sovServices(uint64(m.SlotTo))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func sovServices(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozServices(x uint64) (n int) {
+	return sovServices(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *BlockRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowServices
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BlockRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BlockRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Slot", wireType)
+			}
+			m.Slot = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowServices
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Slot |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RandaoReveal", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowServices
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthServices
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthServices
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RandaoReveal = append(m.RandaoReveal[:0], dAtA[iNdEx:postIndex]...)
+			if m.RandaoReveal == nil {
+				m.RandaoReveal = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Graffiti", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowServices
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthServices
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthServices
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Graffiti = append(m.Graffiti[:0], dAtA[iNdEx:postIndex]...)
+			if m.Graffiti == nil {
+				m.Graffiti = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipServices(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthServices
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthServices
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ProposeResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowServices
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ProposeResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ProposeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockRoot", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowServices
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthServices
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthServices
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BlockRoot = append(m.BlockRoot[:0], dAtA[iNdEx:postIndex]...)
+			if m.BlockRoot == nil {
+				m.BlockRoot = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipServices(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthServices
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthServices
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AttestationRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowServices
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AttestationRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AttestationRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PublicKey", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowServices
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthServices
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthServices
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PublicKey = append(m.PublicKey[:0], dAtA[iNdEx:postIndex]...)
+			if m.PublicKey == nil {
+				m.PublicKey = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PocBit", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowServices
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthServices
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthServices
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PocBit = append(m.PocBit[:0], dAtA[iNdEx:postIndex]...)
+			if m.PocBit == nil {
+				m.PocBit = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Slot", wireType)
+			}
+			m.Slot = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowServices
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Slot |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CommitteeIndex", wireType)
+			}
+			m.CommitteeIndex = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowServices
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CommitteeIndex |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipServices(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthServices
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthServices
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AttestResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowServices
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AttestResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AttestResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Root", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowServices
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthServices
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthServices
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Root = append(m.Root[:0], dAtA[iNdEx:postIndex]...)
+			if m.Root == nil {
+				m.Root = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipServices(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthServices
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthServices
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AggregationRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowServices
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AggregationRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AggregationRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Slot", wireType)
+			}
+			m.Slot = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowServices
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Slot |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CommitteeIndex", wireType)
+			}
+			m.CommitteeIndex = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowServices
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CommitteeIndex |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PublicKey", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowServices
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthServices
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthServices
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PublicKey = append(m.PublicKey[:0], dAtA[iNdEx:postIndex]...)
+			if m.PublicKey == nil {
+				m.PublicKey = []byte{}
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SlotSignature", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowServices
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthServices
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthServices
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SlotSignature = append(m.SlotSignature[:0], dAtA[iNdEx:postIndex]...)
+			if m.SlotSignature == nil {
+				m.SlotSignature = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipServices(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthServices
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthServices
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AggregationResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowServices
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AggregationResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AggregationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Root", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowServices
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthServices
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthServices
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Root = append(m.Root[:0], dAtA[iNdEx:postIndex]...)
+			if m.Root == nil {
+				m.Root = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipServices(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthServices
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthServices
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CheckpointResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowServices
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CheckpointResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CheckpointResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field State", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowServices
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthServices
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthServices
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.State = append(m.State[:0], dAtA[iNdEx:postIndex]...)
+			if m.State == nil {
+				m.State = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Block", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowServices
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthServices
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthServices
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Block = append(m.Block[:0], dAtA[iNdEx:postIndex]...)
+			if m.Block == nil {
+				m.Block = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Epoch", wireType)
+			}
+			m.Epoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowServices
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Epoch |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipServices(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthServices
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthServices
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PeerScore) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowServices
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PeerScore: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PeerScore: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowServices
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthServices
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthServices
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PeerId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowServices
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthServices
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthServices
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PeerId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Score", wireType)
+			}
+			m.Score = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowServices
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Score |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Connected", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowServices
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Connected = bool(v != 0)
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BannedUntil", wireType)
+			}
+			m.BannedUntil = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowServices
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BannedUntil |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipServices(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthServices
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthServices
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PeerScoresResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowServices
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PeerScoresResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PeerScoresResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Peers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowServices
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthServices
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthServices
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Peers = append(m.Peers, &PeerScore{})
+			if err := m.Peers[len(m.Peers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipServices(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthServices
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthServices
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ChainReorg) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowServices
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ChainReorg: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ChainReorg: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OldHeadRoot", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowServices
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthServices
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthServices
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OldHeadRoot = append(m.OldHeadRoot[:0], dAtA[iNdEx:postIndex]...)
+			if m.OldHeadRoot == nil {
+				m.OldHeadRoot = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OldHeadSlot", wireType)
+			}
+			m.OldHeadSlot = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowServices
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OldHeadSlot |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NewHeadRoot", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowServices
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthServices
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthServices
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NewHeadRoot = append(m.NewHeadRoot[:0], dAtA[iNdEx:postIndex]...)
+			if m.NewHeadRoot == nil {
+				m.NewHeadRoot = []byte{}
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NewHeadSlot", wireType)
+			}
+			m.NewHeadSlot = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowServices
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NewHeadSlot |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CommonAncestorRoot", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowServices
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthServices
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthServices
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CommonAncestorRoot = append(m.CommonAncestorRoot[:0], dAtA[iNdEx:postIndex]...)
+			if m.CommonAncestorRoot == nil {
+				m.CommonAncestorRoot = []byte{}
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CommonAncestorSlot", wireType)
+			}
+			m.CommonAncestorSlot = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowServices
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CommonAncestorSlot |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Depth", wireType)
+			}
+			m.Depth = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowServices
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Depth |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipServices(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthServices
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthServices
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ListValidatorRewardsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowServices
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ListValidatorRewardsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ListValidatorRewardsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartEpoch", wireType)
+			}
+			m.StartEpoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowServices
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StartEpoch |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndEpoch", wireType)
+			}
+			m.EndEpoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowServices
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EndEpoch |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: BlockRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: BlockRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 3:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowServices
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.Indices = append(m.Indices, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowServices
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthServices
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthServices
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.Indices) == 0 {
+					m.Indices = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowServices
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.Indices = append(m.Indices, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field Indices", wireType)
+			}
+		case 4:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Slot", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PageSize", wireType)
 			}
-			m.Slot = 0
+			m.PageSize = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowServices
@@ -3786,16 +7545,16 @@ func (m *BlockRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Slot |= uint64(b&0x7F) << shift
+				m.PageSize |= int32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 2:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RandaoReveal", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PageToken", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowServices
@@ -3805,25 +7564,23 @@ func (m *BlockRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthServices
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthServices
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.RandaoReveal = append(m.RandaoReveal[:0], dAtA[iNdEx:postIndex]...)
-			if m.RandaoReveal == nil {
-				m.RandaoReveal = []byte{}
-			}
+			m.PageToken = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -3850,7 +7607,7 @@ func (m *BlockRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ProposeResponse) Unmarshal(dAtA []byte) error {
+func (m *ListValidatorRewardsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3873,17 +7630,17 @@ func (m *ProposeResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ProposeResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: ListValidatorRewardsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ProposeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ListValidatorRewardsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BlockRoot", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Rewards", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowServices
@@ -3893,26 +7650,77 @@ func (m *ProposeResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthServices
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthServices
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.BlockRoot = append(m.BlockRoot[:0], dAtA[iNdEx:postIndex]...)
-			if m.BlockRoot == nil {
-				m.BlockRoot = []byte{}
+			m.Rewards = append(m.Rewards, &ValidatorEpochRewards{})
+			if err := m.Rewards[len(m.Rewards)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextPageToken", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowServices
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthServices
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthServices
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
+			m.NextPageToken = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalSize", wireType)
+			}
+			m.TotalSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowServices
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TotalSize |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipServices(dAtA[iNdEx:])
@@ -3938,7 +7746,7 @@ func (m *ProposeResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *AttestationRequest) Unmarshal(dAtA []byte) error {
+func (m *ValidatorEpochRewards) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3961,17 +7769,131 @@ func (m *AttestationRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: AttestationRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: ValidatorEpochRewards: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AttestationRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ValidatorEpochRewards: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PublicKey", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Epoch", wireType)
+			}
+			m.Epoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowServices
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Epoch |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorIndex", wireType)
+			}
+			m.ValidatorIndex = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowServices
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ValidatorIndex |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SourceReward", wireType)
+			}
+			m.SourceReward = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowServices
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SourceReward |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TargetReward", wireType)
+			}
+			m.TargetReward = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowServices
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TargetReward |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HeadReward", wireType)
+			}
+			m.HeadReward = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowServices
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.HeadReward |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InclusionDelayReward", wireType)
+			}
+			m.InclusionDelayReward = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowServices
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.InclusionDelayReward |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			var byteLen int
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposerReward", wireType)
+			}
+			m.ProposerReward = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowServices
@@ -3981,31 +7903,16 @@ func (m *AttestationRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				m.ProposerReward |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
-				return ErrInvalidLengthServices
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthServices
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.PublicKey = append(m.PublicKey[:0], dAtA[iNdEx:postIndex]...)
-			if m.PublicKey == nil {
-				m.PublicKey = []byte{}
-			}
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PocBit", wireType)
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SourcePenalty", wireType)
 			}
-			var byteLen int
+			m.SourcePenalty = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowServices
@@ -4015,31 +7922,54 @@ func (m *AttestationRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				m.SourcePenalty |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
-				return ErrInvalidLengthServices
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TargetPenalty", wireType)
 			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthServices
+			m.TargetPenalty = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowServices
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TargetPenalty |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HeadPenalty", wireType)
 			}
-			m.PocBit = append(m.PocBit[:0], dAtA[iNdEx:postIndex]...)
-			if m.PocBit == nil {
-				m.PocBit = []byte{}
+			m.HeadPenalty = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowServices
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.HeadPenalty |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
-		case 3:
+		case 11:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Slot", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field InactivityPenalty", wireType)
 			}
-			m.Slot = 0
+			m.InactivityPenalty = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowServices
@@ -4049,16 +7979,16 @@ func (m *AttestationRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Slot |= uint64(b&0x7F) << shift
+				m.InactivityPenalty |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 4:
+		case 12:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CommitteeIndex", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SlashingPenalty", wireType)
 			}
-			m.CommitteeIndex = 0
+			m.SlashingPenalty = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowServices
@@ -4068,7 +7998,7 @@ func (m *AttestationRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.CommitteeIndex |= uint64(b&0x7F) << shift
+				m.SlashingPenalty |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -4098,7 +8028,7 @@ func (m *AttestationRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *AttestResponse) Unmarshal(dAtA []byte) error {
+func (m *StreamExportsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4121,17 +8051,17 @@ func (m *AttestResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: AttestResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: StreamExportsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AttestResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: StreamExportsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Root", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Topics", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowServices
@@ -4141,25 +8071,23 @@ func (m *AttestResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthServices
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthServices
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Root = append(m.Root[:0], dAtA[iNdEx:postIndex]...)
-			if m.Root == nil {
-				m.Root = []byte{}
-			}
+			m.Topics = append(m.Topics, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -4186,7 +8114,7 @@ func (m *AttestResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *AggregationRequest) Unmarshal(dAtA []byte) error {
+func (m *ExportedObject) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4209,89 +8137,17 @@ func (m *AggregationRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: AggregationRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: ExportedObject: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AggregationRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ExportedObject: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Slot", wireType)
-			}
-			m.Slot = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowServices
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Slot |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CommitteeIndex", wireType)
-			}
-			m.CommitteeIndex = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowServices
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.CommitteeIndex |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PublicKey", wireType)
-			}
-			var byteLen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowServices
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				byteLen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if byteLen < 0 {
-				return ErrInvalidLengthServices
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthServices
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.PublicKey = append(m.PublicKey[:0], dAtA[iNdEx:postIndex]...)
-			if m.PublicKey == nil {
-				m.PublicKey = []byte{}
-			}
-			iNdEx = postIndex
-		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SlotSignature", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Topic", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowServices
@@ -4301,83 +8157,27 @@ func (m *AggregationRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthServices
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthServices
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SlotSignature = append(m.SlotSignature[:0], dAtA[iNdEx:postIndex]...)
-			if m.SlotSignature == nil {
-				m.SlotSignature = []byte{}
-			}
+			m.Topic = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipServices(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
-				return ErrInvalidLengthServices
-			}
-			if (iNdEx + skippy) < 0 {
-				return ErrInvalidLengthServices
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *AggregationResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowServices
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: AggregationResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AggregationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Root", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Object", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -4404,9 +8204,9 @@ func (m *AggregationResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Root = append(m.Root[:0], dAtA[iNdEx:postIndex]...)
-			if m.Root == nil {
-				m.Root = []byte{}
+			m.Object = append(m.Object[:0], dAtA[iNdEx:postIndex]...)
+			if m.Object == nil {
+				m.Object = []byte{}
 			}
 			iNdEx = postIndex
 		default:
@@ -6613,6 +10413,7 @@ func (m *TreeBlockSlotRequest) Unmarshal(dAtA []byte) error {
 func skipServices(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
+	depth := 0
 	for iNdEx < l {
 		var wire uint64
 		for shift := uint(0); ; shift += 7 {
@@ -6644,10 +10445,8 @@ func skipServices(dAtA []byte) (n int, err error) {
 					break
 				}
 			}
-			return iNdEx, nil
 		case 1:
 			iNdEx += 8
-			return iNdEx, nil
 		case 2:
 			var length int
 			for shift := uint(0); ; shift += 7 {
@@ -6668,55 +10467,30 @@ func skipServices(dAtA []byte) (n int, err error) {
 				return 0, ErrInvalidLengthServices
 			}
 			iNdEx += length
-			if iNdEx < 0 {
-				return 0, ErrInvalidLengthServices
-			}
-			return iNdEx, nil
 		case 3:
-			for {
-				var innerWire uint64
-				var start int = iNdEx
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return 0, ErrIntOverflowServices
-					}
-					if iNdEx >= l {
-						return 0, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					innerWire |= (uint64(b) & 0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				innerWireType := int(innerWire & 0x7)
-				if innerWireType == 4 {
-					break
-				}
-				next, err := skipServices(dAtA[start:])
-				if err != nil {
-					return 0, err
-				}
-				iNdEx = start + next
-				if iNdEx < 0 {
-					return 0, ErrInvalidLengthServices
-				}
-			}
-			return iNdEx, nil
+			depth++
 		case 4:
-			return iNdEx, nil
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupServices
+			}
+			depth--
 		case 5:
 			iNdEx += 4
-			return iNdEx, nil
 		default:
 			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
 		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthServices
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
 	}
-	panic("unreachable")
+	return 0, io.ErrUnexpectedEOF
 }
 
 var (
-	ErrInvalidLengthServices = fmt.Errorf("proto: negative length found during unmarshaling")
-	ErrIntOverflowServices   = fmt.Errorf("proto: integer overflow")
+	ErrInvalidLengthServices        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowServices          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupServices = fmt.Errorf("proto: unexpected end of group")
 )
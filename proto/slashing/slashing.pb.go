@@ -12,7 +12,6 @@ import (
 
 	_ "github.com/gogo/protobuf/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
-	types "github.com/gogo/protobuf/types"
 	v1alpha1 "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
 	github_com_prysmaticlabs_go_bitfield "github.com/prysmaticlabs/go-bitfield"
 	grpc "google.golang.org/grpc"
@@ -142,11 +141,11 @@ func (m *ValidatorIDToIdxAttList) GetIndicesList() []*ValidatorIDToIdxAtt {
 }
 
 type ProposerSlashingRequest struct {
-	BlockHeader          *v1alpha1.BeaconBlockHeader `protobuf:"bytes,1,opt,name=block_header,json=blockHeader,proto3" json:"block_header,omitempty"`
-	ValidatorIndex       uint64                      `protobuf:"varint,2,opt,name=validator_index,json=validatorIndex,proto3" json:"validator_index,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}                    `json:"-"`
-	XXX_unrecognized     []byte                      `json:"-"`
-	XXX_sizecache        int32                       `json:"-"`
+	BlockHeader          *v1alpha1.SignedBeaconBlockHeader `protobuf:"bytes,1,opt,name=block_header,json=blockHeader,proto3" json:"block_header,omitempty"`
+	ValidatorIndex       uint64                            `protobuf:"varint,2,opt,name=validator_index,json=validatorIndex,proto3" json:"validator_index,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                          `json:"-"`
+	XXX_unrecognized     []byte                            `json:"-"`
+	XXX_sizecache        int32                             `json:"-"`
 }
 
 func (m *ProposerSlashingRequest) Reset()         { *m = ProposerSlashingRequest{} }
@@ -182,7 +181,7 @@ func (m *ProposerSlashingRequest) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_ProposerSlashingRequest proto.InternalMessageInfo
 
-func (m *ProposerSlashingRequest) GetBlockHeader() *v1alpha1.BeaconBlockHeader {
+func (m *ProposerSlashingRequest) GetBlockHeader() *v1alpha1.SignedBeaconBlockHeader {
 	if m != nil {
 		return m.BlockHeader
 	}
@@ -290,6 +289,61 @@ func (m *AttesterSlashingResponse) GetAttesterSlashing() []*v1alpha1.AttesterSla
 	return nil
 }
 
+type SlashingStreamRequest struct {
+	Replay               bool     `protobuf:"varint,1,opt,name=replay,proto3" json:"replay,omitempty"`
+	FromEpoch            uint64   `protobuf:"varint,2,opt,name=from_epoch,json=fromEpoch,proto3" json:"from_epoch,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SlashingStreamRequest) Reset()         { *m = SlashingStreamRequest{} }
+func (m *SlashingStreamRequest) String() string { return proto.CompactTextString(m) }
+func (*SlashingStreamRequest) ProtoMessage()    {}
+func (*SlashingStreamRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_da7e95107d0081b4, []int{5}
+}
+func (m *SlashingStreamRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SlashingStreamRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SlashingStreamRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SlashingStreamRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SlashingStreamRequest.Merge(m, src)
+}
+func (m *SlashingStreamRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *SlashingStreamRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SlashingStreamRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SlashingStreamRequest proto.InternalMessageInfo
+
+func (m *SlashingStreamRequest) GetReplay() bool {
+	if m != nil {
+		return m.Replay
+	}
+	return false
+}
+
+func (m *SlashingStreamRequest) GetFromEpoch() uint64 {
+	if m != nil {
+		return m.FromEpoch
+	}
+	return 0
+}
+
 type MinMaxEpochSpan struct {
 	MinEpochSpan         uint32   `protobuf:"varint,1,opt,name=min_epoch_span,json=minEpochSpan,proto3" json:"min_epoch_span,omitempty"`
 	MaxEpochSpan         uint32   `protobuf:"varint,2,opt,name=max_epoch_span,json=maxEpochSpan,proto3" json:"max_epoch_span,omitempty"`
@@ -302,7 +356,7 @@ func (m *MinMaxEpochSpan) Reset()         { *m = MinMaxEpochSpan{} }
 func (m *MinMaxEpochSpan) String() string { return proto.CompactTextString(m) }
 func (*MinMaxEpochSpan) ProtoMessage()    {}
 func (*MinMaxEpochSpan) Descriptor() ([]byte, []int) {
-	return fileDescriptor_da7e95107d0081b4, []int{5}
+	return fileDescriptor_da7e95107d0081b4, []int{6}
 }
 func (m *MinMaxEpochSpan) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -356,7 +410,7 @@ func (m *EpochSpanMap) Reset()         { *m = EpochSpanMap{} }
 func (m *EpochSpanMap) String() string { return proto.CompactTextString(m) }
 func (*EpochSpanMap) ProtoMessage()    {}
 func (*EpochSpanMap) Descriptor() ([]byte, []int) {
-	return fileDescriptor_da7e95107d0081b4, []int{6}
+	return fileDescriptor_da7e95107d0081b4, []int{7}
 }
 func (m *EpochSpanMap) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -395,6 +449,7 @@ func (m *EpochSpanMap) GetEpochSpanMap() map[uint64]*MinMaxEpochSpan {
 type ProposalHistory struct {
 	EpochBits            github_com_prysmaticlabs_go_bitfield.Bitlist `protobuf:"bytes,1,opt,name=epoch_bits,json=epochBits,proto3,casttype=github.com/prysmaticlabs/go-bitfield.Bitlist" json:"epoch_bits,omitempty"`
 	LatestEpochWritten   uint64                                       `protobuf:"varint,2,opt,name=latest_epoch_written,json=latestEpochWritten,proto3" json:"latest_epoch_written,omitempty"`
+	SlotToSigningRoot    map[uint64][]byte                            `protobuf:"bytes,3,rep,name=slot_to_signing_root,json=slotToSigningRoot,proto3" json:"slot_to_signing_root,omitempty" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
 	XXX_NoUnkeyedLiteral struct{}                                     `json:"-"`
 	XXX_unrecognized     []byte                                       `json:"-"`
 	XXX_sizecache        int32                                        `json:"-"`
@@ -404,7 +459,7 @@ func (m *ProposalHistory) Reset()         { *m = ProposalHistory{} }
 func (m *ProposalHistory) String() string { return proto.CompactTextString(m) }
 func (*ProposalHistory) ProtoMessage()    {}
 func (*ProposalHistory) Descriptor() ([]byte, []int) {
-	return fileDescriptor_da7e95107d0081b4, []int{7}
+	return fileDescriptor_da7e95107d0081b4, []int{8}
 }
 func (m *ProposalHistory) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -447,67 +502,200 @@ func (m *ProposalHistory) GetLatestEpochWritten() uint64 {
 	return 0
 }
 
+func (m *ProposalHistory) GetSlotToSigningRoot() map[uint64][]byte {
+	if m != nil {
+		return m.SlotToSigningRoot
+	}
+	return nil
+}
+
+type AttestationHistory struct {
+	TargetToAttestation  map[uint64]*HistoricalAttestation `protobuf:"bytes,1,rep,name=target_to_attestation,json=targetToAttestation,proto3" json:"target_to_attestation,omitempty" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	LatestEpochWritten   uint64                            `protobuf:"varint,2,opt,name=latest_epoch_written,json=latestEpochWritten,proto3" json:"latest_epoch_written,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                          `json:"-"`
+	XXX_unrecognized     []byte                            `json:"-"`
+	XXX_sizecache        int32                             `json:"-"`
+}
+
+func (m *AttestationHistory) Reset()         { *m = AttestationHistory{} }
+func (m *AttestationHistory) String() string { return proto.CompactTextString(m) }
+func (*AttestationHistory) ProtoMessage()    {}
+func (*AttestationHistory) Descriptor() ([]byte, []int) {
+	return fileDescriptor_da7e95107d0081b4, []int{9}
+}
+func (m *AttestationHistory) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AttestationHistory) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AttestationHistory.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AttestationHistory) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AttestationHistory.Merge(m, src)
+}
+func (m *AttestationHistory) XXX_Size() int {
+	return m.Size()
+}
+func (m *AttestationHistory) XXX_DiscardUnknown() {
+	xxx_messageInfo_AttestationHistory.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AttestationHistory proto.InternalMessageInfo
+
+func (m *AttestationHistory) GetTargetToAttestation() map[uint64]*HistoricalAttestation {
+	if m != nil {
+		return m.TargetToAttestation
+	}
+	return nil
+}
+
+func (m *AttestationHistory) GetLatestEpochWritten() uint64 {
+	if m != nil {
+		return m.LatestEpochWritten
+	}
+	return 0
+}
+
+type HistoricalAttestation struct {
+	SourceEpoch          uint64   `protobuf:"varint,1,opt,name=source_epoch,json=sourceEpoch,proto3" json:"source_epoch,omitempty"`
+	SigningRoot          []byte   `protobuf:"bytes,2,opt,name=signing_root,json=signingRoot,proto3" json:"signing_root,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *HistoricalAttestation) Reset()         { *m = HistoricalAttestation{} }
+func (m *HistoricalAttestation) String() string { return proto.CompactTextString(m) }
+func (*HistoricalAttestation) ProtoMessage()    {}
+func (*HistoricalAttestation) Descriptor() ([]byte, []int) {
+	return fileDescriptor_da7e95107d0081b4, []int{10}
+}
+func (m *HistoricalAttestation) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *HistoricalAttestation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_HistoricalAttestation.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *HistoricalAttestation) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_HistoricalAttestation.Merge(m, src)
+}
+func (m *HistoricalAttestation) XXX_Size() int {
+	return m.Size()
+}
+func (m *HistoricalAttestation) XXX_DiscardUnknown() {
+	xxx_messageInfo_HistoricalAttestation.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_HistoricalAttestation proto.InternalMessageInfo
+
+func (m *HistoricalAttestation) GetSourceEpoch() uint64 {
+	if m != nil {
+		return m.SourceEpoch
+	}
+	return 0
+}
+
+func (m *HistoricalAttestation) GetSigningRoot() []byte {
+	if m != nil {
+		return m.SigningRoot
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*ValidatorIDToIdxAtt)(nil), "ethereum.slashing.ValidatorIDToIdxAtt")
 	proto.RegisterType((*ValidatorIDToIdxAttList)(nil), "ethereum.slashing.ValidatorIDToIdxAttList")
 	proto.RegisterType((*ProposerSlashingRequest)(nil), "ethereum.slashing.ProposerSlashingRequest")
 	proto.RegisterType((*ProposerSlashingResponse)(nil), "ethereum.slashing.ProposerSlashingResponse")
 	proto.RegisterType((*AttesterSlashingResponse)(nil), "ethereum.slashing.AttesterSlashingResponse")
+	proto.RegisterType((*SlashingStreamRequest)(nil), "ethereum.slashing.SlashingStreamRequest")
 	proto.RegisterType((*MinMaxEpochSpan)(nil), "ethereum.slashing.MinMaxEpochSpan")
 	proto.RegisterType((*EpochSpanMap)(nil), "ethereum.slashing.EpochSpanMap")
 	proto.RegisterMapType((map[uint64]*MinMaxEpochSpan)(nil), "ethereum.slashing.EpochSpanMap.EpochSpanMapEntry")
 	proto.RegisterType((*ProposalHistory)(nil), "ethereum.slashing.ProposalHistory")
+	proto.RegisterMapType((map[uint64][]byte)(nil), "ethereum.slashing.ProposalHistory.SlotToSigningRootEntry")
+	proto.RegisterType((*AttestationHistory)(nil), "ethereum.slashing.AttestationHistory")
+	proto.RegisterMapType((map[uint64]*HistoricalAttestation)(nil), "ethereum.slashing.AttestationHistory.TargetToAttestationEntry")
+	proto.RegisterType((*HistoricalAttestation)(nil), "ethereum.slashing.HistoricalAttestation")
 }
 
 func init() { proto.RegisterFile("proto/slashing/slashing.proto", fileDescriptor_da7e95107d0081b4) }
 
 var fileDescriptor_da7e95107d0081b4 = []byte{
-	// 716 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x54, 0x4f, 0x6f, 0xd3, 0x4a,
-	0x10, 0x97, 0x9b, 0xbe, 0xd7, 0xd7, 0x4d, 0x5e, 0x9b, 0xee, 0x7b, 0xb4, 0x51, 0x0a, 0x6d, 0x65,
-	0x21, 0x1a, 0xfe, 0xd4, 0x49, 0xc3, 0xa5, 0xe2, 0xd6, 0x88, 0x4a, 0x8d, 0xa0, 0x02, 0xb9, 0x15,
-	0x95, 0x90, 0x90, 0xb5, 0xb6, 0xb7, 0xf6, 0x52, 0x67, 0x77, 0xf1, 0x4e, 0x4a, 0xf2, 0x29, 0xb8,
-	0x70, 0xe5, 0xfb, 0x70, 0xe0, 0xc0, 0x27, 0x40, 0xa8, 0x1f, 0x83, 0x13, 0xf2, 0xda, 0x49, 0xdc,
-	0xc4, 0x91, 0xca, 0x6d, 0x67, 0xe6, 0x37, 0xf3, 0x9b, 0xfd, 0xed, 0xcc, 0xa2, 0x7b, 0x32, 0x16,
-	0x20, 0x9a, 0x2a, 0x22, 0x2a, 0x64, 0x3c, 0x18, 0x1f, 0x2c, 0xed, 0xc7, 0x6b, 0x14, 0x42, 0x1a,
-	0xd3, 0x7e, 0xcf, 0x1a, 0x05, 0xea, 0x9b, 0x81, 0x10, 0x41, 0x44, 0x9b, 0x1a, 0xe0, 0xf6, 0x2f,
-	0x9a, 0xb4, 0x27, 0x61, 0x98, 0xe2, 0xeb, 0xdb, 0x14, 0xc2, 0xe6, 0xd5, 0x3e, 0x89, 0x64, 0x48,
-	0xf6, 0x9b, 0x2e, 0x25, 0x9e, 0xe0, 0x8e, 0x1b, 0x09, 0xef, 0x32, 0x03, 0xec, 0x05, 0x0c, 0xc2,
-	0xbe, 0x6b, 0x79, 0xa2, 0xd7, 0x0c, 0x44, 0x20, 0x26, 0x65, 0x12, 0x2b, 0x6d, 0x26, 0x39, 0xa5,
-	0x70, 0xf3, 0x3d, 0xfa, 0xef, 0x0d, 0x89, 0x98, 0x4f, 0x40, 0xc4, 0xdd, 0xe7, 0x67, 0xa2, 0xeb,
-	0x0f, 0x0e, 0x01, 0x70, 0x0d, 0x2d, 0x31, 0xee, 0x33, 0x8f, 0xaa, 0x9a, 0xb1, 0x53, 0x6a, 0x2c,
-	0xda, 0x23, 0x13, 0x6f, 0xa2, 0x65, 0x9f, 0x00, 0x71, 0x62, 0x21, 0xa0, 0xb6, 0xb0, 0x63, 0x34,
-	0x2a, 0xf6, 0x3f, 0x89, 0xc3, 0x16, 0x02, 0xf0, 0x5d, 0xb4, 0xac, 0x58, 0xc0, 0x09, 0xf4, 0x63,
-	0x5a, 0x2b, 0xe9, 0xe0, 0xc4, 0x61, 0x7a, 0x68, 0xa3, 0x80, 0xeb, 0x25, 0x53, 0x80, 0x8f, 0x51,
-	0x39, 0x23, 0x48, 0x4c, 0xcd, 0x59, 0x6e, 0x3f, 0xb0, 0x66, 0xc4, 0xb1, 0x0a, 0x0a, 0xd8, 0xf9,
-	0x54, 0xf3, 0x93, 0x81, 0x36, 0x5e, 0xc7, 0x42, 0x0a, 0x45, 0xe3, 0xd3, 0x2c, 0xcb, 0xa6, 0x1f,
-	0xfa, 0x54, 0x01, 0x7e, 0x81, 0x2a, 0x5a, 0x2a, 0x27, 0xa4, 0xc4, 0xa7, 0x71, 0xcd, 0xd8, 0x31,
-	0x1a, 0xe5, 0x76, 0x63, 0x42, 0x43, 0x21, 0xb4, 0x46, 0xe2, 0x5a, 0x1d, 0x2d, 0x6e, 0x27, 0x49,
-	0x38, 0xd6, 0x78, 0xbb, 0xec, 0x4e, 0x0c, 0xbc, 0x8b, 0x56, 0xaf, 0x46, 0xcd, 0x38, 0x8c, 0xfb,
-	0x74, 0xa0, 0xe5, 0x58, 0xb4, 0x57, 0xc6, 0xee, 0x6e, 0xe2, 0x35, 0x25, 0xaa, 0xcd, 0x36, 0xa4,
-	0xa4, 0xe0, 0x8a, 0xe2, 0x33, 0xb4, 0x26, 0xb3, 0x98, 0x33, 0xba, 0x63, 0x76, 0xfb, 0xdd, 0x39,
-	0x6d, 0xcd, 0xd4, 0xaa, 0xca, 0x29, 0x4f, 0xc2, 0x78, 0x08, 0x40, 0x15, 0x14, 0x33, 0x92, 0x2c,
-	0x76, 0x5b, 0xc6, 0x99, 0x5a, 0x55, 0x32, 0xe5, 0x31, 0xdf, 0xa1, 0xd5, 0x13, 0xc6, 0x4f, 0xc8,
-	0xe0, 0x48, 0x0a, 0x2f, 0x3c, 0x95, 0x84, 0xe3, 0xfb, 0x68, 0xa5, 0xc7, 0xb8, 0x43, 0x13, 0x87,
-	0xa3, 0x24, 0xe1, 0x5a, 0xee, 0x7f, 0xed, 0x4a, 0x8f, 0xf1, 0x9b, 0x28, 0x32, 0xc8, 0xa3, 0x16,
-	0x32, 0x54, 0xae, 0x96, 0xf9, 0xcd, 0x40, 0x95, 0xb1, 0x75, 0x42, 0x24, 0x3e, 0x47, 0x2b, 0x93,
-	0x14, 0xa7, 0x47, 0x64, 0x76, 0x85, 0xfd, 0x82, 0x91, 0xc9, 0x27, 0xde, 0x30, 0x8e, 0x38, 0xc4,
-	0x43, 0xbb, 0x42, 0x73, 0xae, 0xba, 0x87, 0xd6, 0x66, 0x20, 0xb8, 0x8a, 0x4a, 0x97, 0x74, 0xa8,
-	0xfb, 0x5f, 0xb4, 0x93, 0x23, 0x3e, 0x40, 0x7f, 0x5d, 0x91, 0xa8, 0x4f, 0x75, 0xb7, 0xe5, 0xb6,
-	0x59, 0x40, 0x3b, 0xa5, 0x87, 0x9d, 0x26, 0x3c, 0x5b, 0x38, 0x30, 0xcc, 0xcf, 0x06, 0x5a, 0x4d,
-	0x9f, 0x91, 0x44, 0xc7, 0x4c, 0x81, 0x88, 0x87, 0xf8, 0x15, 0x42, 0xe9, 0x8d, 0x5c, 0x06, 0x4a,
-	0x53, 0x55, 0x3a, 0xad, 0x5f, 0x3f, 0xb6, 0x9f, 0xe4, 0xf6, 0x59, 0xc6, 0x43, 0xd5, 0x23, 0xc0,
-	0xbc, 0x88, 0xb8, 0xaa, 0x19, 0x88, 0x3d, 0x97, 0xc1, 0x05, 0xa3, 0x91, 0x6f, 0x75, 0x18, 0x44,
-	0x4c, 0x81, 0xbd, 0xac, 0x6b, 0x74, 0x18, 0x28, 0xdc, 0x42, 0xff, 0x47, 0x24, 0x79, 0xa6, 0x4c,
-	0xdc, 0x8f, 0x31, 0x03, 0xa0, 0x3c, 0x1b, 0x52, 0x9c, 0xc6, 0x74, 0x7b, 0xe7, 0x69, 0xa4, 0xfd,
-	0xa5, 0x84, 0x96, 0xf4, 0x8b, 0xd2, 0x18, 0x4b, 0xb4, 0xde, 0x55, 0xda, 0x20, 0x6e, 0x44, 0xd3,
-	0x09, 0x20, 0xc0, 0x04, 0xc7, 0x0f, 0xe7, 0x4c, 0x89, 0x1e, 0x76, 0xea, 0xe7, 0xa0, 0xf5, 0xc7,
-	0x05, 0xb2, 0xcc, 0x1d, 0xcc, 0x4b, 0x54, 0xcd, 0x31, 0xea, 0xb5, 0xc3, 0x8f, 0x0a, 0x0a, 0xcc,
-	0x59, 0xee, 0x42, 0xb2, 0xb9, 0x7b, 0x77, 0x8e, 0xf0, 0x98, 0x6a, 0xf4, 0x12, 0x0a, 0xaf, 0x5b,
-	0xe9, 0xd7, 0x6b, 0x8d, 0xfe, 0x4c, 0xeb, 0x28, 0xf9, 0x7a, 0xeb, 0xb7, 0x5d, 0xc5, 0x96, 0x81,
-	0xdf, 0xa2, 0x3b, 0x45, 0xaa, 0xfd, 0x79, 0xed, 0x69, 0x9d, 0x5a, 0x46, 0xa7, 0xf2, 0xf5, 0x7a,
-	0xcb, 0xf8, 0x7e, 0xbd, 0x65, 0xfc, 0xbc, 0xde, 0x32, 0xdc, 0xbf, 0x75, 0xa1, 0xa7, 0xbf, 0x03,
-	0x00, 0x00, 0xff, 0xff, 0x6b, 0x4f, 0x9c, 0xca, 0x61, 0x06, 0x00, 0x00,
+	// 890 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x56, 0xcd, 0x6e, 0xdb, 0x46,
+	0x10, 0x06, 0x25, 0x37, 0x89, 0x47, 0xac, 0x7f, 0x36, 0xb6, 0x23, 0xa8, 0x8d, 0xe3, 0x12, 0x45,
+	0xe3, 0x36, 0x0d, 0x65, 0xbb, 0x97, 0xb4, 0x87, 0x00, 0x11, 0x12, 0xc0, 0x06, 0xea, 0xfe, 0x50,
+	0x42, 0x73, 0x0a, 0x88, 0x25, 0xb9, 0xa6, 0xd6, 0xa6, 0xb8, 0xec, 0xee, 0xc8, 0xb5, 0x1f, 0xa4,
+	0x6f, 0xd1, 0x97, 0xe8, 0xa5, 0xe8, 0xa1, 0x87, 0x3e, 0x41, 0x51, 0xf8, 0x31, 0x7a, 0x2a, 0xb8,
+	0x4b, 0x4a, 0xb4, 0x48, 0x01, 0x2e, 0xd0, 0xdb, 0xce, 0x37, 0x3f, 0xdf, 0xce, 0xb7, 0xa3, 0xa1,
+	0xe0, 0x71, 0x26, 0x05, 0x8a, 0xbe, 0x4a, 0xa8, 0x1a, 0xf3, 0x34, 0x9e, 0x1d, 0x5c, 0x8d, 0x93,
+	0x4d, 0x86, 0x63, 0x26, 0xd9, 0x74, 0xe2, 0x96, 0x8e, 0xde, 0x13, 0x86, 0xe3, 0xfe, 0xe5, 0x21,
+	0x4d, 0xb2, 0x31, 0x3d, 0xec, 0x07, 0x8c, 0x86, 0x22, 0xf5, 0x83, 0x44, 0x84, 0x17, 0x26, 0xa7,
+	0xf7, 0x3c, 0xe6, 0x38, 0x9e, 0x06, 0x6e, 0x28, 0x26, 0xfd, 0x58, 0xc4, 0xa2, 0xaf, 0xe1, 0x60,
+	0x7a, 0xa6, 0x2d, 0xc3, 0x97, 0x9f, 0x4c, 0xb8, 0x73, 0x0e, 0x0f, 0x7f, 0xa0, 0x09, 0x8f, 0x28,
+	0x0a, 0x79, 0xf2, 0x7a, 0x24, 0x4e, 0xa2, 0xab, 0x57, 0x88, 0xa4, 0x0b, 0xf7, 0x79, 0x1a, 0xf1,
+	0x90, 0xa9, 0xae, 0xb5, 0xd7, 0xde, 0x5f, 0xf1, 0x4a, 0x93, 0x7c, 0x00, 0xab, 0x11, 0x45, 0xea,
+	0x4b, 0x21, 0xb0, 0xdb, 0xda, 0xb3, 0xf6, 0x6d, 0xef, 0x41, 0x0e, 0x78, 0x42, 0x20, 0xf9, 0x10,
+	0x56, 0x15, 0x8f, 0x53, 0x8a, 0x53, 0xc9, 0xba, 0x6d, 0xed, 0x9c, 0x03, 0x4e, 0x08, 0x8f, 0x1a,
+	0xb8, 0xbe, 0xe6, 0x0a, 0xc9, 0x31, 0x74, 0x0a, 0x82, 0xdc, 0xd4, 0x9c, 0x9d, 0xa3, 0x4f, 0xdc,
+	0x5a, 0xff, 0x6e, 0x43, 0x01, 0xaf, 0x9a, 0xea, 0xfc, 0x6c, 0xc1, 0xa3, 0xef, 0xa4, 0xc8, 0x84,
+	0x62, 0x72, 0x58, 0x64, 0x79, 0xec, 0xc7, 0x29, 0x53, 0x48, 0xbe, 0x07, 0x5b, 0x4b, 0xe5, 0x8f,
+	0x19, 0x8d, 0x98, 0xec, 0x5a, 0x7b, 0xd6, 0x7e, 0xe7, 0xc8, 0x9d, 0xd3, 0x30, 0x1c, 0xbb, 0xa5,
+	0xb8, 0xee, 0x90, 0xc7, 0x29, 0x8b, 0x06, 0x5a, 0xe2, 0x41, 0x9e, 0x76, 0xac, 0xb3, 0xbc, 0x4e,
+	0x30, 0x37, 0xc8, 0x53, 0x58, 0xbf, 0x2c, 0xaf, 0xe4, 0xf3, 0x34, 0x62, 0x57, 0x5a, 0x94, 0x15,
+	0x6f, 0x6d, 0x06, 0x9f, 0xe4, 0xa8, 0x93, 0x41, 0xb7, 0x7e, 0x2d, 0x95, 0x89, 0x54, 0x31, 0x32,
+	0x82, 0xcd, 0xac, 0xf0, 0xf9, 0x65, 0xa7, 0x85, 0x06, 0x4f, 0x97, 0x5c, 0xae, 0x56, 0x6b, 0x23,
+	0x5b, 0x40, 0x72, 0xc6, 0x57, 0x88, 0x4c, 0x61, 0x33, 0x23, 0x2d, 0x7c, 0x77, 0x65, 0xac, 0xd5,
+	0xda, 0xa0, 0x0b, 0x88, 0xf3, 0x0d, 0x6c, 0x97, 0xe7, 0x21, 0x4a, 0x46, 0x27, 0xa5, 0xf0, 0x3b,
+	0x70, 0x4f, 0xb2, 0x2c, 0xa1, 0xd7, 0x5a, 0xf2, 0x07, 0x5e, 0x61, 0x91, 0xc7, 0x00, 0x67, 0x52,
+	0x4c, 0x7c, 0x96, 0x89, 0x70, 0x5c, 0x08, 0xb7, 0x9a, 0x23, 0x6f, 0x72, 0xc0, 0x79, 0x07, 0xeb,
+	0xa7, 0x3c, 0x3d, 0xa5, 0x57, 0xda, 0x1c, 0x66, 0x34, 0x25, 0x1f, 0xc3, 0xda, 0x84, 0xa7, 0x26,
+	0xc1, 0x57, 0x19, 0x4d, 0x75, 0xc5, 0xf7, 0x3d, 0x7b, 0xc2, 0xd3, 0xdb, 0x51, 0xf4, 0xaa, 0x1a,
+	0xd5, 0x2a, 0xa2, 0x2a, 0xb5, 0x9c, 0x3f, 0x2c, 0xb0, 0x67, 0xd6, 0x29, 0xcd, 0xc8, 0x5b, 0x58,
+	0x9b, 0xa7, 0xf8, 0x13, 0x9a, 0x15, 0x92, 0x1c, 0x36, 0x0c, 0x62, 0x35, 0xf1, 0x96, 0xf1, 0x26,
+	0x45, 0x79, 0xed, 0xd9, 0xac, 0x02, 0xf5, 0x42, 0xd8, 0xac, 0x85, 0x90, 0x0d, 0x68, 0x5f, 0x30,
+	0xa3, 0xc8, 0x8a, 0x97, 0x1f, 0xc9, 0x0b, 0x78, 0xef, 0x92, 0x26, 0x53, 0xa6, 0x6f, 0xdb, 0x39,
+	0x72, 0x1a, 0x68, 0x17, 0xf4, 0xf0, 0x4c, 0xc2, 0x57, 0xad, 0x17, 0x96, 0xf3, 0x5b, 0x0b, 0xd6,
+	0xcd, 0x58, 0xd0, 0xe4, 0x98, 0x2b, 0x14, 0xf2, 0x9a, 0x7c, 0x0b, 0x60, 0x3a, 0x0a, 0x38, 0x2a,
+	0x4d, 0x65, 0x0f, 0x0e, 0xfe, 0xf9, 0xeb, 0xc9, 0xe7, 0x95, 0x2d, 0x91, 0xc9, 0x6b, 0x35, 0xa1,
+	0xc8, 0xc3, 0x84, 0x06, 0xaa, 0x1f, 0x8b, 0xe7, 0x01, 0xc7, 0x33, 0xce, 0x92, 0xc8, 0x1d, 0x70,
+	0x4c, 0xb8, 0x42, 0x6f, 0x55, 0xd7, 0x18, 0x70, 0x54, 0xe4, 0x00, 0xb6, 0x12, 0x9a, 0x3f, 0x7b,
+	0x21, 0xee, 0x4f, 0x92, 0x23, 0xb2, 0xb4, 0x78, 0x3b, 0x62, 0x7c, 0xfa, 0x7a, 0x6f, 0x8d, 0x87,
+	0x9c, 0xc3, 0x96, 0x4a, 0x04, 0xfa, 0x28, 0xfc, 0x7c, 0x15, 0xf0, 0x34, 0x36, 0xbb, 0xa3, 0xad,
+	0xa5, 0xfd, 0xb2, 0xa1, 0xc7, 0x85, 0x26, 0xdc, 0x61, 0x22, 0x70, 0x24, 0x86, 0x26, 0x39, 0xdf,
+	0x33, 0x46, 0xe2, 0x4d, 0xb5, 0x88, 0xf7, 0x5e, 0xc3, 0x4e, 0x73, 0x70, 0x83, 0xd8, 0x5b, 0x55,
+	0xb1, 0xed, 0xaa, 0x90, 0xbf, 0xb4, 0x80, 0x98, 0x69, 0xa7, 0xc8, 0x45, 0x5a, 0x6a, 0x29, 0x61,
+	0x1b, 0xa9, 0x8c, 0x99, 0x6e, 0x85, 0xce, 0xfd, 0xc5, 0x90, 0xbc, 0x6c, 0xe8, 0xa4, 0x5e, 0xc5,
+	0x1d, 0xe9, 0x12, 0x23, 0x51, 0x71, 0x99, 0x76, 0x1e, 0x62, 0xdd, 0xf3, 0xdf, 0xe5, 0xee, 0x65,
+	0xd0, 0x5d, 0x46, 0xd1, 0x20, 0xc2, 0xcb, 0xdb, 0x13, 0xb7, 0xdf, 0xd0, 0x83, 0xb9, 0x38, 0x0f,
+	0x69, 0x52, 0xa9, 0x57, 0x95, 0xeb, 0x1d, 0x6c, 0x37, 0xc6, 0x90, 0x8f, 0xc0, 0x56, 0x62, 0x2a,
+	0x43, 0x56, 0xfc, 0xbe, 0x0d, 0x6f, 0xc7, 0x60, 0xfa, 0xd2, 0x3a, 0xa4, 0x3a, 0x14, 0xe6, 0x2d,
+	0x3a, 0x6a, 0xfe, 0x7c, 0x47, 0xbf, 0xb6, 0xe1, 0xbe, 0xde, 0x2a, 0x4c, 0x92, 0x0c, 0x76, 0x4e,
+	0x94, 0x36, 0x68, 0x90, 0xb0, 0x2a, 0xd7, 0xa7, 0x4b, 0xb6, 0x96, 0x5e, 0xbe, 0x2c, 0xaa, 0x84,
+	0xf6, 0x9e, 0x2d, 0x7d, 0xa8, 0x86, 0x45, 0x79, 0x01, 0x1b, 0x15, 0x46, 0xfd, 0x19, 0x20, 0x9f,
+	0x2d, 0x9d, 0xd9, 0xda, 0x27, 0xa7, 0xf7, 0xec, 0x4e, 0xb1, 0x05, 0x19, 0x07, 0x32, 0xa3, 0x2a,
+	0x7f, 0x04, 0x8a, 0x34, 0x3d, 0x4a, 0xe3, 0x9a, 0xed, 0xdd, 0xf5, 0x63, 0x71, 0x60, 0x91, 0x04,
+	0xb6, 0x67, 0x54, 0x15, 0x71, 0xfe, 0x0f, 0xb6, 0x45, 0x2d, 0x0f, 0xac, 0x81, 0xfd, 0xfb, 0xcd,
+	0xae, 0xf5, 0xe7, 0xcd, 0xae, 0xf5, 0xf7, 0xcd, 0xae, 0x15, 0xdc, 0xd3, 0x7f, 0x3d, 0xbe, 0xf8,
+	0x77, 0x00, 0xcf, 0x8c, 0x61, 0x24, 0xfe, 0x08, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -524,8 +712,8 @@ const _ = grpc.SupportPackageIsVersion4
 type SlasherClient interface {
 	IsSlashableAttestation(ctx context.Context, in *v1alpha1.IndexedAttestation, opts ...grpc.CallOption) (*AttesterSlashingResponse, error)
 	IsSlashableBlock(ctx context.Context, in *ProposerSlashingRequest, opts ...grpc.CallOption) (*ProposerSlashingResponse, error)
-	SlashableProposals(ctx context.Context, in *types.Empty, opts ...grpc.CallOption) (Slasher_SlashableProposalsClient, error)
-	SlashableAttestations(ctx context.Context, in *types.Empty, opts ...grpc.CallOption) (Slasher_SlashableAttestationsClient, error)
+	SlashableProposals(ctx context.Context, in *SlashingStreamRequest, opts ...grpc.CallOption) (Slasher_SlashableProposalsClient, error)
+	SlashableAttestations(ctx context.Context, in *SlashingStreamRequest, opts ...grpc.CallOption) (Slasher_SlashableAttestationsClient, error)
 }
 
 type slasherClient struct {
@@ -554,7 +742,7 @@ func (c *slasherClient) IsSlashableBlock(ctx context.Context, in *ProposerSlashi
 	return out, nil
 }
 
-func (c *slasherClient) SlashableProposals(ctx context.Context, in *types.Empty, opts ...grpc.CallOption) (Slasher_SlashableProposalsClient, error) {
+func (c *slasherClient) SlashableProposals(ctx context.Context, in *SlashingStreamRequest, opts ...grpc.CallOption) (Slasher_SlashableProposalsClient, error) {
 	stream, err := c.cc.NewStream(ctx, &_Slasher_serviceDesc.Streams[0], "/ethereum.slashing.Slasher/SlashableProposals", opts...)
 	if err != nil {
 		return nil, err
@@ -586,7 +774,7 @@ func (x *slasherSlashableProposalsClient) Recv() (*v1alpha1.ProposerSlashing, er
 	return m, nil
 }
 
-func (c *slasherClient) SlashableAttestations(ctx context.Context, in *types.Empty, opts ...grpc.CallOption) (Slasher_SlashableAttestationsClient, error) {
+func (c *slasherClient) SlashableAttestations(ctx context.Context, in *SlashingStreamRequest, opts ...grpc.CallOption) (Slasher_SlashableAttestationsClient, error) {
 	stream, err := c.cc.NewStream(ctx, &_Slasher_serviceDesc.Streams[1], "/ethereum.slashing.Slasher/SlashableAttestations", opts...)
 	if err != nil {
 		return nil, err
@@ -622,8 +810,8 @@ func (x *slasherSlashableAttestationsClient) Recv() (*v1alpha1.AttesterSlashing,
 type SlasherServer interface {
 	IsSlashableAttestation(context.Context, *v1alpha1.IndexedAttestation) (*AttesterSlashingResponse, error)
 	IsSlashableBlock(context.Context, *ProposerSlashingRequest) (*ProposerSlashingResponse, error)
-	SlashableProposals(*types.Empty, Slasher_SlashableProposalsServer) error
-	SlashableAttestations(*types.Empty, Slasher_SlashableAttestationsServer) error
+	SlashableProposals(*SlashingStreamRequest, Slasher_SlashableProposalsServer) error
+	SlashableAttestations(*SlashingStreamRequest, Slasher_SlashableAttestationsServer) error
 }
 
 // UnimplementedSlasherServer can be embedded to have forward compatible implementations.
@@ -636,10 +824,10 @@ func (*UnimplementedSlasherServer) IsSlashableAttestation(ctx context.Context, r
 func (*UnimplementedSlasherServer) IsSlashableBlock(ctx context.Context, req *ProposerSlashingRequest) (*ProposerSlashingResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method IsSlashableBlock not implemented")
 }
-func (*UnimplementedSlasherServer) SlashableProposals(req *types.Empty, srv Slasher_SlashableProposalsServer) error {
+func (*UnimplementedSlasherServer) SlashableProposals(req *SlashingStreamRequest, srv Slasher_SlashableProposalsServer) error {
 	return status.Errorf(codes.Unimplemented, "method SlashableProposals not implemented")
 }
-func (*UnimplementedSlasherServer) SlashableAttestations(req *types.Empty, srv Slasher_SlashableAttestationsServer) error {
+func (*UnimplementedSlasherServer) SlashableAttestations(req *SlashingStreamRequest, srv Slasher_SlashableAttestationsServer) error {
 	return status.Errorf(codes.Unimplemented, "method SlashableAttestations not implemented")
 }
 
@@ -684,7 +872,7 @@ func _Slasher_IsSlashableBlock_Handler(srv interface{}, ctx context.Context, dec
 }
 
 func _Slasher_SlashableProposals_Handler(srv interface{}, stream grpc.ServerStream) error {
-	m := new(types.Empty)
+	m := new(SlashingStreamRequest)
 	if err := stream.RecvMsg(m); err != nil {
 		return err
 	}
@@ -705,7 +893,7 @@ func (x *slasherSlashableProposalsServer) Send(m *v1alpha1.ProposerSlashing) err
 }
 
 func _Slasher_SlashableAttestations_Handler(srv interface{}, stream grpc.ServerStream) error {
-	m := new(types.Empty)
+	m := new(SlashingStreamRequest)
 	if err := stream.RecvMsg(m); err != nil {
 		return err
 	}
@@ -979,6 +1167,48 @@ func (m *AttesterSlashingResponse) MarshalToSizedBuffer(dAtA []byte) (int, error
 	return len(dAtA) - i, nil
 }
 
+func (m *SlashingStreamRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SlashingStreamRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SlashingStreamRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.FromEpoch != 0 {
+		i = encodeVarintSlashing(dAtA, i, uint64(m.FromEpoch))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Replay {
+		i--
+		if m.Replay {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *MinMaxEpochSpan) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -1091,6 +1321,25 @@ func (m *ProposalHistory) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if len(m.SlotToSigningRoot) > 0 {
+		for k := range m.SlotToSigningRoot {
+			v := m.SlotToSigningRoot[k]
+			baseI := i
+			if len(v) > 0 {
+				i -= len(v)
+				copy(dAtA[i:], v)
+				i = encodeVarintSlashing(dAtA, i, uint64(len(v)))
+				i--
+				dAtA[i] = 0x12
+			}
+			i = encodeVarintSlashing(dAtA, i, uint64(k))
+			i--
+			dAtA[i] = 0x8
+			i = encodeVarintSlashing(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
 	if m.LatestEpochWritten != 0 {
 		i = encodeVarintSlashing(dAtA, i, uint64(m.LatestEpochWritten))
 		i--
@@ -1106,6 +1355,101 @@ func (m *ProposalHistory) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *AttestationHistory) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AttestationHistory) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AttestationHistory) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.LatestEpochWritten != 0 {
+		i = encodeVarintSlashing(dAtA, i, uint64(m.LatestEpochWritten))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.TargetToAttestation) > 0 {
+		for k := range m.TargetToAttestation {
+			v := m.TargetToAttestation[k]
+			baseI := i
+			if v != nil {
+				{
+					size, err := v.MarshalToSizedBuffer(dAtA[:i])
+					if err != nil {
+						return 0, err
+					}
+					i -= size
+					i = encodeVarintSlashing(dAtA, i, uint64(size))
+				}
+				i--
+				dAtA[i] = 0x12
+			}
+			i = encodeVarintSlashing(dAtA, i, uint64(k))
+			i--
+			dAtA[i] = 0x8
+			i = encodeVarintSlashing(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *HistoricalAttestation) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *HistoricalAttestation) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *HistoricalAttestation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.SigningRoot) > 0 {
+		i -= len(m.SigningRoot)
+		copy(dAtA[i:], m.SigningRoot)
+		i = encodeVarintSlashing(dAtA, i, uint64(len(m.SigningRoot)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.SourceEpoch != 0 {
+		i = encodeVarintSlashing(dAtA, i, uint64(m.SourceEpoch))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintSlashing(dAtA []byte, offset int, v uint64) int {
 	offset -= sovSlashing(v)
 	base := offset
@@ -1217,6 +1561,24 @@ func (m *AttesterSlashingResponse) Size() (n int) {
 	return n
 }
 
+func (m *SlashingStreamRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Replay {
+		n += 2
+	}
+	if m.FromEpoch != 0 {
+		n += 1 + sovSlashing(uint64(m.FromEpoch))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
 func (m *MinMaxEpochSpan) Size() (n int) {
 	if m == nil {
 		return 0
@@ -1273,13 +1635,72 @@ func (m *ProposalHistory) Size() (n int) {
 	if m.LatestEpochWritten != 0 {
 		n += 1 + sovSlashing(uint64(m.LatestEpochWritten))
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
-	}
-	return n
-}
-
-func sovSlashing(x uint64) (n int) {
+	if len(m.SlotToSigningRoot) > 0 {
+		for k, v := range m.SlotToSigningRoot {
+			_ = k
+			_ = v
+			l = 0
+			if len(v) > 0 {
+				l = 1 + len(v) + sovSlashing(uint64(len(v)))
+			}
+			mapEntrySize := 1 + sovSlashing(uint64(k)) + l
+			n += mapEntrySize + 1 + sovSlashing(uint64(mapEntrySize))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *AttestationHistory) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.TargetToAttestation) > 0 {
+		for k, v := range m.TargetToAttestation {
+			_ = k
+			_ = v
+			l = 0
+			if v != nil {
+				l = v.Size()
+				l += 1 + sovSlashing(uint64(l))
+			}
+			mapEntrySize := 1 + sovSlashing(uint64(k)) + l
+			n += mapEntrySize + 1 + sovSlashing(uint64(mapEntrySize))
+		}
+	}
+	if m.LatestEpochWritten != 0 {
+		n += 1 + sovSlashing(uint64(m.LatestEpochWritten))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *HistoricalAttestation) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.SourceEpoch != 0 {
+		n += 1 + sovSlashing(uint64(m.SourceEpoch))
+	}
+	l = len(m.SigningRoot)
+	if l > 0 {
+		n += 1 + l + sovSlashing(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func sovSlashing(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
 func sozSlashing(x uint64) (n int) {
@@ -1630,7 +2051,7 @@ func (m *ProposerSlashingRequest) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			if m.BlockHeader == nil {
-				m.BlockHeader = &v1alpha1.BeaconBlockHeader{}
+				m.BlockHeader = &v1alpha1.SignedBeaconBlockHeader{}
 			}
 			if err := m.BlockHeader.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
@@ -1856,6 +2277,99 @@ func (m *AttesterSlashingResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *SlashingStreamRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSlashing
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SlashingStreamRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SlashingStreamRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Replay", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashing
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Replay = bool(v != 0)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FromEpoch", wireType)
+			}
+			m.FromEpoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashing
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FromEpoch |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSlashing(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *MinMaxEpochSpan) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -2199,6 +2713,120 @@ func (m *ProposalHistory) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SlotToSigningRoot", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashing
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.SlotToSigningRoot == nil {
+				m.SlotToSigningRoot = make(map[uint64][]byte)
+			}
+			var mapkey uint64
+			mapvalue := []byte{}
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowSlashing
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowSlashing
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+				} else if fieldNum == 2 {
+					var mapbyteLen uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowSlashing
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapbyteLen |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intMapbyteLen := int(mapbyteLen)
+					if intMapbyteLen < 0 {
+						return ErrInvalidLengthSlashing
+					}
+					postbytesIndex := iNdEx + intMapbyteLen
+					if postbytesIndex < 0 {
+						return ErrInvalidLengthSlashing
+					}
+					if postbytesIndex > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = make([]byte, mapbyteLen)
+					copy(mapvalue, dAtA[iNdEx:postbytesIndex])
+					iNdEx = postbytesIndex
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipSlashing(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if skippy < 0 {
+						return ErrInvalidLengthSlashing
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.SlotToSigningRoot[mapkey] = mapvalue
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipSlashing(dAtA[iNdEx:])
@@ -2224,113 +2852,382 @@ func (m *ProposalHistory) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func skipSlashing(dAtA []byte) (n int, err error) {
+func (m *AttestationHistory) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
+		preIndex := iNdEx
 		var wire uint64
 		for shift := uint(0); ; shift += 7 {
 			if shift >= 64 {
-				return 0, ErrIntOverflowSlashing
+				return ErrIntOverflowSlashing
 			}
 			if iNdEx >= l {
-				return 0, io.ErrUnexpectedEOF
+				return io.ErrUnexpectedEOF
 			}
 			b := dAtA[iNdEx]
 			iNdEx++
-			wire |= (uint64(b) & 0x7F) << shift
+			wire |= uint64(b&0x7F) << shift
 			if b < 0x80 {
 				break
 			}
 		}
+		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
-		switch wireType {
-		case 0:
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return 0, ErrIntOverflowSlashing
-				}
-				if iNdEx >= l {
-					return 0, io.ErrUnexpectedEOF
-				}
-				iNdEx++
-				if dAtA[iNdEx-1] < 0x80 {
-					break
-				}
-			}
-			return iNdEx, nil
+		if wireType == 4 {
+			return fmt.Errorf("proto: AttestationHistory: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AttestationHistory: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
 		case 1:
-			iNdEx += 8
-			return iNdEx, nil
-		case 2:
-			var length int
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TargetToAttestation", wireType)
+			}
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
-					return 0, ErrIntOverflowSlashing
+					return ErrIntOverflowSlashing
 				}
 				if iNdEx >= l {
-					return 0, io.ErrUnexpectedEOF
+					return io.ErrUnexpectedEOF
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				length |= (int(b) & 0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if length < 0 {
-				return 0, ErrInvalidLengthSlashing
+			if msglen < 0 {
+				return ErrInvalidLengthSlashing
 			}
-			iNdEx += length
-			if iNdEx < 0 {
-				return 0, ErrInvalidLengthSlashing
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSlashing
 			}
-			return iNdEx, nil
-		case 3:
-			for {
-				var innerWire uint64
-				var start int = iNdEx
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.TargetToAttestation == nil {
+				m.TargetToAttestation = make(map[uint64]*HistoricalAttestation)
+			}
+			var mapkey uint64
+			var mapvalue *HistoricalAttestation
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
-						return 0, ErrIntOverflowSlashing
+						return ErrIntOverflowSlashing
 					}
 					if iNdEx >= l {
-						return 0, io.ErrUnexpectedEOF
+						return io.ErrUnexpectedEOF
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					innerWire |= (uint64(b) & 0x7F) << shift
+					wire |= uint64(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				innerWireType := int(innerWire & 0x7)
-				if innerWireType == 4 {
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowSlashing
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+				} else if fieldNum == 2 {
+					var mapmsglen int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowSlashing
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapmsglen |= int(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					if mapmsglen < 0 {
+						return ErrInvalidLengthSlashing
+					}
+					postmsgIndex := iNdEx + mapmsglen
+					if postmsgIndex < 0 {
+						return ErrInvalidLengthSlashing
+					}
+					if postmsgIndex > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = &HistoricalAttestation{}
+					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
+						return err
+					}
+					iNdEx = postmsgIndex
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipSlashing(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if skippy < 0 {
+						return ErrInvalidLengthSlashing
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.TargetToAttestation[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LatestEpochWritten", wireType)
+			}
+			m.LatestEpochWritten = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashing
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LatestEpochWritten |= uint64(b&0x7F) << shift
+				if b < 0x80 {
 					break
 				}
-				next, err := skipSlashing(dAtA[start:])
-				if err != nil {
-					return 0, err
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSlashing(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *HistoricalAttestation) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSlashing
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: HistoricalAttestation: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: HistoricalAttestation: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SourceEpoch", wireType)
+			}
+			m.SourceEpoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashing
 				}
-				iNdEx = start + next
-				if iNdEx < 0 {
-					return 0, ErrInvalidLengthSlashing
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SourceEpoch |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
 				}
 			}
-			return iNdEx, nil
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SigningRoot", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashing
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SigningRoot = append(m.SigningRoot[:0], dAtA[iNdEx:postIndex]...)
+			if m.SigningRoot == nil {
+				m.SigningRoot = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSlashing(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipSlashing(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowSlashing
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowSlashing
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowSlashing
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthSlashing
+			}
+			iNdEx += length
+		case 3:
+			depth++
 		case 4:
-			return iNdEx, nil
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupSlashing
+			}
+			depth--
 		case 5:
 			iNdEx += 4
-			return iNdEx, nil
 		default:
 			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
 		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthSlashing
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
 	}
-	panic("unreachable")
+	return 0, io.ErrUnexpectedEOF
 }
 
 var (
-	ErrInvalidLengthSlashing = fmt.Errorf("proto: negative length found during unmarshaling")
-	ErrIntOverflowSlashing   = fmt.Errorf("proto: integer overflow")
+	ErrInvalidLengthSlashing        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowSlashing          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupSlashing = fmt.Errorf("proto: unexpected end of group")
 )
@@ -0,0 +1,110 @@
+package bls
+
+import (
+	"fmt"
+
+	bls12 "github.com/herumi/bls-eth-go-binary/bls"
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/shared/featureconfig"
+)
+
+// SignatureSet is a set of signatures along with the public keys, messages and domains they
+// are verified against, so that all of them can be verified in a single batch. Each signature
+// carries a description used to report it when it fails to verify.
+type SignatureSet struct {
+	Signatures   []*Signature
+	PublicKeys   []*PublicKey
+	Messages     [][32]byte
+	Domains      []uint64
+	Descriptions []string
+}
+
+// NewSet returns an empty signature set.
+func NewSet() *SignatureSet {
+	return &SignatureSet{}
+}
+
+// Add a signature of the message by the public key to the set.
+func (s *SignatureSet) Add(sig *Signature, pubKey *PublicKey, msg [32]byte, domain uint64, description string) {
+	s.Signatures = append(s.Signatures, sig)
+	s.PublicKeys = append(s.PublicKeys, pubKey)
+	s.Messages = append(s.Messages, msg)
+	s.Domains = append(s.Domains, domain)
+	s.Descriptions = append(s.Descriptions, description)
+}
+
+// Join the signatures of the other set to the set.
+func (s *SignatureSet) Join(set *SignatureSet) *SignatureSet {
+	s.Signatures = append(s.Signatures, set.Signatures...)
+	s.PublicKeys = append(s.PublicKeys, set.PublicKeys...)
+	s.Messages = append(s.Messages, set.Messages...)
+	s.Domains = append(s.Domains, set.Domains...)
+	s.Descriptions = append(s.Descriptions, set.Descriptions...)
+	return s
+}
+
+// Verify all the signatures of the set in a single batch. When the batch does not verify, the
+// signatures are verified one by one to report the first one which is invalid.
+func (s *SignatureSet) Verify() error {
+	if len(s.Signatures) == 0 {
+		return nil
+	}
+	ok, err := VerifyMultipleSignatures(s.Signatures, s.Messages, s.Domains, s.PublicKeys)
+	if err != nil {
+		return err
+	}
+	if ok {
+		return nil
+	}
+	for i, sig := range s.Signatures {
+		if !sig.Verify(s.Messages[i][:], s.PublicKeys[i], s.Domains[i]) {
+			return fmt.Errorf("signature of %s did not verify", s.Descriptions[i])
+		}
+	}
+	// The batch can only fail if one of the signatures is invalid.
+	return errors.New("signature batch did not verify")
+}
+
+// VerifyMultipleSignatures verifies the signatures of the messages by their respective public
+// keys in a single multi-pairing. Each signature and public key is multiplied by a random
+// scalar, so that invalid signatures cannot be crafted to cancel each other out in the batch:
+//
+//  e(g1, r_1*sig_1 + ... + r_n*sig_n) == e(r_1*pub_1, H(msg_1, domain_1)) * ... * e(r_n*pub_n, H(msg_n, domain_n))
+func VerifyMultipleSignatures(sigs []*Signature, msgs [][32]byte, domains []uint64, pubKeys []*PublicKey) (bool, error) {
+	if featureconfig.Get().SkipBLSVerify {
+		return true, nil
+	}
+	size := len(sigs)
+	if size == 0 {
+		return false, nil
+	}
+	if len(msgs) != size || len(domains) != size || len(pubKeys) != size {
+		return false, fmt.Errorf(
+			"provided signatures, messages, domains and public keys have differing lengths, %d, %d, %d, %d",
+			size, len(msgs), len(domains), len(pubKeys),
+		)
+	}
+
+	var aggregated bls12.G2
+	rawKeys := make([]bls12.PublicKey, size)
+	hashWithDomains := make([]byte, 0, size*concatMsgDomainSize)
+	for i := 0; i < size; i++ {
+		var r bls12.Fr
+		r.SetByCSPRNG()
+
+		var sig bls12.G2
+		bls12.G2Mul(&sig, bls12.CastFromSign(sigs[i].s), &r)
+		if i == 0 {
+			aggregated = sig
+		} else {
+			bls12.G2Add(&aggregated, &aggregated, &sig)
+		}
+
+		var pub bls12.G1
+		bls12.G1Mul(&pub, bls12.CastFromPublicKey(pubKeys[i].p), &r)
+		rawKeys[i] = *bls12.CastToPublicKey(&pub)
+
+		hashWithDomains = append(hashWithDomains, concatMsgAndDomain(msgs[i][:], domains[i])...)
+	}
+	return bls12.CastToSign(&aggregated).VerifyAggregateHashWithDomain(rawKeys, hashWithDomains), nil
+}
@@ -0,0 +1,66 @@
+package bls_test
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/prysmaticlabs/prysm/shared/bls"
+)
+
+func signatureSet(size int) *bls.SignatureSet {
+	set := bls.NewSet()
+	for i := 0; i < size; i++ {
+		msg := [32]byte{'h', 'e', 'l', 'l', 'o', byte(i)}
+		priv := bls.RandKey()
+		domain := uint64(i % 3)
+		set.Add(priv.Sign(msg[:], domain), priv.PublicKey(), msg, domain, fmt.Sprintf("message %d", i))
+	}
+	return set
+}
+
+func TestSignatureSet_Verify(t *testing.T) {
+	set := signatureSet(10)
+	if err := set.Verify(); err != nil {
+		t.Errorf("Expected signature set to verify, received %v", err)
+	}
+	if err := bls.NewSet().Verify(); err != nil {
+		t.Errorf("Expected empty signature set to verify, received %v", err)
+	}
+}
+
+func TestSignatureSet_Verify_PinpointsInvalidSignature(t *testing.T) {
+	set := signatureSet(10)
+	set.Join(signatureSet(1))
+	set.Messages[4] = [32]byte{'w', 'r', 'o', 'n', 'g'}
+
+	err := set.Verify()
+	if err == nil {
+		t.Fatal("Expected signature set not to verify")
+	}
+	if want := "signature of message 4 did not verify"; !strings.Contains(err.Error(), want) {
+		t.Errorf("Expected %s, received %v", want, err)
+	}
+}
+
+func TestVerifyMultipleSignatures_SwappedSignaturesFail(t *testing.T) {
+	set := signatureSet(2)
+	// The aggregate of the swapped signatures is the aggregate of the valid ones, which only the
+	// random scalars of the batch can tell apart.
+	set.Signatures[0], set.Signatures[1] = set.Signatures[1], set.Signatures[0]
+
+	ok, err := bls.VerifyMultipleSignatures(set.Signatures, set.Messages, set.Domains, set.PublicKeys)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if ok {
+		t.Error("Expected swapped signatures not to verify")
+	}
+}
+
+func TestVerifyMultipleSignatures_DifferingLengths(t *testing.T) {
+	set := signatureSet(2)
+	if _, err := bls.VerifyMultipleSignatures(set.Signatures, set.Messages[:1], set.Domains, set.PublicKeys); err == nil {
+		t.Error("Expected error verifying sets of differing lengths")
+	}
+}
@@ -2,6 +2,8 @@
 package cmd
 
 import (
+	"time"
+
 	"github.com/urfave/cli"
 )
 
@@ -113,6 +115,24 @@ var (
 			"would whitelist connections to peers on your local network only. The default " +
 			"is to accept all connections.",
 	}
+	// P2PPeerDisconnectScore defines the score at or below which a peer is disconnected.
+	P2PPeerDisconnectScore = cli.IntFlag{
+		Name:  "p2p-peer-disconnect-score",
+		Usage: "The score at or below which a misbehaving peer is disconnected.",
+		Value: -40,
+	}
+	// P2PPeerBanScore defines the score at or below which a peer is banned.
+	P2PPeerBanScore = cli.IntFlag{
+		Name:  "p2p-peer-ban-score",
+		Usage: "The score at or below which a misbehaving peer is banned. Bans persist across restarts.",
+		Value: -100,
+	}
+	// P2PPeerBanDuration defines how long a banned peer is refused.
+	P2PPeerBanDuration = cli.DurationFlag{
+		Name:  "p2p-peer-ban-duration",
+		Usage: "How long a banned peer is refused.",
+		Value: time.Hour,
+	}
 	// P2PEncoding defines the encoding format for p2p messages.
 	P2PEncoding = cli.StringFlag{
 		Name:  "p2p-encoding",
@@ -25,17 +25,24 @@ var log = logrus.WithField("prefix", "flags")
 
 // Flags is a struct to represent which features the client will perform on runtime.
 type Flags struct {
-	NoGenesisDelay            bool   // NoGenesisDelay signals to start the chain as quickly as possible.
-	MinimalConfig             bool   // MinimalConfig as defined in the spec.
-	WriteSSZStateTransitions  bool   // WriteSSZStateTransitions to tmp directory.
-	InitSyncNoVerify          bool   // InitSyncNoVerify when initial syncing w/o verifying block's contents.
-	SkipBLSVerify             bool   // Skips BLS verification across the runtime.
-	EnableBackupWebhook       bool   // EnableBackupWebhook to allow database backups to trigger from monitoring port /db/backup.
-	PruneEpochBoundaryStates  bool   // PruneEpochBoundaryStates prunes the epoch boundary state before last finalized check point.
-	EnableSnappyDBCompression bool   // EnableSnappyDBCompression in the database.
-	InitSyncCacheState        bool   // InitSyncCacheState caches state during initial sync.
-	KafkaBootstrapServers     string // KafkaBootstrapServers to find kafka servers to stream blocks, attestations, etc.
-	EnableSavingOfDepositData bool   // EnableSavingOfDepositData allows the saving of eth1 related data such as deposits,chain data to be saved.
+	NoGenesisDelay            bool     // NoGenesisDelay signals to start the chain as quickly as possible.
+	MinimalConfig             bool     // MinimalConfig as defined in the spec.
+	WriteSSZStateTransitions  bool     // WriteSSZStateTransitions to tmp directory.
+	InitSyncNoVerify          bool     // InitSyncNoVerify when initial syncing w/o verifying block's contents.
+	SkipBLSVerify             bool     // Skips BLS verification across the runtime.
+	EnableBackupWebhook       bool     // EnableBackupWebhook to allow database backups to trigger from monitoring port /db/backup.
+	PruneEpochBoundaryStates  bool     // PruneEpochBoundaryStates prunes the epoch boundary state before last finalized check point.
+	EnableSnappyDBCompression bool     // EnableSnappyDBCompression in the database.
+	InitSyncCacheState        bool     // InitSyncCacheState caches state during initial sync.
+	KafkaBootstrapServers     string   // KafkaBootstrapServers to find kafka servers to stream blocks, attestations, etc.
+	ExportFileDir             string   // ExportFileDir to write the objects saved to the database to.
+	ExportFileFormat          string   // ExportFileFormat of the export files, json or ssz.
+	ExportFileMaxSize         uint64   // ExportFileMaxSize in bytes at which a new export file is started.
+	EnableExportStream        bool     // EnableExportStream to stream the objects saved to the database over gRPC.
+	ExportTopics              []string // ExportTopics to export, all topics are exported when empty.
+	EnableSavingOfDepositData bool     // EnableSavingOfDepositData allows the saving of eth1 related data such as deposits,chain data to be saved.
+	ProtoArrayForkChoice      bool     // ProtoArrayForkChoice computes the head with the proto-array fork choice.
+	InitSyncParallelFetch     bool     // InitSyncParallelFetch fetches block ranges from peers concurrently during initial sync.
 
 	// Cache toggles.
 	EnableAttestationCache   bool // EnableAttestationCache; see https://github.com/prysmaticlabs/prysm/issues/3106.
@@ -116,6 +123,17 @@ func ConfigureBeaconChain(ctx *cli.Context) {
 		log.Warn("Enabling experimental kafka streaming.")
 		cfg.KafkaBootstrapServers = ctx.GlobalString(kafkaBootstrapServersFlag.Name)
 	}
+	if ctx.GlobalString(exportFileDirFlag.Name) != "" {
+		log.Warn("Enabling experimental export of database objects to files.")
+		cfg.ExportFileDir = ctx.GlobalString(exportFileDirFlag.Name)
+		cfg.ExportFileFormat = ctx.GlobalString(exportFileFormatFlag.Name)
+		cfg.ExportFileMaxSize = ctx.GlobalUint64(exportFileMaxSizeFlag.Name) << 20
+	}
+	if ctx.GlobalBool(enableExportStreamFlag.Name) {
+		log.Warn("Enabling experimental export of database objects over gRPC.")
+		cfg.EnableExportStream = true
+	}
+	cfg.ExportTopics = ctx.GlobalStringSlice(exportTopicsFlag.Name)
 	if ctx.GlobalBool(initSyncCacheState.Name) {
 		log.Warn("Enabled initial sync cache state mode.")
 		cfg.InitSyncCacheState = true
@@ -128,6 +146,14 @@ func ConfigureBeaconChain(ctx *cli.Context) {
 		log.Warn("Enable slasher connection.")
 		cfg.EnableSlasherConnection = true
 	}
+	if ctx.GlobalBool(protoArrayForkChoiceFlag.Name) {
+		log.Warn("Enabled proto-array fork choice.")
+		cfg.ProtoArrayForkChoice = true
+	}
+	if ctx.GlobalBool(initSyncParallelFetchFlag.Name) {
+		log.Warn("Enabled parallel block fetching during initial sync.")
+		cfg.InitSyncParallelFetch = true
+	}
 	Init(cfg)
 }
 
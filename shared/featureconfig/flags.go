@@ -51,6 +51,30 @@ var (
 		Name:  "kafka-url",
 		Usage: "Stream attestations and blocks to specified kafka servers. This field is used for bootstrap.servers kafka config field.",
 	}
+	exportFileDirFlag = cli.StringFlag{
+		Name:  "export-file-dir",
+		Usage: "Export the objects saved to the database, such as blocks and attestations, to rotated files in the specified directory.",
+	}
+	exportFileFormatFlag = cli.StringFlag{
+		Name:  "export-file-format",
+		Usage: "Format of the files written to the export directory, either json for JSON lines or ssz for length prefixed SSZ records.",
+		Value: "json",
+	}
+	exportFileMaxSizeFlag = cli.Uint64Flag{
+		Name:  "export-file-max-size-mb",
+		Usage: "Size in megabytes at which a new file is started in the export directory.",
+		Value: 256,
+	}
+	enableExportStreamFlag = cli.BoolFlag{
+		Name:  "enable-export-stream",
+		Usage: "Stream the objects saved to the database to the clients of the export gRPC service.",
+	}
+	exportTopicsFlag = cli.StringSliceFlag{
+		Name: "export-topic",
+		Usage: "Topic of the database objects to export to kafka, files and the export stream, all topics are exported when none is set. " +
+			"Valid topics are beacon_block, beacon_attestation, beacon_finalized_checkpoint, beacon_proposer_slashing, " +
+			"beacon_attester_slashing, beacon_voluntary_exit and beacon_archived_balances.",
+	}
 	initSyncVerifyEverythingFlag = cli.BoolFlag{
 		Name: "initial-sync-verify-all-signatures",
 		Usage: "Initial sync to finalized checkpoint with verifying block's signature, RANDAO " +
@@ -68,6 +92,16 @@ var (
 		Usage: "Connect to slasher in order to retrieve slashable events. Slasher is connected to beacon node using grpc" +
 			"User should be running slasher on current machine or include slasher-provider flag.",
 	}
+	protoArrayForkChoiceFlag = cli.BoolFlag{
+		Name: "proto-array-forkchoice",
+		Usage: "Compute the head of the chain with the proto-array fork choice, which keeps the " +
+			"non-finalized block tree and its weights in memory instead of walking the blocks in DB.",
+	}
+	initSyncParallelFetchFlag = cli.BoolFlag{
+		Name: "initial-sync-parallel-fetch",
+		Usage: "Fetch fixed-size block ranges from peers concurrently during initial sync, instead of " +
+			"requesting each batch from all peers in lockstep.",
+	}
 	saveDepositData = cli.BoolFlag{
 		Name:  "save-deposit-data",
 		Usage: "Enable of the saving of deposit related data",
@@ -185,9 +219,16 @@ var BeaconChainFlags = append(deprecatedFlags, []cli.Flag{
 	NewCacheFlag,
 	SkipBLSVerifyFlag,
 	kafkaBootstrapServersFlag,
+	exportFileDirFlag,
+	exportFileFormatFlag,
+	exportFileMaxSizeFlag,
+	enableExportStreamFlag,
+	exportTopicsFlag,
 	enableBackupWebhookFlag,
 	enableShuffledIndexCache,
 	enableSkipSlotsCache,
 	saveDepositData,
 	enableSlasherFlag,
+	protoArrayForkChoiceFlag,
+	initSyncParallelFetchFlag,
 }...)
@@ -97,6 +97,11 @@ type BeaconChainConfig struct {
 	MaxPageSize               int           // MaxPageSize defines the max page size for RPC server respond.
 	MaxPeersToSync            int           // MaxPeersToSync describes the limit for number of peers in round robin sync.
 
+	// Networking constants.
+	AttestationSubnetCount            uint64 // AttestationSubnetCount is the number of gossip subnets the attestations of the committees are spread over.
+	RandomSubnetsPerValidator         uint64 // RandomSubnetsPerValidator is the number of long-lived subnets subscribed to for each attached validator.
+	EpochsPerRandomSubnetSubscription uint64 // EpochsPerRandomSubnetSubscription is the minimum number of epochs a long-lived subnet is subscribed to.
+
 	// Slasher constants.
 	WeakSubjectivityPeriod    uint64 // WeakSubjectivityPeriod defines the time period expressed in number of epochs were proof of stake network should validate block headers and attestations for slashable events.
 	PruneSlasherStoragePeriod uint64 // PruneSlasherStoragePeriod defines the time period expressed in number of epochs were proof of stake network should prune attestation and block header store.
@@ -189,6 +194,11 @@ var defaultBeaconConfig = &BeaconChainConfig{
 	MaxPageSize:               500,
 	MaxPeersToSync:            15,
 
+	// Networking values.
+	AttestationSubnetCount:            64,
+	RandomSubnetsPerValidator:         1,
+	EpochsPerRandomSubnetSubscription: 256,
+
 	// Slasher related values.
 	WeakSubjectivityPeriod:    54000,
 	PruneSlasherStoragePeriod: 10,
@@ -0,0 +1,35 @@
+package db
+
+import (
+	"github.com/boltdb/bolt"
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+)
+
+// LatestEpochDetected returns the latest epoch for which the historical blocks and
+// attestations were run through slashing detection.
+// Returns 0 if no epoch has been processed yet.
+func (db *Store) LatestEpochDetected() (uint64, error) {
+	var epoch uint64
+	err := db.view(func(tx *bolt.Tx) error {
+		enc := tx.Bucket(chainDataBucket).Get(latestEpochDetectedKey)
+		if enc == nil {
+			return nil
+		}
+		epoch = bytesutil.FromBytes8(enc)
+		return nil
+	})
+	return epoch, err
+}
+
+// SetLatestEpochDetected writes the latest epoch for which the historical blocks and
+// attestations were run through slashing detection to disk.
+func (db *Store) SetLatestEpochDetected(epoch uint64) error {
+	return db.update(func(tx *bolt.Tx) error {
+		bucket := tx.Bucket(chainDataBucket)
+		if err := bucket.Put(latestEpochDetectedKey, bytesutil.Bytes8(epoch)); err != nil {
+			return errors.Wrap(err, "failed to save the latest epoch detected")
+		}
+		return nil
+	})
+}
@@ -0,0 +1,29 @@
+package db
+
+import (
+	"testing"
+)
+
+func TestLatestEpochDetected(t *testing.T) {
+	db := SetupSlasherDB(t)
+	defer TeardownSlasherDB(t, db)
+
+	epoch, err := db.LatestEpochDetected()
+	if err != nil {
+		t.Fatalf("failed to get latest epoch detected: %v", err)
+	}
+	if epoch != 0 {
+		t.Errorf("Expected latest epoch detected to be 0 on a new db, received %d", epoch)
+	}
+
+	if err := db.SetLatestEpochDetected(10); err != nil {
+		t.Fatalf("failed to set latest epoch detected: %v", err)
+	}
+	epoch, err = db.LatestEpochDetected()
+	if err != nil {
+		t.Fatalf("failed to get latest epoch detected: %v", err)
+	}
+	if epoch != 10 {
+		t.Errorf("Wanted latest epoch detected 10, received %d", epoch)
+	}
+}
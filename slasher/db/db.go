@@ -87,6 +87,9 @@ func NewKVStore(dirPath string) (*Store, error) {
 			indexedAttestationsIndicesBucket,
 			validatorsPublicKeysBucket,
 			validatorsMinMaxSpanBucket,
+			proposerSlashingsBucket,
+			attesterSlashingsBucket,
+			chainDataBucket,
 		)
 	}); err != nil {
 		return nil, err
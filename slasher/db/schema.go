@@ -14,6 +14,12 @@ var (
 	// the min and max span for each validator for each epoch.
 	// see https://github.com/protolambda/eth2-surround/blob/master/README.md#min-max-surround
 	validatorsMinMaxSpanBucket = []byte("validators-min-max-span-bucket")
+	// Detected slashings are kept so that subscribers can replay them from a given epoch.
+	proposerSlashingsBucket = []byte("proposer-slashings-bucket")
+	attesterSlashingsBucket = []byte("attester-slashings-bucket")
+	chainDataBucket         = []byte("chain-data-bucket")
+
+	latestEpochDetectedKey = []byte("latest-epoch-detected")
 )
 
 func encodeEpochValidatorID(epoch uint64, validatorID uint64) []byte {
@@ -27,3 +33,7 @@ func encodeEpochValidatorIDSig(epoch uint64, validatorID uint64, sig []byte) []b
 func encodeEpochSig(targetEpoch uint64, sig []byte) []byte {
 	return append(bytesutil.Bytes8(targetEpoch), sig...)
 }
+
+func encodeEpochRoot(epoch uint64, root []byte) []byte {
+	return append(bytesutil.Bytes8(epoch), root...)
+}
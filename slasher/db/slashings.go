@@ -0,0 +1,106 @@
+package db
+
+import (
+	"github.com/boltdb/bolt"
+	"github.com/gogo/protobuf/proto"
+	"github.com/pkg/errors"
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/hashutil"
+)
+
+func createProposerSlashing(enc []byte) (*ethpb.ProposerSlashing, error) {
+	protoSlashing := &ethpb.ProposerSlashing{}
+	if err := proto.Unmarshal(enc, protoSlashing); err != nil {
+		return nil, errors.Wrap(err, "failed to unmarshal encoding")
+	}
+	return protoSlashing, nil
+}
+
+func createAttesterSlashing(enc []byte) (*ethpb.AttesterSlashing, error) {
+	protoSlashing := &ethpb.AttesterSlashing{}
+	if err := proto.Unmarshal(enc, protoSlashing); err != nil {
+		return nil, errors.Wrap(err, "failed to unmarshal encoding")
+	}
+	return protoSlashing, nil
+}
+
+// ProposerSlashings returns all the detected proposer slashings starting from the given
+// epoch, ordered by epoch.
+func (db *Store) ProposerSlashings(fromEpoch uint64) ([]*ethpb.ProposerSlashing, error) {
+	var slashings []*ethpb.ProposerSlashing
+	err := db.view(func(tx *bolt.Tx) error {
+		c := tx.Bucket(proposerSlashingsBucket).Cursor()
+		for k, v := c.Seek(bytesutil.Bytes8(fromEpoch)); k != nil; k, v = c.Next() {
+			ps, err := createProposerSlashing(v)
+			if err != nil {
+				return err
+			}
+			slashings = append(slashings, ps)
+		}
+		return nil
+	})
+	return slashings, err
+}
+
+// SaveProposerSlashing accepts a detected proposer slashing and the epoch it was found in
+// and writes it to disk. Returns false if the slashing had already been saved.
+func (db *Store) SaveProposerSlashing(epoch uint64, slashing *ethpb.ProposerSlashing) (bool, error) {
+	root, err := hashutil.HashProto(slashing)
+	if err != nil {
+		return false, errors.Wrap(err, "failed to hash proposer slashing")
+	}
+	enc, err := proto.Marshal(slashing)
+	if err != nil {
+		return false, errors.Wrap(err, "failed to encode proposer slashing")
+	}
+	return db.saveSlashing(proposerSlashingsBucket, encodeEpochRoot(epoch, root[:]), enc)
+}
+
+// AttesterSlashings returns all the detected attester slashings starting from the given
+// epoch, ordered by epoch.
+func (db *Store) AttesterSlashings(fromEpoch uint64) ([]*ethpb.AttesterSlashing, error) {
+	var slashings []*ethpb.AttesterSlashing
+	err := db.view(func(tx *bolt.Tx) error {
+		c := tx.Bucket(attesterSlashingsBucket).Cursor()
+		for k, v := c.Seek(bytesutil.Bytes8(fromEpoch)); k != nil; k, v = c.Next() {
+			as, err := createAttesterSlashing(v)
+			if err != nil {
+				return err
+			}
+			slashings = append(slashings, as)
+		}
+		return nil
+	})
+	return slashings, err
+}
+
+// SaveAttesterSlashing accepts a detected attester slashing and the epoch it was found in
+// and writes it to disk. Returns false if the slashing had already been saved.
+func (db *Store) SaveAttesterSlashing(epoch uint64, slashing *ethpb.AttesterSlashing) (bool, error) {
+	root, err := hashutil.HashProto(slashing)
+	if err != nil {
+		return false, errors.Wrap(err, "failed to hash attester slashing")
+	}
+	enc, err := proto.Marshal(slashing)
+	if err != nil {
+		return false, errors.Wrap(err, "failed to encode attester slashing")
+	}
+	return db.saveSlashing(attesterSlashingsBucket, encodeEpochRoot(epoch, root[:]), enc)
+}
+
+func (db *Store) saveSlashing(bucketName []byte, key []byte, enc []byte) (bool, error) {
+	saved := false
+	err := db.update(func(tx *bolt.Tx) error {
+		bucket := tx.Bucket(bucketName)
+		if bucket.Get(key) != nil {
+			return nil
+		}
+		if err := bucket.Put(key, enc); err != nil {
+			return errors.Wrap(err, "failed to include the slashing in the slashings bucket")
+		}
+		saved = true
+		return nil
+	})
+	return saved, err
+}
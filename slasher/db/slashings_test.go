@@ -0,0 +1,105 @@
+package db
+
+import (
+	"reflect"
+	"testing"
+
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+)
+
+func TestSaveProposerSlashing(t *testing.T) {
+	db := SetupSlasherDB(t)
+	defer TeardownSlasherDB(t, db)
+
+	slashing := func(idx uint64) *ethpb.ProposerSlashing {
+		return &ethpb.ProposerSlashing{
+			ProposerIndex: idx,
+			Header_1:      &ethpb.SignedBeaconBlockHeader{Signature: []byte("let me in")},
+			Header_2:      &ethpb.SignedBeaconBlockHeader{Signature: []byte("let me in 2nd")},
+		}
+	}
+	tests := []struct {
+		epoch    uint64
+		slashing *ethpb.ProposerSlashing
+	}{
+		{epoch: 1, slashing: slashing(1)},
+		{epoch: 2, slashing: slashing(2)},
+		{epoch: 3, slashing: slashing(3)},
+	}
+	for _, tt := range tests {
+		saved, err := db.SaveProposerSlashing(tt.epoch, tt.slashing)
+		if err != nil {
+			t.Fatalf("save proposer slashing failed: %v", err)
+		}
+		if !saved {
+			t.Errorf("Expected proposer slashing %v to be saved", tt.slashing)
+		}
+	}
+	saved, err := db.SaveProposerSlashing(tests[0].epoch, slashing(1))
+	if err != nil {
+		t.Fatalf("save proposer slashing failed: %v", err)
+	}
+	if saved {
+		t.Error("Expected already saved proposer slashing to not be saved again")
+	}
+
+	slashings, err := db.ProposerSlashings(2)
+	if err != nil {
+		t.Fatalf("failed to get proposer slashings: %v", err)
+	}
+	want := []*ethpb.ProposerSlashing{slashing(2), slashing(3)}
+	if !reflect.DeepEqual(slashings, want) {
+		t.Errorf("Wanted %v, received %v", want, slashings)
+	}
+}
+
+func TestSaveAttesterSlashing(t *testing.T) {
+	db := SetupSlasherDB(t)
+	defer TeardownSlasherDB(t, db)
+
+	slashing := func(sig string) *ethpb.AttesterSlashing {
+		return &ethpb.AttesterSlashing{
+			Attestation_1: &ethpb.IndexedAttestation{Signature: []byte(sig)},
+			Attestation_2: &ethpb.IndexedAttestation{Signature: []byte(sig + " 2nd")},
+		}
+	}
+	tests := []struct {
+		epoch    uint64
+		slashing *ethpb.AttesterSlashing
+	}{
+		{epoch: 0, slashing: slashing("let me in")},
+		{epoch: 5, slashing: slashing("let me in 3rd")},
+		{epoch: 5, slashing: slashing("let me in 4th")},
+	}
+	for _, tt := range tests {
+		saved, err := db.SaveAttesterSlashing(tt.epoch, tt.slashing)
+		if err != nil {
+			t.Fatalf("save attester slashing failed: %v", err)
+		}
+		if !saved {
+			t.Errorf("Expected attester slashing %v to be saved", tt.slashing)
+		}
+	}
+	saved, err := db.SaveAttesterSlashing(tests[1].epoch, slashing("let me in 3rd"))
+	if err != nil {
+		t.Fatalf("save attester slashing failed: %v", err)
+	}
+	if saved {
+		t.Error("Expected already saved attester slashing to not be saved again")
+	}
+
+	slashings, err := db.AttesterSlashings(1)
+	if err != nil {
+		t.Fatalf("failed to get attester slashings: %v", err)
+	}
+	if len(slashings) != 2 {
+		t.Fatalf("Expected 2 attester slashings, received %d", len(slashings))
+	}
+	all, err := db.AttesterSlashings(0)
+	if err != nil {
+		t.Fatalf("failed to get attester slashings: %v", err)
+	}
+	if len(all) != 3 {
+		t.Errorf("Expected 3 attester slashings, received %d", len(all))
+	}
+}
@@ -31,6 +31,20 @@ func (db *Store) SavePubKey(validatorID uint64, pubKey []byte) error {
 	return err
 }
 
+// SavePubKeys accepts a map of validator id to public key and writes them to disk
+// in a single transaction.
+func (db *Store) SavePubKeys(pubKeys map[uint64][]byte) error {
+	return db.update(func(tx *bolt.Tx) error {
+		bucket := tx.Bucket(validatorsPublicKeysBucket)
+		for validatorID, pubKey := range pubKeys {
+			if err := bucket.Put(bytesutil.Bytes4(validatorID), pubKey); err != nil {
+				return errors.Wrap(err, "failed to add validator public keys to slasher db.")
+			}
+		}
+		return nil
+	})
+}
+
 // DeletePubKey deletes a public key of a validator id.
 func (db *Store) DeletePubKey(validatorID uint64) error {
 	return db.update(func(tx *bolt.Tx) error {
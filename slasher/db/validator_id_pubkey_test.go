@@ -67,6 +67,29 @@ func TestSavePubKey(t *testing.T) {
 
 }
 
+func TestSavePubKeys(t *testing.T) {
+	db := SetupSlasherDB(t)
+	defer TeardownSlasherDB(t, db)
+
+	pubKeys := make(map[uint64][]byte)
+	for _, tt := range pkTests {
+		pubKeys[tt.validatorID] = tt.pk
+	}
+	if err := db.SavePubKeys(pubKeys); err != nil {
+		t.Fatalf("save validator public keys failed: %v", err)
+	}
+
+	for _, tt := range pkTests {
+		pk, err := db.ValidatorPubKey(tt.validatorID)
+		if err != nil {
+			t.Fatalf("failed to get validator public key: %v", err)
+		}
+		if pk == nil || !bytes.Equal(pk, tt.pk) {
+			t.Fatalf("get should return validator public key: %v", tt.pk)
+		}
+	}
+}
+
 func TestDeletePublicKey(t *testing.T) {
 	db := SetupSlasherDB(t)
 	defer TeardownSlasherDB(t, db)
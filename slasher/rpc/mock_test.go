@@ -0,0 +1,18 @@
+package rpc
+
+import (
+	"context"
+
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+)
+
+// mockSignatureVerifier accepts every block header and indexed attestation.
+type mockSignatureVerifier struct{}
+
+func (m *mockSignatureVerifier) VerifyBlockHeader(_ context.Context, _ uint64, _ *ethpb.SignedBeaconBlockHeader) error {
+	return nil
+}
+
+func (m *mockSignatureVerifier) VerifyIndexedAttestation(_ context.Context, _ *ethpb.IndexedAttestation) error {
+	return nil
+}
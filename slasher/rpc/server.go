@@ -3,15 +3,17 @@ package rpc
 import (
 	"context"
 	"fmt"
+	"reflect"
 	"sync"
 
 	"github.com/gogo/protobuf/proto"
-	"github.com/gogo/protobuf/types"
 	"github.com/pkg/errors"
 	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
 	"github.com/prysmaticlabs/go-ssz"
 	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
 	slashpb "github.com/prysmaticlabs/prysm/proto/slashing"
+	"github.com/prysmaticlabs/prysm/shared/event"
+	"github.com/prysmaticlabs/prysm/shared/hashutil"
 	"github.com/prysmaticlabs/prysm/slasher/db"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
@@ -20,14 +22,23 @@ import (
 // Server defines a server implementation of the gRPC Slasher service,
 // providing RPC endpoints for retrieving slashing proofs for malicious validators.
 type Server struct {
-	SlasherDB *db.Store
-	ctx       context.Context
+	SlasherDB             *db.Store
+	SignatureVerifier     SignatureVerifier
+	ctx                   context.Context
+	proposerSlashingsFeed event.Feed
+	attesterSlashingsFeed event.Feed
 }
 
+// slashingsStreamBufferSize is the number of detected slashings which are buffered for
+// a stream subscriber before it is considered too slow and gets disconnected.
+const slashingsStreamBufferSize = 1000
+
 // IsSlashableAttestation returns an attester slashing if the attestation submitted
 // is a slashable vote.
 func (ss *Server) IsSlashableAttestation(ctx context.Context, req *ethpb.IndexedAttestation) (*slashpb.AttesterSlashingResponse, error) {
-	//TODO(#3133): add signature validation
+	if err := ss.verifyIndexedAttestation(ctx, req); err != nil {
+		return nil, err
+	}
 	if err := ss.SlasherDB.SaveIndexedAttestation(req); err != nil {
 		return nil, err
 	}
@@ -74,18 +85,27 @@ func (ss *Server) IsSlashableAttestation(ctx context.Context, req *ethpb.Indexed
 	close(er)
 	close(at)
 	for e := range er {
+		if err == nil {
+			err = e
+			continue
+		}
 		err = fmt.Errorf(err.Error() + " : " + e.Error())
 	}
 	for atts := range at {
 		atsSlashinngRes.AttesterSlashing = append(atsSlashinngRes.AttesterSlashing, atts...)
 	}
+	if err := ss.recordAttesterSlashings(atsSlashinngRes.AttesterSlashing); err != nil {
+		return nil, err
+	}
 	return atsSlashinngRes, err
 }
 
 // IsSlashableBlock returns a proposer slashing if the block header submitted is
 // a slashable proposal.
 func (ss *Server) IsSlashableBlock(ctx context.Context, psr *slashpb.ProposerSlashingRequest) (*slashpb.ProposerSlashingResponse, error) {
-	//TODO(#3133): add signature validation
+	if err := ss.verifyBlockHeader(ctx, psr); err != nil {
+		return nil, err
+	}
 	epoch := helpers.SlotToEpoch(psr.BlockHeader.Header.Slot)
 	blockHeaders, err := ss.SlasherDB.BlockHeader(epoch, psr.ValidatorIndex)
 	if err != nil {
@@ -106,19 +126,197 @@ func (ss *Server) IsSlashableBlock(ctx context.Context, psr *slashpb.ProposerSla
 			return nil, err
 		}
 	}
+	if err := ss.recordProposerSlashings(pSlashingsResponse.ProposerSlashing); err != nil {
+		return nil, err
+	}
 	return pSlashingsResponse, nil
 }
 
 // SlashableProposals is a subscription to receive all slashable proposer slashing events found by the watchtower.
-func (ss *Server) SlashableProposals(req *types.Empty, server slashpb.Slasher_SlashableProposalsServer) error {
-	//TODO(3133): implement stream provider for newly discovered listening to slashable proposals.
-	return status.Error(codes.Unimplemented, "not implemented")
+// If requested, the slashings detected since the given epoch are replayed before newly detected ones are streamed.
+// A subscriber which does not keep up with the detected slashings is disconnected with a ResourceExhausted
+// status and is expected to reconnect with replay enabled.
+func (ss *Server) SlashableProposals(req *slashpb.SlashingStreamRequest, stream slashpb.Slasher_SlashableProposalsServer) error {
+	stored := func(fromEpoch uint64) ([]proto.Message, error) {
+		slashings, err := ss.SlasherDB.ProposerSlashings(fromEpoch)
+		if err != nil {
+			return nil, err
+		}
+		msgs := make([]proto.Message, len(slashings))
+		for i, slashing := range slashings {
+			msgs[i] = slashing
+		}
+		return msgs, nil
+	}
+	send := func(slashing proto.Message) error {
+		return stream.Send(slashing.(*ethpb.ProposerSlashing))
+	}
+	return streamSlashings(stream.Context(), req, &ss.proposerSlashingsFeed, make(chan *ethpb.ProposerSlashing, 1), stored, send, "proposer")
 }
 
 // SlashableAttestations is a subscription to receive all slashable attester slashing events found by the watchtower.
-func (ss *Server) SlashableAttestations(req *types.Empty, server slashpb.Slasher_SlashableAttestationsServer) error {
-	//TODO(3133): implement stream provider for newly discovered listening to slashable attestation.
-	return status.Error(codes.Unimplemented, "not implemented")
+// If requested, the slashings detected since the given epoch are replayed before newly detected ones are streamed.
+// A subscriber which does not keep up with the detected slashings is disconnected with a ResourceExhausted
+// status and is expected to reconnect with replay enabled.
+func (ss *Server) SlashableAttestations(req *slashpb.SlashingStreamRequest, stream slashpb.Slasher_SlashableAttestationsServer) error {
+	stored := func(fromEpoch uint64) ([]proto.Message, error) {
+		slashings, err := ss.SlasherDB.AttesterSlashings(fromEpoch)
+		if err != nil {
+			return nil, err
+		}
+		msgs := make([]proto.Message, len(slashings))
+		for i, slashing := range slashings {
+			msgs[i] = slashing
+		}
+		return msgs, nil
+	}
+	send := func(slashing proto.Message) error {
+		return stream.Send(slashing.(*ethpb.AttesterSlashing))
+	}
+	return streamSlashings(stream.Context(), req, &ss.attesterSlashingsFeed, make(chan *ethpb.AttesterSlashing, 1), stored, send, "attester")
+}
+
+// streamSlashings sends the slashings sent on the feed to a stream subscriber until the context of
+// the stream is done, after replaying the stored slashings since the requested epoch if replay is
+// requested. The slashings channel is the typed channel the feed is subscribed with, and kind names
+// the slashings in the error statuses.
+func streamSlashings(
+	ctx context.Context,
+	req *slashpb.SlashingStreamRequest,
+	feed *event.Feed,
+	slashingsChannel interface{},
+	stored func(fromEpoch uint64) ([]proto.Message, error),
+	send func(proto.Message) error,
+	kind string,
+) error {
+	sub := feed.Subscribe(slashingsChannel)
+	defer sub.Unsubscribe()
+
+	// Detected slashings are buffered from the moment of subscription so that the feed
+	// is never blocked by a slow subscriber, or by the replay of the stored slashings.
+	queue := make(chan proto.Message, slashingsStreamBufferSize)
+	overflow := make(chan struct{})
+	go func() {
+		cases := []reflect.SelectCase{
+			{Dir: reflect.SelectRecv, Chan: reflect.ValueOf(slashingsChannel)},
+			{Dir: reflect.SelectRecv, Chan: reflect.ValueOf(sub.Err())},
+		}
+		for {
+			chosen, slashing, _ := reflect.Select(cases)
+			if chosen != 0 {
+				return
+			}
+			select {
+			case queue <- slashing.Interface().(proto.Message):
+			default:
+				sub.Unsubscribe()
+				close(overflow)
+				return
+			}
+		}
+	}()
+
+	replayed := make(map[[32]byte]bool)
+	if req.Replay {
+		slashings, err := stored(req.FromEpoch)
+		if err != nil {
+			return status.Errorf(codes.Internal, "Could not retrieve %s slashings: %v", kind, err)
+		}
+		for _, slashing := range slashings {
+			root, err := hashutil.HashProto(slashing)
+			if err != nil {
+				return status.Errorf(codes.Internal, "Could not hash %s slashing: %v", kind, err)
+			}
+			replayed[root] = true
+			if err := send(slashing); err != nil {
+				return status.Errorf(codes.Unavailable, "Could not send over stream: %v", err)
+			}
+		}
+	}
+
+	for {
+		select {
+		case slashing := <-queue:
+			if len(replayed) > 0 {
+				root, err := hashutil.HashProto(slashing)
+				if err != nil {
+					return status.Errorf(codes.Internal, "Could not hash %s slashing: %v", kind, err)
+				}
+				if replayed[root] {
+					continue
+				}
+			}
+			if err := send(slashing); err != nil {
+				return status.Errorf(codes.Unavailable, "Could not send over stream: %v", err)
+			}
+		case <-overflow:
+			return status.Error(codes.ResourceExhausted, "Subscriber is too slow, reconnect with replay to resume")
+		case <-ctx.Done():
+			return status.Error(codes.Canceled, "Stream context canceled")
+		}
+	}
+}
+
+// verifyBlockHeader rejects block headers which are not signed by their proposer with
+// an Unauthenticated status, so they are never recorded as evidence.
+func (ss *Server) verifyBlockHeader(ctx context.Context, psr *slashpb.ProposerSlashingRequest) error {
+	if psr == nil || psr.BlockHeader == nil || psr.BlockHeader.Header == nil {
+		return status.Error(codes.InvalidArgument, "Block header can't be nil")
+	}
+	if ss.SignatureVerifier == nil {
+		return status.Error(codes.Unauthenticated, "No signature verifier to verify block header")
+	}
+	if err := ss.SignatureVerifier.VerifyBlockHeader(ctx, psr.ValidatorIndex, psr.BlockHeader); err != nil {
+		return status.Errorf(codes.Unauthenticated, "Could not verify block header signature: %v", err)
+	}
+	return nil
+}
+
+// verifyIndexedAttestation rejects indexed attestations which are not signed by their
+// attesting validators with an Unauthenticated status, so they are never recorded as evidence.
+func (ss *Server) verifyIndexedAttestation(ctx context.Context, att *ethpb.IndexedAttestation) error {
+	if att == nil || att.Data == nil || att.Data.Source == nil || att.Data.Target == nil {
+		return status.Error(codes.InvalidArgument, "Indexed attestation can't be nil")
+	}
+	if ss.SignatureVerifier == nil {
+		return status.Error(codes.Unauthenticated, "No signature verifier to verify indexed attestation")
+	}
+	if err := ss.SignatureVerifier.VerifyIndexedAttestation(ctx, att); err != nil {
+		return status.Errorf(codes.Unauthenticated, "Could not verify indexed attestation signature: %v", err)
+	}
+	return nil
+}
+
+// recordProposerSlashings persists the detected proposer slashings and notifies the
+// stream subscribers of the ones which were not previously detected.
+func (ss *Server) recordProposerSlashings(slashings []*ethpb.ProposerSlashing) error {
+	for _, slashing := range slashings {
+		epoch := helpers.SlotToEpoch(slashing.Header_1.Header.Slot)
+		saved, err := ss.SlasherDB.SaveProposerSlashing(epoch, slashing)
+		if err != nil {
+			return errors.Wrap(err, "could not save proposer slashing")
+		}
+		if saved {
+			ss.proposerSlashingsFeed.Send(slashing)
+		}
+	}
+	return nil
+}
+
+// recordAttesterSlashings persists the detected attester slashings and notifies the
+// stream subscribers of the ones which were not previously detected.
+func (ss *Server) recordAttesterSlashings(slashings []*ethpb.AttesterSlashing) error {
+	for _, slashing := range slashings {
+		epoch := slashing.Attestation_1.Data.Target.Epoch
+		saved, err := ss.SlasherDB.SaveAttesterSlashing(epoch, slashing)
+		if err != nil {
+			return errors.Wrap(err, "could not save attester slashing")
+		}
+		if saved {
+			ss.attesterSlashingsFeed.Send(slashing)
+		}
+	}
+	return nil
 }
 
 // DetectSurroundVotes is a method used to return the attestation that were detected
@@ -4,20 +4,53 @@ import (
 	"context"
 	"strconv"
 	"testing"
+	"time"
 
 	"github.com/gogo/protobuf/proto"
 	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
 	slashpb "github.com/prysmaticlabs/prysm/proto/slashing"
 	"github.com/prysmaticlabs/prysm/shared/params"
 	"github.com/prysmaticlabs/prysm/slasher/db"
+	"google.golang.org/grpc"
 )
 
+type mockProposalsStream struct {
+	grpc.ServerStream
+	ctx       context.Context
+	slashings chan *ethpb.ProposerSlashing
+}
+
+func (m *mockProposalsStream) Context() context.Context {
+	return m.ctx
+}
+
+func (m *mockProposalsStream) Send(slashing *ethpb.ProposerSlashing) error {
+	m.slashings <- slashing
+	return nil
+}
+
+type mockAttestationsStream struct {
+	grpc.ServerStream
+	ctx       context.Context
+	slashings chan *ethpb.AttesterSlashing
+}
+
+func (m *mockAttestationsStream) Context() context.Context {
+	return m.ctx
+}
+
+func (m *mockAttestationsStream) Send(slashing *ethpb.AttesterSlashing) error {
+	m.slashings <- slashing
+	return nil
+}
+
 func TestServer_IsSlashableBlock(t *testing.T) {
 	dbs := db.SetupSlasherDB(t)
 	defer db.TeardownSlasherDB(t, dbs)
 	ctx := context.Background()
 	slasherServer := &Server{
-		SlasherDB: dbs,
+		SlasherDB:         dbs,
+		SignatureVerifier: &mockSignatureVerifier{},
 	}
 	psr := &slashpb.ProposerSlashingRequest{
 		BlockHeader: &ethpb.SignedBeaconBlockHeader{
@@ -66,7 +99,8 @@ func TestServer_IsNotSlashableBlock(t *testing.T) {
 	defer db.TeardownSlasherDB(t, dbs)
 
 	slasherServer := &Server{
-		SlasherDB: dbs,
+		SlasherDB:         dbs,
+		SignatureVerifier: &mockSignatureVerifier{},
 	}
 	psr := &slashpb.ProposerSlashingRequest{
 		BlockHeader: &ethpb.SignedBeaconBlockHeader{
@@ -107,8 +141,9 @@ func TestServer_DoubleBlock(t *testing.T) {
 	defer db.TeardownSlasherDB(t, dbs)
 	ctx := context.Background()
 	slasherServer := &Server{
-		ctx:       ctx,
-		SlasherDB: dbs,
+		ctx:               ctx,
+		SlasherDB:         dbs,
+		SignatureVerifier: &mockSignatureVerifier{},
 	}
 	psr := &slashpb.ProposerSlashingRequest{
 		BlockHeader: &ethpb.SignedBeaconBlockHeader{
@@ -139,8 +174,9 @@ func TestServer_SameSlotSlashable(t *testing.T) {
 	defer db.TeardownSlasherDB(t, dbs)
 	ctx := context.Background()
 	slasherServer := &Server{
-		ctx:       ctx,
-		SlasherDB: dbs,
+		ctx:               ctx,
+		SlasherDB:         dbs,
+		SignatureVerifier: &mockSignatureVerifier{},
 	}
 	psr := &slashpb.ProposerSlashingRequest{
 		BlockHeader: &ethpb.SignedBeaconBlockHeader{
@@ -188,8 +224,9 @@ func TestServer_SlashDoubleAttestation(t *testing.T) {
 	defer db.TeardownSlasherDB(t, dbs)
 	ctx := context.Background()
 	slasherServer := &Server{
-		ctx:       ctx,
-		SlasherDB: dbs,
+		ctx:               ctx,
+		SlasherDB:         dbs,
+		SignatureVerifier: &mockSignatureVerifier{},
 	}
 	ia1 := &ethpb.IndexedAttestation{
 		AttestingIndices: []uint64{0},
@@ -240,8 +277,9 @@ func TestServer_SlashTripleAttestation(t *testing.T) {
 	defer db.TeardownSlasherDB(t, dbs)
 	ctx := context.Background()
 	slasherServer := &Server{
-		ctx:       ctx,
-		SlasherDB: dbs,
+		ctx:               ctx,
+		SlasherDB:         dbs,
+		SignatureVerifier: &mockSignatureVerifier{},
 	}
 	ia1 := &ethpb.IndexedAttestation{
 		AttestingIndices: []uint64{0},
@@ -314,8 +352,9 @@ func TestServer_DontSlashSameAttestation(t *testing.T) {
 	defer db.TeardownSlasherDB(t, dbs)
 	ctx := context.Background()
 	slasherServer := &Server{
-		ctx:       ctx,
-		SlasherDB: dbs,
+		ctx:               ctx,
+		SlasherDB:         dbs,
+		SignatureVerifier: &mockSignatureVerifier{},
 	}
 	ia1 := &ethpb.IndexedAttestation{
 		AttestingIndices: []uint64{0},
@@ -347,8 +386,9 @@ func TestServer_DontSlashDifferentTargetAttestation(t *testing.T) {
 	defer db.TeardownSlasherDB(t, dbs)
 	ctx := context.Background()
 	slasherServer := &Server{
-		ctx:       ctx,
-		SlasherDB: dbs,
+		ctx:               ctx,
+		SlasherDB:         dbs,
+		SignatureVerifier: &mockSignatureVerifier{},
 	}
 	ia1 := &ethpb.IndexedAttestation{
 		AttestingIndices: []uint64{0},
@@ -391,8 +431,9 @@ func TestServer_DontSlashSameAttestationData(t *testing.T) {
 	defer db.TeardownSlasherDB(t, dbs)
 	ctx := context.Background()
 	slasherServer := &Server{
-		ctx:       ctx,
-		SlasherDB: dbs,
+		ctx:               ctx,
+		SlasherDB:         dbs,
+		SignatureVerifier: &mockSignatureVerifier{},
 	}
 	ad := &ethpb.AttestationData{
 		Slot:            3*params.BeaconConfig().SlotsPerEpoch + 1,
@@ -430,8 +471,9 @@ func TestServer_SlashSurroundedAttestation(t *testing.T) {
 	defer db.TeardownSlasherDB(t, dbs)
 	ctx := context.Background()
 	slasherServer := &Server{
-		ctx:       ctx,
-		SlasherDB: dbs,
+		ctx:               ctx,
+		SlasherDB:         dbs,
+		SignatureVerifier: &mockSignatureVerifier{},
 	}
 	ia1 := &ethpb.IndexedAttestation{
 		AttestingIndices: []uint64{0},
@@ -481,8 +523,9 @@ func TestServer_SlashSurroundAttestation(t *testing.T) {
 	defer db.TeardownSlasherDB(t, dbs)
 	ctx := context.Background()
 	slasherServer := &Server{
-		ctx:       ctx,
-		SlasherDB: dbs,
+		ctx:               ctx,
+		SlasherDB:         dbs,
+		SignatureVerifier: &mockSignatureVerifier{},
 	}
 	ia1 := &ethpb.IndexedAttestation{
 		AttestingIndices: []uint64{0},
@@ -532,8 +575,9 @@ func TestServer_DontSlashValidAttestations(t *testing.T) {
 	defer db.TeardownSlasherDB(t, dbs)
 	ctx := context.Background()
 	slasherServer := &Server{
-		ctx:       ctx,
-		SlasherDB: dbs,
+		ctx:               ctx,
+		SlasherDB:         dbs,
+		SignatureVerifier: &mockSignatureVerifier{},
 	}
 	ia1 := &ethpb.IndexedAttestation{
 		AttestingIndices: []uint64{0},
@@ -575,8 +619,9 @@ func TestServer_Store_100_Attestations(t *testing.T) {
 	defer db.TeardownSlasherDB(t, dbs)
 	ctx := context.Background()
 	slasherServer := &Server{
-		ctx:       ctx,
-		SlasherDB: dbs,
+		ctx:               ctx,
+		SlasherDB:         dbs,
+		SignatureVerifier: &mockSignatureVerifier{},
 	}
 	var cb []uint64
 	for i := uint64(0); i < 100; i++ {
@@ -610,3 +655,110 @@ func TestServer_Store_100_Attestations(t *testing.T) {
 	t.Logf("DB size is: %d", s)
 
 }
+
+func TestServer_SlashableProposals_Replay(t *testing.T) {
+	dbs := db.SetupSlasherDB(t)
+	defer db.TeardownSlasherDB(t, dbs)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	slasherServer := &Server{
+		SlasherDB:         dbs,
+		SignatureVerifier: &mockSignatureVerifier{},
+	}
+	header := func(root string) *slashpb.ProposerSlashingRequest {
+		return &slashpb.ProposerSlashingRequest{
+			BlockHeader: &ethpb.SignedBeaconBlockHeader{
+				Header: &ethpb.BeaconBlockHeader{
+					Slot:      params.BeaconConfig().SlotsPerEpoch,
+					StateRoot: []byte(root),
+				},
+			},
+			ValidatorIndex: 1,
+		}
+	}
+	if _, err := slasherServer.IsSlashableBlock(ctx, header("A")); err != nil {
+		t.Fatalf("Could not call RPC method: %v", err)
+	}
+	sr, err := slasherServer.IsSlashableBlock(ctx, header("B"))
+	if err != nil {
+		t.Fatalf("Could not call RPC method: %v", err)
+	}
+	if len(sr.ProposerSlashing) != 1 {
+		t.Fatalf("Should return 1 slashing proof: %v", sr)
+	}
+
+	stream := &mockProposalsStream{ctx: ctx, slashings: make(chan *ethpb.ProposerSlashing, 1)}
+	exitRoutine := make(chan bool)
+	go func() {
+		if err := slasherServer.SlashableProposals(&slashpb.SlashingStreamRequest{Replay: true, FromEpoch: 1}, stream); err == nil {
+			t.Error("Expected an error once the stream context is canceled")
+		}
+		exitRoutine <- true
+	}()
+	select {
+	case slashing := <-stream.slashings:
+		if !proto.Equal(slashing, sr.ProposerSlashing[0]) {
+			t.Errorf("Wanted replayed slashing %v, received %v", sr.ProposerSlashing[0], slashing)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("Did not receive replayed proposer slashing")
+	}
+	cancel()
+	<-exitRoutine
+}
+
+func TestServer_SlashableAttestations_Live(t *testing.T) {
+	dbs := db.SetupSlasherDB(t)
+	defer db.TeardownSlasherDB(t, dbs)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	slasherServer := &Server{
+		ctx:               ctx,
+		SlasherDB:         dbs,
+		SignatureVerifier: &mockSignatureVerifier{},
+	}
+	att := func(root string, sig string) *ethpb.IndexedAttestation {
+		return &ethpb.IndexedAttestation{
+			AttestingIndices: []uint64{0},
+			Signature:        []byte(sig),
+			Data: &ethpb.AttestationData{
+				Slot:            3*params.BeaconConfig().SlotsPerEpoch + 1,
+				BeaconBlockRoot: []byte(root),
+				Source:          &ethpb.Checkpoint{Epoch: 2},
+				Target:          &ethpb.Checkpoint{Epoch: 3},
+			},
+		}
+	}
+
+	stream := &mockAttestationsStream{ctx: ctx, slashings: make(chan *ethpb.AttesterSlashing, 1)}
+	exitRoutine := make(chan bool)
+	go func() {
+		if err := slasherServer.SlashableAttestations(&slashpb.SlashingStreamRequest{}, stream); err == nil {
+			t.Error("Expected an error once the stream context is canceled")
+		}
+		exitRoutine <- true
+	}()
+	// Wait for the stream to subscribe to the detected slashings.
+	time.Sleep(100 * time.Millisecond)
+
+	if _, err := slasherServer.IsSlashableAttestation(ctx, att("block1", "sig1")); err != nil {
+		t.Fatalf("Could not call RPC method: %v", err)
+	}
+	sr, err := slasherServer.IsSlashableAttestation(ctx, att("block2", "sig2"))
+	if err != nil {
+		t.Fatalf("Could not call RPC method: %v", err)
+	}
+	if len(sr.AttesterSlashing) != 1 {
+		t.Fatalf("Should return 1 slashing proof: %v", sr)
+	}
+	select {
+	case slashing := <-stream.slashings:
+		if !proto.Equal(slashing, sr.AttesterSlashing[0]) {
+			t.Errorf("Wanted streamed slashing %v, received %v", sr.AttesterSlashing[0], slashing)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("Did not receive detected attester slashing")
+	}
+	cancel()
+	<-exitRoutine
+}
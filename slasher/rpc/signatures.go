@@ -0,0 +1,185 @@
+package rpc
+
+import (
+	"context"
+	"fmt"
+
+	lru "github.com/hashicorp/golang-lru"
+	"github.com/pkg/errors"
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/go-ssz"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+	"github.com/prysmaticlabs/prysm/shared/bls"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/slasher/db"
+)
+
+// cachedDomains is the number of signature domains fetched from the beacon node
+// which are kept in memory.
+const cachedDomains = 64
+
+// SignatureVerifier verifies the signatures of the block headers and indexed attestations
+// submitted to the slasher before they are used as slashing evidence.
+type SignatureVerifier interface {
+	VerifyBlockHeader(ctx context.Context, proposerIdx uint64, header *ethpb.SignedBeaconBlockHeader) error
+	VerifyIndexedAttestation(ctx context.Context, att *ethpb.IndexedAttestation) error
+}
+
+// BeaconSignatureVerifier verifies signatures against the validator public keys stored
+// in the slasher db, fetching and storing the missing ones from the beacon node, and
+// against the signature domains of the fork followed by the beacon node.
+type BeaconSignatureVerifier struct {
+	slasherDB       *db.Store
+	beaconClient    ethpb.BeaconChainClient
+	validatorClient ethpb.BeaconNodeValidatorClient
+	domains         *lru.Cache
+}
+
+type domainKey struct {
+	epoch      uint64
+	domainType [4]byte
+}
+
+// NewBeaconSignatureVerifier initializes a signature verifier using the given beacon node clients.
+func NewBeaconSignatureVerifier(
+	slasherDB *db.Store,
+	beaconClient ethpb.BeaconChainClient,
+	validatorClient ethpb.BeaconNodeValidatorClient,
+) (*BeaconSignatureVerifier, error) {
+	domains, err := lru.New(cachedDomains)
+	if err != nil {
+		return nil, err
+	}
+	return &BeaconSignatureVerifier{
+		slasherDB:       slasherDB,
+		beaconClient:    beaconClient,
+		validatorClient: validatorClient,
+		domains:         domains,
+	}, nil
+}
+
+// VerifyBlockHeader verifies the signature of the block header against the public key
+// of its proposer.
+func (v *BeaconSignatureVerifier) VerifyBlockHeader(ctx context.Context, proposerIdx uint64, header *ethpb.SignedBeaconBlockHeader) error {
+	if header == nil || header.Header == nil {
+		return errors.New("nil block header")
+	}
+	pubKey, err := v.validatorPubKey(ctx, proposerIdx)
+	if err != nil {
+		return err
+	}
+	domain, err := v.domain(ctx, helpers.SlotToEpoch(header.Header.Slot), params.BeaconConfig().DomainBeaconProposer)
+	if err != nil {
+		return err
+	}
+	root, err := ssz.HashTreeRoot(header.Header)
+	if err != nil {
+		return errors.Wrap(err, "could not hash block header")
+	}
+	sig, err := bls.SignatureFromBytes(header.Signature)
+	if err != nil {
+		return errors.Wrap(err, "could not convert bytes to signature")
+	}
+	if !sig.Verify(root[:], pubKey, domain) {
+		return errors.New("block header signature did not verify")
+	}
+	return nil
+}
+
+// VerifyIndexedAttestation verifies the aggregate signature of the indexed attestation
+// against the public keys of its attesting validators.
+func (v *BeaconSignatureVerifier) VerifyIndexedAttestation(ctx context.Context, att *ethpb.IndexedAttestation) error {
+	if att == nil || att.Data == nil || att.Data.Target == nil {
+		return errors.New("nil indexed attestation")
+	}
+	indices := att.AttestingIndices
+	if len(indices) == 0 {
+		return errors.New("indexed attestation has no attesting indices")
+	}
+	if uint64(len(indices)) > params.BeaconConfig().MaxValidatorsPerCommittee {
+		return fmt.Errorf("validator indices count exceeds MAX_VALIDATORS_PER_COMMITTEE, %d > %d",
+			len(indices), params.BeaconConfig().MaxValidatorsPerCommittee)
+	}
+	var aggregatePubKey *bls.PublicKey
+	for i, idx := range indices {
+		if i > 0 && idx <= indices[i-1] {
+			return errors.New("attesting indices are not uniquely sorted")
+		}
+		pubKey, err := v.validatorPubKey(ctx, idx)
+		if err != nil {
+			return err
+		}
+		if aggregatePubKey == nil {
+			aggregatePubKey = pubKey
+			continue
+		}
+		aggregatePubKey.Aggregate(pubKey)
+	}
+	domain, err := v.domain(ctx, att.Data.Target.Epoch, params.BeaconConfig().DomainBeaconAttester)
+	if err != nil {
+		return err
+	}
+	root, err := ssz.HashTreeRoot(att.Data)
+	if err != nil {
+		return errors.Wrap(err, "could not hash attestation data")
+	}
+	sig, err := bls.SignatureFromBytes(att.Signature)
+	if err != nil {
+		return errors.Wrap(err, "could not convert bytes to signature")
+	}
+	if !sig.Verify(root[:], aggregatePubKey, domain) {
+		return errors.New("indexed attestation signature did not verify")
+	}
+	return nil
+}
+
+// validatorPubKey returns the public key of the validator from the slasher db, or from
+// the beacon node if it has not been stored yet.
+func (v *BeaconSignatureVerifier) validatorPubKey(ctx context.Context, idx uint64) (*bls.PublicKey, error) {
+	enc, err := v.slasherDB.ValidatorPubKey(idx)
+	if err != nil {
+		return nil, errors.Wrapf(err, "could not retrieve public key of validator %d", idx)
+	}
+	if len(enc) == 0 {
+		if v.beaconClient == nil {
+			return nil, fmt.Errorf("unknown public key of validator %d", idx)
+		}
+		validator, err := v.beaconClient.GetValidator(ctx, &ethpb.GetValidatorRequest{
+			QueryFilter: &ethpb.GetValidatorRequest_Index{Index: idx},
+		})
+		if err != nil {
+			return nil, errors.Wrapf(err, "could not fetch public key of validator %d", idx)
+		}
+		enc = validator.PublicKey
+		if err := v.slasherDB.SavePubKey(idx, enc); err != nil {
+			return nil, errors.Wrapf(err, "could not save public key of validator %d", idx)
+		}
+	}
+	pubKey, err := bls.PublicKeyFromBytes(enc)
+	if err != nil {
+		return nil, errors.Wrapf(err, "could not deserialize public key of validator %d", idx)
+	}
+	return pubKey, nil
+}
+
+// domain returns the signature domain of the given type at the epoch, as computed by the
+// beacon node from the fork it follows.
+func (v *BeaconSignatureVerifier) domain(ctx context.Context, epoch uint64, domainType []byte) (uint64, error) {
+	key := domainKey{epoch: epoch}
+	copy(key.domainType[:], domainType)
+	if domain, ok := v.domains.Get(key); ok {
+		return domain.(uint64), nil
+	}
+	if v.validatorClient == nil {
+		return 0, errors.New("no beacon node to retrieve the signature domain from")
+	}
+	res, err := v.validatorClient.DomainData(ctx, &ethpb.DomainRequest{
+		Epoch:  epoch,
+		Domain: domainType,
+	})
+	if err != nil {
+		return 0, errors.Wrap(err, "could not retrieve signature domain")
+	}
+	v.domains.Add(key, res.SignatureDomain)
+	return res.SignatureDomain, nil
+}
@@ -0,0 +1,129 @@
+package rpc
+
+import (
+	"context"
+	"testing"
+
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/go-ssz"
+	slashpb "github.com/prysmaticlabs/prysm/proto/slashing"
+	"github.com/prysmaticlabs/prysm/shared/bls"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/slasher/db"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func setupVerifier(t *testing.T, dbs *db.Store, epoch uint64) (*BeaconSignatureVerifier, []*bls.SecretKey) {
+	v, err := NewBeaconSignatureVerifier(dbs, nil, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	keys := make([]*bls.SecretKey, 3)
+	for i := range keys {
+		keys[i] = bls.RandKey()
+		if err := dbs.SavePubKey(uint64(i), keys[i].PublicKey().Marshal()); err != nil {
+			t.Fatal(err)
+		}
+	}
+	for _, domainType := range [][]byte{params.BeaconConfig().DomainBeaconProposer, params.BeaconConfig().DomainBeaconAttester} {
+		key := domainKey{epoch: epoch}
+		copy(key.domainType[:], domainType)
+		v.domains.Add(key, bls.Domain(domainType, params.BeaconConfig().GenesisForkVersion))
+	}
+	return v, keys
+}
+
+func TestBeaconSignatureVerifier_VerifyBlockHeader(t *testing.T) {
+	dbs := db.SetupSlasherDB(t)
+	defer db.TeardownSlasherDB(t, dbs)
+	ctx := context.Background()
+	v, keys := setupVerifier(t, dbs, 1)
+
+	header := &ethpb.BeaconBlockHeader{
+		Slot:      params.BeaconConfig().SlotsPerEpoch,
+		StateRoot: []byte("A"),
+	}
+	root, err := ssz.HashTreeRoot(header)
+	if err != nil {
+		t.Fatal(err)
+	}
+	domain := bls.Domain(params.BeaconConfig().DomainBeaconProposer, params.BeaconConfig().GenesisForkVersion)
+	signed := &ethpb.SignedBeaconBlockHeader{
+		Header:    header,
+		Signature: keys[1].Sign(root[:], domain).Marshal(),
+	}
+	if err := v.VerifyBlockHeader(ctx, 1, signed); err != nil {
+		t.Errorf("Could not verify block header signature: %v", err)
+	}
+	if err := v.VerifyBlockHeader(ctx, 2, signed); err == nil {
+		t.Error("Expected block header signed by another validator to fail verification")
+	}
+	// The public key of the validator is unknown and there is no beacon node to fetch it from.
+	if err := v.VerifyBlockHeader(ctx, 5, signed); err == nil {
+		t.Error("Expected block header of an unknown validator to fail verification")
+	}
+}
+
+func TestBeaconSignatureVerifier_VerifyIndexedAttestation(t *testing.T) {
+	dbs := db.SetupSlasherDB(t)
+	defer db.TeardownSlasherDB(t, dbs)
+	ctx := context.Background()
+	v, keys := setupVerifier(t, dbs, 3)
+
+	data := &ethpb.AttestationData{
+		Slot:            3 * params.BeaconConfig().SlotsPerEpoch,
+		BeaconBlockRoot: []byte("block1"),
+		Source:          &ethpb.Checkpoint{Epoch: 2},
+		Target:          &ethpb.Checkpoint{Epoch: 3},
+	}
+	root, err := ssz.HashTreeRoot(data)
+	if err != nil {
+		t.Fatal(err)
+	}
+	domain := bls.Domain(params.BeaconConfig().DomainBeaconAttester, params.BeaconConfig().GenesisForkVersion)
+	sig := bls.AggregateSignatures([]*bls.Signature{keys[0].Sign(root[:], domain), keys[2].Sign(root[:], domain)})
+	att := &ethpb.IndexedAttestation{
+		AttestingIndices: []uint64{0, 2},
+		Data:             data,
+		Signature:        sig.Marshal(),
+	}
+	if err := v.VerifyIndexedAttestation(ctx, att); err != nil {
+		t.Errorf("Could not verify indexed attestation signature: %v", err)
+	}
+	att.AttestingIndices = []uint64{0, 1}
+	if err := v.VerifyIndexedAttestation(ctx, att); err == nil {
+		t.Error("Expected indexed attestation with wrong attesting indices to fail verification")
+	}
+	att.AttestingIndices = []uint64{2, 0}
+	if err := v.VerifyIndexedAttestation(ctx, att); err == nil {
+		t.Error("Expected indexed attestation with unsorted attesting indices to fail verification")
+	}
+}
+
+func TestServer_IsSlashableBlock_RejectsForgedSignature(t *testing.T) {
+	dbs := db.SetupSlasherDB(t)
+	defer db.TeardownSlasherDB(t, dbs)
+	v, _ := setupVerifier(t, dbs, 0)
+	slasherServer := &Server{
+		SlasherDB:         dbs,
+		SignatureVerifier: v,
+	}
+	psr := &slashpb.ProposerSlashingRequest{
+		BlockHeader: &ethpb.SignedBeaconBlockHeader{
+			Header: &ethpb.BeaconBlockHeader{
+				Slot:      1,
+				StateRoot: []byte("A"),
+			},
+			Signature: bls.RandKey().Sign([]byte("forged"), 0).Marshal(),
+		},
+		ValidatorIndex: 1,
+	}
+	_, err := slasherServer.IsSlashableBlock(context.Background(), psr)
+	if status.Code(err) != codes.Unauthenticated {
+		t.Errorf("Expected Unauthenticated error for a forged block header, received %v", err)
+	}
+	if dbs.HasBlockHeader(0, 1) {
+		t.Error("Expected forged block header to not be saved")
+	}
+}
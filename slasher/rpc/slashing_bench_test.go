@@ -104,8 +104,9 @@ func BenchmarkCheckAttestations(b *testing.B) {
 	defer db.TeardownSlasherDB(b, dbs)
 	ctx := context.Background()
 	slasherServer := &Server{
-		ctx:       ctx,
-		SlasherDB: dbs,
+		ctx:               ctx,
+		SlasherDB:         dbs,
+		SignatureVerifier: &mockSignatureVerifier{},
 	}
 	var cb []uint64
 	for i := uint64(0); i < 100; i++ {
@@ -4,36 +4,175 @@ import (
 	"time"
 
 	ptypes "github.com/gogo/protobuf/types"
+	eth "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
 	"github.com/prysmaticlabs/prysm/shared/params"
-	"google.golang.org/grpc/codes"
-	"google.golang.org/grpc/status"
+	"github.com/sirupsen/logrus"
 )
 
-// finalisedChangeUpdater this is a stub for the comming PRs #3133
-// Store validator index to public key map Validate attestation signature.
-func (s *Service) finalisedChangeUpdater() error {
-	secondsPerSlot := params.BeaconConfig().SecondsPerSlot
-	d := time.Duration(secondsPerSlot) * time.Second
-	tick := time.Tick(d)
-	var finalizedEpoch uint64
+// historicalDetection runs the blocks and attestations of the epochs the beacon node
+// processed before the slasher was started through slashing detection. The progress
+// is saved after every epoch, so an interrupted backfill resumes where it left off.
+func (s *Service) historicalDetection() {
+	ch, err := s.beaconClient.GetChainHead(s.context, &ptypes.Empty{})
+	if err != nil {
+		log.WithError(err).Error("Could not retrieve chain head from beacon node")
+		return
+	}
+	latestEpoch, err := s.slasherDb.LatestEpochDetected()
+	if err != nil {
+		log.WithError(err).Error("Could not retrieve latest epoch detected from slasher db")
+		return
+	}
+	// The latest epoch detected was fully run through detection already. Nothing is saved
+	// before the first epoch is detected, in which case detection starts at genesis.
+	startEpoch := uint64(0)
+	if latestEpoch > 0 {
+		startEpoch = latestEpoch + 1
+	}
+	headEpoch := helpers.SlotToEpoch(ch.HeadSlot)
+	if startEpoch >= headEpoch {
+		return
+	}
+	log.WithFields(logrus.Fields{
+		"startEpoch": startEpoch,
+		"headEpoch":  headEpoch,
+	}).Info("Running historical data through slashing detection")
+	for epoch := startEpoch; epoch < headEpoch; epoch++ {
+		if s.context.Err() != nil {
+			return
+		}
+		if err := s.detectHistoricalBlocks(epoch); err != nil {
+			log.WithError(err).WithField("epoch", epoch).Error("Could not run historical blocks through slashing detection")
+			return
+		}
+		if err := s.detectHistoricalAttestations(epoch); err != nil {
+			log.WithError(err).WithField("epoch", epoch).Error("Could not run historical attestations through slashing detection")
+			return
+		}
+		if err := s.slasherDb.SetLatestEpochDetected(epoch); err != nil {
+			log.WithError(err).Error("Could not save latest epoch detected to slasher db")
+			return
+		}
+		log.WithField("epoch", epoch).Debug("Finished historical slashing detection for epoch")
+	}
+	log.WithField("headEpoch", headEpoch).Info("Finished running historical data through slashing detection")
+}
+
+// detectHistoricalBlocks requests all the blocks of the epoch from the beacon node, one page
+// at a time, and runs them through slashing detection.
+func (s *Service) detectHistoricalBlocks(epoch uint64) error {
+	req := &eth.ListBlocksRequest{
+		QueryFilter: &eth.ListBlocksRequest_Epoch{Epoch: epoch},
+		PageSize:    int32(params.BeaconConfig().MaxPageSize),
+	}
+	received := 0
 	for {
-		select {
-		case <-tick:
-			ch, err := s.beaconClient.GetChainHead(s.context, &ptypes.Empty{})
-			if err != nil {
-				log.Error(err)
-				continue
+		res, err := s.beaconClient.ListBlocks(s.context, req)
+		if err != nil {
+			return err
+		}
+		for _, container := range res.BlockContainers {
+			if err := s.detectBlock(s.context, container.Block); err != nil {
+				return err
 			}
-			if ch != nil {
-				if ch.FinalizedEpoch > finalizedEpoch {
-					log.Infof("Finalized epoch %d", ch.FinalizedEpoch)
+		}
+		received += len(res.BlockContainers)
+		if res.NextPageToken == "" || received >= int(res.TotalSize) || len(res.BlockContainers) == 0 {
+			return nil
+		}
+		req.PageToken = res.NextPageToken
+	}
+}
+
+// detectHistoricalAttestations requests all the attestations targeting the epoch from the
+// beacon node, one page at a time, and runs them through slashing detection.
+func (s *Service) detectHistoricalAttestations(epoch uint64) error {
+	req := &eth.ListAttestationsRequest{
+		QueryFilter: &eth.ListAttestationsRequest_TargetEpoch{TargetEpoch: epoch},
+		PageSize:    int32(params.BeaconConfig().MaxPageSize),
+	}
+	received := 0
+	for {
+		res, err := s.beaconClient.ListAttestations(s.context, req)
+		if err != nil {
+			return err
+		}
+		for _, att := range res.Attestations {
+			if err := s.detectAttestation(s.context, att); err != nil {
+				return err
+			}
+		}
+		received += len(res.Attestations)
+		if res.NextPageToken == "" || received >= int(res.TotalSize) || len(res.Attestations) == 0 {
+			return nil
+		}
+		req.PageToken = res.NextPageToken
+	}
+}
+
+// receiveBlocks streams every block processed by the beacon node, including the blocks which
+// do not become the head of the chain, and runs them through slashing detection.
+func (s *Service) receiveBlocks() {
+	for {
+		stream, err := s.blockClient.StreamBlocks(s.context, &ptypes.Empty{})
+		if err != nil {
+			log.WithError(err).Error("Could not subscribe to blocks stream")
+		} else {
+			for {
+				blk, err := stream.Recv()
+				if err != nil {
+					if s.context.Err() == nil {
+						log.WithError(err).Error("Could not receive block from beacon node")
+					}
+					break
+				}
+				if err := s.detectBlock(s.context, blk); err != nil {
+					log.WithError(err).Error("Could not run block through slashing detection")
 				}
-				continue
 			}
-			log.Error("No chain head was returned by beacon chain.")
-		case <-s.context.Done():
-			return status.Error(codes.Canceled, "Stream context canceled")
+		}
+		if !s.waitForRetry() {
+			return
+		}
+	}
+}
 
+// receiveAttestations streams the aggregated attestations seen by the beacon node and
+// runs them through slashing detection.
+func (s *Service) receiveAttestations() {
+	for {
+		stream, err := s.beaconClient.StreamAttestations(s.context, &ptypes.Empty{})
+		if err != nil {
+			log.WithError(err).Error("Could not subscribe to attestations stream")
+		} else {
+			for {
+				att, err := stream.Recv()
+				if err != nil {
+					if s.context.Err() == nil {
+						log.WithError(err).Error("Could not receive attestation from beacon node")
+					}
+					break
+				}
+				if err := s.detectAttestation(s.context, att); err != nil {
+					log.WithError(err).Error("Could not run attestation through slashing detection")
+				}
+			}
+		}
+		if !s.waitForRetry() {
+			return
 		}
 	}
 }
+
+// waitForRetry waits for a slot before a stream to the beacon node is reopened.
+// Returns false if the service is stopped in the meantime.
+func (s *Service) waitForRetry() bool {
+	d := time.Duration(params.BeaconConfig().SecondsPerSlot) * time.Second
+	select {
+	case <-time.After(d):
+		return true
+	case <-s.context.Done():
+		return false
+	}
+}
@@ -0,0 +1,199 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/pkg/errors"
+	eth "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/go-ssz"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+	slashpb "github.com/prysmaticlabs/prysm/proto/slashing"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/sirupsen/logrus"
+)
+
+// detectBlock converts the signed block into a signed block header and runs it through
+// proposer slashing detection.
+func (s *Service) detectBlock(ctx context.Context, blk *eth.SignedBeaconBlock) error {
+	if blk == nil || blk.Block == nil {
+		return errors.New("nil block")
+	}
+	// The genesis block has no proposer.
+	if blk.Block.Slot == 0 {
+		return nil
+	}
+	proposers, err := s.epochProposers(ctx, helpers.SlotToEpoch(blk.Block.Slot))
+	if err != nil {
+		return errors.Wrap(err, "could not retrieve proposers")
+	}
+	proposerIdx, ok := proposers[blk.Block.Slot]
+	if !ok {
+		return fmt.Errorf("could not find proposer for slot %d", blk.Block.Slot)
+	}
+	bodyRoot, err := ssz.HashTreeRoot(blk.Block.Body)
+	if err != nil {
+		return errors.Wrap(err, "could not hash block body")
+	}
+	header := &eth.SignedBeaconBlockHeader{
+		Header: &eth.BeaconBlockHeader{
+			Slot:       blk.Block.Slot,
+			ParentRoot: blk.Block.ParentRoot,
+			StateRoot:  blk.Block.StateRoot,
+			BodyRoot:   bodyRoot[:],
+		},
+		Signature: blk.Signature,
+	}
+	res, err := s.slasher.IsSlashableBlock(ctx, &slashpb.ProposerSlashingRequest{
+		BlockHeader:    header,
+		ValidatorIndex: proposerIdx,
+	})
+	if err != nil {
+		return errors.Wrap(err, "could not detect proposer slashings")
+	}
+	if len(res.ProposerSlashing) > 0 {
+		log.WithFields(logrus.Fields{
+			"slot":          blk.Block.Slot,
+			"proposerIndex": proposerIdx,
+			"numSlashings":  len(res.ProposerSlashing),
+		}).Info("Detected slashable block proposal")
+	}
+	return nil
+}
+
+// detectAttestation converts the attestation into an indexed attestation using the
+// committee of the attestation and runs it through attester slashing detection.
+func (s *Service) detectAttestation(ctx context.Context, att *eth.Attestation) error {
+	indexedAtt, err := s.convertToIndexed(ctx, att)
+	if err != nil {
+		return errors.Wrap(err, "could not convert attestation to indexed attestation")
+	}
+	res, err := s.slasher.IsSlashableAttestation(ctx, indexedAtt)
+	if err != nil {
+		return errors.Wrap(err, "could not detect attester slashings")
+	}
+	if len(res.AttesterSlashing) > 0 {
+		log.WithFields(logrus.Fields{
+			"slot":         att.Data.Slot,
+			"sourceEpoch":  att.Data.Source.Epoch,
+			"targetEpoch":  att.Data.Target.Epoch,
+			"numSlashings": len(res.AttesterSlashing),
+		}).Info("Detected slashable attestation")
+	}
+	return nil
+}
+
+// convertToIndexed converts an attestation into an indexed attestation, retrieving
+// the committee of the attestation from the beacon node.
+func (s *Service) convertToIndexed(ctx context.Context, att *eth.Attestation) (*eth.IndexedAttestation, error) {
+	if att == nil || att.Data == nil || att.Data.Source == nil || att.Data.Target == nil {
+		return nil, errors.New("nil attestation")
+	}
+	committees, err := s.epochCommittees(ctx, helpers.SlotToEpoch(att.Data.Slot))
+	if err != nil {
+		return nil, errors.Wrap(err, "could not retrieve committees")
+	}
+	slotCommittees, ok := committees.Committees[att.Data.Slot]
+	if !ok || att.Data.CommitteeIndex >= uint64(len(slotCommittees.Committees)) {
+		return nil, fmt.Errorf("could not find committee %d for slot %d", att.Data.CommitteeIndex, att.Data.Slot)
+	}
+	committee := slotCommittees.Committees[att.Data.CommitteeIndex].ValidatorIndices
+	if att.AggregationBits.Len() != uint64(len(committee)) {
+		return nil, fmt.Errorf("aggregation bits length %d does not match committee size %d", att.AggregationBits.Len(), len(committee))
+	}
+	indices, err := helpers.AttestingIndices(att.AggregationBits, committee)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not get attesting indices")
+	}
+	sort.Slice(indices, func(i, j int) bool {
+		return indices[i] < indices[j]
+	})
+	return &eth.IndexedAttestation{
+		AttestingIndices: indices,
+		Data:             att.Data,
+		Signature:        att.Signature,
+	}, nil
+}
+
+// epochCommittees returns the beacon committees of the epoch, which are requested from
+// the beacon node on a cache miss.
+func (s *Service) epochCommittees(ctx context.Context, epoch uint64) (*eth.BeaconCommittees, error) {
+	if committees, ok := s.committees.Get(epoch); ok {
+		return committees.(*eth.BeaconCommittees), nil
+	}
+	committees, err := s.beaconClient.ListBeaconCommittees(ctx, &eth.ListCommitteesRequest{
+		QueryFilter: &eth.ListCommitteesRequest_Epoch{Epoch: epoch},
+	})
+	if err != nil {
+		return nil, err
+	}
+	s.committees.Add(epoch, committees)
+	return committees, nil
+}
+
+// epochProposers returns a map of slot to proposer index for the slots of the epoch, which
+// is built from the validator assignments requested from the beacon node on a cache miss.
+func (s *Service) epochProposers(ctx context.Context, epoch uint64) (map[uint64]uint64, error) {
+	if proposers, ok := s.proposers.Get(epoch); ok {
+		return proposers.(map[uint64]uint64), nil
+	}
+
+	// Validator assignments are keyed by public key, so the validator indices are needed
+	// to find the index of each proposer.
+	indices := make(map[[48]byte]uint64)
+	validatorsReq := &eth.ListValidatorsRequest{
+		QueryFilter: &eth.ListValidatorsRequest_Epoch{Epoch: epoch},
+		PageSize:    int32(params.BeaconConfig().MaxPageSize),
+	}
+	for {
+		res, err := s.beaconClient.ListValidators(ctx, validatorsReq)
+		if err != nil {
+			return nil, errors.Wrap(err, "could not list validators")
+		}
+		pubKeys := make(map[uint64][]byte, len(res.ValidatorList))
+		for _, v := range res.ValidatorList {
+			indices[bytesutil.ToBytes48(v.Validator.PublicKey)] = v.Index
+			pubKeys[v.Index] = v.Validator.PublicKey
+		}
+		// Keep the index to public key map used for signature verification up to date.
+		if err := s.slasherDb.SavePubKeys(pubKeys); err != nil {
+			return nil, errors.Wrap(err, "could not save validator public keys")
+		}
+		if res.NextPageToken == "" || len(indices) >= int(res.TotalSize) || len(res.ValidatorList) == 0 {
+			break
+		}
+		validatorsReq.PageToken = res.NextPageToken
+	}
+
+	proposers := make(map[uint64]uint64)
+	assignmentsReq := &eth.ListValidatorAssignmentsRequest{
+		QueryFilter: &eth.ListValidatorAssignmentsRequest_Epoch{Epoch: epoch},
+		PageSize:    int32(params.BeaconConfig().MaxPageSize),
+	}
+	received := 0
+	for {
+		res, err := s.beaconClient.ListValidatorAssignments(ctx, assignmentsReq)
+		if err != nil {
+			return nil, errors.Wrap(err, "could not list validator assignments")
+		}
+		for _, assignment := range res.Assignments {
+			idx, ok := indices[bytesutil.ToBytes48(assignment.PublicKey)]
+			if !ok {
+				continue
+			}
+			// A zero proposer slot means the validator has no proposal during the epoch.
+			if assignment.ProposerSlot != 0 {
+				proposers[assignment.ProposerSlot] = idx
+			}
+		}
+		received += len(res.Assignments)
+		if res.NextPageToken == "" || received >= int(res.TotalSize) || len(res.Assignments) == 0 {
+			break
+		}
+		assignmentsReq.PageToken = res.NextPageToken
+	}
+	s.proposers.Add(epoch, proposers)
+	return proposers, nil
+}
@@ -0,0 +1,140 @@
+package service
+
+import (
+	"context"
+	"io"
+	"reflect"
+	"testing"
+
+	ptypes "github.com/gogo/protobuf/types"
+	lru "github.com/hashicorp/golang-lru"
+	eth "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/go-bitfield"
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/rpc/v1"
+	"github.com/prysmaticlabs/prysm/slasher/db"
+	"github.com/prysmaticlabs/prysm/slasher/rpc"
+	"google.golang.org/grpc"
+)
+
+// mockBlockClient streams the given blocks, then cancels the context of the service.
+type mockBlockClient struct {
+	blocks []*eth.SignedBeaconBlock
+	cancel context.CancelFunc
+}
+
+func (c *mockBlockClient) StreamBlocks(_ context.Context, _ *ptypes.Empty, _ ...grpc.CallOption) (pb.BlockService_StreamBlocksClient, error) {
+	return &blocksStreamClient{blocks: c.blocks, cancel: c.cancel}, nil
+}
+
+type blocksStreamClient struct {
+	grpc.ClientStream
+	blocks []*eth.SignedBeaconBlock
+	cancel context.CancelFunc
+}
+
+func (s *blocksStreamClient) Recv() (*eth.SignedBeaconBlock, error) {
+	if len(s.blocks) == 0 {
+		s.cancel()
+		return nil, io.EOF
+	}
+	blk := s.blocks[0]
+	s.blocks = s.blocks[1:]
+	return blk, nil
+}
+
+// acceptingVerifier considers every signature valid.
+type acceptingVerifier struct{}
+
+func (acceptingVerifier) VerifyBlockHeader(_ context.Context, _ uint64, _ *eth.SignedBeaconBlockHeader) error {
+	return nil
+}
+
+func (acceptingVerifier) VerifyIndexedAttestation(_ context.Context, _ *eth.IndexedAttestation) error {
+	return nil
+}
+
+func TestConvertToIndexed(t *testing.T) {
+	committees, err := lru.New(cachedEpochs)
+	if err != nil {
+		t.Fatal(err)
+	}
+	committees.Add(uint64(0), &eth.BeaconCommittees{
+		Epoch: 0,
+		Committees: map[uint64]*eth.BeaconCommittees_CommitteesList{
+			1: {
+				Committees: []*eth.BeaconCommittees_CommitteeItem{
+					{ValidatorIndices: []uint64{9, 2, 7, 4}},
+					{ValidatorIndices: []uint64{3, 1, 8, 6}},
+				},
+			},
+		},
+	})
+	s := &Service{committees: committees}
+
+	att := &eth.Attestation{
+		AggregationBits: bitfield.Bitlist{0x1B},
+		Data: &eth.AttestationData{
+			Slot:           1,
+			CommitteeIndex: 1,
+			Source:         &eth.Checkpoint{},
+			Target:         &eth.Checkpoint{},
+		},
+		Signature: []byte("sig"),
+	}
+	indexedAtt, err := s.convertToIndexed(context.Background(), att)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := &eth.IndexedAttestation{
+		AttestingIndices: []uint64{1, 3, 6},
+		Data:             att.Data,
+		Signature:        att.Signature,
+	}
+	if !reflect.DeepEqual(indexedAtt, want) {
+		t.Errorf("Wanted %v, received %v", want, indexedAtt)
+	}
+
+	att.Data.CommitteeIndex = 2
+	if _, err := s.convertToIndexed(context.Background(), att); err == nil {
+		t.Error("Expected error converting attestation with an unknown committee")
+	}
+}
+
+func TestReceiveBlocks_DetectsBlocksAtSameSlot(t *testing.T) {
+	slasherDB := db.SetupSlasherDB(t)
+	defer db.TeardownSlasherDB(t, slasherDB)
+	proposers, err := lru.New(cachedEpochs)
+	if err != nil {
+		t.Fatal(err)
+	}
+	proposers.Add(uint64(0), map[uint64]uint64{1: 5})
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	// Only one of the two blocks proposed at the same slot can be the head of the chain.
+	blocks := []*eth.SignedBeaconBlock{
+		{Block: &eth.BeaconBlock{Slot: 1, ParentRoot: []byte{'A'}, Body: &eth.BeaconBlockBody{}}, Signature: []byte("sig1")},
+		{Block: &eth.BeaconBlock{Slot: 1, ParentRoot: []byte{'B'}, Body: &eth.BeaconBlockBody{}}, Signature: []byte("sig2")},
+	}
+	s := &Service{
+		context:     ctx,
+		slasherDb:   slasherDB,
+		blockClient: &mockBlockClient{blocks: blocks, cancel: cancel},
+		slasher: &rpc.Server{
+			SlasherDB:         slasherDB,
+			SignatureVerifier: acceptingVerifier{},
+		},
+		proposers: proposers,
+	}
+
+	s.receiveBlocks()
+	slashings, err := slasherDB.ProposerSlashings(0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(slashings) != 1 {
+		t.Fatalf("Wanted 1 proposer slashing, received %d", len(slashings))
+	}
+	if slashings[0].ProposerIndex != 5 {
+		t.Errorf("Wanted slashing of proposer 5, received %d", slashings[0].ProposerIndex)
+	}
+}
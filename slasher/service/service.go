@@ -16,7 +16,9 @@ import (
 	recovery "github.com/grpc-ecosystem/go-grpc-middleware/recovery"
 	grpc_opentracing "github.com/grpc-ecosystem/go-grpc-middleware/tracing/opentracing"
 	grpc_prometheus "github.com/grpc-ecosystem/go-grpc-prometheus"
+	lru "github.com/hashicorp/golang-lru"
 	eth "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/rpc/v1"
 	slashpb "github.com/prysmaticlabs/prysm/proto/slashing"
 	"github.com/prysmaticlabs/prysm/shared/cmd"
 	"github.com/prysmaticlabs/prysm/shared/debug"
@@ -35,6 +37,10 @@ var log logrus.FieldLogger
 
 const slasherDBName = "slasherdata"
 
+// cachedEpochs is the number of epochs for which the committees and proposers
+// fetched from the beacon node are kept in memory.
+const cachedEpochs = 8
+
 func init() {
 	log = logrus.WithField("prefix", "slasherRPC")
 }
@@ -53,10 +59,16 @@ type Service struct {
 	lock            sync.RWMutex
 	stop            chan struct{} // Channel to wait for termination notifications.
 	context         context.Context
+	cancel          context.CancelFunc
+	slasher         *rpc.Server
 	beaconConn      *grpc.ClientConn
 	beaconProvider  string
 	beaconCert      string
 	beaconClient    eth.BeaconChainClient
+	blockClient     pb.BlockServiceClient
+	validatorClient eth.BeaconNodeValidatorClient
+	committees      *lru.Cache
+	proposers       *lru.Cache
 	started         bool
 }
 
@@ -73,6 +85,14 @@ type Config struct {
 // NewRPCService creates a new instance of a struct implementing the SlasherService
 // interface.
 func NewRPCService(cfg *Config, ctx *cli.Context) (*Service, error) {
+	committees, err := lru.New(cachedEpochs)
+	if err != nil {
+		return nil, err
+	}
+	proposers, err := lru.New(cachedEpochs)
+	if err != nil {
+		return nil, err
+	}
 	s := &Service{
 		slasherDb:      cfg.SlasherDb,
 		port:           cfg.Port,
@@ -82,6 +102,8 @@ func NewRPCService(cfg *Config, ctx *cli.Context) (*Service, error) {
 		stop:           make(chan struct{}),
 		beaconProvider: cfg.BeaconProvider,
 		beaconCert:     cfg.BeaconCert,
+		committees:     committees,
+		proposers:      proposers,
 	}
 	if err := s.startDB(s.ctx); err != nil {
 		return nil, err
@@ -96,10 +118,14 @@ func (s *Service) Start() {
 	log.WithFields(logrus.Fields{
 		"version": version.GetVersion(),
 	}).Info("Starting hash slinging slasher node")
-	s.context = context.Background()
-	s.startSlasher()
+	s.context, s.cancel = context.WithCancel(context.Background())
 	s.startBeaconClient()
-	go s.finalisedChangeUpdater()
+	s.startSlasher()
+	if s.beaconClient != nil && s.slasher != nil {
+		go s.historicalDetection()
+		go s.receiveBlocks()
+		go s.receiveAttestations()
+	}
 	stop := s.stop
 	s.lock.Unlock()
 
@@ -158,11 +184,18 @@ func (s *Service) startSlasher() {
 		log.Warn("You are using an insecure gRPC connection! Provide a certificate and key to connect securely")
 	}
 	s.grpcServer = grpc.NewServer(opts...)
-	slasherServer := rpc.Server{
-		SlasherDB: s.slasherDb,
+	verifier, err := rpc.NewBeaconSignatureVerifier(s.slasherDb, s.beaconClient, s.validatorClient)
+	if err != nil {
+		log.Errorf("Could not initialize signature verifier: %v", err)
+		s.failStatus = err
+		return
+	}
+	s.slasher = &rpc.Server{
+		SlasherDB:         s.slasherDb,
+		SignatureVerifier: verifier,
 	}
 
-	slashpb.RegisterSlasherServer(s.grpcServer, &slasherServer)
+	slashpb.RegisterSlasherServer(s.grpcServer, s.slasher)
 
 	// Register reflection service on gRPC server.
 	reflection.Register(s.grpcServer)
@@ -209,6 +242,8 @@ func (s *Service) startBeaconClient() {
 	log.Info("Successfully started gRPC connection")
 	s.beaconConn = conn
 	s.beaconClient = eth.NewBeaconChainClient(s.beaconConn)
+	s.blockClient = pb.NewBlockServiceClient(s.beaconConn)
+	s.validatorClient = eth.NewBeaconNodeValidatorClient(s.beaconConn)
 }
 
 // Stop the service.
@@ -229,6 +264,10 @@ func (s *Service) Close() {
 	s.lock.Lock()
 	defer s.lock.Unlock()
 	log.Info("Stopping hash slinging slasher")
+	// Stop the ingestion of beacon node data before closing the database.
+	if s.cancel != nil {
+		s.cancel()
+	}
 	err := s.Stop()
 	if err != nil {
 		log.Panicf("Could not stop the slasher service: %v", err)
@@ -236,7 +275,6 @@ func (s *Service) Close() {
 	if err := s.slasherDb.Close(); err != nil {
 		log.Errorf("Failed to close slasher database: %v", err)
 	}
-	s.context.Done()
 	close(s.stop)
 }
 
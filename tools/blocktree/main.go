@@ -42,7 +42,7 @@ func main() {
 	params.UseDemoBeaconConfig()
 
 	flag.Parse()
-	db, err := db.NewDB(*datadir)
+	db, err := db.NewDB(context.Background(), *datadir)
 	if err != nil {
 		panic(err)
 	}
@@ -27,7 +27,7 @@ func init() {
 func main() {
 	flag.Parse()
 	fmt.Println("Starting process...")
-	d, err := db.NewDB(*datadir)
+	d, err := db.NewDB(context.Background(), *datadir)
 	if err != nil {
 		panic(err)
 	}
@@ -0,0 +1,88 @@
+// Remote signer is a reference implementation of the signing server used by the remote key manager
+// of the validator client. It loads the validator keys from a keystore and signs the requests of
+// validator clients authenticated with a certificate signed by the client certificate authority.
+package main
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"flag"
+	"fmt"
+	"io/ioutil"
+	"net"
+
+	pb "github.com/prysmaticlabs/prysm/proto/signer"
+	"github.com/prysmaticlabs/prysm/validator/accounts"
+	"github.com/prysmaticlabs/prysm/validator/keymanager"
+	"github.com/sirupsen/logrus"
+	_ "go.uber.org/automaxprocs"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+)
+
+var (
+	port         = flag.Int("port", 4100, "The port to serve gRPC")
+	keystorePath = flag.String("keystore-path", "", "Path to the validator keystore directory")
+	password     = flag.String("password", "", "Password of the validator keystore, prompted for if empty")
+	tlsCert      = flag.String("tls-cert", "", "Certificate presented by the signer to validator clients")
+	tlsKey       = flag.String("tls-key", "", "Private key of the signer certificate")
+	clientCACert = flag.String("client-ca-cert", "", "Certificate authority validator client certificates must be signed by")
+	verbose      = flag.Bool("verbose", false, "Enable debug logging")
+)
+
+func main() {
+	flag.Parse()
+	if *verbose {
+		logrus.SetLevel(logrus.DebugLevel)
+	}
+	if *keystorePath == "" || *tlsCert == "" || *tlsKey == "" || *clientCACert == "" {
+		log.Fatal("The keystore-path, tls-cert, tls-key and client-ca-cert flags are required")
+	}
+
+	// Unlike the validator client, the signer never creates a new account on a missing keystore.
+	exists, err := accounts.Exists(*keystorePath)
+	if err != nil {
+		log.WithError(err).Fatal("Could not check keystore")
+	}
+	if !exists {
+		log.Fatalf("No keystore found at %s", *keystorePath)
+	}
+	km, err := keymanager.NewKeystore(*keystorePath, *password)
+	if err != nil {
+		log.WithError(err).Fatal("Could not load keystore")
+	}
+	keys, err := km.FetchValidatingKeys()
+	if err != nil {
+		log.WithError(err).Fatal("Could not fetch validating keys")
+	}
+
+	cert, err := tls.LoadX509KeyPair(*tlsCert, *tlsKey)
+	if err != nil {
+		log.WithError(err).Fatal("Could not load TLS certificate")
+	}
+	caCert, err := ioutil.ReadFile(*clientCACert)
+	if err != nil {
+		log.WithError(err).Fatal("Could not read client CA certificate")
+	}
+	caPool := x509.NewCertPool()
+	if !caPool.AppendCertsFromPEM(caCert) {
+		log.Fatalf("No certificate found in %s", *clientCACert)
+	}
+	creds := credentials.NewTLS(&tls.Config{
+		Certificates: []tls.Certificate{cert},
+		ClientAuth:   tls.RequireAndVerifyClientCert,
+		ClientCAs:    caPool,
+	})
+
+	s := grpc.NewServer(grpc.Creds(creds))
+	pb.RegisterRemoteSignerServer(s, &server{keyManager: km})
+
+	lis, err := net.Listen("tcp", fmt.Sprintf(":%d", *port))
+	if err != nil {
+		log.WithError(err).Fatal("Could not listen")
+	}
+	log.WithField("numKeys", len(keys)).Infof("Listening for signing requests on port %d", *port)
+	if err := s.Serve(lis); err != nil {
+		log.Fatal(err)
+	}
+}
@@ -0,0 +1,51 @@
+package main
+
+import (
+	"context"
+	"fmt"
+
+	pb "github.com/prysmaticlabs/prysm/proto/signer"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/validator/keymanager"
+	"github.com/sirupsen/logrus"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// server signs on behalf of remote validator clients with the keys of its key manager.
+type server struct {
+	keyManager keymanager.KeyManager
+}
+
+// ListValidatingPublicKeys lists the public keys of the key manager.
+func (s *server) ListValidatingPublicKeys(ctx context.Context, _ *pb.ListPublicKeysRequest) (*pb.ListPublicKeysResponse, error) {
+	keys, err := s.keyManager.FetchValidatingKeys()
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "could not fetch validating keys: %v", err)
+	}
+	return &pb.ListPublicKeysResponse{
+		PublicKeys: bytesutil.FromBytes48Array(keys),
+	}, nil
+}
+
+// Sign signs the signing root with the secret key of the requested public key.
+func (s *server) Sign(ctx context.Context, req *pb.SignRequest) (*pb.SignResponse, error) {
+	if len(req.PublicKey) != 48 {
+		return nil, status.Errorf(codes.InvalidArgument, "public key must be 48 bytes, received %d", len(req.PublicKey))
+	}
+	if len(req.SigningRoot) != 32 {
+		return nil, status.Errorf(codes.InvalidArgument, "signing root must be 32 bytes, received %d", len(req.SigningRoot))
+	}
+	sig, err := s.keyManager.Sign(bytesutil.ToBytes48(req.PublicKey), bytesutil.ToBytes32(req.SigningRoot), req.SignatureDomain)
+	if err == keymanager.ErrNoSuchKey {
+		return nil, status.Errorf(codes.NotFound, "no secret key for public key %#x", req.PublicKey)
+	}
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "could not sign: %v", err)
+	}
+	log.WithFields(logrus.Fields{
+		"pubKey": fmt.Sprintf("%#x", bytesutil.Trunc(req.PublicKey)),
+		"domain": req.SignatureDomain,
+	}).Debug("Signed request")
+	return &pb.SignResponse{Signature: sig.Marshal()}, nil
+}
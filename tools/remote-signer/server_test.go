@@ -0,0 +1,53 @@
+package main
+
+import (
+	"context"
+	"testing"
+
+	pb "github.com/prysmaticlabs/prysm/proto/signer"
+	"github.com/prysmaticlabs/prysm/shared/bls"
+	"github.com/prysmaticlabs/prysm/validator/keymanager"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestServer_Sign(t *testing.T) {
+	sk := bls.RandKey()
+	s := &server{keyManager: keymanager.NewDirect([]*bls.SecretKey{sk})}
+
+	root := [32]byte{'A'}
+	res, err := s.Sign(context.Background(), &pb.SignRequest{
+		PublicKey:       sk.PublicKey().Marshal(),
+		SigningRoot:     root[:],
+		SignatureDomain: 3,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	sig, err := bls.SignatureFromBytes(res.Signature)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !sig.Verify(root[:], sk.PublicKey(), 3) {
+		t.Error("Signature did not verify")
+	}
+}
+
+func TestServer_Sign_UnknownKey(t *testing.T) {
+	s := &server{keyManager: keymanager.NewDirect(nil)}
+
+	_, err := s.Sign(context.Background(), &pb.SignRequest{
+		PublicKey:   make([]byte, 48),
+		SigningRoot: make([]byte, 32),
+	})
+	if status.Code(err) != codes.NotFound {
+		t.Errorf("Expected not found error, received %v", err)
+	}
+	_, err = s.Sign(context.Background(), &pb.SignRequest{
+		PublicKey:   make([]byte, 47),
+		SigningRoot: make([]byte, 32),
+	})
+	if status.Code(err) != codes.InvalidArgument {
+		t.Errorf("Expected invalid argument error, received %v", err)
+	}
+}
@@ -0,0 +1,300 @@
+package client
+
+import (
+	"context"
+	"reflect"
+	"sync"
+	"time"
+
+	ptypes "github.com/gogo/protobuf/types"
+	middleware "github.com/grpc-ecosystem/go-grpc-middleware"
+	"github.com/pkg/errors"
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/sirupsen/logrus"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// healthCheckTimeout is the time allowed for a beacon node to report its sync status.
+const healthCheckTimeout = 2 * time.Second
+
+// broadcastTimeout is the time allowed for a beacon node to receive a broadcasted block or
+// attestation.
+const broadcastTimeout = 10 * time.Second
+
+// broadcastMethods are the methods sent to every healthy beacon node when broadcasting is
+// enabled, so that signed blocks and attestations reach the network from all of them.
+var broadcastMethods = map[string]bool{
+	"/ethereum.eth.v1alpha1.BeaconNodeValidator/ProposeBlock":       true,
+	"/ethereum.eth.v1alpha1.BeaconNodeValidator/ProposeAttestation": true,
+}
+
+// pinnedKey marks a request context whose request must be sent on the connection it is made
+// on, rather than to the active beacon node.
+type pinnedKey struct{}
+
+// beaconNode is a connection to one of the beacon nodes of the pool.
+type beaconNode struct {
+	endpoint string
+	conn     *grpc.ClientConn
+	healthy  bool
+}
+
+// beaconNodePool keeps a connection to every configured beacon node and routes the requests
+// of the validator to the active one. The beacon nodes are health checked through their sync
+// status, and the first healthy beacon node in the configured order becomes the active one
+// when the active beacon node is down or syncing.
+type beaconNodePool struct {
+	nodes     []*beaconNode
+	active    int
+	lock      sync.RWMutex
+	broadcast bool
+}
+
+// newBeaconNodePool dials all the endpoints with the given options and interceptors. The first
+// connection is the one the validator clients are created from, as it routes their requests
+// through the pool before they reach the interceptors of the beacon node they are sent to.
+func newBeaconNodePool(
+	ctx context.Context,
+	endpoints []string,
+	broadcast bool,
+	opts []grpc.DialOption,
+	unaryInterceptors []grpc.UnaryClientInterceptor,
+	streamInterceptors []grpc.StreamClientInterceptor,
+) (*beaconNodePool, error) {
+	if len(endpoints) == 0 {
+		return nil, errors.New("no beacon node endpoints")
+	}
+	p := &beaconNodePool{
+		nodes:     make([]*beaconNode, len(endpoints)),
+		broadcast: broadcast,
+	}
+	for i, endpoint := range endpoints {
+		unary, stream := unaryInterceptors, streamInterceptors
+		if i == 0 {
+			unary = append([]grpc.UnaryClientInterceptor{p.unaryInterceptor}, unary...)
+			stream = append([]grpc.StreamClientInterceptor{p.streamInterceptor}, stream...)
+		}
+		dialOpts := append([]grpc.DialOption{
+			grpc.WithUnaryInterceptor(middleware.ChainUnaryClient(unary...)),
+			grpc.WithStreamInterceptor(middleware.ChainStreamClient(stream...)),
+		}, opts...)
+		conn, err := grpc.DialContext(ctx, endpoint, dialOpts...)
+		if err != nil {
+			p.Close()
+			return nil, errors.Wrapf(err, "could not dial endpoint %s", endpoint)
+		}
+		// Nodes are assumed healthy until the first health check.
+		p.nodes[i] = &beaconNode{endpoint: endpoint, conn: conn, healthy: true}
+	}
+	return p, nil
+}
+
+// conn returns the connection the validator clients are created from.
+func (p *beaconNodePool) conn() *grpc.ClientConn {
+	return p.nodes[0].conn
+}
+
+// Close closes the connections to all the beacon nodes.
+func (p *beaconNodePool) Close() error {
+	var err error
+	for _, node := range p.nodes {
+		if node == nil {
+			continue
+		}
+		if closeErr := node.conn.Close(); closeErr != nil {
+			err = closeErr
+		}
+	}
+	return err
+}
+
+// run health checks the beacon nodes every slot until the context is canceled.
+func (p *beaconNodePool) run(ctx context.Context) {
+	if len(p.nodes) == 1 {
+		return
+	}
+	ticker := time.NewTicker(time.Duration(params.BeaconConfig().SecondsPerSlot) * time.Second)
+	defer ticker.Stop()
+	p.checkHealth(ctx)
+	for {
+		select {
+		case <-ticker.C:
+			p.checkHealth(ctx)
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+// checkHealth requests the sync status of every beacon node and fails over to the first
+// healthy beacon node if the active one is not healthy.
+func (p *beaconNodePool) checkHealth(ctx context.Context) {
+	healthy := make([]bool, len(p.nodes))
+	var wg sync.WaitGroup
+	for i, node := range p.nodes {
+		wg.Add(1)
+		go func(i int, node *beaconNode) {
+			defer wg.Done()
+			healthy[i] = p.isHealthy(ctx, node)
+		}(i, node)
+	}
+	wg.Wait()
+
+	p.lock.Lock()
+	defer p.lock.Unlock()
+	for i, node := range p.nodes {
+		if node.healthy != healthy[i] {
+			log.WithFields(logrus.Fields{
+				"endpoint": node.endpoint,
+				"healthy":  healthy[i],
+			}).Info("Beacon node health changed")
+		}
+		node.healthy = healthy[i]
+	}
+	p.failover()
+}
+
+// isHealthy returns true if the beacon node is reachable and not syncing.
+func (p *beaconNodePool) isHealthy(ctx context.Context, node *beaconNode) bool {
+	ctx, cancel := context.WithTimeout(context.WithValue(ctx, pinnedKey{}, true), healthCheckTimeout)
+	defer cancel()
+	s, err := ethpb.NewNodeClient(node.conn).GetSyncStatus(ctx, &ptypes.Empty{})
+	if err != nil {
+		log.WithError(err).WithField("endpoint", node.endpoint).Debug("Could not get sync status of beacon node")
+		return false
+	}
+	return !s.Syncing
+}
+
+// failover switches to the first healthy beacon node if the active one is not healthy. The
+// active beacon node is kept if none is healthy. Must be called with the lock held.
+func (p *beaconNodePool) failover() {
+	if p.nodes[p.active].healthy {
+		return
+	}
+	for i, node := range p.nodes {
+		if node.healthy {
+			log.WithFields(logrus.Fields{
+				"from": p.nodes[p.active].endpoint,
+				"to":   node.endpoint,
+			}).Warn("Failing over to another beacon node")
+			p.active = i
+			return
+		}
+	}
+}
+
+// activeNode returns the beacon node requests are currently sent to.
+func (p *beaconNodePool) activeNode() *beaconNode {
+	p.lock.RLock()
+	defer p.lock.RUnlock()
+	return p.nodes[p.active]
+}
+
+// markUnavailable marks the beacon node as unhealthy after a request failed because it was
+// unreachable, failing over to another beacon node until the next health check.
+func (p *beaconNodePool) markUnavailable(node *beaconNode) {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+	if node.healthy {
+		log.WithField("endpoint", node.endpoint).Warn("Beacon node is unavailable")
+	}
+	node.healthy = false
+	p.failover()
+}
+
+// otherHealthyNodes returns the healthy beacon nodes other than the given one.
+func (p *beaconNodePool) otherHealthyNodes(node *beaconNode) []*beaconNode {
+	p.lock.RLock()
+	defer p.lock.RUnlock()
+	var nodes []*beaconNode
+	for _, n := range p.nodes {
+		if n != node && n.healthy {
+			nodes = append(nodes, n)
+		}
+	}
+	return nodes
+}
+
+// unaryInterceptor sends the request to the active beacon node, retrying once on another
+// beacon node if the active one is unavailable. Signed blocks and attestations are also sent
+// to the other healthy beacon nodes when broadcasting is enabled.
+func (p *beaconNodePool) unaryInterceptor(
+	ctx context.Context,
+	method string,
+	req, reply interface{},
+	cc *grpc.ClientConn,
+	invoker grpc.UnaryInvoker,
+	opts ...grpc.CallOption,
+) error {
+	if ctx.Value(pinnedKey{}) != nil {
+		return invoker(ctx, method, req, reply, cc, opts...)
+	}
+	invoke := func(ctx context.Context, node *beaconNode, reply interface{}) error {
+		if node.conn == cc {
+			return invoker(ctx, method, req, reply, cc, opts...)
+		}
+		return node.conn.Invoke(ctx, method, req, reply, opts...)
+	}
+
+	node := p.activeNode()
+	if p.broadcast && broadcastMethods[method] {
+		// The broadcasts outlive the request to the active node, whose context may be canceled
+		// once it returns, so they are sent with a context of their own.
+		broadcastCtx := detachedContext(ctx)
+		for _, other := range p.otherHealthyNodes(node) {
+			go func(other *beaconNode) {
+				ctx, cancel := context.WithTimeout(broadcastCtx, broadcastTimeout)
+				defer cancel()
+				// Each beacon node needs its own reply, the one of the active node is returned.
+				if err := invoke(ctx, other, newReply(reply)); err != nil {
+					log.WithError(err).WithField("endpoint", other.endpoint).Debug("Could not broadcast to beacon node")
+				}
+			}(other)
+		}
+	}
+	err := invoke(ctx, node, reply)
+	if status.Code(err) != codes.Unavailable || len(p.nodes) == 1 {
+		return err
+	}
+	p.markUnavailable(node)
+	if next := p.activeNode(); next != node {
+		return invoke(ctx, next, reply)
+	}
+	return err
+}
+
+// detachedContext returns a context which is never canceled, but carries the outgoing metadata
+// of the given context.
+func detachedContext(ctx context.Context) context.Context {
+	detached := context.Background()
+	if md, ok := metadata.FromOutgoingContext(ctx); ok {
+		detached = metadata.NewOutgoingContext(detached, md)
+	}
+	return detached
+}
+
+// newReply allocates an empty reply of the same type as the given one.
+func newReply(reply interface{}) interface{} {
+	return reflect.New(reflect.TypeOf(reply).Elem()).Interface()
+}
+
+// streamInterceptor opens the stream on the active beacon node.
+func (p *beaconNodePool) streamInterceptor(
+	ctx context.Context,
+	desc *grpc.StreamDesc,
+	cc *grpc.ClientConn,
+	method string,
+	streamer grpc.Streamer,
+	opts ...grpc.CallOption,
+) (grpc.ClientStream, error) {
+	node := p.activeNode()
+	if ctx.Value(pinnedKey{}) != nil || node.conn == cc {
+		return streamer(ctx, desc, cc, method, opts...)
+	}
+	return node.conn.NewStream(ctx, desc, method, opts...)
+}
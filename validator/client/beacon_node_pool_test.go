@@ -0,0 +1,143 @@
+package client
+
+import (
+	"context"
+	"net"
+	"sync"
+	"testing"
+
+	ptypes "github.com/gogo/protobuf/types"
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+// mockNode serves the sync status of a beacon node and counts the requests it receives.
+type mockNode struct {
+	lock     sync.Mutex
+	syncing  bool
+	requests int
+}
+
+func (n *mockNode) getSyncStatus() *ethpb.SyncStatus {
+	n.lock.Lock()
+	defer n.lock.Unlock()
+	n.requests++
+	return &ethpb.SyncStatus{Syncing: n.syncing}
+}
+
+func (n *mockNode) numRequests() int {
+	n.lock.Lock()
+	defer n.lock.Unlock()
+	return n.requests
+}
+
+var mockNodeServiceDesc = grpc.ServiceDesc{
+	ServiceName: "ethereum.eth.v1alpha1.Node",
+	HandlerType: (*interface{})(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetSyncStatus",
+			Handler: func(srv interface{}, ctx context.Context, dec func(interface{}) error, _ grpc.UnaryServerInterceptor) (interface{}, error) {
+				if err := dec(&ptypes.Empty{}); err != nil {
+					return nil, err
+				}
+				return srv.(*mockNode).getSyncStatus(), nil
+			},
+		},
+	},
+}
+
+func startMockNode(t *testing.T, node *mockNode) (string, func()) {
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	s := grpc.NewServer()
+	s.RegisterService(&mockNodeServiceDesc, node)
+	go func() {
+		if err := s.Serve(lis); err != nil {
+			t.Log(err)
+		}
+	}()
+	return lis.Addr().String(), s.Stop
+}
+
+func TestBeaconNodePool_FailsOverToHealthyNode(t *testing.T) {
+	primary := &mockNode{syncing: true}
+	secondary := &mockNode{}
+	primaryAddr, stopPrimary := startMockNode(t, primary)
+	defer stopPrimary()
+	secondaryAddr, stopSecondary := startMockNode(t, secondary)
+	defer stopSecondary()
+
+	ctx := context.Background()
+	p, err := newBeaconNodePool(ctx, []string{primaryAddr, secondaryAddr}, false, []grpc.DialOption{grpc.WithInsecure()}, nil, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer p.Close()
+
+	p.checkHealth(ctx)
+	if p.activeNode().endpoint != secondaryAddr {
+		t.Fatalf("Expected to fail over to %s, active node is %s", secondaryAddr, p.activeNode().endpoint)
+	}
+
+	// Requests made through the routing connection reach the active beacon node.
+	before := secondary.numRequests()
+	if _, err := ethpb.NewNodeClient(p.conn()).GetSyncStatus(ctx, &ptypes.Empty{}); err != nil {
+		t.Fatal(err)
+	}
+	if secondary.numRequests() != before+1 {
+		t.Error("Expected request to be sent to the active beacon node")
+	}
+
+	// The active beacon node is kept once the primary is healthy again.
+	primary.lock.Lock()
+	primary.syncing = false
+	primary.lock.Unlock()
+	p.checkHealth(ctx)
+	if p.activeNode().endpoint != secondaryAddr {
+		t.Errorf("Expected active node to remain %s, active node is %s", secondaryAddr, p.activeNode().endpoint)
+	}
+}
+
+func TestBeaconNodePool_FailsOverOnUnavailableNode(t *testing.T) {
+	primary := &mockNode{}
+	secondary := &mockNode{}
+	primaryAddr, stopPrimary := startMockNode(t, primary)
+	secondaryAddr, stopSecondary := startMockNode(t, secondary)
+	defer stopSecondary()
+
+	ctx := context.Background()
+	p, err := newBeaconNodePool(ctx, []string{primaryAddr, secondaryAddr}, false, []grpc.DialOption{grpc.WithInsecure()}, nil, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer p.Close()
+
+	stopPrimary()
+	if _, err := ethpb.NewNodeClient(p.conn()).GetSyncStatus(ctx, &ptypes.Empty{}); err != nil {
+		t.Fatalf("Expected request to be retried on the healthy beacon node, received %v", err)
+	}
+	if p.activeNode().endpoint != secondaryAddr {
+		t.Errorf("Expected to fail over to %s, active node is %s", secondaryAddr, p.activeNode().endpoint)
+	}
+	if secondary.numRequests() != 1 {
+		t.Errorf("Expected 1 request on the healthy beacon node, received %d", secondary.numRequests())
+	}
+}
+
+func TestDetachedContext_OutlivesCanceledContext(t *testing.T) {
+	ctx, cancel := context.WithCancel(metadata.AppendToOutgoingContext(context.Background(), "key", "value"))
+	detached := detachedContext(ctx)
+	cancel()
+
+	if detached.Err() != nil {
+		t.Errorf("Detached context should not be canceled, received %v", detached.Err())
+	}
+	md, ok := metadata.FromOutgoingContext(detached)
+	if !ok || len(md.Get("key")) != 1 || md.Get("key")[0] != "value" {
+		t.Errorf("Detached context should keep the outgoing metadata, received %v", md)
+	}
+}
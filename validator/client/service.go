@@ -3,7 +3,6 @@ package client
 import (
 	"context"
 
-	middleware "github.com/grpc-ecosystem/go-grpc-middleware"
 	grpc_opentracing "github.com/grpc-ecosystem/go-grpc-middleware/tracing/opentracing"
 	grpc_prometheus "github.com/grpc-ecosystem/go-grpc-prometheus"
 	"github.com/pkg/errors"
@@ -26,8 +25,9 @@ type ValidatorService struct {
 	cancel               context.CancelFunc
 	validator            Validator
 	graffiti             []byte
-	conn                 *grpc.ClientConn
-	endpoint             string
+	beaconNodes          *beaconNodePool
+	endpoints            []string
+	broadcast            bool
 	withCert             string
 	dataDir              string
 	keyManager           keymanager.KeyManager
@@ -36,7 +36,8 @@ type ValidatorService struct {
 
 // Config for the validator service.
 type Config struct {
-	Endpoint             string
+	Endpoints            []string
+	BroadcastToAllNodes  bool
 	DataDir              string
 	CertFlag             string
 	GraffitiFlag         string
@@ -51,7 +52,8 @@ func NewValidatorService(ctx context.Context, cfg *Config) (*ValidatorService, e
 	return &ValidatorService{
 		ctx:                  ctx,
 		cancel:               cancel,
-		endpoint:             cfg.Endpoint,
+		endpoints:            cfg.Endpoints,
+		broadcast:            cfg.BroadcastToAllNodes,
 		withCert:             cfg.CertFlag,
 		dataDir:              cfg.DataDir,
 		graffiti:             []byte(cfg.GraffitiFlag),
@@ -81,21 +83,26 @@ func (v *ValidatorService) Start() {
 			grpc.MaxCallRecvMsgSize(10 * 5 << 20), // 10Mb
 		),
 		grpc.WithStatsHandler(&ocgrpc.ClientHandler{}),
-		grpc.WithStreamInterceptor(middleware.ChainStreamClient(
-			grpc_opentracing.StreamClientInterceptor(),
-			grpc_prometheus.StreamClientInterceptor,
-		)),
-		grpc.WithUnaryInterceptor(middleware.ChainUnaryClient(
+	}
+	beaconNodes, err := newBeaconNodePool(
+		v.ctx,
+		v.endpoints,
+		v.broadcast,
+		opts,
+		[]grpc.UnaryClientInterceptor{
 			grpc_opentracing.UnaryClientInterceptor(),
 			grpc_prometheus.UnaryClientInterceptor,
-		)),
-	}
-	conn, err := grpc.DialContext(v.ctx, v.endpoint, opts...)
+		},
+		[]grpc.StreamClientInterceptor{
+			grpc_opentracing.StreamClientInterceptor(),
+			grpc_prometheus.StreamClientInterceptor,
+		},
+	)
 	if err != nil {
-		log.Errorf("Could not dial endpoint: %s, %v", v.endpoint, err)
+		log.Errorf("Could not dial beacon nodes: %v", err)
 		return
 	}
-	log.Info("Successfully started gRPC connection")
+	log.WithField("endpoints", v.endpoints).Info("Successfully started gRPC connection")
 
 	pubkeys, err := v.keyManager.FetchValidatingKeys()
 	if err != nil {
@@ -109,13 +116,14 @@ func (v *ValidatorService) Start() {
 		return
 	}
 
-	v.conn = conn
+	v.beaconNodes = beaconNodes
+	conn := beaconNodes.conn()
 	v.validator = &validator{
 		db:                   valDB,
-		validatorClient:      ethpb.NewBeaconNodeValidatorClient(v.conn),
-		beaconClient:         ethpb.NewBeaconChainClient(v.conn),
-		aggregatorClient:     pb.NewAggregatorServiceClient(v.conn),
-		node:                 ethpb.NewNodeClient(v.conn),
+		validatorClient:      ethpb.NewBeaconNodeValidatorClient(conn),
+		beaconClient:         ethpb.NewBeaconChainClient(conn),
+		aggregatorClient:     pb.NewAggregatorServiceClient(conn),
+		node:                 ethpb.NewNodeClient(conn),
 		keyManager:           v.keyManager,
 		graffiti:             v.graffiti,
 		logValidatorBalances: v.logValidatorBalances,
@@ -123,6 +131,7 @@ func (v *ValidatorService) Start() {
 		attLogs:              make(map[[32]byte]*attSubmitted),
 		pubKeyToID:           make(map[[48]byte]uint64),
 	}
+	go beaconNodes.run(v.ctx)
 	go run(v.ctx, v.validator)
 }
 
@@ -130,8 +139,8 @@ func (v *ValidatorService) Start() {
 func (v *ValidatorService) Stop() error {
 	v.cancel()
 	log.Info("Stopping service")
-	if v.conn != nil {
-		return v.conn.Close()
+	if v.beaconNodes != nil {
+		return v.beaconNodes.Close()
 	}
 	return nil
 }
@@ -140,7 +149,7 @@ func (v *ValidatorService) Stop() error {
 //
 // WIP - not done.
 func (v *ValidatorService) Status() error {
-	if v.conn == nil {
+	if v.beaconNodes == nil {
 		return errors.New("no connection to beacon RPC")
 	}
 	return nil
@@ -81,7 +81,7 @@ func TestLifecycle(t *testing.T) {
 	validatorService := &ValidatorService{
 		ctx:        ctx,
 		cancel:     cancel,
-		endpoint:   "merkle tries",
+		endpoints:  []string{"merkle tries"},
 		withCert:   "alice.crt",
 		keyManager: keymanager.NewDirect(nil),
 	}
@@ -100,7 +100,7 @@ func TestLifecycle_Insecure(t *testing.T) {
 	validatorService := &ValidatorService{
 		ctx:        ctx,
 		cancel:     cancel,
-		endpoint:   "merkle tries",
+		endpoints:  []string{"merkle tries"},
 		keyManager: keymanager.NewDirect(nil),
 	}
 	validatorService.Start()
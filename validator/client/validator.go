@@ -41,6 +41,8 @@ type validator struct {
 	attLogsLock          sync.Mutex
 	pubKeyToID           map[[48]byte]uint64
 	pubKeyToIDLock       sync.RWMutex
+	attesterHistoryLock  sync.Mutex
+	proposerHistoryLock  sync.Mutex
 }
 
 // Done cleans up the validator.
@@ -10,14 +10,22 @@ import (
 	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
 	"github.com/prysmaticlabs/go-bitfield"
 	"github.com/prysmaticlabs/go-ssz"
+	slashpb "github.com/prysmaticlabs/prysm/proto/slashing"
 	"github.com/prysmaticlabs/prysm/shared/bytesutil"
 	"github.com/prysmaticlabs/prysm/shared/hashutil"
 	"github.com/prysmaticlabs/prysm/shared/params"
 	"github.com/prysmaticlabs/prysm/shared/roughtime"
 	"github.com/prysmaticlabs/prysm/shared/slotutil"
+	"github.com/sirupsen/logrus"
 	"go.opencensus.io/trace"
 )
 
+var (
+	errAttestationDoubleVote     = errors.New("attestation is a double vote")
+	errAttestationSurroundVote   = errors.New("attestation is a surround vote")
+	errAttestationOutsideHistory = errors.New("attestation target is older than the attestation history")
+)
+
 // SubmitAttestation completes the validator client's attester responsibility at a given slot.
 // It fetches the latest beacon block head along with the latest canonical beacon state
 // information in order to sign the block and include information about the validator's
@@ -55,8 +63,23 @@ func (v *validator) SubmitAttestation(ctx context.Context, slot uint64, pubKey [
 		return
 	}
 
-	sig, err := v.signAtt(ctx, pubKey, data)
-	if err != nil {
+	if data.Source == nil || data.Target == nil {
+		log.Errorf("Received attestation data without source or target at slot %d", slot)
+		return
+	}
+
+	sig, err := v.signAttWithProtection(ctx, pubKey, data)
+	switch err {
+	case nil:
+	case errAttestationDoubleVote, errAttestationSurroundVote, errAttestationOutsideHistory:
+		log.WithFields(logrus.Fields{
+			"pubKey":      fmt.Sprintf("%#x", bytesutil.Trunc(pubKey[:])),
+			"slot":        slot,
+			"sourceEpoch": data.Source.Epoch,
+			"targetEpoch": data.Target.Epoch,
+		}).WithError(err).Error("Refusing to sign slashable attestation")
+		return
+	default:
 		log.Errorf("Could not sign attestation: %v", err)
 		return
 	}
@@ -162,6 +185,98 @@ func (v *validator) signAtt(ctx context.Context, pubKey [48]byte, data *ethpb.At
 	return sig.Marshal(), nil
 }
 
+// Given validator's public key, this signs the attestation data only if it is not slashable
+// with respect to the attestation history of the validator, which is updated with the signed
+// attestation before the signature is returned.
+func (v *validator) signAttWithProtection(ctx context.Context, pubKey [48]byte, data *ethpb.AttestationData) ([]byte, error) {
+	ctx, span := trace.StartSpan(ctx, "validator.signAttWithProtection")
+	defer span.End()
+
+	// The history is read, checked and written under a single lock so that two concurrent
+	// attestations for the same key cannot both pass the check.
+	v.attesterHistoryLock.Lock()
+	defer v.attesterHistoryLock.Unlock()
+
+	history, err := v.db.AttestationHistory(ctx, pubKey[:])
+	if err != nil {
+		return nil, fmt.Errorf("could not get attestation history from DB: %v", err)
+	}
+	if history == nil {
+		history = &slashpb.AttestationHistory{
+			TargetToAttestation: make(map[uint64]*slashpb.HistoricalAttestation),
+		}
+	}
+	if history.TargetToAttestation == nil {
+		history.TargetToAttestation = make(map[uint64]*slashpb.HistoricalAttestation)
+	}
+
+	root, err := ssz.HashTreeRoot(data)
+	if err != nil {
+		return nil, err
+	}
+	if err := isNewAttSlashable(history, data.Source.Epoch, data.Target.Epoch, root); err != nil {
+		return nil, err
+	}
+
+	sig, err := v.signAtt(ctx, pubKey, data)
+	if err != nil {
+		return nil, err
+	}
+
+	// The signature is only released once the history recording it is persisted, so that
+	// a crash cannot lead to signing a conflicting attestation after a restart.
+	markAttestationForTargetEpoch(history, data.Source.Epoch, data.Target.Epoch, root)
+	if err := v.db.SaveAttestationHistory(ctx, pubKey[:], history); err != nil {
+		return nil, fmt.Errorf("could not save attestation history to DB: %v", err)
+	}
+	return sig, nil
+}
+
+// isNewAttSlashable checks whether an attestation with the given source and target epochs and
+// signing root is a double vote or a surround vote with respect to the attestation history.
+// Targets which were pruned from the history are rejected, as they can no longer be checked.
+func isNewAttSlashable(history *slashpb.AttestationHistory, sourceEpoch uint64, targetEpoch uint64, signingRoot [32]byte) error {
+	wsPeriod := params.BeaconConfig().WeakSubjectivityPeriod
+	if history.LatestEpochWritten >= wsPeriod && targetEpoch <= history.LatestEpochWritten-wsPeriod {
+		return errAttestationOutsideHistory
+	}
+	for target, att := range history.TargetToAttestation {
+		if target == targetEpoch {
+			if !bytes.Equal(att.SigningRoot, signingRoot[:]) {
+				return errAttestationDoubleVote
+			}
+			continue
+		}
+		// Surrounding a previous attestation or being surrounded by it.
+		if (sourceEpoch < att.SourceEpoch && target < targetEpoch) ||
+			(att.SourceEpoch < sourceEpoch && targetEpoch < target) {
+			return errAttestationSurroundVote
+		}
+	}
+	return nil
+}
+
+// markAttestationForTargetEpoch records the attestation in the attestation history and prunes
+// the attestations whose target falls outside of the weak subjectivity period.
+func markAttestationForTargetEpoch(history *slashpb.AttestationHistory, sourceEpoch uint64, targetEpoch uint64, signingRoot [32]byte) {
+	history.TargetToAttestation[targetEpoch] = &slashpb.HistoricalAttestation{
+		SourceEpoch: sourceEpoch,
+		SigningRoot: signingRoot[:],
+	}
+	if targetEpoch > history.LatestEpochWritten {
+		history.LatestEpochWritten = targetEpoch
+	}
+	wsPeriod := params.BeaconConfig().WeakSubjectivityPeriod
+	if history.LatestEpochWritten < wsPeriod {
+		return
+	}
+	for target := range history.TargetToAttestation {
+		if target <= history.LatestEpochWritten-wsPeriod {
+			delete(history.TargetToAttestation, target)
+		}
+	}
+}
+
 // For logging, this saves the last submitted attester index to its attestation data. The purpose of this
 // is to enhance attesting logs to be readable when multiple validator keys ran in a single client.
 func (v *validator) saveAttesterIndexToData(data *ethpb.AttestationData, index uint64) error {
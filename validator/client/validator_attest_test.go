@@ -8,10 +8,12 @@ import (
 	"testing"
 	"time"
 
+	"github.com/gogo/protobuf/proto"
 	"github.com/golang/mock/gomock"
 	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
 	"github.com/prysmaticlabs/go-bitfield"
 	"github.com/prysmaticlabs/go-ssz"
+	slashpb "github.com/prysmaticlabs/prysm/proto/slashing"
 	"github.com/prysmaticlabs/prysm/shared/params"
 	"github.com/prysmaticlabs/prysm/shared/roughtime"
 	"github.com/prysmaticlabs/prysm/shared/testutil"
@@ -259,3 +261,152 @@ func TestWaitForSlotOneThird_WaitCorrectly(t *testing.T) {
 		t.Errorf("Wanted %d time for slot one-third but got %d", oneThird, currentTime)
 	}
 }
+
+func TestAttestToBlockHead_BlocksDoubleVote(t *testing.T) {
+	hook := logTest.NewGlobal()
+
+	validator, m, finish := setup(t)
+	defer finish()
+	validatorIndex := uint64(7)
+	committee := []uint64{0, 3, 4, 2, validatorIndex, 6, 8, 9, 10}
+	validator.duties = &ethpb.DutiesResponse{Duties: []*ethpb.DutiesResponse_Duty{
+		{
+			PublicKey:      validatorKey.PublicKey.Marshal(),
+			CommitteeIndex: 5,
+			Committee:      committee,
+		}}}
+	history := &slashpb.AttestationHistory{
+		TargetToAttestation: map[uint64]*slashpb.HistoricalAttestation{
+			4: {SourceEpoch: 2, SigningRoot: []byte("another attestation root")},
+		},
+		LatestEpochWritten: 4,
+	}
+	if err := validator.db.SaveAttestationHistory(context.Background(), validatorPubKey[:], history); err != nil {
+		t.Fatal(err)
+	}
+	m.validatorClient.EXPECT().GetAttestationData(
+		gomock.Any(), // ctx
+		gomock.AssignableToTypeOf(&ethpb.AttestationDataRequest{}),
+	).Return(&ethpb.AttestationData{
+		BeaconBlockRoot: []byte("A"),
+		Target:          &ethpb.Checkpoint{Root: []byte("B"), Epoch: 4},
+		Source:          &ethpb.Checkpoint{Root: []byte("C"), Epoch: 2},
+	}, nil)
+
+	m.validatorClient.EXPECT().DomainData(
+		gomock.Any(), // ctx
+		gomock.Any(), // epoch
+	).Times(0)
+
+	m.validatorClient.EXPECT().ProposeAttestation(
+		gomock.Any(), // ctx
+		gomock.AssignableToTypeOf(&ethpb.Attestation{}),
+	).Times(0)
+
+	validator.SubmitAttestation(context.Background(), 30, validatorPubKey)
+	testutil.AssertLogsContain(t, hook, "Refusing to sign slashable attestation")
+}
+
+func TestAttestToBlockHead_SavesAttestationHistory(t *testing.T) {
+	validator, m, finish := setup(t)
+	defer finish()
+	validatorIndex := uint64(7)
+	committee := []uint64{0, 3, 4, 2, validatorIndex, 6, 8, 9, 10}
+	validator.duties = &ethpb.DutiesResponse{Duties: []*ethpb.DutiesResponse_Duty{
+		{
+			PublicKey:      validatorKey.PublicKey.Marshal(),
+			CommitteeIndex: 5,
+			Committee:      committee,
+		}}}
+	data := &ethpb.AttestationData{
+		BeaconBlockRoot: []byte("A"),
+		Target:          &ethpb.Checkpoint{Root: []byte("B"), Epoch: 4},
+		Source:          &ethpb.Checkpoint{Root: []byte("C"), Epoch: 2},
+	}
+	m.validatorClient.EXPECT().GetAttestationData(
+		gomock.Any(), // ctx
+		gomock.AssignableToTypeOf(&ethpb.AttestationDataRequest{}),
+	).Return(data, nil)
+
+	m.validatorClient.EXPECT().DomainData(
+		gomock.Any(), // ctx
+		gomock.Any(), // epoch
+	).Return(&ethpb.DomainResponse{}, nil /*err*/)
+
+	m.validatorClient.EXPECT().ProposeAttestation(
+		gomock.Any(), // ctx
+		gomock.AssignableToTypeOf(&ethpb.Attestation{}),
+	).Return(&ethpb.AttestResponse{}, nil /* error */)
+
+	validator.SubmitAttestation(context.Background(), 30, validatorPubKey)
+
+	history, err := validator.db.AttestationHistory(context.Background(), validatorPubKey[:])
+	if err != nil {
+		t.Fatal(err)
+	}
+	root, err := ssz.HashTreeRoot(data)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := &slashpb.AttestationHistory{
+		TargetToAttestation: map[uint64]*slashpb.HistoricalAttestation{
+			4: {SourceEpoch: 2, SigningRoot: root[:]},
+		},
+		LatestEpochWritten: 4,
+	}
+	if !proto.Equal(history, want) {
+		t.Errorf("Wanted attestation history %v, received %v", want, history)
+	}
+}
+
+func TestIsNewAttSlashable(t *testing.T) {
+	rootA := [32]byte{'A'}
+	rootB := [32]byte{'B'}
+	history := &slashpb.AttestationHistory{
+		TargetToAttestation: make(map[uint64]*slashpb.HistoricalAttestation),
+	}
+	markAttestationForTargetEpoch(history, 2, 5, rootA)
+
+	tests := []struct {
+		name   string
+		source uint64
+		target uint64
+		root   [32]byte
+		want   error
+	}{
+		{name: "same attestation", source: 2, target: 5, root: rootA, want: nil},
+		{name: "double vote", source: 2, target: 5, root: rootB, want: errAttestationDoubleVote},
+		{name: "surrounding vote", source: 1, target: 6, root: rootB, want: errAttestationSurroundVote},
+		{name: "surrounded vote", source: 3, target: 4, root: rootB, want: errAttestationSurroundVote},
+		{name: "next attestation", source: 5, target: 6, root: rootB, want: nil},
+		{name: "earlier attestation", source: 1, target: 2, root: rootB, want: nil},
+	}
+	for _, tt := range tests {
+		if err := isNewAttSlashable(history, tt.source, tt.target, tt.root); err != tt.want {
+			t.Errorf("%s: wanted %v, received %v", tt.name, tt.want, err)
+		}
+	}
+}
+
+func TestMarkAttestationForTargetEpoch_PrunesHistory(t *testing.T) {
+	wsPeriod := params.BeaconConfig().WeakSubjectivityPeriod
+	history := &slashpb.AttestationHistory{
+		TargetToAttestation: make(map[uint64]*slashpb.HistoricalAttestation),
+	}
+	markAttestationForTargetEpoch(history, 0, 1, [32]byte{'A'})
+	markAttestationForTargetEpoch(history, 1, 2, [32]byte{'B'})
+	markAttestationForTargetEpoch(history, 2, wsPeriod+1, [32]byte{'C'})
+
+	if _, ok := history.TargetToAttestation[1]; ok {
+		t.Error("Expected attestation outside of the weak subjectivity period to be pruned")
+	}
+	if _, ok := history.TargetToAttestation[2]; !ok {
+		t.Error("Expected attestation inside of the weak subjectivity period to be kept")
+	}
+	if history.LatestEpochWritten != wsPeriod+1 {
+		t.Errorf("Wanted latest epoch written %d, received %d", wsPeriod+1, history.LatestEpochWritten)
+	}
+	if err := isNewAttSlashable(history, 0, 1, [32]byte{'D'}); err != errAttestationOutsideHistory {
+		t.Errorf("Wanted %v, received %v", errAttestationOutsideHistory, err)
+	}
+}
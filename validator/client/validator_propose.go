@@ -2,19 +2,28 @@ package client
 
 // Validator client proposer functions.
 import (
+	"bytes"
 	"context"
 	"encoding/binary"
 	"fmt"
 
 	"github.com/pkg/errors"
 	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/go-bitfield"
 	"github.com/prysmaticlabs/go-ssz"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+	slashpb "github.com/prysmaticlabs/prysm/proto/slashing"
 	"github.com/prysmaticlabs/prysm/shared/bytesutil"
 	"github.com/prysmaticlabs/prysm/shared/params"
 	"github.com/sirupsen/logrus"
 	"go.opencensus.io/trace"
 )
 
+var (
+	errProposalDoubleProposal = errors.New("block is a second proposal for the slot")
+	errProposalOutsideHistory = errors.New("block slot is older than the proposal history")
+)
+
 // ProposeBlock A new beacon block for a given slot. This method collects the
 // previous beacon block, any pending deposits, and ETH1 data from the beacon
 // chain node to construct the new block. The new block is then processed with
@@ -51,7 +60,15 @@ func (v *validator) ProposeBlock(ctx context.Context, slot uint64, pubKey [48]by
 	}
 
 	// Sign returned block from beacon node
-	sig, err := v.signBlock(ctx, pubKey, epoch, b)
+	sig, err := v.signBlockWithProtection(ctx, pubKey, epoch, b)
+	if err == errProposalDoubleProposal || err == errProposalOutsideHistory {
+		slashableProposalsRejected.Inc()
+		log.WithFields(logrus.Fields{
+			"slot":       b.Slot,
+			"parentRoot": fmt.Sprintf("%#x", bytesutil.Trunc(b.ParentRoot)),
+		}).WithError(err).Error("Refusing to sign slashable block proposed by beacon node")
+		return
+	}
 	if err != nil {
 		log.WithError(err).Error("Failed to sign block")
 		return
@@ -109,6 +126,91 @@ func (v *validator) signRandaoReveal(ctx context.Context, pubKey [48]byte, epoch
 	return randaoReveal.Marshal(), nil
 }
 
+// Sign block with proposer domain and private key, only if no other block was signed for the same
+// slot according to the proposal history of the validator. The block is recorded in the proposal
+// history before the signature is returned, so a block signed but not broadcast before a crash
+// can be signed again while a conflicting block cannot.
+func (v *validator) signBlockWithProtection(ctx context.Context, pubKey [48]byte, epoch uint64, b *ethpb.BeaconBlock) ([]byte, error) {
+	ctx, span := trace.StartSpan(ctx, "validator.signBlockWithProtection")
+	defer span.End()
+
+	v.proposerHistoryLock.Lock()
+	defer v.proposerHistoryLock.Unlock()
+
+	history, err := v.db.ProposalHistory(ctx, pubKey[:])
+	if err != nil {
+		return nil, errors.Wrap(err, "could not get proposal history from DB")
+	}
+	if history == nil {
+		history = &slashpb.ProposalHistory{}
+	}
+	root, err := ssz.HashTreeRoot(b)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not get signing root")
+	}
+	if err := isNewProposalSlashable(history, b.Slot, root); err != nil {
+		return nil, err
+	}
+
+	sig, err := v.signBlock(ctx, pubKey, epoch, b)
+	if err != nil {
+		return nil, err
+	}
+
+	markProposalForSlot(history, b.Slot, root)
+	if err := v.db.SaveProposalHistory(ctx, pubKey[:], history); err != nil {
+		return nil, errors.Wrap(err, "could not save proposal history to DB")
+	}
+	return sig, nil
+}
+
+// isNewProposalSlashable checks whether a block with the given signing root at the slot would be
+// a second proposal for the slot with respect to the proposal history. Slots which were pruned
+// from the history are rejected, as they can no longer be checked.
+func isNewProposalSlashable(history *slashpb.ProposalHistory, slot uint64, signingRoot [32]byte) error {
+	wsPeriod := params.BeaconConfig().WeakSubjectivityPeriod
+	epoch := helpers.SlotToEpoch(slot)
+	if history.LatestEpochWritten >= wsPeriod && epoch <= history.LatestEpochWritten-wsPeriod {
+		return errProposalOutsideHistory
+	}
+	if root, ok := history.SlotToSigningRoot[slot]; ok && !bytes.Equal(root, signingRoot[:]) {
+		return errProposalDoubleProposal
+	}
+	return nil
+}
+
+// markProposalForSlot records the signing root of the block proposed at the slot and marks its
+// epoch in the epoch bitlist, which is used as a ring buffer over the weak subjectivity period.
+// Slots outside of the weak subjectivity period are pruned.
+func markProposalForSlot(history *slashpb.ProposalHistory, slot uint64, signingRoot [32]byte) {
+	wsPeriod := params.BeaconConfig().WeakSubjectivityPeriod
+	epoch := helpers.SlotToEpoch(slot)
+	if history.EpochBits == nil || history.EpochBits.Len() != wsPeriod {
+		history.EpochBits = bitfield.NewBitlist(wsPeriod)
+	}
+	if epoch > history.LatestEpochWritten {
+		// Clear the bits of the skipped epochs, which still hold epochs of the previous period.
+		for e := history.LatestEpochWritten + 1; e < epoch && e <= history.LatestEpochWritten+wsPeriod; e++ {
+			history.EpochBits.SetBitAt(e%wsPeriod, false)
+		}
+		history.LatestEpochWritten = epoch
+	}
+	history.EpochBits.SetBitAt(epoch%wsPeriod, true)
+
+	if history.SlotToSigningRoot == nil {
+		history.SlotToSigningRoot = make(map[uint64][]byte)
+	}
+	history.SlotToSigningRoot[slot] = signingRoot[:]
+	if history.LatestEpochWritten < wsPeriod {
+		return
+	}
+	for s := range history.SlotToSigningRoot {
+		if helpers.SlotToEpoch(s) <= history.LatestEpochWritten-wsPeriod {
+			delete(history.SlotToSigningRoot, s)
+		}
+	}
+}
+
 // Sign block with proposer domain and private key.
 func (v *validator) signBlock(ctx context.Context, pubKey [48]byte, epoch uint64, b *ethpb.BeaconBlock) ([]byte, error) {
 	domain, err := v.validatorClient.DomainData(ctx, &ethpb.DomainRequest{
@@ -7,6 +7,8 @@ import (
 
 	"github.com/golang/mock/gomock"
 	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	slashpb "github.com/prysmaticlabs/prysm/proto/slashing"
+	"github.com/prysmaticlabs/prysm/shared/params"
 	"github.com/prysmaticlabs/prysm/shared/testutil"
 	"github.com/prysmaticlabs/prysm/validator/db"
 	"github.com/prysmaticlabs/prysm/validator/internal"
@@ -172,3 +174,96 @@ func TestProposeBlock_BroadcastsBlock_WithGraffiti(t *testing.T) {
 		t.Errorf("Block was broadcast with the wrong graffiti field, wanted \"%v\", got \"%v\"", string(validator.graffiti), string(sentBlock.Block.Body.Graffiti))
 	}
 }
+
+func TestProposeBlock_BlocksDoubleProposal(t *testing.T) {
+	hook := logTest.NewGlobal()
+	validator, m, finish := setup(t)
+	defer finish()
+
+	m.validatorClient.EXPECT().DomainData(
+		gomock.Any(), // ctx
+		gomock.Any(), //epoch
+	).Times(3).Return(&ethpb.DomainResponse{}, nil /*err*/)
+
+	m.validatorClient.EXPECT().GetBlock(
+		gomock.Any(), // ctx
+		gomock.Any(),
+	).Return(&ethpb.BeaconBlock{Slot: 5, Body: &ethpb.BeaconBlockBody{}}, nil /*err*/)
+	m.validatorClient.EXPECT().GetBlock(
+		gomock.Any(), // ctx
+		gomock.Any(),
+	).Return(&ethpb.BeaconBlock{Slot: 5, Body: &ethpb.BeaconBlockBody{Graffiti: []byte("other")}}, nil /*err*/)
+
+	m.validatorClient.EXPECT().ProposeBlock(
+		gomock.Any(), // ctx
+		gomock.AssignableToTypeOf(&ethpb.SignedBeaconBlock{}),
+	).Times(1).Return(&ethpb.ProposeResponse{}, nil /*error*/)
+
+	validator.ProposeBlock(context.Background(), 5, validatorPubKey)
+	testutil.AssertLogsDoNotContain(t, hook, "Refusing to sign slashable block")
+
+	validator.ProposeBlock(context.Background(), 5, validatorPubKey)
+	testutil.AssertLogsContain(t, hook, "Refusing to sign slashable block")
+}
+
+func TestProposeBlock_AllowsSameBlockAfterFailedBroadcast(t *testing.T) {
+	hook := logTest.NewGlobal()
+	validator, m, finish := setup(t)
+	defer finish()
+
+	m.validatorClient.EXPECT().DomainData(
+		gomock.Any(), // ctx
+		gomock.Any(), //epoch
+	).Times(4).Return(&ethpb.DomainResponse{}, nil /*err*/)
+
+	m.validatorClient.EXPECT().GetBlock(
+		gomock.Any(), // ctx
+		gomock.Any(),
+	).Times(2).Return(&ethpb.BeaconBlock{Slot: 5, Body: &ethpb.BeaconBlockBody{}}, nil /*err*/)
+
+	m.validatorClient.EXPECT().ProposeBlock(
+		gomock.Any(), // ctx
+		gomock.AssignableToTypeOf(&ethpb.SignedBeaconBlock{}),
+	).Return(nil /*response*/, errors.New("uh oh"))
+	m.validatorClient.EXPECT().ProposeBlock(
+		gomock.Any(), // ctx
+		gomock.AssignableToTypeOf(&ethpb.SignedBeaconBlock{}),
+	).Return(&ethpb.ProposeResponse{}, nil /*error*/)
+
+	validator.ProposeBlock(context.Background(), 5, validatorPubKey)
+	validator.ProposeBlock(context.Background(), 5, validatorPubKey)
+	testutil.AssertLogsDoNotContain(t, hook, "Refusing to sign slashable block")
+	testutil.AssertLogsContain(t, hook, "Submitted new block")
+}
+
+func TestMarkProposalForSlot_PrunesHistory(t *testing.T) {
+	wsPeriod := params.BeaconConfig().WeakSubjectivityPeriod
+	slotsPerEpoch := params.BeaconConfig().SlotsPerEpoch
+	history := &slashpb.ProposalHistory{}
+
+	markProposalForSlot(history, slotsPerEpoch, [32]byte{'A'})
+	markProposalForSlot(history, 2*slotsPerEpoch, [32]byte{'B'})
+	if err := isNewProposalSlashable(history, 2*slotsPerEpoch, [32]byte{'C'}); err != errProposalDoubleProposal {
+		t.Errorf("Wanted %v, received %v", errProposalDoubleProposal, err)
+	}
+	if err := isNewProposalSlashable(history, 2*slotsPerEpoch+1, [32]byte{'C'}); err != nil {
+		t.Errorf("Expected proposal at another slot of the same epoch to be allowed, received %v", err)
+	}
+
+	markProposalForSlot(history, (wsPeriod+1)*slotsPerEpoch, [32]byte{'C'})
+	if _, ok := history.SlotToSigningRoot[slotsPerEpoch]; ok {
+		t.Error("Expected proposal outside of the weak subjectivity period to be pruned")
+	}
+	if _, ok := history.SlotToSigningRoot[2*slotsPerEpoch]; !ok {
+		t.Error("Expected proposal inside of the weak subjectivity period to be kept")
+	}
+	if !history.EpochBits.BitAt(1) {
+		t.Error("Expected epoch bit of the latest proposal to be set")
+	}
+	if !history.EpochBits.BitAt(2) {
+		t.Error("Expected epoch bit of the proposal inside of the weak subjectivity period to be set")
+	}
+	if err := isNewProposalSlashable(history, slotsPerEpoch, [32]byte{'D'}); err != errProposalOutsideHistory {
+		t.Errorf("Wanted %v, received %v", errProposalOutsideHistory, err)
+	}
+}
@@ -0,0 +1,78 @@
+package db
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	slashpb "github.com/prysmaticlabs/prysm/proto/slashing"
+)
+
+func TestAttestationHistory_NilDB(t *testing.T) {
+	db := SetupDB(t, [][48]byte{})
+	defer TeardownDB(t, db)
+
+	balPubkey := []byte{1, 2, 3}
+
+	attestationHistory, err := db.AttestationHistory(context.Background(), balPubkey)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if attestationHistory != nil {
+		t.Fatalf("Expected attestation history to be nil, received: %v", attestationHistory)
+	}
+}
+
+func TestSaveAttestationHistory_OK(t *testing.T) {
+	db := SetupDB(t, [][48]byte{})
+	defer TeardownDB(t, db)
+
+	pubkey := []byte{3}
+	history := &slashpb.AttestationHistory{
+		TargetToAttestation: map[uint64]*slashpb.HistoricalAttestation{
+			1: {SourceEpoch: 0, SigningRoot: []byte("root1")},
+			2: {SourceEpoch: 1, SigningRoot: []byte("root2")},
+		},
+		LatestEpochWritten: 2,
+	}
+
+	if err := db.SaveAttestationHistory(context.Background(), pubkey, history); err != nil {
+		t.Fatalf("Saving attestation history failed: %v", err)
+	}
+	savedHistory, err := db.AttestationHistory(context.Background(), pubkey)
+	if err != nil {
+		t.Fatalf("Failed to get attestation history: %v", err)
+	}
+
+	if savedHistory == nil || !reflect.DeepEqual(history, savedHistory) {
+		t.Fatalf("Expected DB to keep object the same, received: %v", savedHistory)
+	}
+}
+
+func TestDeleteAttestationHistory_OK(t *testing.T) {
+	db := SetupDB(t, [][48]byte{})
+	defer TeardownDB(t, db)
+
+	pubkey := []byte{2}
+	history := &slashpb.AttestationHistory{
+		TargetToAttestation: map[uint64]*slashpb.HistoricalAttestation{
+			5: {SourceEpoch: 4, SigningRoot: []byte("root")},
+		},
+		LatestEpochWritten: 5,
+	}
+
+	if err := db.SaveAttestationHistory(context.Background(), pubkey, history); err != nil {
+		t.Fatalf("Save attestation history failed: %v", err)
+	}
+	if err := db.DeleteAttestationHistory(context.Background(), pubkey); err != nil {
+		t.Fatalf("Failed to delete attestation history: %v", err)
+	}
+	savedHistory, err := db.AttestationHistory(context.Background(), pubkey)
+	if err != nil {
+		t.Fatalf("Failed to get attestation history: %v", err)
+	}
+	if savedHistory != nil {
+		t.Fatalf("Expected attestation history to be nil, received: %v", savedHistory)
+	}
+}
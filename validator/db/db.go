@@ -87,6 +87,7 @@ func NewKVStore(dirPath string, pubkeys [][48]byte) (*Store, error) {
 		return createBuckets(
 			tx,
 			historicProposalsBucket,
+			historicAttestationsBucket,
 			validatorsMinMaxSpanBucket,
 		)
 	}); err != nil {
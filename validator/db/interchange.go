@@ -0,0 +1,325 @@
+package db
+
+import (
+	"bytes"
+	"context"
+	"encoding/hex"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/boltdb/bolt"
+	"github.com/gogo/protobuf/proto"
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/go-bitfield"
+	slashpb "github.com/prysmaticlabs/prysm/proto/slashing"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"go.opencensus.io/trace"
+)
+
+// InterchangeFormatVersion is the version of the slashing protection interchange format
+// written by ExportInterchange and accepted by ImportInterchange.
+const InterchangeFormatVersion = "1"
+
+// Interchange is the slashing protection history of a set of validator keys, in a JSON
+// format which can be moved between hosts or validator clients.
+type Interchange struct {
+	Metadata *InterchangeMetadata `json:"metadata"`
+	Data     []*InterchangeData   `json:"data"`
+}
+
+// InterchangeMetadata identifies the format version of the interchange and the chain,
+// by its genesis validators root, the history belongs to.
+type InterchangeMetadata struct {
+	InterchangeFormatVersion string `json:"interchange_format_version"`
+	GenesisValidatorsRoot    string `json:"genesis_validators_root"`
+}
+
+// InterchangeData is the slashing protection history of a single validator key.
+type InterchangeData struct {
+	PubKey             string               `json:"pubkey"`
+	LatestProposal     uint64               `json:"latest_proposal_epoch,string"`
+	LatestAttestation  uint64               `json:"latest_attestation_epoch,string"`
+	SignedBlocks       []*SignedBlock       `json:"signed_blocks"`
+	SignedAttestations []*SignedAttestation `json:"signed_attestations"`
+}
+
+// SignedBlock is a block proposal recorded in the slashing protection history.
+type SignedBlock struct {
+	Slot        uint64 `json:"slot,string"`
+	SigningRoot string `json:"signing_root"`
+}
+
+// SignedAttestation is an attestation recorded in the slashing protection history.
+type SignedAttestation struct {
+	SourceEpoch uint64 `json:"source_epoch,string"`
+	TargetEpoch uint64 `json:"target_epoch,string"`
+	SigningRoot string `json:"signing_root"`
+}
+
+// ExportInterchange returns the proposal and attestation history of every validator key in
+// the database, for the chain identified by the genesis validators root.
+func (db *Store) ExportInterchange(ctx context.Context, genesisValidatorsRoot []byte) (*Interchange, error) {
+	ctx, span := trace.StartSpan(ctx, "Validator.ExportInterchange")
+	defer span.End()
+
+	data := make(map[string]*InterchangeData)
+	dataForKey := func(pubKey []byte) *InterchangeData {
+		key := encodeHex(pubKey)
+		if _, ok := data[key]; !ok {
+			data[key] = &InterchangeData{
+				PubKey:             key,
+				SignedBlocks:       []*SignedBlock{},
+				SignedAttestations: []*SignedAttestation{},
+			}
+		}
+		return data[key]
+	}
+	err := db.view(func(tx *bolt.Tx) error {
+		if err := tx.Bucket(historicProposalsBucket).ForEach(func(k, v []byte) error {
+			history, err := unmarshalProposalHistory(v)
+			if err != nil {
+				return err
+			}
+			d := dataForKey(k)
+			d.LatestProposal = history.LatestEpochWritten
+			for slot, root := range history.SlotToSigningRoot {
+				d.SignedBlocks = append(d.SignedBlocks, &SignedBlock{
+					Slot:        slot,
+					SigningRoot: encodeHex(root),
+				})
+			}
+			sort.Slice(d.SignedBlocks, func(i, j int) bool {
+				return d.SignedBlocks[i].Slot < d.SignedBlocks[j].Slot
+			})
+			return nil
+		}); err != nil {
+			return err
+		}
+		return tx.Bucket(historicAttestationsBucket).ForEach(func(k, v []byte) error {
+			history, err := unmarshalAttestationHistory(v)
+			if err != nil {
+				return err
+			}
+			d := dataForKey(k)
+			d.LatestAttestation = history.LatestEpochWritten
+			for target, att := range history.TargetToAttestation {
+				d.SignedAttestations = append(d.SignedAttestations, &SignedAttestation{
+					SourceEpoch: att.SourceEpoch,
+					TargetEpoch: target,
+					SigningRoot: encodeHex(att.SigningRoot),
+				})
+			}
+			sort.Slice(d.SignedAttestations, func(i, j int) bool {
+				return d.SignedAttestations[i].TargetEpoch < d.SignedAttestations[j].TargetEpoch
+			})
+			return nil
+		})
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	interchange := &Interchange{
+		Metadata: &InterchangeMetadata{
+			InterchangeFormatVersion: InterchangeFormatVersion,
+			GenesisValidatorsRoot:    encodeHex(genesisValidatorsRoot),
+		},
+		Data: make([]*InterchangeData, 0, len(data)),
+	}
+	for _, d := range data {
+		interchange.Data = append(interchange.Data, d)
+	}
+	sort.Slice(interchange.Data, func(i, j int) bool {
+		return interchange.Data[i].PubKey < interchange.Data[j].PubKey
+	})
+	return interchange, nil
+}
+
+// ImportInterchange merges the history of the interchange into the proposal and attestation
+// history of the database. The merge is conservative: records are only ever added, and the
+// latest epoch written of each history is the maximum of the stored and imported ones. The
+// interchange is rejected if it does not belong to the chain of the genesis validators root.
+func (db *Store) ImportInterchange(ctx context.Context, interchange *Interchange, genesisValidatorsRoot []byte) error {
+	ctx, span := trace.StartSpan(ctx, "Validator.ImportInterchange")
+	defer span.End()
+
+	if interchange == nil || interchange.Metadata == nil {
+		return errors.New("interchange has no metadata")
+	}
+	if interchange.Metadata.InterchangeFormatVersion != InterchangeFormatVersion {
+		return fmt.Errorf("unsupported interchange format version %q, expected %q",
+			interchange.Metadata.InterchangeFormatVersion, InterchangeFormatVersion)
+	}
+	root, err := decodeHex(interchange.Metadata.GenesisValidatorsRoot, 32)
+	if err != nil {
+		return errors.Wrap(err, "invalid genesis validators root")
+	}
+	if !bytes.Equal(root, genesisValidatorsRoot) {
+		return fmt.Errorf("interchange genesis validators root %#x does not match %#x", root, genesisValidatorsRoot)
+	}
+
+	// Decode the whole interchange first, so that nothing is written if any of it is invalid.
+	proposals := make(map[[48]byte]*slashpb.ProposalHistory)
+	attestations := make(map[[48]byte]*slashpb.AttestationHistory)
+	for _, d := range interchange.Data {
+		enc, err := decodeHex(d.PubKey, 48)
+		if err != nil {
+			return errors.Wrap(err, "invalid public key")
+		}
+		var pubKey [48]byte
+		copy(pubKey[:], enc)
+		if _, ok := proposals[pubKey]; ok {
+			return fmt.Errorf("duplicate public key %s", d.PubKey)
+		}
+		proposalHistory := &slashpb.ProposalHistory{
+			LatestEpochWritten: d.LatestProposal,
+			SlotToSigningRoot:  make(map[uint64][]byte),
+		}
+		for _, blk := range d.SignedBlocks {
+			signingRoot, err := decodeHex(blk.SigningRoot, 32)
+			if err != nil {
+				return errors.Wrapf(err, "invalid block signing root for public key %s", d.PubKey)
+			}
+			proposalHistory.SlotToSigningRoot[blk.Slot] = signingRoot
+		}
+		attestationHistory := &slashpb.AttestationHistory{
+			LatestEpochWritten:  d.LatestAttestation,
+			TargetToAttestation: make(map[uint64]*slashpb.HistoricalAttestation),
+		}
+		for _, att := range d.SignedAttestations {
+			signingRoot, err := decodeHex(att.SigningRoot, 32)
+			if err != nil {
+				return errors.Wrapf(err, "invalid attestation signing root for public key %s", d.PubKey)
+			}
+			attestationHistory.TargetToAttestation[att.TargetEpoch] = &slashpb.HistoricalAttestation{
+				SourceEpoch: att.SourceEpoch,
+				SigningRoot: signingRoot,
+			}
+		}
+		proposals[pubKey] = proposalHistory
+		attestations[pubKey] = attestationHistory
+	}
+
+	return db.update(func(tx *bolt.Tx) error {
+		proposalsBucket := tx.Bucket(historicProposalsBucket)
+		attestationsBucket := tx.Bucket(historicAttestationsBucket)
+		for pubKey, imported := range proposals {
+			history := &slashpb.ProposalHistory{}
+			if enc := proposalsBucket.Get(pubKey[:]); enc != nil {
+				if history, err = unmarshalProposalHistory(enc); err != nil {
+					return err
+				}
+			}
+			mergeProposalHistory(history, imported)
+			enc, err := proto.Marshal(history)
+			if err != nil {
+				return errors.Wrap(err, "failed to encode proposal history")
+			}
+			if err := proposalsBucket.Put(pubKey[:], enc); err != nil {
+				return err
+			}
+		}
+		for pubKey, imported := range attestations {
+			history := &slashpb.AttestationHistory{}
+			if enc := attestationsBucket.Get(pubKey[:]); enc != nil {
+				if history, err = unmarshalAttestationHistory(enc); err != nil {
+					return err
+				}
+			}
+			mergeAttestationHistory(history, imported)
+			enc, err := proto.Marshal(history)
+			if err != nil {
+				return errors.Wrap(err, "failed to encode attestation history")
+			}
+			if err := attestationsBucket.Put(pubKey[:], enc); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+// mergeProposalHistory adds the imported proposals to the history. A stored proposal is kept
+// over an imported one for the same slot, as both prevent signing any other block at that slot.
+// The epochs of the merged proposals are marked in the epoch bitlist, whose bits for the epochs
+// skipped by a newer latest epoch written are cleared.
+func mergeProposalHistory(history *slashpb.ProposalHistory, imported *slashpb.ProposalHistory) {
+	wsPeriod := params.BeaconConfig().WeakSubjectivityPeriod
+	slotsPerEpoch := params.BeaconConfig().SlotsPerEpoch
+	if history.EpochBits == nil || history.EpochBits.Len() != wsPeriod {
+		history.EpochBits = bitfield.NewBitlist(wsPeriod)
+	}
+	if history.SlotToSigningRoot == nil {
+		history.SlotToSigningRoot = make(map[uint64][]byte)
+	}
+
+	latest := imported.LatestEpochWritten
+	for slot, root := range imported.SlotToSigningRoot {
+		if _, ok := history.SlotToSigningRoot[slot]; !ok {
+			history.SlotToSigningRoot[slot] = root
+		}
+		if slot/slotsPerEpoch > latest {
+			latest = slot / slotsPerEpoch
+		}
+	}
+	if latest > history.LatestEpochWritten {
+		for e := history.LatestEpochWritten + 1; e <= latest && e <= history.LatestEpochWritten+wsPeriod; e++ {
+			history.EpochBits.SetBitAt(e%wsPeriod, false)
+		}
+		history.LatestEpochWritten = latest
+	}
+
+	for slot := range history.SlotToSigningRoot {
+		epoch := slot / slotsPerEpoch
+		if history.LatestEpochWritten >= wsPeriod && epoch <= history.LatestEpochWritten-wsPeriod {
+			delete(history.SlotToSigningRoot, slot)
+			continue
+		}
+		history.EpochBits.SetBitAt(epoch%wsPeriod, true)
+	}
+}
+
+// mergeAttestationHistory adds the imported attestations to the history. A stored attestation
+// is kept over an imported one for the same target, as both prevent signing any other attestation
+// for that target.
+func mergeAttestationHistory(history *slashpb.AttestationHistory, imported *slashpb.AttestationHistory) {
+	if imported.LatestEpochWritten > history.LatestEpochWritten {
+		history.LatestEpochWritten = imported.LatestEpochWritten
+	}
+	if history.TargetToAttestation == nil {
+		history.TargetToAttestation = make(map[uint64]*slashpb.HistoricalAttestation)
+	}
+	for target, att := range imported.TargetToAttestation {
+		if _, ok := history.TargetToAttestation[target]; !ok {
+			history.TargetToAttestation[target] = att
+		}
+		if target > history.LatestEpochWritten {
+			history.LatestEpochWritten = target
+		}
+	}
+	wsPeriod := params.BeaconConfig().WeakSubjectivityPeriod
+	if history.LatestEpochWritten < wsPeriod {
+		return
+	}
+	for target := range history.TargetToAttestation {
+		if target <= history.LatestEpochWritten-wsPeriod {
+			delete(history.TargetToAttestation, target)
+		}
+	}
+}
+
+func encodeHex(b []byte) string {
+	return "0x" + hex.EncodeToString(b)
+}
+
+func decodeHex(s string, length int) ([]byte, error) {
+	b, err := hex.DecodeString(strings.TrimPrefix(s, "0x"))
+	if err != nil {
+		return nil, err
+	}
+	if len(b) != length {
+		return nil, fmt.Errorf("expected %d bytes, received %d", length, len(b))
+	}
+	return b, nil
+}
@@ -0,0 +1,148 @@
+package db
+
+import (
+	"context"
+	"encoding/json"
+	"reflect"
+	"testing"
+
+	"github.com/gogo/protobuf/proto"
+	slashpb "github.com/prysmaticlabs/prysm/proto/slashing"
+)
+
+func TestInterchange_ExportImport_RoundTrip(t *testing.T) {
+	pubKey := [48]byte{1}
+	genesisValidatorsRoot := make([]byte, 32)
+	genesisValidatorsRoot[0] = 'G'
+	ctx := context.Background()
+
+	src := SetupDB(t, [][48]byte{pubKey})
+	defer TeardownDB(t, src)
+	proposals := &slashpb.ProposalHistory{
+		SlotToSigningRoot:  map[uint64][]byte{70: make([]byte, 32)},
+		LatestEpochWritten: 1,
+	}
+	if err := src.SaveProposalHistory(ctx, pubKey[:], proposals); err != nil {
+		t.Fatal(err)
+	}
+	attestations := &slashpb.AttestationHistory{
+		TargetToAttestation: map[uint64]*slashpb.HistoricalAttestation{
+			2: {SourceEpoch: 1, SigningRoot: make([]byte, 32)},
+		},
+		LatestEpochWritten: 2,
+	}
+	if err := src.SaveAttestationHistory(ctx, pubKey[:], attestations); err != nil {
+		t.Fatal(err)
+	}
+
+	exported, err := src.ExportInterchange(ctx, genesisValidatorsRoot)
+	if err != nil {
+		t.Fatal(err)
+	}
+	enc, err := json.Marshal(exported)
+	if err != nil {
+		t.Fatal(err)
+	}
+	decoded := &Interchange{}
+	if err := json.Unmarshal(enc, decoded); err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(exported, decoded) {
+		t.Fatalf("Expected interchange to survive JSON encoding, wanted %v, received %v", exported, decoded)
+	}
+
+	dst := SetupDB(t, [][48]byte{})
+	defer TeardownDB(t, dst)
+	if err := dst.ImportInterchange(ctx, decoded, genesisValidatorsRoot); err != nil {
+		t.Fatal(err)
+	}
+	imported, err := dst.ProposalHistory(ctx, pubKey[:])
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(imported.SlotToSigningRoot, proposals.SlotToSigningRoot) || imported.LatestEpochWritten != 2 {
+		t.Errorf("Unexpected imported proposal history %v", imported)
+	}
+	if !imported.EpochBits.BitAt(2) {
+		t.Error("Expected epoch of the imported proposal to be marked")
+	}
+	importedAtts, err := dst.AttestationHistory(ctx, pubKey[:])
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !proto.Equal(importedAtts, attestations) {
+		t.Errorf("Wanted imported attestation history %v, received %v", attestations, importedAtts)
+	}
+}
+
+func TestInterchange_Import_KeepsMaximumWatermark(t *testing.T) {
+	pubKey := [48]byte{1}
+	genesisValidatorsRoot := make([]byte, 32)
+	ctx := context.Background()
+
+	db := SetupDB(t, [][48]byte{})
+	defer TeardownDB(t, db)
+	stored := &slashpb.AttestationHistory{
+		TargetToAttestation: map[uint64]*slashpb.HistoricalAttestation{
+			10: {SourceEpoch: 9, SigningRoot: []byte{'A'}},
+		},
+		LatestEpochWritten: 10,
+	}
+	if err := db.SaveAttestationHistory(ctx, pubKey[:], stored); err != nil {
+		t.Fatal(err)
+	}
+
+	interchange := &Interchange{
+		Metadata: &InterchangeMetadata{
+			InterchangeFormatVersion: InterchangeFormatVersion,
+			GenesisValidatorsRoot:    encodeHex(genesisValidatorsRoot),
+		},
+		Data: []*InterchangeData{
+			{
+				PubKey:            encodeHex(pubKey[:]),
+				LatestAttestation: 8,
+				SignedAttestations: []*SignedAttestation{
+					{SourceEpoch: 7, TargetEpoch: 8, SigningRoot: encodeHex(make([]byte, 32))},
+					{SourceEpoch: 8, TargetEpoch: 10, SigningRoot: encodeHex(make([]byte, 32))},
+				},
+			},
+		},
+	}
+	if err := db.ImportInterchange(ctx, interchange, genesisValidatorsRoot); err != nil {
+		t.Fatal(err)
+	}
+	merged, err := db.AttestationHistory(ctx, pubKey[:])
+	if err != nil {
+		t.Fatal(err)
+	}
+	if merged.LatestEpochWritten != 10 {
+		t.Errorf("Wanted latest epoch written %d, received %d", 10, merged.LatestEpochWritten)
+	}
+	if !proto.Equal(merged.TargetToAttestation[10], stored.TargetToAttestation[10]) {
+		t.Errorf("Expected stored attestation to be kept, received %v", merged.TargetToAttestation[10])
+	}
+	if _, ok := merged.TargetToAttestation[8]; !ok {
+		t.Error("Expected imported attestation to be added")
+	}
+}
+
+func TestInterchange_Import_RejectsOtherChain(t *testing.T) {
+	db := SetupDB(t, [][48]byte{})
+	defer TeardownDB(t, db)
+
+	interchange := &Interchange{
+		Metadata: &InterchangeMetadata{
+			InterchangeFormatVersion: InterchangeFormatVersion,
+			GenesisValidatorsRoot:    encodeHex(make([]byte, 32)),
+		},
+	}
+	otherRoot := make([]byte, 32)
+	otherRoot[0] = 1
+	if err := db.ImportInterchange(context.Background(), interchange, otherRoot); err == nil {
+		t.Error("Expected error importing interchange of another chain")
+	}
+	interchange.Metadata.InterchangeFormatVersion = "0"
+	if err := db.ImportInterchange(context.Background(), interchange, make([]byte, 32)); err == nil {
+		t.Error("Expected error importing interchange of unsupported version")
+	}
+}
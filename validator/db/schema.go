@@ -3,6 +3,8 @@ package db
 var (
 	// Validator slashing protection from double proposals.
 	historicProposalsBucket = []byte("proposal-history-bucket")
+	// Validator slashing protection from double votes and surround votes.
+	historicAttestationsBucket = []byte("attestation-history-bucket")
 	// In order to quickly detect surround and surrounded attestations we need to store
 	// the min and max span for each validator for each epoch.
 	// see https://github.com/protolambda/eth2-surround/blob/master/README.md#min-max-surround
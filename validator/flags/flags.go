@@ -16,12 +16,19 @@ var (
 		Name:  "no-custom-config",
 		Usage: "Run the beacon chain with the real parameters from phase 0.",
 	}
-	// BeaconRPCProviderFlag defines a beacon node RPC endpoint.
+	// BeaconRPCProviderFlag defines a beacon node RPC endpoint, or a comma separated list of beacon
+	// node RPC endpoints the validator fails over between.
 	BeaconRPCProviderFlag = cli.StringFlag{
 		Name:  "beacon-rpc-provider",
-		Usage: "Beacon node RPC provider endpoint",
+		Usage: "Beacon node RPC provider endpoint, or a comma separated list of endpoints in order of preference to fail over between",
 		Value: "localhost:4000",
 	}
+	// BroadcastToAllBeaconNodesFlag enables sending signed blocks and attestations to all the healthy
+	// beacon nodes rather than only to the active one.
+	BroadcastToAllBeaconNodesFlag = cli.BoolFlag{
+		Name:  "broadcast-to-all-beacon-nodes",
+		Usage: "Send signed blocks and attestations to all the healthy beacon nodes of the beacon-rpc-provider list",
+	}
 	// CertFlag defines a flag for the node's TLS certificate.
 	CertFlag = cli.StringFlag{
 		Name:  "tls-cert",
@@ -55,6 +62,39 @@ var (
 		Name:  "graffiti",
 		Usage: "String to include in proposed blocks",
 	}
+	// RemoteSignerFlag defines the address of a remote signer holding the validator keys, which is used
+	// instead of a local keystore.
+	RemoteSignerFlag = cli.StringFlag{
+		Name:  "remote-signer",
+		Usage: "gRPC endpoint of a remote signer to sign with instead of holding validator keys locally",
+	}
+	// RemoteSignerCACertFlag defines the certificate authority the remote signer certificate is verified against.
+	RemoteSignerCACertFlag = cli.StringFlag{
+		Name:  "remote-signer-ca-cert",
+		Usage: "Certificate authority the remote signer TLS certificate is verified against",
+	}
+	// RemoteSignerClientCertFlag defines the certificate the validator authenticates with to the remote signer.
+	RemoteSignerClientCertFlag = cli.StringFlag{
+		Name:  "remote-signer-client-cert",
+		Usage: "TLS certificate the validator client authenticates with to the remote signer",
+	}
+	// RemoteSignerClientKeyFlag defines the private key of the remote signer client certificate.
+	RemoteSignerClientKeyFlag = cli.StringFlag{
+		Name:  "remote-signer-client-key",
+		Usage: "Private key of the TLS certificate the validator client authenticates with to the remote signer",
+	}
+	// SlashingProtectionFileFlag defines the path of the slashing protection interchange file
+	// which is exported to or imported from.
+	SlashingProtectionFileFlag = cli.StringFlag{
+		Name:  "slashing-protection-file",
+		Usage: "Path to the JSON file the slashing protection history is exported to or imported from",
+	}
+	// GenesisValidatorsRootFlag defines the genesis validators root, as a hex string, identifying the
+	// chain of the slashing protection history which is exported or imported.
+	GenesisValidatorsRootFlag = cli.StringFlag{
+		Name:  "genesis-validators-root",
+		Usage: "Hex encoded genesis validators root of the chain the slashing protection history belongs to",
+	}
 )
 
 func homeDir() string {
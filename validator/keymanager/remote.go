@@ -0,0 +1,119 @@
+package keymanager
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"io/ioutil"
+	"time"
+
+	"github.com/pkg/errors"
+	pb "github.com/prysmaticlabs/prysm/proto/signer"
+	"github.com/prysmaticlabs/prysm/shared/bls"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/status"
+)
+
+// remoteTimeout is the time allowed for a request to the remote signer to complete.
+const remoteTimeout = 5 * time.Second
+
+// RemoteOpts are the options to connect to a remote signer over mutual TLS.
+type RemoteOpts struct {
+	// Endpoint is the address of the remote signer gRPC server.
+	Endpoint string
+	// CACertPath is the path of the certificate authority the signer certificate is verified against.
+	CACertPath string
+	// ClientCertPath is the path of the certificate presented by the validator to the signer.
+	ClientCertPath string
+	// ClientKeyPath is the path of the private key of the client certificate.
+	ClientKeyPath string
+}
+
+// Remote is a key manager that signs through a remote signer, so that secret keys are never
+// held by the validator.
+type Remote struct {
+	conn   *grpc.ClientConn
+	client pb.RemoteSignerClient
+}
+
+// NewRemote creates a key manager connected to the remote signer over mutual TLS.
+func NewRemote(opts *RemoteOpts) (*Remote, error) {
+	clientCert, err := tls.LoadX509KeyPair(opts.ClientCertPath, opts.ClientKeyPath)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not load client certificate")
+	}
+	caCert, err := ioutil.ReadFile(opts.CACertPath)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not read CA certificate")
+	}
+	caPool := x509.NewCertPool()
+	if !caPool.AppendCertsFromPEM(caCert) {
+		return nil, fmt.Errorf("no certificate found in %s", opts.CACertPath)
+	}
+	creds := credentials.NewTLS(&tls.Config{
+		Certificates: []tls.Certificate{clientCert},
+		RootCAs:      caPool,
+	})
+	conn, err := grpc.Dial(opts.Endpoint, grpc.WithTransportCredentials(creds))
+	if err != nil {
+		return nil, errors.Wrapf(err, "could not connect to remote signer at %s", opts.Endpoint)
+	}
+	log.WithField("endpoint", opts.Endpoint).Info("Using remote signer")
+	return newRemote(conn), nil
+}
+
+func newRemote(conn *grpc.ClientConn) *Remote {
+	return &Remote{
+		conn:   conn,
+		client: pb.NewRemoteSignerClient(conn),
+	}
+}
+
+// Close closes the connection to the remote signer.
+func (km *Remote) Close() error {
+	return km.conn.Close()
+}
+
+// FetchValidatingKeys fetches the list of public keys that should be used to validate with.
+func (km *Remote) FetchValidatingKeys() ([][48]byte, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), remoteTimeout)
+	defer cancel()
+	res, err := km.client.ListValidatingPublicKeys(ctx, &pb.ListPublicKeysRequest{})
+	if err != nil {
+		return nil, errors.Wrap(err, "could not list public keys of remote signer")
+	}
+	keys := make([][48]byte, 0, len(res.PublicKeys))
+	for _, key := range res.PublicKeys {
+		if len(key) != 48 {
+			return nil, fmt.Errorf("remote signer returned public key of %d bytes", len(key))
+		}
+		keys = append(keys, bytesutil.ToBytes48(key))
+	}
+	return keys, nil
+}
+
+// Sign signs a message for the validator to broadcast.
+func (km *Remote) Sign(pubKey [48]byte, root [32]byte, domain uint64) (*bls.Signature, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), remoteTimeout)
+	defer cancel()
+	res, err := km.client.Sign(ctx, &pb.SignRequest{
+		PublicKey:       pubKey[:],
+		SigningRoot:     root[:],
+		SignatureDomain: domain,
+	})
+	if err != nil {
+		if status.Code(err) == codes.NotFound {
+			return nil, ErrNoSuchKey
+		}
+		return nil, errors.Wrap(err, "could not sign with remote signer")
+	}
+	sig, err := bls.SignatureFromBytes(res.Signature)
+	if err != nil {
+		return nil, errors.Wrap(err, "remote signer returned invalid signature")
+	}
+	return sig, nil
+}
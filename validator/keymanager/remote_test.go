@@ -0,0 +1,105 @@
+package keymanager
+
+import (
+	"context"
+	"net"
+	"testing"
+
+	pb "github.com/prysmaticlabs/prysm/proto/signer"
+	"github.com/prysmaticlabs/prysm/shared/bls"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type mockSigner struct {
+	keyManager KeyManager
+}
+
+func (s *mockSigner) ListValidatingPublicKeys(_ context.Context, _ *pb.ListPublicKeysRequest) (*pb.ListPublicKeysResponse, error) {
+	keys, err := s.keyManager.FetchValidatingKeys()
+	if err != nil {
+		return nil, err
+	}
+	return &pb.ListPublicKeysResponse{PublicKeys: bytesutil.FromBytes48Array(keys)}, nil
+}
+
+func (s *mockSigner) Sign(_ context.Context, req *pb.SignRequest) (*pb.SignResponse, error) {
+	sig, err := s.keyManager.Sign(bytesutil.ToBytes48(req.PublicKey), bytesutil.ToBytes32(req.SigningRoot), req.SignatureDomain)
+	if err == ErrNoSuchKey {
+		return nil, status.Error(codes.NotFound, err.Error())
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &pb.SignResponse{Signature: sig.Marshal()}, nil
+}
+
+func setupRemote(t *testing.T, sks []*bls.SecretKey) (*Remote, func()) {
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	s := grpc.NewServer()
+	pb.RegisterRemoteSignerServer(s, &mockSigner{keyManager: NewDirect(sks)})
+	go func() {
+		if err := s.Serve(lis); err != nil {
+			t.Log(err)
+		}
+	}()
+	conn, err := grpc.Dial(lis.Addr().String(), grpc.WithInsecure())
+	if err != nil {
+		t.Fatal(err)
+	}
+	km := newRemote(conn)
+	return km, func() {
+		if err := km.Close(); err != nil {
+			t.Error(err)
+		}
+		s.Stop()
+	}
+}
+
+func TestRemote_FetchValidatingKeys(t *testing.T) {
+	sks := []*bls.SecretKey{bls.RandKey(), bls.RandKey()}
+	km, cleanup := setupRemote(t, sks)
+	defer cleanup()
+
+	keys, err := km.FetchValidatingKeys()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(keys) != len(sks) {
+		t.Fatalf("Incorrect number of keys returned; expected %d, received %d", len(sks), len(keys))
+	}
+	found := make(map[[48]byte]bool)
+	for _, key := range keys {
+		found[key] = true
+	}
+	for _, sk := range sks {
+		if !found[bytesutil.ToBytes48(sk.PublicKey().Marshal())] {
+			t.Errorf("Public key %#x not returned", sk.PublicKey().Marshal())
+		}
+	}
+}
+
+func TestRemote_Sign(t *testing.T) {
+	sk := bls.RandKey()
+	km, cleanup := setupRemote(t, []*bls.SecretKey{sk})
+	defer cleanup()
+
+	root := [32]byte{'A'}
+	domain := uint64(7)
+	sig, err := km.Sign(bytesutil.ToBytes48(sk.PublicKey().Marshal()), root, domain)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !sig.Verify(root[:], sk.PublicKey(), domain) {
+		t.Error("Remote signature did not verify")
+	}
+
+	if _, err := km.Sign([48]byte{}, root, domain); err != ErrNoSuchKey {
+		t.Errorf("Expected %v signing with unknown key, received %v", ErrNoSuchKey, err)
+	}
+}
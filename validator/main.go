@@ -36,12 +36,17 @@ func startNode(ctx *cli.Context) error {
 var appFlags = []cli.Flag{
 	flags.NoCustomConfigFlag,
 	flags.BeaconRPCProviderFlag,
+	flags.BroadcastToAllBeaconNodesFlag,
 	flags.CertFlag,
 	flags.GraffitiFlag,
 	flags.KeystorePathFlag,
 	flags.PasswordFlag,
 	flags.DisablePenaltyRewardLogFlag,
 	flags.UnencryptedKeysFlag,
+	flags.RemoteSignerFlag,
+	flags.RemoteSignerCACertFlag,
+	flags.RemoteSignerClientCertFlag,
+	flags.RemoteSignerClientKeyFlag,
 	flags.InteropStartIndex,
 	flags.InteropNumValidators,
 	cmd.VerbosityFlag,
@@ -112,6 +117,43 @@ contract in order to activate the validator client`,
 				},
 			},
 		},
+		{
+			Name:     "slashing-protection",
+			Category: "slashing-protection",
+			Usage:    "moves the validator's slashing protection history between hosts or validator clients",
+			Subcommands: cli.Commands{
+				cli.Command{
+					Name: "export",
+					Description: `exports the proposal and attestation history of all the validator keys in the
+validator database to a versioned JSON interchange file`,
+					Flags: []cli.Flag{
+						cmd.DataDirFlag,
+						flags.SlashingProtectionFileFlag,
+						flags.GenesisValidatorsRootFlag,
+					},
+					Action: func(ctx *cli.Context) {
+						if err := exportSlashingProtection(ctx); err != nil {
+							log.WithError(err).Fatal("Could not export slashing protection history")
+						}
+					},
+				},
+				cli.Command{
+					Name: "import",
+					Description: `imports the proposal and attestation history of a JSON interchange file into the
+validator database, merging it with the existing history without ever removing records`,
+					Flags: []cli.Flag{
+						cmd.DataDirFlag,
+						flags.SlashingProtectionFileFlag,
+						flags.GenesisValidatorsRootFlag,
+					},
+					Action: func(ctx *cli.Context) {
+						if err := importSlashingProtection(ctx); err != nil {
+							log.WithError(err).Fatal("Could not import slashing protection history")
+						}
+					},
+				},
+			},
+		},
 	}
 	app.Flags = appFlags
 
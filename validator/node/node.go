@@ -8,6 +8,7 @@ import (
 	"os"
 	"os/signal"
 	"path/filepath"
+	"strings"
 	"sync"
 	"syscall"
 
@@ -163,13 +164,20 @@ func (s *ValidatorClient) registerPrometheusService(ctx *cli.Context) error {
 }
 
 func (s *ValidatorClient) registerClientService(ctx *cli.Context, keyManager keymanager.KeyManager) error {
-	endpoint := ctx.GlobalString(flags.BeaconRPCProviderFlag.Name)
+	var endpoints []string
+	for _, endpoint := range strings.Split(ctx.GlobalString(flags.BeaconRPCProviderFlag.Name), ",") {
+		if endpoint = strings.TrimSpace(endpoint); endpoint != "" {
+			endpoints = append(endpoints, endpoint)
+		}
+	}
+	broadcast := ctx.GlobalBool(flags.BroadcastToAllBeaconNodesFlag.Name)
 	dataDir := ctx.GlobalString(cmd.DataDirFlag.Name)
 	logValidatorBalances := !ctx.GlobalBool(flags.DisablePenaltyRewardLogFlag.Name)
 	cert := ctx.GlobalString(flags.CertFlag.Name)
 	graffiti := ctx.GlobalString(flags.GraffitiFlag.Name)
 	v, err := client.NewValidatorService(context.Background(), &client.Config{
-		Endpoint:             endpoint,
+		Endpoints:            endpoints,
+		BroadcastToAllNodes:  broadcast,
 		DataDir:              dataDir,
 		KeyManager:           keyManager,
 		LogValidatorBalances: logValidatorBalances,
@@ -184,6 +192,15 @@ func (s *ValidatorClient) registerClientService(ctx *cli.Context, keyManager key
 
 // selectKeyManager selects the key manager depending on the options provided by the user.
 func selectKeyManager(ctx *cli.Context) (keymanager.KeyManager, error) {
+	if endpoint := ctx.GlobalString(flags.RemoteSignerFlag.Name); endpoint != "" {
+		// Sign through a remote signer, holding no keys locally.
+		return keymanager.NewRemote(&keymanager.RemoteOpts{
+			Endpoint:       endpoint,
+			CACertPath:     ctx.GlobalString(flags.RemoteSignerCACertFlag.Name),
+			ClientCertPath: ctx.GlobalString(flags.RemoteSignerClientCertFlag.Name),
+			ClientKeyPath:  ctx.GlobalString(flags.RemoteSignerClientKeyFlag.Name),
+		})
+	}
 	if unencryptedKeys := ctx.String(flags.UnencryptedKeysFlag.Name); unencryptedKeys != "" {
 		// Fetch keys from unencrypted store.
 		path, err := filepath.Abs(unencryptedKeys)
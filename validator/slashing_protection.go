@@ -0,0 +1,96 @@
+package main
+
+import (
+	"context"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"strings"
+
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/shared/cmd"
+	"github.com/prysmaticlabs/prysm/validator/db"
+	"github.com/prysmaticlabs/prysm/validator/flags"
+	"github.com/urfave/cli"
+)
+
+// exportSlashingProtection writes the slashing protection history of the validator db in the
+// data directory to an interchange file.
+func exportSlashingProtection(ctx *cli.Context) error {
+	genesisValidatorsRoot, err := genesisValidatorsRoot(ctx)
+	if err != nil {
+		return err
+	}
+	path := ctx.String(flags.SlashingProtectionFileFlag.Name)
+	if path == "" {
+		return fmt.Errorf("--%s is required", flags.SlashingProtectionFileFlag.Name)
+	}
+	valDB, err := db.NewKVStore(ctx.String(cmd.DataDirFlag.Name), nil)
+	if err != nil {
+		return errors.Wrap(err, "could not open validator db")
+	}
+	defer func() {
+		if err := valDB.Close(); err != nil {
+			log.WithError(err).Error("Could not close validator db")
+		}
+	}()
+
+	interchange, err := valDB.ExportInterchange(context.Background(), genesisValidatorsRoot)
+	if err != nil {
+		return errors.Wrap(err, "could not export slashing protection history")
+	}
+	enc, err := json.MarshalIndent(interchange, "", "  ")
+	if err != nil {
+		return err
+	}
+	if err := ioutil.WriteFile(path, enc, 0600); err != nil {
+		return errors.Wrapf(err, "could not write slashing protection history to %s", path)
+	}
+	log.WithField("numValidators", len(interchange.Data)).Infof("Exported slashing protection history to %s", path)
+	return nil
+}
+
+// importSlashingProtection merges the slashing protection history of an interchange file into
+// the validator db in the data directory.
+func importSlashingProtection(ctx *cli.Context) error {
+	genesisValidatorsRoot, err := genesisValidatorsRoot(ctx)
+	if err != nil {
+		return err
+	}
+	path := ctx.String(flags.SlashingProtectionFileFlag.Name)
+	if path == "" {
+		return fmt.Errorf("--%s is required", flags.SlashingProtectionFileFlag.Name)
+	}
+	enc, err := ioutil.ReadFile(path)
+	if err != nil {
+		return errors.Wrapf(err, "could not read slashing protection history from %s", path)
+	}
+	interchange := &db.Interchange{}
+	if err := json.Unmarshal(enc, interchange); err != nil {
+		return errors.Wrap(err, "could not decode slashing protection history")
+	}
+	valDB, err := db.NewKVStore(ctx.String(cmd.DataDirFlag.Name), nil)
+	if err != nil {
+		return errors.Wrap(err, "could not open validator db")
+	}
+	defer func() {
+		if err := valDB.Close(); err != nil {
+			log.WithError(err).Error("Could not close validator db")
+		}
+	}()
+
+	if err := valDB.ImportInterchange(context.Background(), interchange, genesisValidatorsRoot); err != nil {
+		return errors.Wrap(err, "could not import slashing protection history")
+	}
+	log.WithField("numValidators", len(interchange.Data)).Infof("Imported slashing protection history from %s", path)
+	return nil
+}
+
+func genesisValidatorsRoot(ctx *cli.Context) ([]byte, error) {
+	root, err := hex.DecodeString(strings.TrimPrefix(ctx.String(flags.GenesisValidatorsRootFlag.Name), "0x"))
+	if err != nil || len(root) != 32 {
+		return nil, fmt.Errorf("--%s must be a 32 byte hex string", flags.GenesisValidatorsRootFlag.Name)
+	}
+	return root, nil
+}
@@ -75,11 +75,16 @@ var appHelpFlagGroups = []flagGroup{
 		Flags: []cli.Flag{
 			flags.NoCustomConfigFlag,
 			flags.BeaconRPCProviderFlag,
+			flags.BroadcastToAllBeaconNodesFlag,
 			flags.CertFlag,
 			flags.KeystorePathFlag,
 			flags.PasswordFlag,
 			flags.DisablePenaltyRewardLogFlag,
 			flags.UnencryptedKeysFlag,
+			flags.RemoteSignerFlag,
+			flags.RemoteSignerCACertFlag,
+			flags.RemoteSignerClientCertFlag,
+			flags.RemoteSignerClientKeyFlag,
 			flags.GraffitiFlag,
 		},
 	},